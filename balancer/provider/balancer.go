@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,6 +17,7 @@ import (
 	"github.com/pg-sharding/spqr/pkg/models/tasks"
 	protos "github.com/pg-sharding/spqr/pkg/protos"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -22,6 +25,7 @@ import (
 type BalancerImpl struct {
 	coordinatorConn *grpc.ClientConn
 	threshold       []float64
+	metrics         MetricsSource
 
 	shardConns    *config.DatatransferConnections
 	dsToKeyRanges map[string][]*kr.KeyRange
@@ -45,10 +49,15 @@ func NewBalancer() (*BalancerImpl, error) {
 	if err != nil {
 		return nil, err
 	}
+	metrics, err := NewMetricsSource(conn)
+	if err != nil {
+		return nil, err
+	}
 	return &BalancerImpl{
 		shardConns:      shards,
 		coordinatorConn: conn,
 		threshold:       threshold,
+		metrics:         metrics,
 		dsToKeyRanges:   map[string][]*kr.KeyRange{},
 		dsToKrIdx:       map[string]map[string]int{},
 		shardKr:         map[string][]string{},
@@ -86,61 +95,67 @@ func (b *BalancerImpl) RunBalancer(ctx context.Context) {
 }
 
 func (b *BalancerImpl) generateTasks(ctx context.Context) (*tasks.TaskGroup, error) {
+	if err := b.updateKeyRanges(ctx); err != nil {
+		return nil, fmt.Errorf("error updating key range info: %s", err)
+	}
+	if err := b.metrics.Prepare(ctx); err != nil {
+		return nil, fmt.Errorf("error preparing metrics source: %s", err)
+	}
+
+	shardService := protos.NewShardServiceClient(b.coordinatorConn)
+	shardsResp, err := shardService.ListShards(ctx, &protos.ListShardsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing shards: %s", err)
+	}
+
 	shardToState := make(map[string]*ShardMetrics)
 	shardStates := make([]*ShardMetrics, 0)
-	for shardId, shard := range b.shardConns.ShardsData {
-		state, err := b.getShardCurrentState(ctx, shardId, shard)
+	for _, sh := range shardsResp.Shards {
+		shard, ok := b.shardConns.ShardConnect(sh.Id, sh.Hosts)
+		if !ok {
+			spqrlog.Zero.Warn().Str("shard id", sh.Id).Msg("no connection parameters for shard, skipping it")
+			continue
+		}
+		state, err := b.getShardCurrentState(ctx, sh.Id, shard)
 		if err != nil {
 			return nil, err
 		}
-		shardToState[shardId] = state
+		state.Draining = sh.Draining
+		shardToState[sh.Id] = state
 		shardStates = append(shardStates, state)
 	}
 
-	maxMetric, criterion := b.getCriterion(shardStates)
-	sort.Slice(shardStates, func(i, j int) bool {
-		return shardStates[i].MetricsTotal[criterion] > shardStates[j].MetricsTotal[criterion]
-	})
-
-	spqrlog.Zero.Debug().Float64("metric", maxMetric).Int("criterion", criterion).Msg("Max metric")
-
-	if maxMetric <= 1 {
-		spqrlog.Zero.Debug().Msg("Metrics below the threshold, exiting")
+	if maxMetric, _ := b.getCriterion(shardStates); maxMetric <= 1 {
+		spqrlog.Zero.Debug().Float64("metric", maxMetric).Msg("Metrics below the threshold, exiting")
 		return &tasks.TaskGroup{}, nil
 	}
 
-	if err := b.updateKeyRanges(ctx); err != nil {
-		return nil, fmt.Errorf("error updating key range info: %s", err)
+	for _, state := range shardStates {
+		if state.Master == "" {
+			continue
+		}
+		if err := b.getStatsByKeyRange(ctx, state); err != nil {
+			return nil, fmt.Errorf("error getting detailed stats: %s", err)
+		}
 	}
 
-	if err := b.getStatsByKeyRange(ctx, shardStates[0]); err != nil {
-		return nil, fmt.Errorf("error getting detailed stats: %s", err)
+	planner := &movePlanner{
+		threshold:  b.threshold,
+		maxMoves:   config.BalancerConfig().MaxMovesPerRun,
+		neighbours: b.getAdjacentKeyRanges,
 	}
-
-	// determine most loaded key range
-	shardFrom := shardStates[0]
-
-	kRLoad, krId := b.getMostLoadedKR(shardFrom, criterion)
-
-	meanKeyLoad := kRLoad / float64(shardFrom.KeyCountKR[krId])
-	keyCount := int((shardFrom.MetricsTotal[criterion] - b.threshold[criterion]) / meanKeyLoad)
-	// do not move more keys than there are in the key range
-	keyCount = min(keyCount, int(shardFrom.KeyCountKR[krId]))
-
-	// determine where to move keys to
-	shId, ok := b.getShardToMoveTo(shardStates, shardToState, krId, shardFrom.ShardId, keyCount)
-
-	if !ok {
-		shId, keyCount = b.moveMaxPossible(shardStates, shardToState, krId, shardFrom.ShardId)
-		if keyCount < 0 {
-			return nil, fmt.Errorf("could not find shard to move keys to")
+	group := &tasks.TaskGroup{Tasks: []*tasks.Task{}}
+	for _, move := range planner.plan(shardStates) {
+		moveGroup, err := b.getTasks(ctx, shardToState[move.ShardFromId], move.KrId, move.ShardToId, move.KeyCount)
+		if err != nil {
+			return nil, err
 		}
+		for _, task := range moveGroup.Tasks {
+			task.JoinType = moveGroup.JoinType
+		}
+		group.Tasks = append(group.Tasks, moveGroup.Tasks...)
 	}
-
-	if keyCount == 0 {
-		return &tasks.TaskGroup{Tasks: []*tasks.Task{}}, nil
-	}
-	return b.getTasks(ctx, shardFrom, krId, shId, keyCount)
+	return group, nil
 }
 
 func (b *BalancerImpl) getShardCurrentState(ctx context.Context, shardId string, shard *config.ShardConnect) (*ShardMetrics, error) {
@@ -150,7 +165,7 @@ func (b *BalancerImpl) getShardCurrentState(ctx context.Context, shardId string,
 	res.ShardId = shardId
 	replicaMetrics := NewHostMetrics()
 	for _, connString := range connStrings {
-		hostsMetrics, isMaster, err := b.getHostStatus(ctx, connString)
+		hostsMetrics, isMaster, err := b.getHostStatus(ctx, connString, shardId)
 		if err != nil {
 			return nil, err
 		}
@@ -172,7 +187,7 @@ func (b *BalancerImpl) getShardCurrentState(ctx context.Context, shardId string,
 	return res, nil
 }
 
-func (b *BalancerImpl) getHostStatus(ctx context.Context, dsn string) (metrics HostMetrics, isMaster bool, err error) {
+func (b *BalancerImpl) getHostStatus(ctx context.Context, dsn string, shardId string) (metrics HostMetrics, isMaster bool, err error) {
 	spqrlog.Zero.Debug().Str("host", dsn).Msg("getting host state")
 	conn, err := pgx.Connect(ctx, dsn)
 	if err != nil {
@@ -185,17 +200,16 @@ func (b *BalancerImpl) getHostStatus(ctx context.Context, dsn string) (metrics H
 		return nil, false, err
 	}
 
-	query := fmt.Sprintf(`
-	SELECT coalesce(SUM((user_time + system_time)), 0) AS cpu_total
-	FROM pgcs_get_stats_time_interval(now() - interval '%ds', now())
-`, config.BalancerConfig().StatIntervalSec)
-	spqrlog.Zero.Debug().Str("query", query).Msg("Getting cpu stats")
-	row = conn.QueryRow(ctx, query)
-	if err = row.Scan(&metrics[cpuMetric]); err != nil {
+	shardKrs := make([]*kr.KeyRange, 0, len(b.shardKr[shardId]))
+	for _, krId := range b.shardKr[shardId] {
+		ds := b.krToDs[krId]
+		shardKrs = append(shardKrs, b.dsToKeyRanges[ds][b.dsToKrIdx[ds][krId]])
+	}
+	if metrics[cpuMetric], err = b.metrics.HostCPU(ctx, conn, isMaster, shardKrs); err != nil {
 		return nil, isMaster, err
 	}
 
-	query = `SELECT SUM(pg_database_size(datname)) as total_size 
+	query := `SELECT SUM(pg_database_size(datname)) as total_size 
 			 FROM pg_database 
 				WHERE datname != 'template0' 
 				  AND datname != 'template1' 
@@ -218,12 +232,18 @@ func (b *BalancerImpl) getHostStatus(ctx context.Context, dsn string) (metrics H
 func (b *BalancerImpl) getStatsByKeyRange(ctx context.Context, shard *ShardMetrics) error {
 	spqrlog.Zero.Debug().Str("shard", shard.ShardId).Msg("getting shard detailed state")
 
+	scopes, err := b.getKeyRangeScopes(ctx, shard.ShardId)
+	if err != nil {
+		return err
+	}
+
 	type paramsStruct struct {
 		Host            string
+		IsMaster        bool
 		MetricsStartInd int
 	}
 	paramsList := []paramsStruct{
-		{Host: shard.Master, MetricsStartInd: 0},
+		{Host: shard.Master, IsMaster: true, MetricsStartInd: 0},
 	}
 	if shard.TargetReplica != "" {
 		paramsList = append(paramsList, paramsStruct{Host: shard.TargetReplica, MetricsStartInd: metricsCount})
@@ -234,30 +254,11 @@ func (b *BalancerImpl) getStatsByKeyRange(ctx context.Context, shard *ShardMetri
 		if err != nil {
 			return err
 		}
-		query := fmt.Sprintf(`
-		SELECT
-		    comment_keys->>'key_range_id' AS key_range_id,
-			SUM(user_time + system_time) AS cpu
-		FROM (
-		    SELECT * 
-		    FROM pgcs_get_stats_time_interval(now() - interval '%ds', now())
-		    WHERE comment_keys->>'key_range_id' IS NOT NULL        
-		) as pg_comment_stats
-		GROUP BY key_range_id;
-`, config.BalancerConfig().StatIntervalSec)
-		rows, err := conn.Query(ctx, query)
+		krsCPU, err := b.metrics.KeyRangesCPU(ctx, conn, params.IsMaster, scopes)
 		if err != nil {
 			return err
 		}
-		for rows.Next() {
-			krId := ""
-			cpu := 0.0
-			if err = rows.Scan(&krId, &cpu); err != nil {
-				return err
-			}
-			if _, ok := b.krToDs[krId]; !ok {
-				continue
-			}
+		for krId, cpu := range krsCPU {
 			if _, ok := shard.MetricsKR[krId]; !ok {
 				shard.MetricsKR[krId] = make([]float64, 2*metricsCount)
 			}
@@ -270,36 +271,18 @@ func (b *BalancerImpl) getStatsByKeyRange(ctx context.Context, shard *ShardMetri
 		return err
 	}
 
-	for _, krId := range b.shardKr[shard.ShardId] {
-		ds := b.krToDs[krId]
-		i := b.dsToKrIdx[ds][krId]
-		krg := b.dsToKeyRanges[ds][i]
-		if krg.ShardID != shard.ShardId {
-			continue
-		}
-		rels, err := b.getKRRelations(ctx, krg)
-		if err != nil {
-			return err
-		}
-
-		for _, rel := range rels {
+	for _, scope := range scopes {
+		krg := scope.KeyRange
+		for relName, condition := range scope.Conditions {
 			queryRaw := `
 				SELECT sum(pg_column_size(t.*)) as filesize, count(*) as filerow 
 				FROM %s as t
 				WHERE %s;
 `
-			var nextKR *kr.KeyRange
-			if i < len(b.dsToKeyRanges[ds])-1 {
-				nextKR = b.dsToKeyRanges[ds][i+1]
-			}
-			condition, err := b.getKRCondition(rel, krg, nextKR, "t")
-			if err != nil {
-				return err
-			}
-			query := fmt.Sprintf(queryRaw, rel.Name, condition)
+			query := fmt.Sprintf(queryRaw, quoteIdentifier(relName), condition.SQL)
 			spqrlog.Zero.Debug().Str("query", query).Msg("getting space usage & key count")
 
-			row := conn.QueryRow(ctx, query)
+			row := conn.QueryRow(ctx, query, condition.Args...)
 			var size, count int64
 			if err := row.Scan(&size, &count); err != nil {
 				return err
@@ -312,12 +295,47 @@ func (b *BalancerImpl) getStatsByKeyRange(ctx context.Context, shard *ShardMetri
 			if _, ok := shard.KeyCountRelKR[krg.ID]; !ok {
 				shard.KeyCountRelKR[krg.ID] = make(map[string]int64)
 			}
-			shard.KeyCountRelKR[krg.ID][rel.Name] = count
+			shard.KeyCountRelKR[krg.ID][relName] = count
 		}
 	}
 	return nil
 }
 
+// getKeyRangeScopes returns key ranges of the shard with conditions on their distributed relations
+func (b *BalancerImpl) getKeyRangeScopes(ctx context.Context, shardId string) ([]*KeyRangeScope, error) {
+	scopes := make([]*KeyRangeScope, 0, len(b.shardKr[shardId]))
+	for _, krId := range b.shardKr[shardId] {
+		ds := b.krToDs[krId]
+		i := b.dsToKrIdx[ds][krId]
+		krg := b.dsToKeyRanges[ds][i]
+		if krg.ShardID != shardId {
+			continue
+		}
+		rels, err := b.getKRRelations(ctx, krg)
+		if err != nil {
+			return nil, err
+		}
+
+		var nextKR *kr.KeyRange
+		if i < len(b.dsToKeyRanges[ds])-1 {
+			nextKR = b.dsToKeyRanges[ds][i+1]
+		}
+		scope := &KeyRangeScope{
+			KeyRange:   krg,
+			Conditions: make(map[string]*KeyRangeCondition, len(rels)),
+		}
+		for _, rel := range rels {
+			condition, err := b.getKRCondition(rel, krg, nextKR, "t")
+			if err != nil {
+				return nil, err
+			}
+			scope.Conditions[rel.Name] = condition
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
 func (b *BalancerImpl) getKRRelations(ctx context.Context, kRange *kr.KeyRange) ([]*distributions.DistributedRelation, error) {
 	distributionService := protos.NewDistributionServiceClient(b.coordinatorConn)
 	res, err := distributionService.GetDistribution(ctx, &protos.GetDistributionRequest{Id: kRange.Distribution})
@@ -331,107 +349,44 @@ func (b *BalancerImpl) getKRRelations(ctx context.Context, kRange *kr.KeyRange)
 	return rels, nil
 }
 
-// getKRCondition returns SQL condition for elements of distributed relation between two key ranges
+// getKRCondition returns SQL condition for elements of distributed relation between two key ranges.
+// Bounds of key ranges are passed as arguments of condition.
 // TODO support multidimensional key ranges
-func (b *BalancerImpl) getKRCondition(rel *distributions.DistributedRelation, kRange *kr.KeyRange, nextKR *kr.KeyRange, prefix string) (string, error) {
-	buf := make([]string, len(rel.DistributionKey))
+func (b *BalancerImpl) getKRCondition(rel *distributions.DistributedRelation, kRange *kr.KeyRange, nextKR *kr.KeyRange, prefix string) (*KeyRangeCondition, error) {
+	buf := make([]string, 0, 1)
+	var args []any
 	for i, entry := range rel.DistributionKey {
 		// TODO remove after multidimensional key range support
 		if i > 0 {
 			break
 		}
 		// TODO add hash (depends on col type)
-		hashedCol := ""
+		hashedCol := quoteIdentifier(entry.Column)
 		if prefix != "" {
-			hashedCol = fmt.Sprintf("%s.%s", prefix, entry.Column)
-		} else {
-			hashedCol = entry.Column
+			hashedCol = fmt.Sprintf("%s.%s", prefix, hashedCol)
 		}
+		args = append(args, string(kRange.LowerBound))
+		cond := fmt.Sprintf("%s >= $%d", hashedCol, len(args))
 		if nextKR != nil {
-			buf[i] = fmt.Sprintf("%s >= %s AND %s < %s", hashedCol, string(kRange.LowerBound), hashedCol, string(nextKR.LowerBound))
-		} else {
-			buf[i] = fmt.Sprintf("%s >= %s", hashedCol, string(kRange.LowerBound))
-		}
-	}
-	return strings.Join(buf, " AND "), nil
-}
-
-// getShardToMoveTo determines where to send keys from specified key range
-// TODO unit tests
-func (b *BalancerImpl) getShardToMoveTo(shardMetrics []*ShardMetrics, shardIdToMetrics map[string]*ShardMetrics, krId string, krShardId string, keyCountToMove int) (string, bool) {
-	krKeyCount := int(shardIdToMetrics[krShardId].KeyCountKR[krId])
-	shardToMetrics := shardIdToMetrics[krShardId].MetricsKR[krId]
-
-	// try fitting on shards with adjacent key ranges
-	adjShards := b.getAdjacentShards(krId)
-	for adjShard := range adjShards {
-		if b.fitsOnShard(shardToMetrics, keyCountToMove, krKeyCount, shardIdToMetrics[adjShard]) {
-			return adjShard, true
-		}
-	}
-	// try fitting on other shards ordered by criterion load ascending
-	for i := len(shardMetrics) - 1; i >= 0; i-- {
-		if b.fitsOnShard(shardToMetrics, keyCountToMove, krKeyCount, shardMetrics[i]) {
-			return shardMetrics[i].ShardId, true
+			args = append(args, string(nextKR.LowerBound))
+			cond += fmt.Sprintf(" AND %s < $%d", hashedCol, len(args))
 		}
+		buf = append(buf, cond)
 	}
-	return "", false
+	return &KeyRangeCondition{SQL: strings.Join(buf, " AND "), Args: args}, nil
 }
 
-// moveMaxPossible determines where most keys can be sent
-// TODO unit tests
-func (b *BalancerImpl) moveMaxPossible(shardMetrics []*ShardMetrics, shardIdToMetrics map[string]*ShardMetrics, krId string, krShardId string) (shardId string, maxKeyCount int) {
-	maxKeyCount = -1
-	for i := len(shardMetrics) - 1; i >= 0; i-- {
-		keyCount := b.maxFitOnShard(shardIdToMetrics[krShardId].MetricsKR[krId], shardIdToMetrics[krShardId].KeyCountKR[krId], shardMetrics[i])
-		if keyCount > maxKeyCount {
-			maxKeyCount = keyCount
-			shardId = shardMetrics[i].ShardId
-		}
-	}
-	return
-}
-
-// fitsOnShard
-// TODO unit tests
-func (b *BalancerImpl) fitsOnShard(krMetrics []float64, keyCountToMove int, krKeyCount int, shard *ShardMetrics) bool {
-	for kind, metric := range shard.MetricsTotal {
-		meanKeyMetric := krMetrics[kind] / float64(krKeyCount)
-		loadExpectation := meanKeyMetric*float64(keyCountToMove) + metric
-		if b.threshold[kind] < loadExpectation {
-			return false
-		}
-	}
-	return true
-}
-
-// maxFitOnShard determines how many keys we can fit on shard
-// TODO unit tests
-func (b *BalancerImpl) maxFitOnShard(krMetrics []float64, krKeyCount int64, shard *ShardMetrics) (maxCount int) {
-	maxCount = -1
-	for kind, metric := range shard.MetricsTotal {
-		// TODO move const to config
-		krMeanMetricKey := krMetrics[kind] / float64(krKeyCount)
-		count := int(0.8 * ((b.threshold[kind] - metric) / krMeanMetricKey))
-		if count > maxCount {
-			maxCount = count
-		}
-	}
-	return
-}
-
-func (b *BalancerImpl) getAdjacentShards(krId string) map[string]struct{} {
-	res := make(map[string]struct{}, 0)
+// getAdjacentKeyRanges returns key ranges, adjacent to the key range in its distribution
+func (b *BalancerImpl) getAdjacentKeyRanges(krId string) []*kr.KeyRange {
+	res := make([]*kr.KeyRange, 0, 2)
 	ds := b.krToDs[krId]
 	krIdx := b.dsToKrIdx[ds][krId]
 	if krIdx != 0 {
-		res[b.dsToKeyRanges[ds][krIdx-1].ShardID] = struct{}{}
+		res = append(res, b.dsToKeyRanges[ds][krIdx-1])
 	}
-	if krIdx < len(b.dsToKeyRanges)-1 {
-		res[b.dsToKeyRanges[ds][krIdx+1].ShardID] = struct{}{}
+	if krIdx < len(b.dsToKeyRanges[ds])-1 {
+		res = append(res, b.dsToKeyRanges[ds][krIdx+1])
 	}
-	// do not include current shard
-	delete(res, b.dsToKeyRanges[ds][krIdx].ShardID)
 	return res
 }
 
@@ -448,19 +403,6 @@ func (b *BalancerImpl) getCriterion(shards []*ShardMetrics) (value float64, kind
 	return
 }
 
-func (b *BalancerImpl) getMostLoadedKR(shard *ShardMetrics, kind int) (value float64, krId string) {
-	value = -1
-	for krg := range shard.MetricsKR {
-		metric := shard.MetricsKR[krg][kind]
-		totalKRMetric := metric
-		if totalKRMetric > value {
-			value = totalKRMetric
-			krId = krg
-		}
-	}
-	return
-}
-
 func (b *BalancerImpl) getTasks(ctx context.Context, shardFrom *ShardMetrics, krId string, shardToId string, keyCount int) (*tasks.TaskGroup, error) {
 	spqrlog.Zero.Debug().
 		Str("shard_from", shardFrom.ShardId).
@@ -586,14 +528,92 @@ func (b *BalancerImpl) removeTaskGroupFromQDB(ctx context.Context) error {
 	return err
 }
 
+// executeTasks executes chains of tasks of the group (moves of different key ranges) in parallel.
+// Number of concurrent moves with a shard as source or destination is limited by
+// MaxConcurrentMovesPerShard. Task group state is synced with QDB after every step.
 func (b *BalancerImpl) executeTasks(ctx context.Context, group *tasks.TaskGroup) error {
-
 	keyRangeService := protos.NewKeyRangeServiceClient(b.coordinatorConn)
 
+	chains := group.Chains()
+	mu := sync.Mutex{}
+	// update applies change to chains and syncs remaining tasks with QDB
+	update := func(change func()) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		change()
+		remaining := make([]*tasks.Task, 0, len(group.Tasks))
+		for _, chain := range chains {
+			remaining = append(remaining, chain...)
+		}
+		group.Tasks = remaining
+		// TODO mb retry?
+		return b.syncTaskGroupWithQDB(ctx, group)
+	}
+
+	shardSems := make(map[string]chan struct{})
+	for _, chain := range chains {
+		for _, task := range chain {
+			for _, shardId := range []string{task.ShardFromId, task.ShardToId} {
+				if _, ok := shardSems[shardId]; !ok {
+					shardSems[shardId] = make(chan struct{}, config.BalancerConfig().MaxConcurrentMovesPerShard)
+				}
+			}
+		}
+	}
+
+	eg := errgroup.Group{}
+	for i := range chains {
+		i := i
+		eg.Go(func() error {
+			mu.Lock()
+			shardIds := chainShards(chains[i])
+			mu.Unlock()
+
+			for _, shardId := range shardIds {
+				select {
+				case shardSems[shardId] <- struct{}{}:
+					defer func(shardId string) { <-shardSems[shardId] }(shardId)
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			return b.executeChain(ctx, keyRangeService, &chains[i], &mu, update)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	// TODO mb retry?
+	return b.removeTaskGroupFromQDB(ctx)
+}
+
+// chainShards returns sorted shards, touched by any task of the chain. Semaphores of all
+// of them are held while the chain is executed, and are acquired in the same order
+// by all chains to avoid deadlocks.
+func chainShards(chain []*tasks.Task) []string {
+	shardIds := make([]string, 0, 2*len(chain))
+	for _, task := range chain {
+		shardIds = append(shardIds, task.ShardFromId, task.ShardToId)
+	}
+	sort.Strings(shardIds)
+	return slices.Compact(shardIds)
+}
+
+// executeChain executes tasks of one key range move one by one
+func (b *BalancerImpl) executeChain(ctx context.Context, keyRangeService protos.KeyRangeServiceClient, chain *[]*tasks.Task, mu *sync.Mutex, update func(func()) error) error {
 	id := uuid.New()
 
-	for len(group.Tasks) > 0 {
-		task := group.Tasks[len(group.Tasks)-1]
+	for {
+		mu.Lock()
+		if len(*chain) == 0 {
+			mu.Unlock()
+			return nil
+		}
+		task := *(*chain)[len(*chain)-1]
+		mu.Unlock()
+
 		spqrlog.Zero.Debug().
 			Str("key_range_from", task.KrIdFrom).
 			Str("key_range_to", task.KrIdTo).
@@ -609,18 +629,18 @@ func (b *BalancerImpl) executeTasks(ctx context.Context, group *tasks.TaskGroup)
 				NewId:     newKeyRange,
 				SourceId:  task.KrIdFrom,
 				Bound:     task.Bound,
-				SplitLeft: group.JoinType == tasks.JoinLeft,
+				SplitLeft: task.JoinType == tasks.JoinLeft,
 			}); err != nil {
 				return err
 			}
 
-			task.KrIdTemp = newKeyRange
-			task.State = tasks.TaskSplit
-			if err := b.syncTaskGroupWithQDB(ctx, group); err != nil {
-				// TODO mb retry?
+			if err := update(func() {
+				t := (*chain)[len(*chain)-1]
+				t.KrIdTemp = newKeyRange
+				t.State = tasks.TaskSplit
+			}); err != nil {
 				return err
 			}
-			continue
 		case tasks.TaskSplit:
 			// TODO account for join type
 			if _, err := keyRangeService.MoveKeyRange(ctx, &protos.MoveKeyRangeRequest{
@@ -629,14 +649,13 @@ func (b *BalancerImpl) executeTasks(ctx context.Context, group *tasks.TaskGroup)
 			}); err != nil {
 				return err
 			}
-			task.State = tasks.TaskMoved
-			if err := b.syncTaskGroupWithQDB(ctx, group); err != nil {
-				// TODO mb retry?
+			if err := update(func() {
+				(*chain)[len(*chain)-1].State = tasks.TaskMoved
+			}); err != nil {
 				return err
 			}
-			continue
 		case tasks.TaskMoved:
-			if group.JoinType != tasks.JoinNone {
+			if task.JoinType != tasks.JoinNone {
 				if _, err := keyRangeService.MergeKeyRange(ctx, &protos.MergeKeyRangeRequest{
 					BaseId:      task.KrIdTo,
 					AppendageId: task.KrIdTemp,
@@ -644,25 +663,23 @@ func (b *BalancerImpl) executeTasks(ctx context.Context, group *tasks.TaskGroup)
 					return err
 				}
 			} else {
-				for _, otherTask := range group.Tasks {
-					otherTask.KrIdTo = task.KrIdTemp
-				}
-				group.JoinType = tasks.JoinRight
 				id = uuid.New()
 			}
-			group.Tasks = group.Tasks[:len(group.Tasks)-1]
-			if err := b.syncTaskGroupWithQDB(ctx, group); err != nil {
-				// TODO mb retry?
+			if err := update(func() {
+				*chain = (*chain)[:len(*chain)-1]
+				if task.JoinType == tasks.JoinNone {
+					for _, otherTask := range *chain {
+						otherTask.KrIdTo = task.KrIdTemp
+						otherTask.JoinType = tasks.JoinRight
+					}
+				}
+			}); err != nil {
 				return err
 			}
-			continue
 		default:
 			return fmt.Errorf("unknown task state %d", task.State)
 		}
 	}
-
-	// TODO mb retry?
-	return b.removeTaskGroupFromQDB(ctx)
 }
 
 func (b *BalancerImpl) updateKeyRanges(ctx context.Context) error {
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/pg-sharding/spqr/pkg/models/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainShards(t *testing.T) {
+	assert := assert.New(t)
+
+	/* chain moves parts of one key range to several shards */
+	chain := []*tasks.Task{
+		{ShardFromId: "sh3", ShardToId: "sh1", KrIdFrom: "kr1"},
+		{ShardFromId: "sh3", ShardToId: "sh4", KrIdFrom: "kr1"},
+		{ShardFromId: "sh3", ShardToId: "sh2", KrIdFrom: "kr1"},
+	}
+	assert.Equal([]string{"sh1", "sh2", "sh3", "sh4"}, chainShards(chain))
+
+	assert.Equal([]string{"sh1"}, chainShards([]*tasks.Task{{ShardFromId: "sh1", ShardToId: "sh1"}}))
+}
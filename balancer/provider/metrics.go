@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	protos "github.com/pg-sharding/spqr/pkg/protos"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"google.golang.org/grpc"
+)
+
+// KeyRangeCondition is SQL condition on alias "t", selecting rows of the key range.
+// Bounds of the key range are passed as query arguments.
+type KeyRangeCondition struct {
+	SQL  string
+	Args []any
+}
+
+// KeyRangeScope describes rows of the key range stored on a shard
+type KeyRangeScope struct {
+	KeyRange *kr.KeyRange
+	// Conditions maps relation name to condition, selecting rows of the key range
+	Conditions map[string]*KeyRangeCondition
+}
+
+// quoteIdentifier quotes possibly schema qualified name, given unquoted,
+// so it is folded to lower case, as PostgreSQL does
+func quoteIdentifier(name string) string {
+	return pgx.Identifier(strings.Split(strings.ToLower(name), ".")).Sanitize()
+}
+
+// MetricsSource provides cpu load of shard hosts.
+// Load is measured per config.Balancer StatIntervalSec.
+type MetricsSource interface {
+	// Prepare is called once per balancer run, before any metrics are collected
+	Prepare(ctx context.Context) error
+	// HostCPU returns load of the host, which stores shardKrs
+	HostCPU(ctx context.Context, conn *pgx.Conn, isMaster bool, shardKrs []*kr.KeyRange) (float64, error)
+	// KeyRangesCPU returns load of the host by key range
+	KeyRangesCPU(ctx context.Context, conn *pgx.Conn, isMaster bool, scopes []*KeyRangeScope) (map[string]float64, error)
+}
+
+func NewMetricsSource(coordinatorConn *grpc.ClientConn) (MetricsSource, error) {
+	switch config.BalancerConfig().MetricsSource {
+	case config.MetricsSourcePgCommentStats, "":
+		return &pgCommentStatsSource{}, nil
+	case config.MetricsSourcePgStatStatements:
+		return &pgStatStatementsSource{}, nil
+	case config.MetricsSourceRouter:
+		return &routerMetricsSource{coordinatorConn: coordinatorConn}, nil
+	default:
+		return nil, fmt.Errorf("unknown balancer metrics source \"%s\"", config.BalancerConfig().MetricsSource)
+	}
+}
+
+// pgCommentStatsSource collects cpu time with pg_comment_stats extension.
+// Queries have to be marked with key_range_id comment.
+type pgCommentStatsSource struct{}
+
+var _ MetricsSource = &pgCommentStatsSource{}
+
+func (s *pgCommentStatsSource) Prepare(context.Context) error {
+	return nil
+}
+
+func (s *pgCommentStatsSource) HostCPU(ctx context.Context, conn *pgx.Conn, _ bool, _ []*kr.KeyRange) (float64, error) {
+	query := fmt.Sprintf(`
+	SELECT coalesce(SUM((user_time + system_time)), 0) AS cpu_total
+	FROM pgcs_get_stats_time_interval(now() - interval '%ds', now())
+`, config.BalancerConfig().StatIntervalSec)
+	spqrlog.Zero.Debug().Str("query", query).Msg("Getting cpu stats")
+	var cpu float64
+	if err := conn.QueryRow(ctx, query).Scan(&cpu); err != nil {
+		return 0, err
+	}
+	return cpu, nil
+}
+
+func (s *pgCommentStatsSource) KeyRangesCPU(ctx context.Context, conn *pgx.Conn, _ bool, scopes []*KeyRangeScope) (map[string]float64, error) {
+	query := fmt.Sprintf(`
+		SELECT
+		    comment_keys->>'key_range_id' AS key_range_id,
+			SUM(user_time + system_time) AS cpu
+		FROM (
+		    SELECT *
+		    FROM pgcs_get_stats_time_interval(now() - interval '%ds', now())
+		    WHERE comment_keys->>'key_range_id' IS NOT NULL
+		) as pg_comment_stats
+		GROUP BY key_range_id;
+`, config.BalancerConfig().StatIntervalSec)
+	rows, err := conn.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	known := make(map[string]struct{}, len(scopes))
+	for _, scope := range scopes {
+		known[scope.KeyRange.ID] = struct{}{}
+	}
+	res := make(map[string]float64)
+	for rows.Next() {
+		krId := ""
+		cpu := 0.0
+		if err = rows.Scan(&krId, &cpu); err != nil {
+			return nil, err
+		}
+		if _, ok := known[krId]; !ok {
+			continue
+		}
+		res[krId] = cpu
+	}
+	return res, rows.Err()
+}
+
+// pgStatStatementsSource estimates load with stock PostgreSQL statistics.
+// Host load is total execution time from pg_stat_statements, averaged over the time since
+// statistics reset. If pg_stat_statements is not installed, active time of databases
+// from pg_stat_database is used instead. It is distributed between key ranges proportionally to tuple activity
+// of relations from pg_stat_user_tables and to the share of relation rows belonging to
+// the key range, estimated with TABLESAMPLE.
+type pgStatStatementsSource struct{}
+
+var _ MetricsSource = &pgStatStatementsSource{}
+
+func (s *pgStatStatementsSource) Prepare(context.Context) error {
+	return nil
+}
+
+const pgStatStatementsCPUQuery = `
+	SELECT coalesce((SELECT SUM(total_exec_time) FROM pg_stat_statements), 0) / 1000
+		/ greatest(extract(epoch FROM now() - stats_reset), 1) * %d AS cpu_total
+	FROM pg_stat_statements_info
+`
+
+// pgStatDatabaseCPUQuery uses time spent executing statements, which
+// is counted without pg_stat_statements since PostgreSQL 14
+const pgStatDatabaseCPUQuery = `
+	SELECT coalesce(SUM(active_time / 1000
+		/ greatest(extract(epoch FROM now() - coalesce(stats_reset, pg_postmaster_start_time())), 1)), 0) * %d AS cpu_total
+	FROM pg_stat_database
+`
+
+// pgStatStatementsInstalled reports if pg_stat_statements extension is installed on the host
+func pgStatStatementsInstalled(ctx context.Context, conn *pgx.Conn) (bool, error) {
+	var installed bool
+	err := conn.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_stat_statements')`).Scan(&installed)
+	return installed, err
+}
+
+func (s *pgStatStatementsSource) HostCPU(ctx context.Context, conn *pgx.Conn, _ bool, _ []*kr.KeyRange) (float64, error) {
+	installed, err := pgStatStatementsInstalled(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	queryRaw := pgStatStatementsCPUQuery
+	if !installed {
+		spqrlog.Zero.Warn().Msg("pg_stat_statements is not installed, using active time from pg_stat_database")
+		queryRaw = pgStatDatabaseCPUQuery
+	}
+	query := fmt.Sprintf(queryRaw, config.BalancerConfig().StatIntervalSec)
+	spqrlog.Zero.Debug().Str("query", query).Msg("Getting cpu stats")
+	var cpu float64
+	if err := conn.QueryRow(ctx, query).Scan(&cpu); err != nil {
+		return 0, err
+	}
+	return cpu, nil
+}
+
+const tupleActivityExpr = `coalesce(seq_tup_read, 0) + coalesce(idx_tup_fetch, 0) + n_tup_ins + n_tup_upd + n_tup_del`
+
+func (s *pgStatStatementsSource) KeyRangesCPU(ctx context.Context, conn *pgx.Conn, isMaster bool, scopes []*KeyRangeScope) (map[string]float64, error) {
+	res := make(map[string]float64)
+	if len(scopes) == 0 {
+		return res, nil
+	}
+	hostCPU, err := s.HostCPU(ctx, conn, isMaster, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalActivity float64
+	query := fmt.Sprintf(`SELECT coalesce(SUM(%s), 0) FROM pg_stat_user_tables`, tupleActivityExpr)
+	if err := conn.QueryRow(ctx, query).Scan(&totalActivity); err != nil {
+		return nil, err
+	}
+	if totalActivity == 0 {
+		return res, nil
+	}
+
+	relActivity := make(map[string]float64)
+	for _, scope := range scopes {
+		load := 0.0
+		for relName, condition := range scope.Conditions {
+			activity, ok := relActivity[relName]
+			if !ok {
+				query := fmt.Sprintf(`SELECT coalesce(SUM(%s), 0) FROM pg_stat_user_tables WHERE relid = to_regclass($1)`, tupleActivityExpr)
+				if err := conn.QueryRow(ctx, query, relName).Scan(&activity); err != nil {
+					return nil, err
+				}
+				relActivity[relName] = activity
+			}
+			if activity == 0 {
+				continue
+			}
+			share, err := s.keyRangeShare(ctx, conn, relName, condition)
+			if err != nil {
+				return nil, err
+			}
+			load += activity * share
+		}
+		res[scope.KeyRange.ID] = hostCPU * load / totalActivity
+	}
+	return res, nil
+}
+
+// keyRangeShare estimates the fraction of relation rows satisfying condition
+func (s *pgStatStatementsSource) keyRangeShare(ctx context.Context, conn *pgx.Conn, relName string, condition *KeyRangeCondition) (float64, error) {
+	queryRaw := `
+		SELECT count(*) FILTER (WHERE %s), count(*)
+		FROM %s as t %s;
+`
+	var matched, total int64
+	sample := fmt.Sprintf("TABLESAMPLE SYSTEM (%f)", config.BalancerConfig().SamplePercent)
+	for _, sampleClause := range []string{sample, ""} {
+		query := fmt.Sprintf(queryRaw, condition.SQL, quoteIdentifier(relName), sampleClause)
+		spqrlog.Zero.Debug().Str("query", query).Msg("getting key range share")
+		if err := conn.QueryRow(ctx, query, condition.Args...).Scan(&matched, &total); err != nil {
+			return 0, err
+		}
+		// sample of a small relation may be empty, count all rows then
+		if total > 0 {
+			break
+		}
+	}
+	if total == 0 {
+		return 0, nil
+	}
+	return float64(matched) / float64(total), nil
+}
+
+// routerMetricsSource uses number of queries, routed to key ranges by routers.
+// Load is assigned to shard masters and measured in queries per stat interval.
+type routerMetricsSource struct {
+	coordinatorConn *grpc.ClientConn
+	counts          map[string]uint64
+}
+
+var _ MetricsSource = &routerMetricsSource{}
+
+func (s *routerMetricsSource) Prepare(ctx context.Context) error {
+	keyRangeService := protos.NewKeyRangeServiceClient(s.coordinatorConn)
+	resp, err := keyRangeService.GetKeyRangeStats(ctx, &protos.GetKeyRangeStatsRequest{
+		IntervalSec: int64(config.BalancerConfig().StatIntervalSec),
+	})
+	if err != nil {
+		return err
+	}
+	s.counts = kr.QueryCountsFromProto(resp.Stats)
+	return nil
+}
+
+func (s *routerMetricsSource) HostCPU(_ context.Context, _ *pgx.Conn, isMaster bool, shardKrs []*kr.KeyRange) (float64, error) {
+	if !isMaster {
+		return 0, nil
+	}
+	var total float64
+	for _, krg := range shardKrs {
+		total += float64(s.counts[krg.ID])
+	}
+	return total, nil
+}
+
+func (s *routerMetricsSource) KeyRangesCPU(_ context.Context, _ *pgx.Conn, isMaster bool, scopes []*KeyRangeScope) (map[string]float64, error) {
+	res := make(map[string]float64)
+	if !isMaster {
+		return res, nil
+	}
+	for _, scope := range scopes {
+		res[scope.KeyRange.ID] = float64(s.counts[scope.KeyRange.ID])
+	}
+	return res, nil
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/models/distributions"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMetricsSource(t *testing.T) {
+	assert := assert.New(t)
+
+	prev := config.BalancerConfig().MetricsSource
+	defer func() { config.BalancerConfig().MetricsSource = prev }()
+
+	for source, expected := range map[config.BalancerMetricsSource]MetricsSource{
+		"":                                   &pgCommentStatsSource{},
+		config.MetricsSourcePgCommentStats:   &pgCommentStatsSource{},
+		config.MetricsSourcePgStatStatements: &pgStatStatementsSource{},
+		config.MetricsSourceRouter:           &routerMetricsSource{},
+	} {
+		config.BalancerConfig().MetricsSource = source
+		s, err := NewMetricsSource(nil)
+		assert.NoError(err)
+		assert.IsType(expected, s, string(source))
+	}
+
+	config.BalancerConfig().MetricsSource = "unknown"
+	_, err := NewMetricsSource(nil)
+	assert.Error(err)
+}
+
+func TestRouterMetricsSource(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := &routerMetricsSource{counts: map[string]uint64{"kr1": 10, "kr2": 5, "kr3": 7}}
+	krs := []*kr.KeyRange{{ID: "kr1"}, {ID: "kr2"}, {ID: "kr4"}}
+	scopes := []*KeyRangeScope{{KeyRange: krs[0]}, {KeyRange: krs[1]}, {KeyRange: krs[2]}}
+
+	cpu, err := s.HostCPU(ctx, nil, true, krs)
+	assert.NoError(err)
+	assert.Equal(15.0, cpu)
+
+	krCpu, err := s.KeyRangesCPU(ctx, nil, true, scopes)
+	assert.NoError(err)
+	assert.Equal(map[string]float64{"kr1": 10, "kr2": 5, "kr4": 0}, krCpu)
+
+	// load is assigned to masters only
+	cpu, err = s.HostCPU(ctx, nil, false, krs)
+	assert.NoError(err)
+	assert.Equal(0.0, cpu)
+
+	krCpu, err = s.KeyRangesCPU(ctx, nil, false, scopes)
+	assert.NoError(err)
+	assert.Empty(krCpu)
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(`"orders"`, quoteIdentifier("Orders"))
+	assert.Equal(`"public"."orders"`, quoteIdentifier("public.orders"))
+	assert.Equal(`"x"" or true --"`, quoteIdentifier(`x" or true --`))
+}
+
+func TestKeyRangeCondition(t *testing.T) {
+	assert := assert.New(t)
+
+	b := &BalancerImpl{}
+	rel := &distributions.DistributedRelation{
+		Name:            "orders",
+		DistributionKey: []distributions.DistributionKeyEntry{{Column: "id"}},
+	}
+	krg := &kr.KeyRange{ID: "kr1", LowerBound: []byte("10")}
+
+	cond, err := b.getKRCondition(rel, krg, &kr.KeyRange{ID: "kr2", LowerBound: []byte("20")}, "t")
+	assert.NoError(err)
+	assert.Equal(`t."id" >= $1 AND t."id" < $2`, cond.SQL)
+	assert.Equal([]any{"10", "20"}, cond.Args)
+
+	/* the last key range is not bounded */
+	cond, err = b.getKRCondition(rel, krg, nil, "")
+	assert.NoError(err)
+	assert.Equal(`"id" >= $1`, cond.SQL)
+	assert.Equal([]any{"10"}, cond.Args)
+}
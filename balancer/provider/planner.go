@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+)
+
+// plannedMove describes move of KeyCount keys of the key range to another shard
+type plannedMove struct {
+	KrId        string
+	ShardFromId string
+	ShardToId   string
+	KeyCount    int
+}
+
+// movePlanner greedily plans key moves between all shards at once,
+// decreasing the maximal relative load of shards across all metrics.
+type movePlanner struct {
+	threshold []float64
+	maxMoves  int
+	// neighbours returns key ranges adjacent to the key range in its distribution
+	neighbours func(krId string) []*kr.KeyRange
+}
+
+type plannerShard struct {
+	metrics *ShardMetrics
+	total   []float64
+	// exhausted shards have no key ranges, which can be moved
+	exhausted bool
+}
+
+func (p *movePlanner) relative(sh *plannerShard) (float64, int) {
+	return MaxRelative(sh.total, p.threshold)
+}
+
+// level returns the load of metric kind, which shards are balanced to:
+// the mean load if it is below threshold and the threshold otherwise.
+// Load of draining shards is spread between other shards.
+func (p *movePlanner) level(shards []*plannerShard, kind int) float64 {
+	sum := 0.0
+	count := 0
+	for _, sh := range shards {
+		sum += sh.total[kind]
+		if !sh.metrics.Draining {
+			count++
+		}
+	}
+	if count == 0 {
+		return p.threshold[kind]
+	}
+	return math.Min(sum/float64(count), p.threshold[kind])
+}
+
+// plan returns moves, which bring loads of all shards to the mean load
+// or at least below the threshold. Each key range is moved at most once, key ranges
+// adjacent to moved ones are not moved, so that moves can be executed in parallel.
+// TODO support moves to several destination shards from one key range
+func (p *movePlanner) plan(metrics []*ShardMetrics) []*plannedMove {
+	moves := make([]*plannedMove, 0)
+	if len(metrics) < 2 {
+		return moves
+	}
+
+	shards := make([]*plannerShard, len(metrics))
+	for i, m := range metrics {
+		shards[i] = &plannerShard{
+			metrics: m,
+			total:   append([]float64{}, m.MetricsTotal...),
+		}
+	}
+	busyKrs := make(map[string]struct{})
+
+	maxLoad := -1.0
+	for _, sh := range shards {
+		if v, _ := p.relative(sh); v > maxLoad {
+			maxLoad = v
+		}
+	}
+	spqrlog.Zero.Debug().Float64("metric", maxLoad).Msg("Max metric")
+	if maxLoad <= 1 {
+		spqrlog.Zero.Debug().Msg("Metrics below the threshold, exiting")
+		return moves
+	}
+
+	for len(moves) < p.maxMoves {
+		// the most loaded shard, which still has something to move
+		var src *plannerShard
+		srcLoad, kind := -1.0, -1
+		for _, sh := range shards {
+			if sh.exhausted {
+				continue
+			}
+			if v, k := p.relative(sh); v > srcLoad {
+				src, srcLoad, kind = sh, v, k
+			}
+		}
+		if src == nil {
+			break
+		}
+		level := p.level(shards, kind)
+		if src.total[kind] <= level {
+			break
+		}
+
+		move := p.planFromShard(shards, src, kind, level, busyKrs)
+		if move == nil {
+			src.exhausted = true
+			continue
+		}
+		spqrlog.Zero.Debug().
+			Str("key_range", move.KrId).
+			Str("shard_from", move.ShardFromId).
+			Str("shard_to", move.ShardToId).
+			Int("key_count", move.KeyCount).
+			Msg("planned move")
+		moves = append(moves, move)
+	}
+	return moves
+}
+
+// planFromShard finds the most loaded movable key range of src and the shard to move its keys to
+func (p *movePlanner) planFromShard(shards []*plannerShard, src *plannerShard, kind int, level float64, busyKrs map[string]struct{}) *plannedMove {
+	srcLoad, _ := p.relative(src)
+	krIds := make([]string, 0, len(src.metrics.MetricsKR))
+	for krId, m := range src.metrics.MetricsKR {
+		if _, ok := busyKrs[krId]; ok {
+			continue
+		}
+		if src.metrics.KeyCountKR[krId] <= 0 || m[kind] <= 0 {
+			continue
+		}
+		krIds = append(krIds, krId)
+	}
+	sort.Slice(krIds, func(i, j int) bool {
+		return src.metrics.MetricsKR[krIds[i]][kind] > src.metrics.MetricsKR[krIds[j]][kind]
+	})
+
+	dsts := make([]*plannerShard, 0, len(shards)-1)
+	for _, sh := range shards {
+		if sh.metrics.Draining {
+			continue
+		}
+		// moving keys to a shard as loaded as src does not decrease the maximal load
+		if v, _ := p.relative(sh); sh != src && v < srcLoad {
+			dsts = append(dsts, sh)
+		}
+	}
+	sort.SliceStable(dsts, func(i, j int) bool {
+		vi, _ := p.relative(dsts[i])
+		vj, _ := p.relative(dsts[j])
+		return vi < vj
+	})
+
+	for _, krId := range krIds {
+		krMetrics := src.metrics.MetricsKR[krId]
+		krKeyCount := src.metrics.KeyCountKR[krId]
+		meanKeyLoad := krMetrics[kind] / float64(krKeyCount)
+		needed := int(math.Min(float64(krKeyCount), (src.total[kind]-level)/meanKeyLoad))
+		if needed <= 0 {
+			continue
+		}
+
+		adjacent := make(map[string]struct{})
+		for _, n := range p.neighbours(krId) {
+			adjacent[n.ShardID] = struct{}{}
+		}
+
+		var dst *plannerShard
+		keyCount := 0
+		for _, sh := range dsts {
+			count := min(needed, p.maxFit(krMetrics, krKeyCount, sh, kind, level))
+			if _, ok := adjacent[sh.metrics.ShardId]; ok && count == needed {
+				// moving to shard with adjacent key range allows to merge key ranges
+				dst, keyCount = sh, count
+				break
+			}
+			if count > keyCount {
+				dst, keyCount = sh, count
+			}
+		}
+		if dst == nil || keyCount <= 0 {
+			continue
+		}
+
+		for i := range src.total {
+			delta := krMetrics[i] / float64(krKeyCount) * float64(keyCount)
+			src.total[i] -= delta
+			dst.total[i] += delta
+		}
+		busyKrs[krId] = struct{}{}
+		for _, n := range p.neighbours(krId) {
+			busyKrs[n.ID] = struct{}{}
+		}
+		return &plannedMove{
+			KrId:        krId,
+			ShardFromId: src.metrics.ShardId,
+			ShardToId:   dst.metrics.ShardId,
+			KeyCount:    keyCount,
+		}
+	}
+	return nil
+}
+
+// maxFit returns how many keys of the key range can be moved to the shard, so that its load
+// of the criterion kind stays below level and loads of other kinds stay below threshold
+func (p *movePlanner) maxFit(krMetrics []float64, krKeyCount int64, sh *plannerShard, kind int, level float64) int {
+	res := math.MaxInt
+	for i, metric := range sh.total {
+		meanKeyLoad := krMetrics[i] / float64(krKeyCount)
+		if meanKeyLoad <= 0 {
+			continue
+		}
+		limit := p.threshold[i]
+		if i == kind {
+			limit = level
+		}
+		res = min(res, int((limit-metric)/meanKeyLoad))
+	}
+	return res
+}
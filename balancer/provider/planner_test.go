@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestShard(id string, krs map[string][]float64, keyCount int64) *ShardMetrics {
+	m := NewShardMetrics()
+	m.ShardId = id
+	for krId, metrics := range krs {
+		krMetrics := make([]float64, 2*metricsCount)
+		copy(krMetrics, metrics)
+		m.MetricsKR[krId] = krMetrics
+		m.KeyCountKR[krId] = keyCount
+		for i, v := range krMetrics {
+			m.MetricsTotal[i] += v
+		}
+	}
+	return m
+}
+
+func noNeighbours(string) []*kr.KeyRange {
+	return nil
+}
+
+func TestPlannerBelowThreshold(t *testing.T) {
+	assert := assert.New(t)
+
+	p := &movePlanner{
+		threshold:  []float64{100, 100, 100, 100},
+		maxMoves:   10,
+		neighbours: noNeighbours,
+	}
+	moves := p.plan([]*ShardMetrics{
+		newTestShard("sh1", map[string][]float64{"kr1": {90, 10}}, 100),
+		newTestShard("sh2", map[string][]float64{}, 0),
+	})
+	assert.Empty(moves)
+}
+
+func TestPlannerSpreadsToEmptyShards(t *testing.T) {
+	assert := assert.New(t)
+
+	p := &movePlanner{
+		threshold:  []float64{100, 1000, 100, 1000},
+		maxMoves:   10,
+		neighbours: noNeighbours,
+	}
+	moves := p.plan([]*ShardMetrics{
+		newTestShard("sh1", map[string][]float64{
+			"kr1": {60, 10},
+			"kr2": {60, 10},
+			"kr3": {60, 10},
+			"kr4": {60, 10},
+		}, 100),
+		newTestShard("sh2", map[string][]float64{}, 0),
+		newTestShard("sh3", map[string][]float64{}, 0),
+		newTestShard("sh4", map[string][]float64{}, 0),
+	})
+
+	assert.Len(moves, 3)
+	dsts := map[string]int{}
+	krs := map[string]struct{}{}
+	for _, move := range moves {
+		assert.Equal("sh1", move.ShardFromId)
+		assert.Equal(100, move.KeyCount)
+		dsts[move.ShardToId]++
+		krs[move.KrId] = struct{}{}
+	}
+	assert.Equal(map[string]int{"sh2": 1, "sh3": 1, "sh4": 1}, dsts)
+	assert.Len(krs, 3)
+}
+
+func TestPlannerMovesPartOfKeyRange(t *testing.T) {
+	assert := assert.New(t)
+
+	p := &movePlanner{
+		threshold:  []float64{100, 1000, 100, 1000},
+		maxMoves:   10,
+		neighbours: noNeighbours,
+	}
+	moves := p.plan([]*ShardMetrics{
+		newTestShard("sh1", map[string][]float64{"kr1": {200, 10}}, 1000),
+		newTestShard("sh2", map[string][]float64{"kr2": {0, 10}}, 1000),
+	})
+
+	assert.Equal([]*plannedMove{
+		{KrId: "kr1", ShardFromId: "sh1", ShardToId: "sh2", KeyCount: 500},
+	}, moves)
+}
+
+func TestPlannerRespectsOtherMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	p := &movePlanner{
+		threshold:  []float64{100, 100, 100, 100},
+		maxMoves:   10,
+		neighbours: noNeighbours,
+	}
+	moves := p.plan([]*ShardMetrics{
+		newTestShard("sh1", map[string][]float64{"kr1": {200, 40}}, 100),
+		newTestShard("sh2", map[string][]float64{"kr2": {0, 90}}, 100),
+	})
+
+	// only a quarter of keys fits into sh2 by space
+	assert.Equal([]*plannedMove{
+		{KrId: "kr1", ShardFromId: "sh1", ShardToId: "sh2", KeyCount: 25},
+	}, moves)
+}
+
+func TestPlannerSkipsNeighbours(t *testing.T) {
+	assert := assert.New(t)
+
+	neighbours := map[string][]*kr.KeyRange{
+		"kr1": {{ID: "kr2", ShardID: "sh1"}},
+		"kr2": {{ID: "kr1", ShardID: "sh1"}},
+	}
+	p := &movePlanner{
+		threshold: []float64{100, 1000, 100, 1000},
+		maxMoves:  10,
+		neighbours: func(krId string) []*kr.KeyRange {
+			return neighbours[krId]
+		},
+	}
+	moves := p.plan([]*ShardMetrics{
+		newTestShard("sh1", map[string][]float64{
+			"kr1": {100, 10},
+			"kr2": {90, 10},
+		}, 100),
+		newTestShard("sh2", map[string][]float64{}, 0),
+		newTestShard("sh3", map[string][]float64{}, 0),
+	})
+
+	assert.Len(moves, 1)
+	assert.Equal("kr1", moves[0].KrId)
+}
+
+func TestPlannerMaxMoves(t *testing.T) {
+	assert := assert.New(t)
+
+	p := &movePlanner{
+		threshold:  []float64{100, 1000, 100, 1000},
+		maxMoves:   1,
+		neighbours: noNeighbours,
+	}
+	moves := p.plan([]*ShardMetrics{
+		newTestShard("sh1", map[string][]float64{
+			"kr1": {60, 10},
+			"kr2": {60, 10},
+			"kr3": {60, 10},
+		}, 100),
+		newTestShard("sh2", map[string][]float64{}, 0),
+		newTestShard("sh3", map[string][]float64{}, 0),
+	})
+
+	assert.Len(moves, 1)
+}
+
+func TestPlannerSkipsDrainingShards(t *testing.T) {
+	assert := assert.New(t)
+
+	p := &movePlanner{
+		threshold:  []float64{100, 1000, 100, 1000},
+		maxMoves:   10,
+		neighbours: noNeighbours,
+	}
+	draining := newTestShard("sh3", map[string][]float64{}, 0)
+	draining.Draining = true
+	moves := p.plan([]*ShardMetrics{
+		newTestShard("sh1", map[string][]float64{
+			"kr1": {60, 10},
+			"kr2": {60, 10},
+			"kr3": {60, 10},
+		}, 100),
+		newTestShard("sh2", map[string][]float64{}, 0),
+		draining,
+		newTestShard("sh4", map[string][]float64{}, 0),
+	})
+
+	assert.Len(moves, 2)
+	dsts := map[string]int{}
+	for _, move := range moves {
+		assert.Equal("sh1", move.ShardFromId)
+		dsts[move.ShardToId]++
+	}
+	assert.Equal(map[string]int{"sh2": 1, "sh4": 1}, dsts)
+}
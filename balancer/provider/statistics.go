@@ -14,6 +14,8 @@ type ShardMetrics struct {
 	KeyCountRelKR map[string]map[string]int64
 	Master        string
 	TargetReplica string
+	// Draining shard is not a destination of moves
+	Draining bool
 }
 
 type HostMetrics []float64
@@ -15,6 +15,7 @@ import (
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
 
 	"github.com/pg-sharding/spqr/pkg/decode"
+	"github.com/pg-sharding/spqr/pkg/models/distributions"
 
 	protos "github.com/pg-sharding/spqr/pkg/protos"
 )
@@ -153,7 +154,9 @@ func dumpPsql(query string, rowToStr func(v *pgproto3.DataRow) (string, error))
 				if err != nil {
 					return err
 				}
-				fmt.Println(s)
+				if s != "" {
+					fmt.Println(s)
+				}
 			case *pgproto3.ErrorResponse:
 				return fmt.Errorf("failed to wait for RQF: %s", v.Message)
 			case *pgproto3.ReadyForQuery:
@@ -199,7 +202,9 @@ func DumpDistributions() error {
 			Msg("failed to dump endpoint distributions")
 	} else {
 		for _, ds := range dss.Distributions {
-			fmt.Println(decode.Distribution(ds))
+			if s := decode.Distribution(ds); s != "" {
+				fmt.Println(s)
+			}
 			for _, rel := range ds.Relations {
 				fmt.Println(decode.DistributedRelation(rel, ds.Id))
 			}
@@ -230,6 +235,10 @@ func DumpRelationsPsql() error {
 	return dumpPsql("SHOW relations;", func(v *pgproto3.DataRow) (string, error) {
 		name := string(v.Values[0])
 		ds := string(v.Values[1])
+		if ds == distributions.REPLICATED {
+			/* reference relations have no distribution key */
+			return decode.DistributedRelation(&protos.DistributedRelation{Name: name}, ds), nil
+		}
 		dsKeyStr := strings.Split(string(v.Values[2]), ",")
 		dsKey := make([]*protos.DistributionKeyEntry, len(dsKeyStr))
 		for i, elem := range dsKeyStr {
@@ -237,9 +246,15 @@ func DumpRelationsPsql() error {
 			if len(elems) != 2 {
 				return "", fmt.Errorf("incorrect distribution key entry: \"%s\"", elem)
 			}
+			col, expr := strings.Trim(elems[0], "\""), ""
+			/* expression-based key is shown as expr(column) */
+			if j := strings.Index(col, "("); j != -1 {
+				col, expr = strings.TrimSuffix(col[j+1:], ")"), col[:j]
+			}
 			dsKey[i] = &protos.DistributionKeyEntry{
-				Column:       strings.Trim(elems[0], "\""),
+				Column:       col,
 				HashFunction: elems[1],
+				Expression:   expr,
 			}
 		}
 
@@ -89,7 +89,7 @@ func (w *WorldMock) serv(netconn net.Conn) error {
 		return err
 	}
 
-	r := route.NewRoute(nil, nil, nil)
+	r := route.NewRoute(nil, nil, nil, nil, "")
 	r.SetParams(shard.ParameterSet{})
 	if err := cl.Auth(r); err != nil {
 		return err
@@ -138,12 +138,18 @@ func (app *App) ServeGrpcApi(wg *sync.WaitGroup) error {
 	shardServ := provider.NewShardServer(app.coordinator)
 	dsServ := provider.NewDistributionServer(app.coordinator)
 	tasksServ := provider.NewTasksServer(app.coordinator)
+	opServ := provider.NewOperationServer(app.coordinator)
+	seqServ := provider.NewSequenceServer(app.coordinator)
+	schemaServ := provider.NewSchemaServer(app.coordinator)
 	protos.RegisterKeyRangeServiceServer(serv, krServ)
 	protos.RegisterRouterServiceServer(serv, rrServ)
 	protos.RegisterTopologyServiceServer(serv, topServ)
 	protos.RegisterShardServiceServer(serv, shardServ)
 	protos.RegisterDistributionServiceServer(serv, dsServ)
 	protos.RegisterTasksServiceServer(serv, tasksServ)
+	protos.RegisterOperationServiceServer(serv, opServ)
+	protos.RegisterSequenceServiceServer(serv, seqServ)
+	protos.RegisterSchemaServiceServer(serv, schemaServ)
 
 	address := net.JoinHostPort(config.CoordinatorConfig().Host, config.CoordinatorConfig().GrpcApiPort)
 	listener, err := net.Listen("tcp", address)
@@ -2,6 +2,7 @@ package coordinator
 
 import (
 	"context"
+	"time"
 
 	"github.com/pg-sharding/spqr/pkg/clientinteractor"
 	"github.com/pg-sharding/spqr/pkg/meta"
@@ -12,4 +13,7 @@ type Coordinator interface {
 	meta.EntityMgr
 
 	RunCoordinator(ctx context.Context, initialRouter bool)
+	// KeyRangeQueryCounts sums up number of queries routed to each key range
+	// by all opened routers during interval
+	KeyRangeQueryCounts(ctx context.Context, interval time.Duration) (map[string]uint64, error)
 }
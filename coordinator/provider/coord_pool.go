@@ -25,6 +25,9 @@ func (r *CoordPool) Connection(clid uint, shardKey kr.ShardKey) (shard.Shard, er
 	return nil, spqrerror.New(spqrerror.SPQR_NOT_IMPLEMENTED, "CoordPool.Connection method unimplemented")
 }
 
+// Invalidate is a no-op, coordinator does not keep connections of routers
+func (r *CoordPool) Invalidate() {}
+
 // TODO : unit tests
 // TODO : implement
 func (r *CoordPool) ForEach(cb func(p shard.Shardinfo) error) error {
@@ -5,10 +5,12 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/pg-sharding/spqr/pkg/models/distributions"
 
+	"github.com/pg-sharding/spqr/pkg/models/sequences"
 	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 
 	"github.com/google/uuid"
@@ -184,8 +186,13 @@ type CoordinatorClient interface {
 }
 
 type qdbCoordinator struct {
-	tlsconfig *tls.Config
-	db        qdb.XQDB
+	tlsconfig   *tls.Config
+	db          qdb.XQDB
+	opScheduler *operationScheduler
+	// shardsMu is held shared while key range is assigned to a shard
+	// and exclusively while shard is dropped, so that no key range
+	// is left on dropped shard
+	shardsMu sync.RWMutex
 }
 
 func (qc *qdbCoordinator) ShareKeyRange(id string) error {
@@ -278,8 +285,9 @@ func (qc *qdbCoordinator) watchRouters(ctx context.Context) {
 
 func NewCoordinator(tlsconfig *tls.Config, db qdb.XQDB) *qdbCoordinator {
 	return &qdbCoordinator{
-		db:        db,
-		tlsconfig: tlsconfig,
+		db:          db,
+		tlsconfig:   tlsconfig,
+		opScheduler: newOperationScheduler(),
 	}
 }
 
@@ -339,7 +347,9 @@ func (qc *qdbCoordinator) RunCoordinator(ctx context.Context, initialRouter bool
 			Msg("failed to list key ranges")
 	}
 
-	// Finish any key range move or data transfer transaction in progress
+	// Find any key range move or data transfer transaction in progress
+	interrupted := make([]*kr.MoveKeyRange, 0)
+	resumable := map[string]bool{}
 	for _, r := range ranges {
 		move, err := qc.GetKeyRangeMove(context.TODO(), r.KeyRangeID)
 		if err != nil {
@@ -351,24 +361,32 @@ func (qc *qdbCoordinator) RunCoordinator(ctx context.Context, initialRouter bool
 			spqrlog.Zero.Error().Err(err).Msg("error getting data transfer transaction from qdb")
 		}
 
-		var krm *kr.MoveKeyRange
 		if move != nil {
-			krm = &kr.MoveKeyRange{
+			interrupted = append(interrupted, &kr.MoveKeyRange{
 				Krid:    move.KeyRangeID,
 				ShardId: move.ShardId,
-			}
+			})
+			resumable[move.KeyRangeID] = true
 		} else if tx != nil {
-			krm = &kr.MoveKeyRange{
+			interrupted = append(interrupted, &kr.MoveKeyRange{
 				Krid:    r.KeyRangeID,
 				ShardId: tx.ToShardId,
-			}
+			})
+			resumable[r.KeyRangeID] = true
 		}
+	}
 
-		if krm != nil {
-			err = qc.Move(context.TODO(), krm)
-			if err != nil {
-				spqrlog.Zero.Error().Err(err).Msg("error moving key range")
-			}
+	moveOps, err := qc.recoverOperations(context.TODO(), resumable)
+	if err != nil {
+		spqrlog.Zero.Error().
+			Err(err).
+			Msg("failed to recover interrupted operations")
+	}
+
+	// Finish interrupted moves
+	for _, krm := range interrupted {
+		if err := qc.resumeMove(context.TODO(), krm, moveOps[krm.Krid]); err != nil {
+			spqrlog.Zero.Error().Err(err).Msg("error moving key range")
 		}
 	}
 
@@ -418,6 +436,51 @@ func (qc *qdbCoordinator) traverseRouters(ctx context.Context, cb func(cc *grpc.
 	return nil
 }
 
+// KeyRangeQueryCounts sums numbers of queries, routed to key ranges by routers.
+// Routers, which can not be reached, are skipped.
+func (qc *qdbCoordinator) KeyRangeQueryCounts(ctx context.Context, interval time.Duration) (map[string]uint64, error) {
+	rtrs, err := qc.db.ListRouters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]uint64)
+	for _, rtr := range rtrs {
+		if rtr.State != qdb.OPENED {
+			spqrlog.Zero.Debug().Str("router id", rtr.ID).Msg("skip closed router while collecting key range stats")
+			continue
+		}
+		if err := func() error {
+			cc, err := DialRouter(&topology.Router{
+				ID:      rtr.ID,
+				Address: rtr.Addr(),
+			})
+			if err != nil {
+				return err
+			}
+			defer cc.Close()
+
+			cl := routerproto.NewKeyRangeServiceClient(cc)
+			resp, err := cl.GetKeyRangeStats(ctx, &routerproto.GetKeyRangeStatsRequest{
+				IntervalSec: int64(interval / time.Second),
+			})
+			if err != nil {
+				return err
+			}
+			for krId, count := range kr.QueryCountsFromProto(resp.Stats) {
+				res[krId] += count
+			}
+			return nil
+		}(); err != nil {
+			spqrlog.Zero.Warn().
+				Str("router id", rtr.ID).
+				Err(err).
+				Msg("skip unreachable router while collecting key range stats")
+		}
+	}
+	return res, nil
+}
+
 // TODO : unit tests
 func (qc *qdbCoordinator) ListRouters(ctx context.Context) ([]*topology.Router, error) {
 	resp, err := qc.db.ListRouters(ctx)
@@ -451,7 +514,9 @@ func (qc *qdbCoordinator) CreateKeyRange(ctx context.Context, keyRange *kr.KeyRa
 		Str("key-range-id", keyRange.ID).
 		Msg("add key range")
 
+	qc.shardsMu.RLock()
 	err := ops.CreateKeyRangeWithChecks(ctx, qc.db, keyRange)
+	qc.shardsMu.RUnlock()
 	if err != nil {
 		return err
 	}
@@ -515,6 +580,8 @@ func (qc *qdbCoordinator) ListAllKeyRanges(ctx context.Context) ([]*kr.KeyRange,
 
 // TODO : unit tests
 func (qc *qdbCoordinator) MoveKeyRange(ctx context.Context, keyRange *kr.KeyRange) error {
+	qc.shardsMu.RLock()
+	defer qc.shardsMu.RUnlock()
 	return ops.ModifyKeyRangeWithChecks(ctx, qc.db, keyRange)
 }
 
@@ -564,9 +631,28 @@ func (qc *qdbCoordinator) UnlockKeyRange(ctx context.Context, keyRangeID string)
 	})
 }
 
+// waitOperation waits for operation, started by the request, to finish
+func waitOperation(ctx context.Context, done <-chan error) error {
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Split splits key range by req.bound
 // TODO : unit tests
 func (qc *qdbCoordinator) Split(ctx context.Context, req *kr.SplitKeyRange) error {
+	_, done, err := qc.splitOperation(ctx, req)
+	if err != nil {
+		return err
+	}
+	return waitOperation(ctx, done)
+}
+
+// TODO : unit tests
+func (qc *qdbCoordinator) split(ctx context.Context, req *kr.SplitKeyRange) error {
 	spqrlog.Zero.Debug().
 		Str("krid", req.Krid).
 		Interface("bound", req.Bound).
@@ -698,8 +784,18 @@ func (qc *qdbCoordinator) DropKeyRange(ctx context.Context, id string) error {
 	return qc.db.DropKeyRange(ctx, id)
 }
 
+// Unite merges two adjacent key ranges
 // TODO : unit tests
 func (qc *qdbCoordinator) Unite(ctx context.Context, uniteKeyRange *kr.UniteKeyRange) error {
+	_, done, err := qc.uniteOperation(ctx, uniteKeyRange)
+	if err != nil {
+		return err
+	}
+	return waitOperation(ctx, done)
+}
+
+// TODO : unit tests
+func (qc *qdbCoordinator) unite(ctx context.Context, uniteKeyRange *kr.UniteKeyRange) error {
 	krBase, err := qc.db.LockKeyRange(ctx, uniteKeyRange.BaseKeyRangeId)
 	if err != nil {
 		return err
@@ -823,10 +919,20 @@ func (qc *qdbCoordinator) GetKeyRangeMove(ctx context.Context, krId string) (*qd
 }
 
 // Move key range from one logical shard to another
-// This function re-shards data by locking a portion of it,
-// making it unavailable for read and write access during the process.
 // TODO : unit tests
 func (qc *qdbCoordinator) Move(ctx context.Context, req *kr.MoveKeyRange) error {
+	_, done, err := qc.moveOperation(ctx, req)
+	if err != nil {
+		return err
+	}
+	return waitOperation(ctx, done)
+}
+
+// move re-shards data by locking a portion of it,
+// making it unavailable for read and write access during the process.
+// Progress is reported after each stage of the move.
+// TODO : unit tests
+func (qc *qdbCoordinator) move(ctx context.Context, req *kr.MoveKeyRange, report func(progress int32)) error {
 	// First, we create a record in the qdb to track the data movement.
 	// If the coordinator crashes during the process, we need to rerun this function.
 
@@ -876,6 +982,7 @@ func (qc *qdbCoordinator) Move(ctx context.Context, req *kr.MoveKeyRange) error
 				return err
 			}
 			move.Status = qdb.MoveKeyRangeStarted
+			report(10)
 		case qdb.MoveKeyRangeStarted:
 			// move the data
 			ds, err := qc.GetDistribution(ctx, keyRange.Distribution)
@@ -887,6 +994,7 @@ func (qc *qdbCoordinator) Move(ctx context.Context, req *kr.MoveKeyRange) error
 				spqrlog.Zero.Error().Err(err).Msg("failed to move rows")
 				return err
 			}
+			report(70)
 
 			// update key range
 			krg, err := qc.GetKeyRange(ctx, req.Krid)
@@ -894,7 +1002,10 @@ func (qc *qdbCoordinator) Move(ctx context.Context, req *kr.MoveKeyRange) error
 				return err
 			}
 			krg.ShardID = req.ShardId
-			if err := ops.ModifyKeyRangeWithChecks(ctx, qc.db, krg); err != nil {
+			qc.shardsMu.RLock()
+			err = ops.ModifyKeyRangeWithChecks(ctx, qc.db, krg)
+			qc.shardsMu.RUnlock()
+			if err != nil {
 				// TODO: check if unlock here is ok
 				return err
 			}
@@ -918,6 +1029,7 @@ func (qc *qdbCoordinator) Move(ctx context.Context, req *kr.MoveKeyRange) error
 				spqrlog.Zero.Error().Err(err).Msg("")
 			}
 			move.Status = qdb.MoveKeyRangeComplete
+			report(90)
 		case qdb.MoveKeyRangeComplete:
 			// unlock key range
 			if err := qc.UnlockKeyRange(ctx, req.Krid); err != nil {
@@ -980,6 +1092,30 @@ func (qc *qdbCoordinator) SyncRouterMetadata(ctx context.Context, qRouter *topol
 		return err
 	}
 
+	// Configure sequences
+	seqCl := routerproto.NewSequenceServiceClient(cc)
+	spqrlog.Zero.Debug().Msg("qdb coordinator: configure sequences")
+	seqs, err := qc.db.ListSequences(ctx)
+	if err != nil {
+		return err
+	}
+	seqResp, err := seqCl.ListSequences(ctx, &routerproto.ListSequencesRequest{})
+	if err != nil {
+		return err
+	}
+	for _, seq := range seqResp.Sequences {
+		if _, err := seqCl.DropSequence(ctx, &routerproto.DropSequenceRequest{Name: seq.Name}); err != nil {
+			return err
+		}
+	}
+	for _, seq := range seqs {
+		if _, err := seqCl.CreateSequence(ctx, &routerproto.CreateSequenceRequest{
+			Sequence: sequences.SequenceToProto(sequences.SequenceFromDB(seq)),
+		}); err != nil {
+			return err
+		}
+	}
+
 	// Configure key ranges.
 	krClient := routerproto.NewKeyRangeServiceClient(cc)
 	spqrlog.Zero.Debug().Msg("qdb coordinator: configure key ranges")
@@ -1097,11 +1233,19 @@ func (qc *qdbCoordinator) GetTaskGroup(ctx context.Context) (*tasks.TaskGroup, e
 }
 
 func (qc *qdbCoordinator) WriteTaskGroup(ctx context.Context, taskGroup *tasks.TaskGroup) error {
-	return qc.db.WriteTaskGroup(ctx, tasks.TaskGroupToDb(taskGroup))
+	if err := qc.db.WriteTaskGroup(ctx, tasks.TaskGroupToDb(taskGroup)); err != nil {
+		return err
+	}
+	qc.trackTaskGroup(ctx, taskGroup)
+	return nil
 }
 
 func (qc *qdbCoordinator) RemoveTaskGroup(ctx context.Context) error {
-	return qc.db.RemoveTaskGroup(ctx)
+	if err := qc.db.RemoveTaskGroup(ctx); err != nil {
+		return err
+	}
+	qc.finishTaskGroup(ctx)
+	return nil
 }
 
 // TODO : unit tests
@@ -1141,7 +1285,7 @@ func (qc *qdbCoordinator) PrepareClient(nconn net.Conn, pt port.RouterPortType)
 		return nil, err
 	}
 
-	r := route.NewRoute(nil, nil, nil)
+	r := route.NewRoute(nil, nil, nil, nil, "")
 	r.SetParams(cl.Params())
 	if err := cl.Auth(r); err != nil {
 		return nil, err
@@ -1213,7 +1357,21 @@ func (qc *qdbCoordinator) AddWorldShard(_ context.Context, _ *datashards.DataSha
 	panic("implement me")
 }
 
+// DropShard removes shard, which does not own any key ranges.
+// Key ranges can not be assigned to the shard concurrently.
 func (qc *qdbCoordinator) DropShard(ctx context.Context, shardId string) error {
+	qc.shardsMu.Lock()
+	defer qc.shardsMu.Unlock()
+
+	krs, err := qc.db.ListAllKeyRanges(ctx)
+	if err != nil {
+		return err
+	}
+	for _, krg := range krs {
+		if krg.ShardID == shardId {
+			return spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "shard %s still owns key range %s, drain it first", shardId, krg.KeyRangeID)
+		}
+	}
 	return qc.db.DropShard(ctx, shardId)
 }
 
@@ -1232,6 +1390,7 @@ func (qc *qdbCoordinator) ListShards(ctx context.Context) ([]*datashards.DataSha
 			Cfg: &config.Shard{
 				Hosts: shard.Hosts,
 			},
+			Draining: shard.Draining,
 		})
 	}
 
@@ -1398,6 +1557,20 @@ func (qc *qdbCoordinator) AlterDistributionDetach(ctx context.Context, id string
 	})
 }
 
+// TODO : unit tests
 func (qc *qdbCoordinator) GetShard(ctx context.Context, shardID string) (*datashards.DataShard, error) {
-	panic("implement or delete me")
+	sh, err := qc.getQdbShard(ctx, shardID)
+	if err != nil {
+		return nil, err
+	}
+	if sh == nil {
+		return nil, spqrerror.Newf(spqrerror.SPQR_NO_DATASHARD, "unknown shard %s", shardID)
+	}
+	return &datashards.DataShard{
+		ID: sh.ID,
+		Cfg: &config.Shard{
+			Hosts: sh.Hosts,
+		},
+		Draining: sh.Draining,
+	}, nil
 }
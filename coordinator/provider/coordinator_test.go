@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/models/datashards"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/qdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyRangeQueryCountsSkipsUnreachableRouter(t *testing.T) {
+	assert := assert.New(t)
+	qc := newTestCoordinator(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	assert.NoError(qc.db.AddRouter(ctx, &qdb.Router{ID: "r1", Address: "127.0.0.1:1", State: qdb.OPENED}))
+	assert.NoError(qc.db.AddRouter(ctx, &qdb.Router{ID: "r2", Address: "127.0.0.1:2", State: qdb.CLOSED}))
+
+	counts, err := qc.KeyRangeQueryCounts(ctx, time.Minute)
+	assert.NoError(err)
+	assert.Empty(counts)
+}
+
+func TestDropShardOwningKeyRange(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	assert.NoError(qc.db.AddShard(ctx, &qdb.Shard{ID: "sh1"}))
+	assert.NoError(qc.db.CreateDistribution(ctx, qdb.NewDistribution("ds1", []string{"integer"})))
+	assert.NoError(qc.CreateKeyRange(ctx, &kr.KeyRange{
+		ID:           "kr1",
+		ShardID:      "sh1",
+		Distribution: "ds1",
+		LowerBound:   []byte("1"),
+	}))
+
+	assert.Error(qc.DropShard(ctx, "sh1"))
+
+	assert.NoError(qc.DropKeyRange(ctx, "kr1"))
+	assert.NoError(qc.DropShard(ctx, "sh1"))
+	shards, err := qc.ListShards(ctx)
+	assert.NoError(err)
+	assert.Empty(shards)
+}
+
+func TestDropShardWaitsForKeyRangeAssignment(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	assert.NoError(qc.db.AddShard(ctx, &qdb.Shard{ID: "sh1"}))
+	assert.NoError(qc.db.CreateDistribution(ctx, qdb.NewDistribution("ds1", []string{"integer"})))
+
+	// key range is being assigned to the shard
+	qc.shardsMu.RLock()
+	dropped := make(chan error, 1)
+	go func() {
+		dropped <- qc.DropShard(ctx, "sh1")
+	}()
+
+	select {
+	case <-dropped:
+		t.Fatal("shard dropped during key range assignment")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	assert.NoError(qc.db.CreateKeyRange(ctx, &qdb.KeyRange{
+		KeyRangeID:     "kr1",
+		ShardID:        "sh1",
+		DistributionId: "ds1",
+		LowerBound:     []byte("1"),
+	}))
+	qc.shardsMu.RUnlock()
+
+	assert.Error(<-dropped)
+}
+
+func TestCopySchemaValidatesShards(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	assert.NoError(qc.db.AddShard(ctx, &qdb.Shard{ID: "sh1", Hosts: []string{"sh1-host:6432"}}))
+	assert.NoError(qc.db.AddShard(ctx, &qdb.Shard{ID: "sh2", Hosts: []string{"sh2-host:6432"}}))
+
+	newShard := func(id string) *datashards.DataShard {
+		return datashards.NewDataShard(id, &config.Shard{
+			Hosts: []string{"sh3-host:6432"},
+			Type:  config.DataShard,
+		})
+	}
+
+	/* schema is copied only to a shard, which is not registered yet */
+	assert.Error(qc.CopySchema(ctx, "sh1", newShard("")))
+	assert.Error(qc.CopySchema(ctx, "sh1", newShard("sh1")))
+	assert.Error(qc.CopySchema(ctx, "sh1", newShard("sh2")))
+	assert.Error(qc.CopySchema(ctx, "sh4", newShard("sh3")))
+}
+
+func TestReplaySchema(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	assert.NoError(qc.db.AddShard(ctx, &qdb.Shard{ID: "sh1", Hosts: []string{"sh1-host:6432"}}))
+
+	newShard := func(id string) *datashards.DataShard {
+		return datashards.NewDataShard(id, &config.Shard{
+			Hosts: []string{"sh3-host:6432"},
+			Type:  config.DataShard,
+		})
+	}
+
+	/* history is replayed only on a shard, which is not registered yet */
+	assert.Error(qc.ReplaySchema(ctx, newShard("")))
+	assert.Error(qc.ReplaySchema(ctx, newShard("sh1")))
+	/* empty history needs no connection to the shard */
+	assert.NoError(qc.ReplaySchema(ctx, newShard("sh3")))
+}
+
+func TestDDLAllowsTransaction(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(ddlAllowsTransaction("CREATE TABLE xx (i int)"))
+	assert.True(ddlAllowsTransaction("CREATE INDEX xx_i ON xx (i)"))
+	assert.False(ddlAllowsTransaction("CREATE INDEX CONCURRENTLY xx_i ON xx (i)"))
+}
@@ -89,3 +89,15 @@ func (d *DistributionsServer) GetRelationDistribution(ctx context.Context, req *
 	}
 	return &protos.GetRelationDistributionReply{Distribution: distributions.DistributionToProto(ds)}, nil
 }
+
+func (d *DistributionsServer) CheckReferenceRelation(ctx context.Context, req *protos.CheckReferenceRelationRequest) (*protos.CheckReferenceRelationReply, error) {
+	states, err := d.impl.CheckReferenceRelation(ctx, req.GetRelName())
+	if err != nil {
+		return nil, err
+	}
+	reply := &protos.CheckReferenceRelationReply{}
+	for _, st := range states {
+		reply.States = append(reply.States, distributions.ReferenceRelationShardStateToProto(st))
+	}
+	return reply, nil
+}
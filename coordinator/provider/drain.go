@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pg-sharding/spqr/pkg/datatransfers"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/operations"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/qdb"
+)
+
+// getQdbShard returns shard from QDB or nil, if there is no such shard
+func (qc *qdbCoordinator) getQdbShard(ctx context.Context, shardId string) (*qdb.Shard, error) {
+	shards, err := qc.db.ListShards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, sh := range shards {
+		if sh.ID == shardId {
+			return sh, nil
+		}
+	}
+	return nil, nil
+}
+
+// checkShardAcceptsKeyRanges returns error if the shard is being drained
+func (qc *qdbCoordinator) checkShardAcceptsKeyRanges(ctx context.Context, shardId string) error {
+	sh, err := qc.getQdbShard(ctx, shardId)
+	if err != nil {
+		return err
+	}
+	if sh != nil && sh.Draining {
+		return spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "shard %s is draining and does not accept key ranges", shardId)
+	}
+	return nil
+}
+
+func (qc *qdbCoordinator) drainOperation(ctx context.Context, shardId string) (*operations.Operation, <-chan error, error) {
+	sh, err := qc.getQdbShard(ctx, shardId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sh == nil {
+		return nil, nil, spqrerror.Newf(spqrerror.SPQR_NO_DATASHARD, "unknown shard %s", shardId)
+	}
+
+	op := newOperation(operations.KindDrain, fmt.Sprintf("drain shard %s", shardId), nil)
+	// key ranges are locked by the moves, drain operation only
+	// makes sure the shard is not drained concurrently
+	return qc.startOperation(ctx, op, []string{fmt.Sprintf("drain shard %s", shardId)},
+		func(ctx context.Context, report func(int32)) error {
+			return qc.drain(ctx, shardId, report)
+		})
+}
+
+func (qc *qdbCoordinator) DrainShard(ctx context.Context, shardId string) error {
+	_, done, err := qc.drainOperation(ctx, shardId)
+	if err != nil {
+		return err
+	}
+	return waitOperation(ctx, done)
+}
+
+func (qc *qdbCoordinator) StartDrain(ctx context.Context, shardId string) (*operations.Operation, error) {
+	op, _, err := qc.drainOperation(ctx, shardId)
+	return op, err
+}
+
+// drain marks shard as draining and moves all its key ranges to other shards.
+// Each key range is moved with a separate move operation. If drain fails or is
+// cancelled, the shard accepts key ranges again, key ranges moved so far stay moved.
+func (qc *qdbCoordinator) drain(ctx context.Context, shardId string, report func(int32)) (err error) {
+	sh, err := qc.getQdbShard(ctx, shardId)
+	if err != nil {
+		return err
+	}
+	if sh == nil {
+		return spqrerror.Newf(spqrerror.SPQR_NO_DATASHARD, "unknown shard %s", shardId)
+	}
+	if !sh.Draining {
+		sh.Draining = true
+		if err := qc.db.AddShard(ctx, sh); err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				qc.undrain(shardId)
+			}
+		}()
+	}
+
+	allKrs, err := qc.ListAllKeyRanges(ctx)
+	if err != nil {
+		return err
+	}
+	krs := make([]*kr.KeyRange, 0)
+	for _, krg := range allKrs {
+		if krg.ShardID == shardId {
+			krs = append(krs, krg)
+		}
+	}
+	if len(krs) == 0 {
+		return nil
+	}
+
+	shards, err := qc.db.ListShards(ctx)
+	if err != nil {
+		return err
+	}
+	shardSizes := make(map[string]int64)
+	for _, dest := range shards {
+		if dest.ID == shardId || dest.Draining {
+			continue
+		}
+		size, err := datatransfers.ShardDataSize(ctx, dest.ID)
+		if err != nil {
+			return err
+		}
+		shardSizes[dest.ID] = size
+	}
+	if len(shardSizes) == 0 {
+		return spqrerror.Newf(spqrerror.SPQR_NO_DATASHARD, "there are no shards to move key ranges of shard %s to", shardId)
+	}
+
+	krSizes := make(map[string]int64, len(krs))
+	for _, krg := range krs {
+		ds, err := qc.GetDistribution(ctx, krg.Distribution)
+		if err != nil {
+			return err
+		}
+		size, err := datatransfers.KeyRangeDataSize(ctx, shardId, krg, ds, qc)
+		if err != nil {
+			return err
+		}
+		krSizes[krg.ID] = size
+	}
+
+	plan := planDrain(krs, krSizes, shardSizes)
+	for i, krg := range krs {
+		spqrlog.Zero.Info().
+			Str("key range", krg.ID).
+			Str("shard from", shardId).
+			Str("shard to", plan[krg.ID]).
+			Msg("draining key range")
+		if err := qc.Move(ctx, &kr.MoveKeyRange{
+			Krid:    krg.ID,
+			ShardId: plan[krg.ID],
+		}); err != nil {
+			return err
+		}
+		report(int32(100 * (i + 1) / len(krs)))
+	}
+	return nil
+}
+
+// undrain clears draining mark of the shard after failed drain. Context of
+// the drain may be already cancelled, so the mark is cleared without it.
+func (qc *qdbCoordinator) undrain(shardId string) {
+	ctx := context.Background()
+	sh, err := qc.getQdbShard(ctx, shardId)
+	if err == nil && sh != nil {
+		sh.Draining = false
+		err = qc.db.AddShard(ctx, sh)
+	}
+	if err != nil {
+		spqrlog.Zero.Error().
+			Str("shard", shardId).
+			Err(err).
+			Msg("failed to clear draining mark of shard")
+	}
+}
+
+// planDrain assigns key ranges to destination shards. Key ranges are sorted in descending
+// order of size, each one goes to the shard with the most free space, i.e. the smallest
+// amount of data, taking key ranges assigned before into account.
+func planDrain(krs []*kr.KeyRange, krSizes map[string]int64, shardSizes map[string]int64) map[string]string {
+	sort.SliceStable(krs, func(i, j int) bool {
+		return krSizes[krs[i].ID] > krSizes[krs[j].ID]
+	})
+
+	shardIds := make([]string, 0, len(shardSizes))
+	projected := make(map[string]int64, len(shardSizes))
+	for id, size := range shardSizes {
+		shardIds = append(shardIds, id)
+		projected[id] = size
+	}
+	sort.Strings(shardIds)
+
+	res := make(map[string]string, len(krs))
+	for _, krg := range krs {
+		dest := shardIds[0]
+		for _, id := range shardIds[1:] {
+			if projected[id] < projected[dest] {
+				dest = id
+			}
+		}
+		res[krg.ID] = dest
+		projected[dest] += krSizes[krg.ID]
+	}
+	return res
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/operations"
+	"github.com/pg-sharding/spqr/qdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanDrain(t *testing.T) {
+	assert := assert.New(t)
+
+	krs := []*kr.KeyRange{
+		{ID: "kr1", ShardID: "sh1"},
+		{ID: "kr2", ShardID: "sh1"},
+		{ID: "kr3", ShardID: "sh1"},
+	}
+	plan := planDrain(krs,
+		map[string]int64{"kr1": 10, "kr2": 100, "kr3": 50},
+		map[string]int64{"sh2": 20, "sh3": 0},
+	)
+
+	// kr2 goes to the empty shard, kr3 to sh2 which is smaller now, kr1 to sh2 again
+	assert.Equal(map[string]string{"kr1": "sh2", "kr2": "sh3", "kr3": "sh2"}, plan)
+}
+
+func TestPlanDrainSingleShard(t *testing.T) {
+	assert := assert.New(t)
+
+	krs := []*kr.KeyRange{
+		{ID: "kr1", ShardID: "sh1"},
+		{ID: "kr2", ShardID: "sh1"},
+	}
+	plan := planDrain(krs,
+		map[string]int64{"kr1": 0, "kr2": 0},
+		map[string]int64{"sh2": 0},
+	)
+
+	assert.Equal(map[string]string{"kr1": "sh2", "kr2": "sh2"}, plan)
+}
+
+func addDrainTestShard(t *testing.T, qc *qdbCoordinator, id string, draining bool, krids ...string) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	assert.NoError(qc.db.AddShard(ctx, &qdb.Shard{ID: id, Draining: draining}))
+	if len(krids) > 0 {
+		assert.NoError(qc.db.CreateDistribution(ctx, &qdb.Distribution{ID: "ds1"}))
+	}
+	for _, krid := range krids {
+		assert.NoError(qc.db.CreateKeyRange(ctx, &qdb.KeyRange{
+			KeyRangeID:     krid,
+			ShardID:        id,
+			DistributionId: "ds1",
+			LowerBound:     []byte(krid),
+		}))
+	}
+}
+
+func isDraining(t *testing.T, qc *qdbCoordinator, id string) bool {
+	sh, err := qc.getQdbShard(context.Background(), id)
+	assert.NoError(t, err)
+	assert.NotNil(t, sh)
+	return sh.Draining
+}
+
+func TestDrainShardWithoutKeyRanges(t *testing.T) {
+	assert := assert.New(t)
+	qc := newTestCoordinator(t)
+	addDrainTestShard(t, qc, "sh1", false)
+
+	assert.NoError(qc.DrainShard(context.Background(), "sh1"))
+
+	/* drained shard does not accept key ranges */
+	assert.True(isDraining(t, qc, "sh1"))
+	assert.Error(qc.checkShardAcceptsKeyRanges(context.Background(), "sh1"))
+}
+
+func TestDrainShardUnknown(t *testing.T) {
+	assert := assert.New(t)
+	qc := newTestCoordinator(t)
+
+	assert.Error(qc.DrainShard(context.Background(), "sh1"))
+}
+
+func TestDrainFailureClearsDraining(t *testing.T) {
+	assert := assert.New(t)
+	qc := newTestCoordinator(t)
+	/* there is no shard to move key range to */
+	addDrainTestShard(t, qc, "sh1", false, "kr1")
+	addDrainTestShard(t, qc, "sh2", true)
+
+	assert.Error(qc.DrainShard(context.Background(), "sh1"))
+
+	assert.False(isDraining(t, qc, "sh1"))
+	assert.NoError(qc.checkShardAcceptsKeyRanges(context.Background(), "sh1"))
+}
+
+func TestDrainFailureKeepsPreviousDraining(t *testing.T) {
+	assert := assert.New(t)
+	qc := newTestCoordinator(t)
+	addDrainTestShard(t, qc, "sh1", true, "kr1")
+
+	assert.Error(qc.drain(context.Background(), "sh1", func(int32) {}))
+
+	/* shard was marked draining before the failed drain */
+	assert.True(isDraining(t, qc, "sh1"))
+}
+
+func TestStartDrain(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+	addDrainTestShard(t, qc, "sh1", false, "kr1")
+
+	op, err := qc.StartDrain(ctx, "sh1")
+	assert.NoError(err)
+	assert.Equal(operations.KindDrain, op.Kind)
+
+	assert.Eventually(func() bool {
+		got, err := qc.GetOperation(ctx, op.ID)
+		return err == nil && got.Status == operations.StatusFailed
+	}, time.Second, 10*time.Millisecond)
+	assert.False(isDraining(t, qc, "sh1"))
+}
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 
 	"github.com/pg-sharding/spqr/coordinator"
+	"github.com/pg-sharding/spqr/pkg/meta"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
 	protos "github.com/pg-sharding/spqr/pkg/protos"
 )
@@ -78,6 +80,14 @@ func (c *CoordinatorService) SplitKeyRange(ctx context.Context, request *protos.
 		SplitLeft: request.SplitLeft,
 	}
 
+	if request.Nowait {
+		op, err := c.impl.StartSplit(ctx, splitKR)
+		if err != nil {
+			return nil, err
+		}
+		return &protos.ModifyReply{OperationId: op.ID}, nil
+	}
+
 	if err := c.impl.Split(ctx, splitKR); err != nil {
 		return nil, err
 	}
@@ -137,10 +147,20 @@ func (c *CoordinatorService) ListAllKeyRanges(ctx context.Context, _ *protos.Lis
 
 // TODO : unit tests
 func (c *CoordinatorService) MoveKeyRange(ctx context.Context, request *protos.MoveKeyRangeRequest) (*protos.ModifyReply, error) {
-	if err := c.impl.Move(ctx, &kr.MoveKeyRange{
+	move := &kr.MoveKeyRange{
 		Krid:    request.Id,
 		ShardId: request.ToShardId,
-	}); err != nil {
+	}
+
+	if request.Nowait {
+		op, err := c.impl.StartMove(ctx, move)
+		if err != nil {
+			return nil, err
+		}
+		return &protos.ModifyReply{OperationId: op.ID}, nil
+	}
+
+	if err := c.impl.Move(ctx, move); err != nil {
 		return nil, err
 	}
 
@@ -149,16 +169,48 @@ func (c *CoordinatorService) MoveKeyRange(ctx context.Context, request *protos.M
 
 // TODO : unit tests
 func (c *CoordinatorService) MergeKeyRange(ctx context.Context, request *protos.MergeKeyRangeRequest) (*protos.ModifyReply, error) {
-	if err := c.impl.Unite(ctx, &kr.UniteKeyRange{
+	unite := &kr.UniteKeyRange{
 		BaseKeyRangeId:      request.GetBaseId(),
 		AppendageKeyRangeId: request.GetAppendageId(),
-	}); err != nil {
+	}
+
+	if request.Nowait {
+		op, err := c.impl.StartUnite(ctx, unite)
+		if err != nil {
+			return nil, err
+		}
+		return &protos.ModifyReply{OperationId: op.ID}, nil
+	}
+
+	if err := c.impl.Unite(ctx, unite); err != nil {
 		return nil, spqrerror.Newf(spqrerror.SPQR_KEYRANGE_ERROR, "failed to unite key ranges: %s", err.Error())
 	}
 
 	return &protos.ModifyReply{}, nil
 }
 
+// ResolveKeyRange finds key ranges, containing given keys
+// TODO : unit tests
+func (c *CoordinatorService) ResolveKeyRange(ctx context.Context, request *protos.ResolveKeyRangeRequest) (*protos.ResolveKeyRangeReply, error) {
+	krs, err := meta.ResolveKeyRange(ctx, c.impl, request.DistributionId, request.Relation, request.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.ResolveKeyRangeReply{KeyRanges: kr.ResolvedToProto(request.Keys, krs)}, nil
+}
+
+// GetKeyRangeStats returns number of queries routed to each key range by all routers
+// TODO : unit tests
+func (c *CoordinatorService) GetKeyRangeStats(ctx context.Context, request *protos.GetKeyRangeStatsRequest) (*protos.GetKeyRangeStatsReply, error) {
+	counts, err := c.impl.KeyRangeQueryCounts(ctx, time.Duration(request.IntervalSec)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.GetKeyRangeStatsReply{Stats: kr.QueryCountsToProto(counts)}, nil
+}
+
 var _ protos.KeyRangeServiceServer = &CoordinatorService{}
 
 func NewKeyRangeService(impl coordinator.Coordinator) protos.KeyRangeServiceServer {
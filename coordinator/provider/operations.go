@@ -0,0 +1,491 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/pg-sharding/spqr/coordinator"
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/operations"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
+	"github.com/pg-sharding/spqr/pkg/models/tasks"
+	protos "github.com/pg-sharding/spqr/pkg/protos"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+)
+
+// operationScheduler makes sure operations, touching the same key ranges,
+// are not executed concurrently. While waiting for its turn,
+// operation stays in PLANNED status and can be cancelled.
+type operationScheduler struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	// key ranges, touched by running operations
+	busy map[string]struct{}
+	// operations, waiting for their turn
+	waiting map[string]bool
+}
+
+func newOperationScheduler() *operationScheduler {
+	s := &operationScheduler{
+		busy:    map[string]struct{}{},
+		waiting: map[string]bool{},
+	}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+// enqueue registers planned operation as waiting, so that it can be cancelled
+// before it calls acquire
+func (s *operationScheduler) enqueue(opId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.waiting[opId] = false
+}
+
+// acquire blocks until none of the key ranges is busy.
+// Returns false, if operation was cancelled while waiting.
+// New status of operation, running or cancelled, is recorded with record
+// under scheduler lock, so that cancel never sees the operation between
+// leaving the queue and recording its status.
+func (s *operationScheduler) acquire(opId string, krIds []string, record func(status operations.Status)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.waiting[opId]; !ok {
+		s.waiting[opId] = false
+	}
+	defer delete(s.waiting, opId)
+
+	for {
+		if s.waiting[opId] {
+			record(operations.StatusCancelled)
+			return false
+		}
+
+		free := true
+		for _, id := range krIds {
+			if _, ok := s.busy[id]; ok {
+				free = false
+				break
+			}
+		}
+		if free {
+			break
+		}
+		s.cond.Wait()
+	}
+
+	for _, id := range krIds {
+		s.busy[id] = struct{}{}
+	}
+	record(operations.StatusRunning)
+	return true
+}
+
+// dequeue forgets operation, which was not started
+func (s *operationScheduler) dequeue(opId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.waiting, opId)
+}
+
+func (s *operationScheduler) release(krIds []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range krIds {
+		delete(s.busy, id)
+	}
+	s.cond.Broadcast()
+}
+
+// cancel marks waiting operation as cancelled. Operation, which is not
+// waiting, is handled by cancelIdle under scheduler lock, so that
+// it can not be started by this coordinator meanwhile.
+func (s *operationScheduler) cancel(opId string, cancelIdle func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.waiting[opId]; !ok {
+		return cancelIdle()
+	}
+	s.waiting[opId] = true
+	s.cond.Broadcast()
+	return nil
+}
+
+func (qc *qdbCoordinator) recordOperation(ctx context.Context, op *operations.Operation) error {
+	return qc.db.RecordOperation(ctx, operations.OperationToDB(op))
+}
+
+// newOperation creates planned operation, modifying key ranges krIds
+func newOperation(kind string, description string, krIds []string) *operations.Operation {
+	return &operations.Operation{
+		ID:          uuid.NewString(),
+		Kind:        kind,
+		Description: description,
+		Status:      operations.StatusPlanned,
+		StartedAt:   time.Now(),
+		KeyRangeIDs: krIds,
+	}
+}
+
+// startOperation persists new operation and executes it in background.
+// Operations with intersecting locks are executed one after another.
+// Returned channel receives the result of execution.
+// run may report progress of the operation in percents.
+func (qc *qdbCoordinator) startOperation(ctx context.Context, op *operations.Operation, locks []string, run func(ctx context.Context, report func(progress int32)) error) (*operations.Operation, <-chan error, error) {
+	/* operation is waiting from the moment it is visible, so that cancel finds it */
+	qc.opScheduler.enqueue(op.ID)
+	if err := qc.recordOperation(ctx, op); err != nil {
+		qc.opScheduler.dequeue(op.ID)
+		return nil, nil, err
+	}
+
+	spqrlog.Zero.Info().
+		Str("id", op.ID).
+		Str("kind", op.Kind).
+		Str("description", op.Description).
+		Msg("operation planned")
+
+	done := make(chan error, 1)
+	res := *op
+
+	go func() {
+		done <- qc.runOperation(op, locks, run)
+	}()
+
+	return &res, done, nil
+}
+
+func (qc *qdbCoordinator) runOperation(op *operations.Operation, locks []string, run func(ctx context.Context, report func(progress int32)) error) error {
+	// operation outlives request, which started it
+	ctx := context.Background()
+
+	if !qc.opScheduler.acquire(op.ID, locks, func(status operations.Status) {
+		op.Status = status
+		if status == operations.StatusCancelled {
+			op.FinishedAt = time.Now()
+		}
+		if err := qc.recordOperation(ctx, op); err != nil {
+			spqrlog.Zero.Error().Err(err).Str("id", op.ID).Msg("failed to record operation state")
+		}
+	}) {
+		spqrlog.Zero.Info().Str("id", op.ID).Msg("operation cancelled")
+		qc.pruneOperations(ctx)
+		return spqrerror.Newf(spqrerror.SPQR_OPERATION_ERROR, "operation %s was cancelled", op.ID)
+	}
+	defer qc.opScheduler.release(locks)
+
+	err := run(ctx, func(progress int32) {
+		op.Progress = progress
+		if err := qc.recordOperation(ctx, op); err != nil {
+			spqrlog.Zero.Error().Err(err).Str("id", op.ID).Msg("failed to record operation progress")
+		}
+	})
+
+	op.FinishedAt = time.Now()
+	if err != nil {
+		op.Status = operations.StatusFailed
+		op.Error = err.Error()
+	} else {
+		op.Status = operations.StatusDone
+		op.Progress = 100
+	}
+	if err := qc.recordOperation(ctx, op); err != nil {
+		spqrlog.Zero.Error().Err(err).Str("id", op.ID).Msg("failed to record operation state")
+	}
+
+	spqrlog.Zero.Info().
+		Str("id", op.ID).
+		Str("status", string(op.Status)).
+		Err(err).
+		Msg("operation finished")
+	qc.pruneOperations(ctx)
+	return err
+}
+
+// recoverOperations reconciles operations, left unfinished by previous coordinator.
+// Moves of key ranges from resumable stay running and are returned by key range id,
+// the coordinator resumes them. Balancer task group operation stays running while
+// task group exists, it is resumed by the balancer. Other operations are marked as failed.
+func (qc *qdbCoordinator) recoverOperations(ctx context.Context, resumable map[string]bool) (map[string]*operations.Operation, error) {
+	ops, err := qc.ListOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	group, err := qc.db.GetTaskGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	moves := map[string]*operations.Operation{}
+	for _, op := range ops {
+		if op.Finished() {
+			continue
+		}
+		switch {
+		case op.Kind == operations.KindMove && op.Status == operations.StatusRunning &&
+			len(op.KeyRangeIDs) == 1 && resumable[op.KeyRangeIDs[0]]:
+			moves[op.KeyRangeIDs[0]] = op
+			continue
+		case op.Kind == operations.KindBalance && group != nil && len(group.Tasks) != 0:
+			continue
+		}
+
+		op.Status = operations.StatusFailed
+		op.FinishedAt = time.Now()
+		op.Error = "interrupted by coordinator restart"
+		if err := qc.recordOperation(ctx, op); err != nil {
+			return nil, err
+		}
+	}
+	return moves, nil
+}
+
+// resumeMove finishes key range move, interrupted by coordinator restart.
+// If the move was started as an operation, it is finished within it.
+func (qc *qdbCoordinator) resumeMove(ctx context.Context, req *kr.MoveKeyRange, op *operations.Operation) error {
+	if op == nil {
+		return qc.Move(ctx, req)
+	}
+	spqrlog.Zero.Info().
+		Str("id", op.ID).
+		Str("key range", req.Krid).
+		Msg("resuming interrupted move operation")
+	return qc.runOperation(op, op.KeyRangeIDs, func(ctx context.Context, report func(int32)) error {
+		return qc.move(ctx, req, report)
+	})
+}
+
+func (qc *qdbCoordinator) moveOperation(ctx context.Context, req *kr.MoveKeyRange) (*operations.Operation, <-chan error, error) {
+	if err := qc.checkShardAcceptsKeyRanges(ctx, req.ShardId); err != nil {
+		return nil, nil, err
+	}
+	op := newOperation(operations.KindMove,
+		fmt.Sprintf("move key range %s to shard %s", req.Krid, req.ShardId),
+		[]string{req.Krid})
+	return qc.startOperation(ctx, op, op.KeyRangeIDs,
+		func(ctx context.Context, report func(int32)) error {
+			return qc.move(ctx, req, report)
+		})
+}
+
+func (qc *qdbCoordinator) splitOperation(ctx context.Context, req *kr.SplitKeyRange) (*operations.Operation, <-chan error, error) {
+	op := newOperation(operations.KindSplit,
+		fmt.Sprintf("split key range %s from %s by %s", req.Krid, req.SourceID, req.Bound),
+		[]string{req.SourceID, req.Krid})
+	return qc.startOperation(ctx, op, op.KeyRangeIDs,
+		func(ctx context.Context, _ func(int32)) error {
+			return qc.split(ctx, req)
+		})
+}
+
+func (qc *qdbCoordinator) uniteOperation(ctx context.Context, req *kr.UniteKeyRange) (*operations.Operation, <-chan error, error) {
+	op := newOperation(operations.KindUnite,
+		fmt.Sprintf("unite key range %s with %s", req.BaseKeyRangeId, req.AppendageKeyRangeId),
+		[]string{req.BaseKeyRangeId, req.AppendageKeyRangeId})
+	return qc.startOperation(ctx, op, op.KeyRangeIDs,
+		func(ctx context.Context, _ func(int32)) error {
+			return qc.unite(ctx, req)
+		})
+}
+
+// taskGroupSteps returns number of steps left to execute task group.
+// Each task is split, moved and merged.
+func taskGroupSteps(group *tasks.TaskGroup) int32 {
+	steps := int32(0)
+	for _, task := range group.Tasks {
+		steps += int32(tasks.TaskMoved + 1 - task.State)
+	}
+	return steps
+}
+
+// taskGroupOperation returns unfinished operation of balancer task group, if any
+func (qc *qdbCoordinator) taskGroupOperation(ctx context.Context) (*operations.Operation, error) {
+	ops, err := qc.ListOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range ops {
+		if op.Kind == operations.KindBalance && !op.Finished() {
+			return op, nil
+		}
+	}
+	return nil, nil
+}
+
+// trackTaskGroup records progress of task group, written by the balancer.
+// First write of task group starts new operation.
+func (qc *qdbCoordinator) trackTaskGroup(ctx context.Context, group *tasks.TaskGroup) {
+	op, err := qc.taskGroupOperation(ctx)
+	if err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("failed to get task group operation")
+		return
+	}
+	left := taskGroupSteps(group)
+	if op == nil {
+		if len(group.Tasks) == 0 {
+			return
+		}
+		krIds := make([]string, 0)
+		for _, chain := range group.Chains() {
+			krIds = append(krIds, chain[0].KrIdFrom)
+		}
+		op = newOperation(operations.KindBalance,
+			fmt.Sprintf("execute balancer task group of %d tasks", len(group.Tasks)), krIds)
+		op.Status = operations.StatusRunning
+		op.Steps = left
+	}
+	if op.Steps > 0 && left <= op.Steps {
+		op.Progress = (op.Steps - left) * 100 / op.Steps
+	}
+	if err := qc.recordOperation(ctx, op); err != nil {
+		spqrlog.Zero.Error().Err(err).Str("id", op.ID).Msg("failed to record task group operation")
+	}
+}
+
+// finishTaskGroup marks operation of removed task group as done
+func (qc *qdbCoordinator) finishTaskGroup(ctx context.Context) {
+	op, err := qc.taskGroupOperation(ctx)
+	if err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("failed to get task group operation")
+		return
+	}
+	if op == nil {
+		return
+	}
+	op.Status = operations.StatusDone
+	op.Progress = 100
+	op.FinishedAt = time.Now()
+	if err := qc.recordOperation(ctx, op); err != nil {
+		spqrlog.Zero.Error().Err(err).Str("id", op.ID).Msg("failed to record task group operation")
+	}
+	qc.pruneOperations(ctx)
+}
+
+func (qc *qdbCoordinator) StartMove(ctx context.Context, req *kr.MoveKeyRange) (*operations.Operation, error) {
+	op, _, err := qc.moveOperation(ctx, req)
+	return op, err
+}
+
+func (qc *qdbCoordinator) StartSplit(ctx context.Context, req *kr.SplitKeyRange) (*operations.Operation, error) {
+	op, _, err := qc.splitOperation(ctx, req)
+	return op, err
+}
+
+func (qc *qdbCoordinator) StartUnite(ctx context.Context, req *kr.UniteKeyRange) (*operations.Operation, error) {
+	op, _, err := qc.uniteOperation(ctx, req)
+	return op, err
+}
+
+func (qc *qdbCoordinator) GetOperation(ctx context.Context, id string) (*operations.Operation, error) {
+	op, err := qc.db.GetOperation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return operations.OperationFromDB(op), nil
+}
+
+func (qc *qdbCoordinator) ListOperations(ctx context.Context) ([]*operations.Operation, error) {
+	opsDb, err := qc.db.ListOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*operations.Operation, len(opsDb))
+	for i, op := range opsDb {
+		res[i] = operations.OperationFromDB(op)
+	}
+	return res, nil
+}
+
+// CancelOperation cancels operation, which is waiting for its turn.
+// Running operations can not be cancelled safely, as they
+// may leave key ranges in intermediate state.
+func (qc *qdbCoordinator) CancelOperation(ctx context.Context, id string) error {
+	return qc.opScheduler.cancel(id, func() error {
+		op, err := qc.GetOperation(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		switch op.Status {
+		case operations.StatusPlanned:
+			// operation is not executed by this coordinator
+			op.Status = operations.StatusCancelled
+			op.FinishedAt = time.Now()
+			return qc.recordOperation(ctx, op)
+		case operations.StatusRunning:
+			return spqrerror.Newf(spqrerror.SPQR_OPERATION_ERROR, "operation %s is running and can not be cancelled safely", id)
+		default:
+			return spqrerror.Newf(spqrerror.SPQR_OPERATION_ERROR, "operation %s is already finished", id)
+		}
+	})
+}
+
+// pruneOperations removes records of operations, finished longer than
+// retention period ago
+func (qc *qdbCoordinator) pruneOperations(ctx context.Context) {
+	ops, err := qc.ListOperations(ctx)
+	if err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("failed to list operations to prune")
+		return
+	}
+	deadline := time.Now().Add(-config.CoordinatorConfig().OperationRetention())
+	for _, op := range ops {
+		if !op.Finished() || op.FinishedAt.After(deadline) {
+			continue
+		}
+		if err := qc.db.DeleteOperation(ctx, op.ID); err != nil {
+			spqrlog.Zero.Error().Err(err).Str("id", op.ID).Msg("failed to prune operation")
+			return
+		}
+		spqrlog.Zero.Debug().Str("id", op.ID).Msg("pruned finished operation")
+	}
+}
+
+type OperationServer struct {
+	protos.UnimplementedOperationServiceServer
+
+	impl coordinator.Coordinator
+}
+
+func NewOperationServer(impl coordinator.Coordinator) *OperationServer {
+	return &OperationServer{
+		impl: impl,
+	}
+}
+
+var _ protos.OperationServiceServer = &OperationServer{}
+
+func (o *OperationServer) GetOperation(ctx context.Context, request *protos.GetOperationRequest) (*protos.GetOperationReply, error) {
+	op, err := o.impl.GetOperation(ctx, request.OperationId)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.GetOperationReply{Operation: operations.OperationToProto(op)}, nil
+}
+
+func (o *OperationServer) ListOperations(ctx context.Context, _ *protos.ListOperationsRequest) (*protos.ListOperationsReply, error) {
+	ops, err := o.impl.ListOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*protos.Operation, len(ops))
+	for i, op := range ops {
+		res[i] = operations.OperationToProto(op)
+	}
+	return &protos.ListOperationsReply{Operations: res}, nil
+}
+
+func (o *OperationServer) CancelOperation(ctx context.Context, request *protos.CancelOperationRequest) (*protos.CancelOperationReply, error) {
+	return &protos.CancelOperationReply{}, o.impl.CancelOperation(ctx, request.OperationId)
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pg-sharding/spqr/pkg/models/operations"
+	"github.com/pg-sharding/spqr/pkg/models/tasks"
+	"github.com/pg-sharding/spqr/qdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCoordinator(t *testing.T) *qdbCoordinator {
+	db, err := qdb.NewMemQDB("")
+	assert.NoError(t, err)
+	return NewCoordinator(nil, db)
+}
+
+func TestOperationDone(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	op := newOperation(operations.KindMove, "move", []string{"kr1"})
+	res, done, err := qc.startOperation(ctx, op, op.KeyRangeIDs, func(_ context.Context, report func(int32)) error {
+		report(50)
+		got, err := qc.GetOperation(ctx, op.ID)
+		assert.NoError(err)
+		assert.Equal(operations.StatusRunning, got.Status)
+		assert.Equal(int32(50), got.Progress)
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal(operations.StatusPlanned, res.Status)
+	assert.NoError(waitOperation(ctx, done))
+
+	got, err := qc.GetOperation(ctx, op.ID)
+	assert.NoError(err)
+	assert.Equal(operations.StatusDone, got.Status)
+	assert.Equal(int32(100), got.Progress)
+	assert.Equal([]string{"kr1"}, got.KeyRangeIDs)
+	assert.False(got.FinishedAt.IsZero())
+}
+
+func TestOperationFailed(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	op := newOperation(operations.KindSplit, "split", []string{"kr1", "kr2"})
+	_, done, err := qc.startOperation(ctx, op, op.KeyRangeIDs, func(context.Context, func(int32)) error {
+		return errors.New("boom")
+	})
+	assert.NoError(err)
+	assert.Error(waitOperation(ctx, done))
+
+	got, err := qc.GetOperation(ctx, op.ID)
+	assert.NoError(err)
+	assert.Equal(operations.StatusFailed, got.Status)
+	assert.Equal("boom", got.Error)
+}
+
+func TestCancelWaitingOperation(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	first := newOperation(operations.KindMove, "first", []string{"kr1"})
+	_, firstDone, err := qc.startOperation(ctx, first, first.KeyRangeIDs, func(context.Context, func(int32)) error {
+		close(started)
+		<-release
+		return nil
+	})
+	assert.NoError(err)
+	<-started
+
+	second := newOperation(operations.KindMove, "second", []string{"kr1"})
+	_, secondDone, err := qc.startOperation(ctx, second, second.KeyRangeIDs, func(context.Context, func(int32)) error {
+		t.Error("cancelled operation must not run")
+		return nil
+	})
+	assert.NoError(err)
+
+	// running operation can not be cancelled
+	assert.Error(qc.CancelOperation(ctx, first.ID))
+
+	assert.Eventually(func() bool {
+		return qc.CancelOperation(ctx, second.ID) == nil
+	}, time.Second, 10*time.Millisecond)
+	assert.Error(waitOperation(ctx, secondDone))
+
+	close(release)
+	assert.NoError(waitOperation(ctx, firstDone))
+
+	got, err := qc.GetOperation(ctx, second.ID)
+	assert.NoError(err)
+	assert.Equal(operations.StatusCancelled, got.Status)
+
+	// finished operation can not be cancelled
+	assert.Error(qc.CancelOperation(ctx, first.ID))
+}
+
+func TestCancelOperationRace(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	for i := 0; i < 100; i++ {
+		op := newOperation(operations.KindMove, "move", []string{"kr1"})
+		ran := false
+		_, done, err := qc.startOperation(ctx, op, op.KeyRangeIDs, func(context.Context, func(int32)) error {
+			ran = true
+			return nil
+		})
+		assert.NoError(err)
+
+		cancelErr := qc.CancelOperation(ctx, op.ID)
+		runErr := waitOperation(ctx, done)
+
+		got, err := qc.GetOperation(ctx, op.ID)
+		assert.NoError(err)
+		if cancelErr == nil {
+			/* cancelled operation never runs and is never overwritten */
+			assert.Error(runErr)
+			assert.False(ran)
+			assert.Equal(operations.StatusCancelled, got.Status)
+		} else {
+			assert.NoError(runErr)
+			assert.True(ran)
+			assert.Equal(operations.StatusDone, got.Status)
+		}
+	}
+}
+
+func TestPruneOperations(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	old := time.Now().Add(-30 * 24 * time.Hour)
+	for _, op := range []*operations.Operation{
+		{ID: "old", Status: operations.StatusDone, StartedAt: old, FinishedAt: old},
+		{ID: "old-running", Status: operations.StatusRunning, StartedAt: old},
+		{ID: "recent", Status: operations.StatusFailed, StartedAt: time.Now(), FinishedAt: time.Now()},
+	} {
+		assert.NoError(qc.recordOperation(ctx, op))
+	}
+
+	qc.pruneOperations(ctx)
+
+	ops, err := qc.ListOperations(ctx)
+	assert.NoError(err)
+	ids := make([]string, 0, len(ops))
+	for _, op := range ops {
+		ids = append(ids, op.ID)
+	}
+	assert.ElementsMatch([]string{"old-running", "recent"}, ids)
+}
+
+func TestRecoverOperations(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	record := func(kind string, status operations.Status, krIds ...string) *operations.Operation {
+		op := newOperation(kind, kind, krIds)
+		op.Status = status
+		assert.NoError(qc.recordOperation(ctx, op))
+		return op
+	}
+
+	resumed := record(operations.KindMove, operations.StatusRunning, "kr1")
+	notResumed := record(operations.KindMove, operations.StatusRunning, "kr2")
+	planned := record(operations.KindMove, operations.StatusPlanned, "kr3")
+	split := record(operations.KindSplit, operations.StatusRunning, "kr1", "kr4")
+	balance := record(operations.KindBalance, operations.StatusRunning, "kr5")
+	done := record(operations.KindMove, operations.StatusDone, "kr6")
+
+	assert.NoError(qc.db.WriteTaskGroup(ctx, &qdb.TaskGroup{Tasks: []*qdb.Task{{KrIdFrom: "kr5"}}}))
+
+	moves, err := qc.recoverOperations(ctx, map[string]bool{"kr1": true, "kr3": true})
+	assert.NoError(err)
+	assert.Len(moves, 1)
+	assert.Equal(resumed.ID, moves["kr1"].ID)
+
+	for op, status := range map[*operations.Operation]operations.Status{
+		resumed:    operations.StatusRunning,
+		notResumed: operations.StatusFailed,
+		planned:    operations.StatusFailed,
+		split:      operations.StatusFailed,
+		balance:    operations.StatusRunning,
+		done:       operations.StatusDone,
+	} {
+		got, err := qc.GetOperation(ctx, op.ID)
+		assert.NoError(err)
+		assert.Equal(status, got.Status, op.Description)
+	}
+
+	// balancer task group is gone
+	assert.NoError(qc.db.RemoveTaskGroup(ctx))
+	_, err = qc.recoverOperations(ctx, nil)
+	assert.NoError(err)
+	got, err := qc.GetOperation(ctx, balance.ID)
+	assert.NoError(err)
+	assert.Equal(operations.StatusFailed, got.Status)
+}
+
+func TestTaskGroupOperation(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	qc := newTestCoordinator(t)
+
+	group := &tasks.TaskGroup{Tasks: []*tasks.Task{
+		{KrIdFrom: "kr1", ShardFromId: "sh1", ShardToId: "sh2", State: tasks.TaskPlanned},
+		{KrIdFrom: "kr1", ShardFromId: "sh1", ShardToId: "sh2", State: tasks.TaskPlanned},
+		{KrIdFrom: "kr2", ShardFromId: "sh1", ShardToId: "sh3", State: tasks.TaskPlanned},
+		{KrIdFrom: "kr3", ShardFromId: "sh1", ShardToId: "sh3", State: tasks.TaskPlanned},
+	}}
+	assert.NoError(qc.WriteTaskGroup(ctx, group))
+
+	op, err := qc.taskGroupOperation(ctx)
+	assert.NoError(err)
+	assert.NotNil(op)
+	assert.Equal(operations.StatusRunning, op.Status)
+	assert.Equal(int32(12), op.Steps)
+	assert.Equal(int32(0), op.Progress)
+	assert.Equal([]string{"kr1", "kr2", "kr3"}, op.KeyRangeIDs)
+
+	// kr3 is done, kr2 is moved
+	group.Tasks = group.Tasks[:3]
+	group.Tasks[2].State = tasks.TaskMoved
+	assert.NoError(qc.WriteTaskGroup(ctx, group))
+
+	got, err := qc.GetOperation(ctx, op.ID)
+	assert.NoError(err)
+	assert.Equal(int32(41), got.Progress)
+
+	assert.NoError(qc.RemoveTaskGroup(ctx))
+	got, err = qc.GetOperation(ctx, op.ID)
+	assert.NoError(err)
+	assert.Equal(operations.StatusDone, got.Status)
+	assert.Equal(int32(100), got.Progress)
+
+	// next task group gets new operation
+	assert.NoError(qc.WriteTaskGroup(ctx, group))
+	next, err := qc.taskGroupOperation(ctx)
+	assert.NoError(err)
+	assert.NotEqual(op.ID, next.ID)
+}
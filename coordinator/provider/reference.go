@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/pg-sharding/spqr/pkg/datatransfers"
+	"github.com/pg-sharding/spqr/pkg/models/distributions"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
+)
+
+// CheckReferenceRelation collects number of rows and contents checksum
+// of reference relation on every shard. Writes to reference relations are
+// broadcast by routers, so differing states mean the copies diverged.
+// TODO : unit tests
+func (qc *qdbCoordinator) CheckReferenceRelation(ctx context.Context, relName string) ([]*distributions.ReferenceRelationShardState, error) {
+	ds, err := qc.GetRelationDistribution(ctx, relName)
+	if err != nil {
+		return nil, err
+	}
+	if ds.Id != distributions.REPLICATED {
+		return nil, spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "relation \"%s\" is not a reference relation", relName)
+	}
+
+	shards, err := qc.db.ListShards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*distributions.ReferenceRelationShardState, 0, len(shards))
+	for _, sh := range shards {
+		rows, checksum, err := datatransfers.ReferenceRelationChecksum(ctx, sh.ID, relName)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, &distributions.ReferenceRelationShardState{
+			ShardId:  sh.ID,
+			Rows:     rows,
+			Checksum: checksum,
+		})
+	}
+	return states, nil
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pg-sharding/lyx/lyx"
+	"github.com/pg-sharding/spqr/coordinator"
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/datatransfers"
+	"github.com/pg-sharding/spqr/pkg/models/datashards"
+	"github.com/pg-sharding/spqr/pkg/models/schema"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
+	protos "github.com/pg-sharding/spqr/pkg/protos"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/router/qrouter"
+)
+
+// RecordSchemaChange appends DDL statement, applied by router on all shards,
+// to schema history.
+// TODO : unit tests
+func (qc *qdbCoordinator) RecordSchemaChange(ctx context.Context, query string) error {
+	change, err := qc.db.AppendSchemaChange(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	spqrlog.Zero.Debug().
+		Int64("id", change.ID).
+		Str("query", query).
+		Msg("recorded schema change")
+	return nil
+}
+
+// TODO : unit tests
+func (qc *qdbCoordinator) ListSchemaChanges(ctx context.Context) ([]*schema.SchemaChange, error) {
+	changes, err := qc.db.ListSchemaChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*schema.SchemaChange, len(changes))
+	for i, change := range changes {
+		res[i] = schema.SchemaChangeFromDB(change)
+	}
+	return res, nil
+}
+
+// CheckSchema collects checksum of relation definition, i.e. its columns,
+// constraints and indexes, on every shard. Definitions are expected
+// to be equal, as DDL is applied on all shards.
+// TODO : unit tests
+func (qc *qdbCoordinator) CheckSchema(ctx context.Context, relName string) ([]*schema.RelationSchemaState, error) {
+	relNames := []string{relName}
+	if relName == "" {
+		var err error
+		if relNames, err = qc.relationNames(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	shards, err := qc.db.ListShards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checksums := make([]map[string]string, len(shards))
+	for i, sh := range shards {
+		if checksums[i], err = datatransfers.RelationSchemaChecksums(ctx, sh.ID, relNames); err != nil {
+			return nil, err
+		}
+	}
+
+	states := make([]*schema.RelationSchemaState, 0, len(relNames)*len(shards))
+	for _, name := range relNames {
+		for i, sh := range shards {
+			states = append(states, &schema.RelationSchemaState{
+				ShardId:      sh.ID,
+				RelationName: name,
+				Checksum:     checksums[i][strings.ToLower(name)],
+			})
+		}
+	}
+	return states, nil
+}
+
+// CopySchema copies definitions of distributed and reference relations
+// to newly added shard, e.g. to prepare it for key range moves.
+func (qc *qdbCoordinator) CopySchema(ctx context.Context, fromShardId string, to *datashards.DataShard) error {
+	if to.ID == "" || to.ID == fromShardId {
+		return spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "invalid shard \"%s\" to copy schema to", to.ID)
+	}
+	if _, err := qc.db.GetShard(ctx, to.ID); err == nil {
+		return spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "shard \"%s\" already exists", to.ID)
+	}
+	from, err := qc.db.GetShard(ctx, fromShardId)
+	if err != nil {
+		return err
+	}
+
+	relNames, err := qc.relationNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	spqrlog.Zero.Info().
+		Str("from", fromShardId).
+		Str("to", to.ID).
+		Strs("relations", relNames).
+		Msg("copying schema between shards")
+	return datatransfers.CopySchema(ctx, datashards.NewDataShard(from.ID, &config.Shard{
+		Hosts: from.Hosts,
+		Type:  config.DataShard,
+	}), to, relNames)
+}
+
+// ReplaySchema applies schema history on newly added shard, which is not
+// registered yet. Shard, which already has distributed or reference relations,
+// is left as is.
+func (qc *qdbCoordinator) ReplaySchema(ctx context.Context, to *datashards.DataShard) error {
+	if to.ID == "" {
+		return spqrerror.New(spqrerror.SPQR_INVALID_REQUEST, "invalid shard to replay schema on")
+	}
+	if _, err := qc.db.GetShard(ctx, to.ID); err == nil {
+		return spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "shard \"%s\" already exists", to.ID)
+	}
+
+	changes, err := qc.db.ListSchemaChanges(ctx)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	queries := make([]string, len(changes))
+	for i, change := range changes {
+		queries[i] = change.Query
+	}
+
+	relNames, err := qc.relationNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	applied, err := datatransfers.ReplaySchema(ctx, to, relNames, queries, ddlAllowsTransaction)
+	if err != nil {
+		return err
+	}
+	spqrlog.Zero.Info().
+		Str("shard", to.ID).
+		Int("changes", len(queries)).
+		Bool("applied", applied).
+		Msg("replayed schema history on new shard")
+	return nil
+}
+
+// ddlAllowsTransaction reports if recorded DDL statement may be applied in transaction block
+func ddlAllowsTransaction(query string) bool {
+	stmt, err := lyx.Parse(query)
+	if err != nil {
+		/* let shard report the error */
+		return true
+	}
+	return qrouter.DDLAllowsTransaction(stmt)
+}
+
+// relationNames returns sorted names of all distributed and reference relations
+func (qc *qdbCoordinator) relationNames(ctx context.Context) ([]string, error) {
+	dss, err := qc.db.ListDistributions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var relNames []string
+	for _, ds := range dss {
+		for name := range ds.Relations {
+			relNames = append(relNames, name)
+		}
+	}
+	sort.Strings(relNames)
+	return relNames, nil
+}
+
+type SchemaServer struct {
+	protos.UnimplementedSchemaServiceServer
+
+	impl coordinator.Coordinator
+}
+
+func NewSchemaServer(impl coordinator.Coordinator) *SchemaServer {
+	return &SchemaServer{
+		impl: impl,
+	}
+}
+
+var _ protos.SchemaServiceServer = &SchemaServer{}
+
+func (s *SchemaServer) RecordSchemaChange(ctx context.Context, request *protos.RecordSchemaChangeRequest) (*protos.RecordSchemaChangeReply, error) {
+	return &protos.RecordSchemaChangeReply{}, s.impl.RecordSchemaChange(ctx, request.Query)
+}
+
+func (s *SchemaServer) ListSchemaChanges(ctx context.Context, _ *protos.ListSchemaChangesRequest) (*protos.ListSchemaChangesReply, error) {
+	changes, err := s.impl.ListSchemaChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*protos.SchemaChange, len(changes))
+	for i, change := range changes {
+		res[i] = schema.SchemaChangeToProto(change)
+	}
+	return &protos.ListSchemaChangesReply{Changes: res}, nil
+}
+
+func (s *SchemaServer) CheckSchema(ctx context.Context, request *protos.CheckSchemaRequest) (*protos.CheckSchemaReply, error) {
+	states, err := s.impl.CheckSchema(ctx, request.RelationName)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*protos.RelationSchemaState, len(states))
+	for i, st := range states {
+		res[i] = schema.RelationSchemaStateToProto(st)
+	}
+	return &protos.CheckSchemaReply{States: res}, nil
+}
+
+func (s *SchemaServer) ReplaySchema(ctx context.Context, request *protos.ReplaySchemaRequest) (*protos.ReplaySchemaReply, error) {
+	if request.ToShard == nil {
+		return nil, spqrerror.New(spqrerror.SPQR_INVALID_REQUEST, "new shard is not specified")
+	}
+	return &protos.ReplaySchemaReply{}, s.impl.ReplaySchema(ctx, datashards.DataShardFromProto(request.ToShard))
+}
+
+func (s *SchemaServer) CopySchema(ctx context.Context, request *protos.CopySchemaRequest) (*protos.CopySchemaReply, error) {
+	if request.ToShard == nil {
+		return nil, spqrerror.New(spqrerror.SPQR_INVALID_REQUEST, "new shard is not specified")
+	}
+	return &protos.CopySchemaReply{}, s.impl.CopySchema(ctx, request.FromShardId, datashards.DataShardFromProto(request.ToShard))
+}
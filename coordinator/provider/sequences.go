@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/pg-sharding/spqr/coordinator"
+	"github.com/pg-sharding/spqr/pkg/models/sequences"
+	protos "github.com/pg-sharding/spqr/pkg/protos"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+)
+
+// CreateSequence stores sequence in QDB and propagates its definition to routers.
+// Values of sequence are never propagated, routers request them in blocks.
+// TODO : unit tests
+func (qc *qdbCoordinator) CreateSequence(ctx context.Context, seq *sequences.Sequence) error {
+	if err := qc.db.CreateSequence(ctx, sequences.SequenceToDB(seq)); err != nil {
+		return err
+	}
+
+	return qc.traverseRouters(ctx, func(cc *grpc.ClientConn) error {
+		cl := protos.NewSequenceServiceClient(cc)
+		resp, err := cl.CreateSequence(ctx, &protos.CreateSequenceRequest{
+			Sequence: sequences.SequenceToProto(seq),
+		})
+		if err != nil {
+			return err
+		}
+
+		spqrlog.Zero.Debug().
+			Interface("response", resp).
+			Msg("create sequence response")
+		return nil
+	})
+}
+
+// TODO : unit tests
+func (qc *qdbCoordinator) DropSequence(ctx context.Context, name string) error {
+	if err := qc.db.DropSequence(ctx, name); err != nil {
+		return err
+	}
+
+	return qc.traverseRouters(ctx, func(cc *grpc.ClientConn) error {
+		cl := protos.NewSequenceServiceClient(cc)
+		resp, err := cl.DropSequence(ctx, &protos.DropSequenceRequest{
+			Name: name,
+		})
+		if err != nil {
+			return err
+		}
+
+		spqrlog.Zero.Debug().
+			Interface("response", resp).
+			Msg("drop sequence response")
+		return nil
+	})
+}
+
+// TODO : unit tests
+func (qc *qdbCoordinator) ListSequences(ctx context.Context) ([]*sequences.Sequence, error) {
+	seqs, err := qc.db.ListSequences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*sequences.Sequence, len(seqs))
+	for i, seq := range seqs {
+		res[i] = sequences.SequenceFromDB(seq)
+	}
+	return res, nil
+}
+
+// NextSequenceRange hands out block of sequence values to router.
+// TODO : unit tests
+func (qc *qdbCoordinator) NextSequenceRange(ctx context.Context, name string, count int64) (int64, error) {
+	return qc.db.NextSequenceRange(ctx, name, count)
+}
+
+type SequenceServer struct {
+	protos.UnimplementedSequenceServiceServer
+
+	impl coordinator.Coordinator
+}
+
+func NewSequenceServer(impl coordinator.Coordinator) *SequenceServer {
+	return &SequenceServer{
+		impl: impl,
+	}
+}
+
+var _ protos.SequenceServiceServer = &SequenceServer{}
+
+func (s *SequenceServer) CreateSequence(ctx context.Context, request *protos.CreateSequenceRequest) (*protos.CreateSequenceReply, error) {
+	return &protos.CreateSequenceReply{}, s.impl.CreateSequence(ctx, sequences.SequenceFromProto(request.Sequence))
+}
+
+func (s *SequenceServer) DropSequence(ctx context.Context, request *protos.DropSequenceRequest) (*protos.DropSequenceReply, error) {
+	return &protos.DropSequenceReply{}, s.impl.DropSequence(ctx, request.Name)
+}
+
+func (s *SequenceServer) ListSequences(ctx context.Context, _ *protos.ListSequencesRequest) (*protos.ListSequencesReply, error) {
+	seqs, err := s.impl.ListSequences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*protos.Sequence, len(seqs))
+	for i, seq := range seqs {
+		res[i] = sequences.SequenceToProto(seq)
+	}
+	return &protos.ListSequencesReply{Sequences: res}, nil
+}
+
+func (s *SequenceServer) NextSequenceRange(ctx context.Context, request *protos.NextSequenceRangeRequest) (*protos.NextSequenceRangeReply, error) {
+	first, err := s.impl.NextSequenceRange(ctx, request.Name, request.Count)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.NextSequenceRangeReply{First: first}, nil
+}
@@ -72,6 +72,20 @@ func (s *ShardServer) GetShard(ctx context.Context, shardRequest *protos.ShardRe
 	}, nil
 }
 
+// DrainShard moves all key ranges out of the shard
+// TODO : unit tests
+func (s *ShardServer) DrainShard(ctx context.Context, request *protos.DrainShardRequest) (*protos.DrainShardReply, error) {
+	if request.Nowait {
+		op, err := s.impl.StartDrain(ctx, request.Id)
+		if err != nil {
+			return nil, err
+		}
+		return &protos.DrainShardReply{OperationId: op.ID}, nil
+	}
+
+	return &protos.DrainShardReply{}, s.impl.DrainShard(ctx, request.Id)
+}
+
 type CoordShardInfo struct {
 	underlying *routerproto.BackendConnectionsInfo
 	router     string
@@ -119,3 +133,11 @@ func (c *CoordShardInfo) TxServed() int64 {
 func (c *CoordShardInfo) TxStatus() txstatus.TXStatus {
 	return txstatus.TXStatus(c.underlying.TxStatus)
 }
+
+func (c *CoordShardInfo) PrepStmtCacheHits() int64 {
+	return c.underlying.PrepStmtCacheHits
+}
+
+func (c *CoordShardInfo) PrepStmtCacheMisses() int64 {
+	return c.underlying.PrepStmtCacheMisses
+}
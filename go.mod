@@ -101,3 +101,6 @@ require (
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	gotest.tools/v3 v3.5.1 // indirect
 )
+
+// lyx is extended for SPQR until the changes are accepted upstream, see third_party/lyx/README.md
+replace github.com/pg-sharding/lyx => ./third_party/lyx
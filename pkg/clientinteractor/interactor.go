@@ -8,8 +8,12 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pg-sharding/spqr/pkg/models/hashfunction"
+	"github.com/pg-sharding/spqr/pkg/models/operations"
+	"github.com/pg-sharding/spqr/pkg/models/schema"
+	"github.com/pg-sharding/spqr/pkg/models/sequences"
 
 	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 
@@ -135,11 +139,20 @@ func (pi *PSQLInteractor) Pools(_ context.Context, ps []pool.Pool) error {
 		"pool host",
 		"used connections",
 		"idle connections",
-		"queue residual size"); err != nil {
+		"queue residual size",
+		"closed by lifetime",
+		"closed by idle timeout",
+		"failed checks",
+		"warmed up",
+		"waiting clients",
+		"wait timeouts",
+		"avg wait",
+		"max wait"); err != nil {
 		spqrlog.Zero.Error().Err(err).Msg("")
 		return err
 	}
 	for _, p := range ps {
+		stats := p.Stats()
 		if err := pi.WriteDataRow(
 			fmt.Sprintf("%p", p),
 			p.RouterName(),
@@ -148,7 +161,15 @@ func (pi *PSQLInteractor) Pools(_ context.Context, ps []pool.Pool) error {
 			p.Hostname(),
 			fmt.Sprintf("%d", p.UsedConnectionCount()),
 			fmt.Sprintf("%d", p.IdleConnectionCount()),
-			fmt.Sprintf("%d", p.QueueResidualSize())); err != nil {
+			fmt.Sprintf("%d", p.QueueResidualSize()),
+			fmt.Sprintf("%d", stats.ClosedByLifetime),
+			fmt.Sprintf("%d", stats.ClosedByIdleTimeout),
+			fmt.Sprintf("%d", stats.FailedChecks),
+			fmt.Sprintf("%d", stats.WarmedUp),
+			fmt.Sprintf("%d", stats.WaitingClients),
+			fmt.Sprintf("%d", stats.WaitTimeouts),
+			stats.AvgWait.String(),
+			stats.MaxWait.String()); err != nil {
 			spqrlog.Zero.Error().Err(err).Msg("")
 			return err
 		}
@@ -246,6 +267,24 @@ func (pi *PSQLInteractor) KeyRanges(krs []*kr.KeyRange) error {
 	return pi.CompleteMsg(0)
 }
 
+// ResolvedKeyRanges reports key ranges, matched by keys
+// TODO : unit tests
+func (pi *PSQLInteractor) ResolvedKeyRanges(_ context.Context, keys []string, krs []*kr.KeyRange) error {
+	if err := pi.WriteHeader("Key", "Key range ID", "Shard ID"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	for i, krg := range krs {
+		if err := pi.WriteDataRow(keys[i], krg.ID, krg.ShardID); err != nil {
+			spqrlog.Zero.Error().Err(err).Msg("")
+			return err
+		}
+	}
+
+	return pi.CompleteMsg(0)
+}
+
 // TODO : unit tests
 func (pi *PSQLInteractor) CreateKeyRange(ctx context.Context, keyRange *kr.KeyRange) error {
 	if err := pi.WriteHeader("add key range"); err != nil {
@@ -657,6 +696,217 @@ func (pi *PSQLInteractor) MoveKeyRange(_ context.Context, move *kr.MoveKeyRange)
 	return nil
 }
 
+// TODO : unit tests
+func (pi *PSQLInteractor) Operations(_ context.Context, ops []*operations.Operation) error {
+	if err := pi.WriteHeader("Operation ID", "Kind", "Status", "Progress", "Started at", "Finished at", "Description", "Error"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	for _, op := range ops {
+		if err := pi.WriteDataRow(
+			op.ID,
+			op.Kind,
+			string(op.Status),
+			fmt.Sprintf("%d%%", op.Progress),
+			formatTime(op.StartedAt),
+			formatTime(op.FinishedAt),
+			op.Description,
+			op.Error,
+		); err != nil {
+			spqrlog.Zero.Error().Err(err).Msg("")
+			return err
+		}
+	}
+
+	return pi.CompleteMsg(0)
+}
+
+// StartOperation reports an operation, scheduled by the NOWAIT command
+// TODO : unit tests
+func (pi *PSQLInteractor) StartOperation(_ context.Context, op *operations.Operation) error {
+	if err := pi.WriteHeader("Operation ID", "Description"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	if err := pi.WriteDataRow(op.ID, op.Description); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	return pi.CompleteMsg(0)
+}
+
+// TODO : unit tests
+func (pi *PSQLInteractor) CancelOperation(_ context.Context, id string) error {
+	if err := pi.WriteHeader("cancel operation"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	if err := pi.WriteDataRow(fmt.Sprintf("operation id -> %s", id)); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	return pi.CompleteMsg(0)
+}
+
+// TODO : unit tests
+func (pi *PSQLInteractor) DrainShard(_ context.Context, id string) error {
+	if err := pi.WriteHeader("drain shard"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	if err := pi.WriteDataRow(fmt.Sprintf("drained shard %s", id)); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	return pi.CompleteMsg(0)
+}
+
+// TODO : unit tests
+func (pi *PSQLInteractor) CreateReferenceRelation(_ context.Context, relName string) error {
+	if err := pi.WriteHeader("create reference relation"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	if err := pi.WriteDataRow(fmt.Sprintf("created reference relation %s", relName)); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	return pi.CompleteMsg(0)
+}
+
+// CheckReferenceRelation reports contents summary of reference relation on every shard
+// and warns client, if the copies diverged.
+func (pi *PSQLInteractor) CheckReferenceRelation(_ context.Context, relName string, states []*distributions.ReferenceRelationShardState) error {
+	if err := pi.WriteHeader("shard id", "rows", "checksum"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	for _, st := range states {
+		if err := pi.WriteDataRow(st.ShardId, fmt.Sprintf("%d", st.Rows), st.Checksum); err != nil {
+			spqrlog.Zero.Error().Err(err).Msg("")
+			return err
+		}
+	}
+
+	if !distributions.ReferenceRelationConsistent(states) {
+		if err := pi.cl.ReplyWarningf("reference relation %s differs between shards", relName); err != nil {
+			return err
+		}
+	}
+
+	return pi.CompleteMsg(len(states))
+}
+
+// TODO : unit tests
+func (pi *PSQLInteractor) CreateSequence(_ context.Context, seq *sequences.Sequence) error {
+	if err := pi.WriteHeader("create sequence"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	if err := pi.WriteDataRow(fmt.Sprintf("created sequence %s", seq.Name)); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	return pi.CompleteMsg(0)
+}
+
+// TODO : unit tests
+func (pi *PSQLInteractor) DropSequence(_ context.Context, name string) error {
+	if err := pi.WriteHeader("drop sequence"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	if err := pi.WriteDataRow(fmt.Sprintf("dropped sequence %s", name)); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	return pi.CompleteMsg(0)
+}
+
+// TODO : unit tests
+func (pi *PSQLInteractor) Sequences(_ context.Context, seqs []*sequences.Sequence) error {
+	if err := pi.WriteHeader("name", "relation", "column"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	for _, seq := range seqs {
+		if err := pi.WriteDataRow(seq.Name, seq.RelationName, seq.ColumnName); err != nil {
+			spqrlog.Zero.Error().Err(err).Msg("")
+			return err
+		}
+	}
+
+	return pi.CompleteMsg(len(seqs))
+}
+
+// TODO : unit tests
+func (pi *PSQLInteractor) SchemaChanges(_ context.Context, changes []*schema.SchemaChange) error {
+	if err := pi.WriteHeader("id", "query"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	for _, change := range changes {
+		if err := pi.WriteDataRow(fmt.Sprintf("%d", change.ID), change.Query); err != nil {
+			spqrlog.Zero.Error().Err(err).Msg("")
+			return err
+		}
+	}
+
+	return pi.CompleteMsg(len(changes))
+}
+
+// CheckSchema reports definition summary of relations on every shard
+// and warns client about relations, which definitions differ between shards.
+// TODO : unit tests
+func (pi *PSQLInteractor) CheckSchema(_ context.Context, states []*schema.RelationSchemaState) error {
+	if err := pi.WriteHeader("relation", "shard id", "checksum"); err != nil {
+		spqrlog.Zero.Error().Err(err).Msg("")
+		return err
+	}
+
+	for _, st := range states {
+		checksum := st.Checksum
+		if checksum == "" {
+			checksum = "missing"
+		}
+		if err := pi.WriteDataRow(st.RelationName, st.ShardId, checksum); err != nil {
+			spqrlog.Zero.Error().Err(err).Msg("")
+			return err
+		}
+	}
+
+	for _, rel := range schema.InconsistentRelations(states) {
+		if err := pi.cl.ReplyWarningf("relation %s differs between shards", rel); err != nil {
+			return err
+		}
+	}
+
+	return pi.CompleteMsg(len(states))
+}
+
 // TODO : unit tests
 func (pi *PSQLInteractor) Routers(resp []*topology.Router) error {
 	if err := pi.WriteHeader("show routers", "status"); err != nil {
@@ -844,7 +1094,7 @@ func (pi *PSQLInteractor) KillClient(clientID uint) error {
 
 // TODO : unit tests
 func (pi *PSQLInteractor) BackendConnections(ctx context.Context, shs []shard.Shardinfo) error {
-	if err := pi.WriteHeader("backend connection id", "router", "shard key name", "hostname", "user", "dbname", "sync", "tx_served", "tx status"); err != nil {
+	if err := pi.WriteHeader("backend connection id", "router", "shard key name", "hostname", "user", "dbname", "sync", "tx_served", "tx status", "prep stmt cache hits", "prep stmt cache misses"); err != nil {
 		spqrlog.Zero.Error().Err(err).Msg("")
 		return err
 	}
@@ -856,7 +1106,7 @@ func (pi *PSQLInteractor) BackendConnections(ctx context.Context, shs []shard.Sh
 			router = s.Router()
 		}
 
-		if err := pi.WriteDataRow(fmt.Sprintf("%d", sh.ID()), router, sh.ShardKeyName(), sh.InstanceHostname(), sh.Usr(), sh.DB(), strconv.FormatInt(sh.Sync(), 10), strconv.FormatInt(sh.TxServed(), 10), sh.TxStatus().String()); err != nil {
+		if err := pi.WriteDataRow(fmt.Sprintf("%d", sh.ID()), router, sh.ShardKeyName(), sh.InstanceHostname(), sh.Usr(), sh.DB(), strconv.FormatInt(sh.Sync(), 10), strconv.FormatInt(sh.TxServed(), 10), sh.TxStatus().String(), strconv.FormatInt(sh.PrepStmtCacheHits(), 10), strconv.FormatInt(sh.PrepStmtCacheMisses(), 10)); err != nil {
 			spqrlog.Zero.Error().Err(err).Msg("")
 			return err
 		}
@@ -905,7 +1155,11 @@ func (pi *PSQLInteractor) Relations(dsToRels map[string][]*distributions.Distrib
 				if err != nil {
 					return err
 				}
-				dsKey[i] = fmt.Sprintf("(\"%s\", %s)", e.Column, hashfunction.ToString(t))
+				if e.Expression != "" {
+					dsKey[i] = fmt.Sprintf("(\"%s(%s)\", %s)", e.Expression, e.Column, hashfunction.ToString(t))
+				} else {
+					dsKey[i] = fmt.Sprintf("(\"%s\", %s)", e.Column, hashfunction.ToString(t))
+				}
 			}
 			if err := pi.cl.Send(&pgproto3.DataRow{
 				Values: [][]byte{
@@ -1,11 +1,16 @@
 package clientinteractor_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/pg-sharding/spqr/pkg/clientinteractor"
+	"github.com/pg-sharding/spqr/pkg/models/distributions"
+	mockcl "github.com/pg-sharding/spqr/router/mock/client"
 	spqrparser "github.com/pg-sharding/spqr/yacc/console"
 )
 
@@ -108,3 +113,54 @@ func TestOrMatchWhere(t *testing.T) {
 	assert.NoError(err)
 	assert.Equal(expected, actual)
 }
+
+func TestCheckReferenceRelation(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	for _, tt := range []struct {
+		states []*distributions.ReferenceRelationShardState
+		warn   bool
+	}{
+		{
+			states: []*distributions.ReferenceRelationShardState{
+				{ShardId: "sh1", Rows: 2, Checksum: "abc"},
+				{ShardId: "sh2", Rows: 2, Checksum: "abc"},
+			},
+			warn: false,
+		},
+		{
+			states: []*distributions.ReferenceRelationShardState{
+				{ShardId: "sh1", Rows: 2, Checksum: "abc"},
+				{ShardId: "sh2", Rows: 2, Checksum: "abd"},
+			},
+			warn: true,
+		},
+	} {
+		cl := mockcl.NewMockRouterClient(ctrl)
+
+		var sent []pgproto3.BackendMessage
+		cl.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg pgproto3.BackendMessage) error {
+			sent = append(sent, msg)
+			return nil
+		}).AnyTimes()
+		if tt.warn {
+			cl.EXPECT().ReplyWarningf(gomock.Any(), "currencies").Return(nil).Times(1)
+		}
+
+		pi := clientinteractor.NewPSQLInteractor(cl)
+		assert.NoError(pi.CheckReferenceRelation(context.TODO(), "currencies", tt.states))
+
+		assert.Equal([]pgproto3.BackendMessage{
+			&pgproto3.RowDescription{Fields: []pgproto3.FieldDescription{
+				clientinteractor.TextOidFD("shard id"),
+				clientinteractor.TextOidFD("rows"),
+				clientinteractor.TextOidFD("checksum"),
+			}},
+			&pgproto3.DataRow{Values: [][]byte{[]byte("sh1"), []byte("2"), []byte("abc")}},
+			&pgproto3.DataRow{Values: [][]byte{[]byte("sh2"), []byte("2"), []byte(tt.states[1].Checksum)}},
+			&pgproto3.CommandComplete{CommandTag: []byte("SELECT 2")},
+			&pgproto3.ReadyForQuery{TxStatus: 'I'},
+		}, sent)
+	}
+}
@@ -11,7 +11,26 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-const defaultBalancerTimeout = 60
+const (
+	defaultBalancerTimeout = 60
+	defaultSamplePercent   = 1
+
+	defaultMaxMovesPerRun             = 10
+	defaultMaxConcurrentMovesPerShard = 1
+)
+
+type BalancerMetricsSource string
+
+const (
+	// MetricsSourcePgCommentStats collects cpu usage with pg_comment_stats extension,
+	// queries have to be marked with key_range_id comment
+	MetricsSourcePgCommentStats = BalancerMetricsSource("pg_comment_stats")
+	// MetricsSourcePgStatStatements collects cpu usage with pg_stat_statements,
+	// pg_stat_user_tables and row sampling
+	MetricsSourcePgStatStatements = BalancerMetricsSource("pg_stat_statements")
+	// MetricsSourceRouter uses per-key-range query counters, reported by routers
+	MetricsSourceRouter = BalancerMetricsSource("router")
+)
 
 type Balancer struct {
 	LogLevel string `json:"log_level" toml:"log_level" yaml:"log_level"` // TODO usage
@@ -26,9 +45,19 @@ type Balancer struct {
 
 	StatIntervalSec int `json:"stat_interval_sec" yaml:"stat_interval_sec" toml:"stat_interval_sec"`
 
+	MetricsSource BalancerMetricsSource `json:"metrics_source" yaml:"metrics_source" toml:"metrics_source"`
+	// SamplePercent is the percent of relation pages, sampled to estimate key range load
+	// with pg_stat_statements metrics source
+	SamplePercent float64 `json:"sample_percent" yaml:"sample_percent" toml:"sample_percent"`
+
 	MaxMoveCount int `json:"max_move_count" yaml:"max_move_count" toml:"max_move_count"`
 	KeysPerMove  int `json:"keys_per_move" yaml:"keys_per_move" toml:"keys_per_move"`
 
+	// MaxMovesPerRun limits number of key ranges moved during one balancer run
+	MaxMovesPerRun int `json:"max_moves_per_run" yaml:"max_moves_per_run" toml:"max_moves_per_run"`
+	// MaxConcurrentMovesPerShard limits number of moves, executed in parallel, with the shard as source or destination
+	MaxConcurrentMovesPerShard int `json:"max_concurrent_moves_per_shard" yaml:"max_concurrent_moves_per_shard" toml:"max_concurrent_moves_per_shard"`
+
 	TimeoutSec int `json:"timeout" yaml:"timeout" toml:"timeout"`
 }
 
@@ -48,6 +77,18 @@ func LoadBalancerCfg(cfgPath string) error {
 	if cfgBalancer.TimeoutSec == 0 {
 		cfgBalancer.TimeoutSec = defaultBalancerTimeout
 	}
+	if cfgBalancer.MetricsSource == "" {
+		cfgBalancer.MetricsSource = MetricsSourcePgCommentStats
+	}
+	if cfgBalancer.SamplePercent == 0 {
+		cfgBalancer.SamplePercent = defaultSamplePercent
+	}
+	if cfgBalancer.MaxMovesPerRun == 0 {
+		cfgBalancer.MaxMovesPerRun = defaultMaxMovesPerRun
+	}
+	if cfgBalancer.MaxConcurrentMovesPerShard == 0 {
+		cfgBalancer.MaxConcurrentMovesPerShard = defaultMaxConcurrentMovesPerShard
+	}
 
 	configBytes, err := json.MarshalIndent(cfgBalancer, "", "  ")
 	if err != nil {
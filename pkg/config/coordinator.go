@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"gopkg.in/yaml.v2"
@@ -22,6 +23,18 @@ type Coordinator struct {
 	Auth            *AuthCfg   `json:"auth" toml:"auth" yaml:"auth"`
 	FrontendTLS     *TLSConfig `json:"frontend_tls" yaml:"frontend_tls" toml:"frontend_tls"`
 	ShardDataCfg    string     `json:"shard_data" toml:"shard_data" yaml:"shard_data"`
+	// how long records of finished operations are kept, a week by default
+	OperationRetentionSec int `json:"operation_retention" toml:"operation_retention" yaml:"operation_retention"`
+}
+
+const defaultOperationRetention = 7 * 24 * time.Hour
+
+// OperationRetention returns how long records of finished operations are kept
+func (c *Coordinator) OperationRetention() time.Duration {
+	if c.OperationRetentionSec <= 0 {
+		return defaultOperationRetention
+	}
+	return time.Duration(c.OperationRetentionSec) * time.Second
 }
 
 func LoadCoordinatorCfg(cfgPath string) error {
@@ -14,6 +14,27 @@ import (
 
 type DatatransferConnections struct {
 	ShardsData map[string]*ShardConnect `json:"shards" toml:"shards" yaml:"shards"`
+	// Default holds database and credentials of shards, which are not listed
+	// in ShardsData, e.g. of ones added at runtime
+	Default *ShardConnect `json:"default" toml:"default" yaml:"default"`
+}
+
+// ShardConnect returns connection parameters of the shard with given hosts.
+// Shards, which are not listed in config, are connected with default credentials.
+// ok is false, if parameters of the shard can not be resolved.
+func (c *DatatransferConnections) ShardConnect(shardId string, hosts []string) (*ShardConnect, bool) {
+	if sd, ok := c.ShardsData[shardId]; ok && len(sd.Hosts) != 0 {
+		return sd, true
+	}
+	if c.Default == nil || len(hosts) == 0 {
+		return nil, false
+	}
+	return &ShardConnect{
+		Hosts:    hosts,
+		DB:       c.Default.DB,
+		User:     c.Default.User,
+		Password: c.Default.Password,
+	}, true
 }
 
 type ShardConnect struct {
@@ -15,18 +15,41 @@ import (
 type PoolMode string
 type ShardType string
 type RouterMode string
+type HostSelectionPolicy string
 
 const (
 	PoolModeSession     = PoolMode("SESSION")
 	PoolModeTransaction = PoolMode("TRANSACTION")
+	PoolModeStatement   = PoolMode("STATEMENT")
 
 	DataShard  = ShardType("DATA")
 	WorldShard = ShardType("WORLD")
 
 	LocalMode = RouterMode("LOCAL")
 	ProxyMode = RouterMode("PROXY")
+
+	HostSelectionRandom = HostSelectionPolicy("random")
+	// HostSelectionRoundRobin cycles through shard hosts
+	HostSelectionRoundRobin = HostSelectionPolicy("round_robin")
+	// HostSelectionLeastConnections prefers hosts with the least number of used connections
+	HostSelectionLeastConnections = HostSelectionPolicy("least_connections")
+	// HostSelectionLowestLatency prefers hosts with the lowest average query time
+	HostSelectionLowestLatency = HostSelectionPolicy("lowest_latency")
+	// HostSelectionLocalZone prefers hosts from the availability zone of the router
+	HostSelectionLocalZone = HostSelectionPolicy("local_zone")
 )
 
+// Validate returns error for unknown host selection policy
+func (p HostSelectionPolicy) Validate() error {
+	switch p {
+	case "", HostSelectionRandom, HostSelectionRoundRobin, HostSelectionLeastConnections,
+		HostSelectionLowestLatency, HostSelectionLocalZone:
+		return nil
+	default:
+		return fmt.Errorf("unknown host selection policy \"%s\"", p)
+	}
+}
+
 var cfgRouter Router
 
 type Router struct {
@@ -44,6 +67,7 @@ type Router struct {
 	LogFileName string `json:"log_filename" toml:"log_filename" yaml:"log_filename"`
 
 	Host             string `json:"host" toml:"host" yaml:"host"`
+	AvailabilityZone string `json:"availability_zone" toml:"availability_zone" yaml:"availability_zone"`
 	RouterPort       string `json:"router_port" toml:"router_port" yaml:"router_port"`
 	RouterROPort     string `json:"router_ro_port" toml:"router_ro_port" yaml:"router_ro_port"`
 	AdminConsolePort string `json:"admin_console_port" toml:"admin_console_port" yaml:"admin_console_port"`
@@ -73,6 +97,8 @@ type Router struct {
 
 	WithCoordinator bool `json:"with_coordinator" toml:"with_coordinator" yaml:"with_coordinator"`
 
+	HostHealthCheck HostHealthCheck `json:"host_health_check" toml:"host_health_check" yaml:"host_health_check"`
+
 	UseSystemdNotifier   bool `json:"use_systemd_notifier" toml:"use_systemd_notifier" yaml:"use_systemd_notifier"`
 	SystemdNotifierDebug bool `json:"systemd_notifier_debug" toml:"systemd_notifier_debug" yaml:"systemd_notifier_debug"`
 }
@@ -80,6 +106,25 @@ type Router struct {
 type QRouter struct {
 	MulticastUnroutableInsertStatement bool   `json:"multicast_unroutable_insert_statement" toml:"multicast_unroutable_insert_statement" yaml:"multicast_unroutable_insert_statement"`
 	DefaultRouteBehaviour              string `json:"default_route_behaviour" toml:"default_route_behaviour" yaml:"default_route_behaviour"`
+	// SequenceCacheSize is the number of distributed sequence values, requested at once
+	SequenceCacheSize int64 `json:"sequence_cache_size" toml:"sequence_cache_size" yaml:"sequence_cache_size"`
+}
+
+type HostHealthCheck struct {
+	Enabled bool `json:"enabled" toml:"enabled" yaml:"enabled"`
+	// DB and Usr select backend rule, used to connect to shard hosts
+	DB  string `json:"db" toml:"db" yaml:"db"`
+	Usr string `json:"usr" toml:"usr" yaml:"usr"`
+
+	IntervalMs int `json:"interval_ms" toml:"interval_ms" yaml:"interval_ms"`
+	// TimeoutMs bounds connect and query of single check, interval is used by default
+	TimeoutMs int `json:"timeout_ms" toml:"timeout_ms" yaml:"timeout_ms"`
+	// FallThreshold is the number of consecutive failed checks to mark host down
+	FallThreshold int `json:"fall_threshold" toml:"fall_threshold" yaml:"fall_threshold"`
+	// RiseThreshold is the number of consecutive successful checks to mark host up
+	RiseThreshold int `json:"rise_threshold" toml:"rise_threshold" yaml:"rise_threshold"`
+	// MaxReplicaLagMs excludes lagging replicas from read-only routing, zero disables the limit
+	MaxReplicaLagMs int `json:"max_replica_lag" toml:"max_replica_lag" yaml:"max_replica_lag"`
 }
 
 type BackendRule struct {
@@ -90,6 +135,20 @@ type BackendRule struct {
 	PoolDefault       bool                `json:"pool_default" yaml:"pool_default" toml:"pool_default"`
 	ConnectionLimit   int                 `json:"connection_limit" yaml:"connection_limit" toml:"connection_limit"`
 	ConnectionRetries int                 `json:"connection_retries" yaml:"connection_retries" toml:"connection_retries"`
+	// QueryWaitTimeoutSec limits time, client waits for server connection when pool is full
+	QueryWaitTimeoutSec int `json:"query_wait_timeout" yaml:"query_wait_timeout" toml:"query_wait_timeout"`
+	// HostSelectionPolicy orders hosts for read-only and prefer-standby connections
+	HostSelectionPolicy HostSelectionPolicy `json:"host_selection_policy" yaml:"host_selection_policy" toml:"host_selection_policy"`
+	// ServerLifetimeSec is maximum age of server connection, zero disables the limit
+	ServerLifetimeSec int `json:"server_lifetime" yaml:"server_lifetime" toml:"server_lifetime"`
+	// ServerIdleTimeoutSec closes server connections, which are idle for longer, zero disables the timeout
+	ServerIdleTimeoutSec int `json:"server_idle_timeout" yaml:"server_idle_timeout" toml:"server_idle_timeout"`
+	// MinPoolSize is number of connections kept open to each host
+	MinPoolSize int `json:"min_pool_size" yaml:"min_pool_size" toml:"min_pool_size"`
+	// ServerCheckQuery is run on idle server connections to check if they are still alive
+	ServerCheckQuery string `json:"server_check_query" yaml:"server_check_query" toml:"server_check_query"`
+	// PreparedStatementsCacheSize limits prepared statements kept on each server connection, zero disables the limit
+	PreparedStatementsCacheSize int `json:"prepared_statements_cache_size" yaml:"prepared_statements_cache_size" toml:"prepared_statements_cache_size"`
 }
 
 type FrontendRule struct {
@@ -101,6 +160,15 @@ type FrontendRule struct {
 	PoolRollback          bool     `json:"pool_rollback" yaml:"pool_rollback" toml:"pool_rollback"`
 	PoolPreparedStatement bool     `json:"pool_prepared_statement" yaml:"pool_prepared_statement" toml:"pool_prepared_statement"`
 	PoolDefault           bool     `json:"pool_default" yaml:"pool_default" toml:"pool_default"`
+	// ReservePoolSize is number of connections to each host, held back from backend rule connection limit
+	// for clients of the rule, which waited for connection longer than reserve pool timeout
+	ReservePoolSize       int `json:"reserve_pool_size" yaml:"reserve_pool_size" toml:"reserve_pool_size"`
+	ReservePoolTimeoutSec int `json:"reserve_pool_timeout" yaml:"reserve_pool_timeout" toml:"reserve_pool_timeout"`
+	// QueryTimeoutSec cancels statements, which run on shards for longer, zero disables the timeout
+	QueryTimeoutSec int `json:"query_timeout" yaml:"query_timeout" toml:"query_timeout"`
+	// IdleTransactionTimeoutSec terminates clients, which are idle inside transaction for longer,
+	// zero disables the timeout
+	IdleTransactionTimeoutSec int `json:"idle_transaction_timeout" yaml:"idle_transaction_timeout" toml:"idle_transaction_timeout"`
 }
 
 const (
@@ -114,6 +182,8 @@ type Shard struct {
 	Hosts []string   `json:"hosts" toml:"hosts" yaml:"hosts"`
 	Type  ShardType  `json:"type" toml:"type" yaml:"type"`
 	TLS   *TLSConfig `json:"tls" yaml:"tls" toml:"tls"`
+	// HostZones maps host to its availability zone
+	HostZones map[string]string `json:"host_zones" toml:"host_zones" yaml:"host_zones"`
 }
 
 func LoadRouterCfg(cfgPath string) error {
@@ -135,6 +205,13 @@ func LoadRouterCfg(cfgPath string) error {
 		return err
 	}
 
+	for _, rule := range rcfg.BackendRules {
+		if err := rule.HostSelectionPolicy.Validate(); err != nil {
+			cfgRouter = rcfg
+			return fmt.Errorf("backend rule for db %s usr %s: %w", rule.DB, rule.Usr, err)
+		}
+	}
+
 	statistics.InitStatistics(rcfg.TimeQuantiles)
 
 	configBytes, err := json.MarshalIndent(rcfg, "", "  ")
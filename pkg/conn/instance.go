@@ -1,10 +1,12 @@
 package conn
 
 import (
+	"context"
 	"crypto/tls"
 	"encoding/binary"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
@@ -29,6 +31,8 @@ type DBInstance interface {
 	ShardName() string
 
 	Close() error
+	// SetDeadline sets read and write deadline of the connection, zero time clears it
+	SetDeadline(t time.Time) error
 	Status() InstanceStatus
 	SetStatus(status InstanceStatus)
 
@@ -68,6 +72,10 @@ func (pgi *PostgreSQLInstance) Close() error {
 	return pgi.conn.Close()
 }
 
+func (pgi *PostgreSQLInstance) SetDeadline(t time.Time) error {
+	return pgi.conn.SetDeadline(t)
+}
+
 func (pgi *PostgreSQLInstance) Hostname() string {
 	return pgi.hostname
 }
@@ -86,10 +94,24 @@ func (pgi *PostgreSQLInstance) Receive() (pgproto3.BackendMessage, error) {
 }
 
 func NewInstanceConn(host string, shard string, tlsconfig *tls.Config) (DBInstance, error) {
-	netconn, err := net.Dial("tcp", host)
+	return NewInstanceConnContext(context.Background(), host, shard, tlsconfig)
+}
+
+// NewInstanceConnContext dials host until context is done. Deadline of the context
+// is also set as deadline of the connection, so that handshake following the dial
+// does not outlive the context. Caller clears it with SetDeadline.
+func NewInstanceConnContext(ctx context.Context, host string, shard string, tlsconfig *tls.Config) (DBInstance, error) {
+	var dialer net.Dialer
+	netconn, err := dialer.DialContext(ctx, "tcp", host)
 	if err != nil {
 		return nil, err
 	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := netconn.SetDeadline(deadline); err != nil {
+			_ = netconn.Close()
+			return nil, err
+		}
+	}
 
 	instance := &PostgreSQLInstance{
 		hostname:  host,
@@ -12,6 +12,9 @@ import (
 	"github.com/pg-sharding/spqr/pkg/models/datashards"
 	"github.com/pg-sharding/spqr/pkg/models/distributions"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/operations"
+	"github.com/pg-sharding/spqr/pkg/models/schema"
+	"github.com/pg-sharding/spqr/pkg/models/sequences"
 	"github.com/pg-sharding/spqr/pkg/models/topology"
 	proto "github.com/pg-sharding/spqr/pkg/protos"
 	"github.com/pg-sharding/spqr/qdb"
@@ -225,6 +228,85 @@ func (a *Adapter) Move(ctx context.Context, move *kr.MoveKeyRange) error {
 	return spqrerror.Newf(spqrerror.SPQR_KEYRANGE_ERROR, "key range with id %s not found", move.Krid)
 }
 
+// TODO : unit tests
+func (a *Adapter) StartMove(ctx context.Context, move *kr.MoveKeyRange) (*operations.Operation, error) {
+	c := proto.NewKeyRangeServiceClient(a.conn)
+	reply, err := c.MoveKeyRange(ctx, &proto.MoveKeyRangeRequest{
+		Id:        move.Krid,
+		ToShardId: move.ShardId,
+		Nowait:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.GetOperation(ctx, reply.OperationId)
+}
+
+// TODO : unit tests
+func (a *Adapter) StartSplit(ctx context.Context, split *kr.SplitKeyRange) (*operations.Operation, error) {
+	c := proto.NewKeyRangeServiceClient(a.conn)
+	reply, err := c.SplitKeyRange(ctx, &proto.SplitKeyRangeRequest{
+		Bound:     split.Bound,
+		SourceId:  split.SourceID,
+		NewId:     split.Krid,
+		SplitLeft: split.SplitLeft,
+		Nowait:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.GetOperation(ctx, reply.OperationId)
+}
+
+// TODO : unit tests
+func (a *Adapter) StartUnite(ctx context.Context, unite *kr.UniteKeyRange) (*operations.Operation, error) {
+	c := proto.NewKeyRangeServiceClient(a.conn)
+	reply, err := c.MergeKeyRange(ctx, &proto.MergeKeyRangeRequest{
+		BaseId:      unite.BaseKeyRangeId,
+		AppendageId: unite.AppendageKeyRangeId,
+		Nowait:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.GetOperation(ctx, reply.OperationId)
+}
+
+// TODO : unit tests
+func (a *Adapter) GetOperation(ctx context.Context, id string) (*operations.Operation, error) {
+	c := proto.NewOperationServiceClient(a.conn)
+	reply, err := c.GetOperation(ctx, &proto.GetOperationRequest{
+		OperationId: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return operations.OperationFromProto(reply.Operation), nil
+}
+
+// TODO : unit tests
+func (a *Adapter) ListOperations(ctx context.Context) ([]*operations.Operation, error) {
+	c := proto.NewOperationServiceClient(a.conn)
+	reply, err := c.ListOperations(ctx, &proto.ListOperationsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*operations.Operation, len(reply.Operations))
+	for i, op := range reply.Operations {
+		res[i] = operations.OperationFromProto(op)
+	}
+	return res, nil
+}
+
+// TODO : unit tests
+func (a *Adapter) CancelOperation(ctx context.Context, id string) error {
+	c := proto.NewOperationServiceClient(a.conn)
+	_, err := c.CancelOperation(ctx, &proto.CancelOperationRequest{
+		OperationId: id,
+	})
+	return err
+}
+
 // TODO : unit tests
 func (a *Adapter) DropKeyRange(ctx context.Context, krid string) error {
 	c := proto.NewKeyRangeServiceClient(a.conn)
@@ -303,6 +385,26 @@ func (a *Adapter) DropShard(ctx context.Context, shardId string) error {
 	return spqrerror.New(spqrerror.SPQR_NOT_IMPLEMENTED, "DropShard not implemented")
 }
 
+// TODO : unit tests
+func (a *Adapter) DrainShard(ctx context.Context, shardId string) error {
+	c := proto.NewShardServiceClient(a.conn)
+	_, err := c.DrainShard(ctx, &proto.DrainShardRequest{Id: shardId})
+	return err
+}
+
+// TODO : unit tests
+func (a *Adapter) StartDrain(ctx context.Context, shardId string) (*operations.Operation, error) {
+	c := proto.NewShardServiceClient(a.conn)
+	reply, err := c.DrainShard(ctx, &proto.DrainShardRequest{
+		Id:     shardId,
+		Nowait: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.GetOperation(ctx, reply.OperationId)
+}
+
 // TODO : unit tests
 // TODO : implement
 func (a *Adapter) AddWorldShard(ctx context.Context, shard *datashards.DataShard) error {
@@ -317,8 +419,9 @@ func (a *Adapter) ListShards(ctx context.Context) ([]*datashards.DataShard, erro
 	var ds []*datashards.DataShard
 	for _, shard := range shards {
 		ds = append(ds, &datashards.DataShard{
-			ID:  shard.Id,
-			Cfg: &config.Shard{Hosts: shard.Hosts},
+			ID:       shard.Id,
+			Cfg:      &config.Shard{Hosts: shard.Hosts},
+			Draining: shard.Draining,
 		})
 	}
 	return ds, err
@@ -429,6 +532,130 @@ func (a *Adapter) GetRelationDistribution(ctx context.Context, id string) (*dist
 	return distributions.DistributionFromProto(resp.Distribution), nil
 }
 
+// TODO : unit tests
+func (a *Adapter) CheckReferenceRelation(ctx context.Context, relName string) ([]*distributions.ReferenceRelationShardState, error) {
+	c := proto.NewDistributionServiceClient(a.conn)
+
+	resp, err := c.CheckReferenceRelation(ctx, &proto.CheckReferenceRelationRequest{
+		RelName: relName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*distributions.ReferenceRelationShardState, len(resp.States))
+	for i, st := range resp.States {
+		states[i] = distributions.ReferenceRelationShardStateFromProto(st)
+	}
+	return states, nil
+}
+
+// TODO : unit tests
+func (a *Adapter) CreateSequence(ctx context.Context, seq *sequences.Sequence) error {
+	c := proto.NewSequenceServiceClient(a.conn)
+	_, err := c.CreateSequence(ctx, &proto.CreateSequenceRequest{
+		Sequence: sequences.SequenceToProto(seq),
+	})
+	return err
+}
+
+// TODO : unit tests
+func (a *Adapter) DropSequence(ctx context.Context, name string) error {
+	c := proto.NewSequenceServiceClient(a.conn)
+	_, err := c.DropSequence(ctx, &proto.DropSequenceRequest{
+		Name: name,
+	})
+	return err
+}
+
+// TODO : unit tests
+func (a *Adapter) ListSequences(ctx context.Context) ([]*sequences.Sequence, error) {
+	c := proto.NewSequenceServiceClient(a.conn)
+	resp, err := c.ListSequences(ctx, &proto.ListSequencesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*sequences.Sequence, len(resp.Sequences))
+	for i, seq := range resp.Sequences {
+		res[i] = sequences.SequenceFromProto(seq)
+	}
+	return res, nil
+}
+
+// TODO : unit tests
+func (a *Adapter) NextSequenceRange(ctx context.Context, name string, count int64) (int64, error) {
+	c := proto.NewSequenceServiceClient(a.conn)
+	resp, err := c.NextSequenceRange(ctx, &proto.NextSequenceRangeRequest{
+		Name:  name,
+		Count: count,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.First, nil
+}
+
+// TODO : unit tests
+func (a *Adapter) RecordSchemaChange(ctx context.Context, query string) error {
+	c := proto.NewSchemaServiceClient(a.conn)
+	_, err := c.RecordSchemaChange(ctx, &proto.RecordSchemaChangeRequest{
+		Query: query,
+	})
+	return err
+}
+
+// TODO : unit tests
+func (a *Adapter) ListSchemaChanges(ctx context.Context) ([]*schema.SchemaChange, error) {
+	c := proto.NewSchemaServiceClient(a.conn)
+	resp, err := c.ListSchemaChanges(ctx, &proto.ListSchemaChangesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*schema.SchemaChange, len(resp.Changes))
+	for i, change := range resp.Changes {
+		res[i] = schema.SchemaChangeFromProto(change)
+	}
+	return res, nil
+}
+
+// TODO : unit tests
+func (a *Adapter) CheckSchema(ctx context.Context, relName string) ([]*schema.RelationSchemaState, error) {
+	c := proto.NewSchemaServiceClient(a.conn)
+	resp, err := c.CheckSchema(ctx, &proto.CheckSchemaRequest{
+		RelationName: relName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*schema.RelationSchemaState, len(resp.States))
+	for i, st := range resp.States {
+		states[i] = schema.RelationSchemaStateFromProto(st)
+	}
+	return states, nil
+}
+
+// TODO : unit tests
+func (a *Adapter) CopySchema(ctx context.Context, fromShardId string, to *datashards.DataShard) error {
+	c := proto.NewSchemaServiceClient(a.conn)
+	_, err := c.CopySchema(ctx, &proto.CopySchemaRequest{
+		FromShardId: fromShardId,
+		ToShard:     datashards.DataShardToProto(to),
+	})
+	return err
+}
+
+// TODO : unit tests
+func (a *Adapter) ReplaySchema(ctx context.Context, to *datashards.DataShard) error {
+	c := proto.NewSchemaServiceClient(a.conn)
+	_, err := c.ReplaySchema(ctx, &proto.ReplaySchemaRequest{
+		ToShard: datashards.DataShardToProto(to),
+	})
+	return err
+}
+
 func (a *Adapter) GetTaskGroup(ctx context.Context) (*tasks.TaskGroup, error) {
 	tasksService := proto.NewTasksServiceClient(a.conn)
 	res, err := tasksService.GetTaskGroup(ctx, &proto.GetTaskGroupRequest{})
@@ -13,11 +13,16 @@ import (
 	"github.com/pg-sharding/spqr/pkg/models/datashards"
 	"github.com/pg-sharding/spqr/pkg/models/distributions"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/operations"
+	"github.com/pg-sharding/spqr/pkg/models/schema"
+	"github.com/pg-sharding/spqr/pkg/models/sequences"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 	"github.com/pg-sharding/spqr/pkg/models/topology"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
 	"github.com/pg-sharding/spqr/qdb"
 	"github.com/pg-sharding/spqr/qdb/ops"
 	"github.com/pg-sharding/spqr/router/routingstate"
+	"github.com/pg-sharding/spqr/router/statistics"
 )
 
 type LocalCoordinator struct {
@@ -172,6 +177,16 @@ func (lc *LocalCoordinator) DropShard(ctx context.Context, shardId string) error
 	lc.mu.Lock()
 	defer lc.mu.Unlock()
 
+	krs, err := lc.qdb.ListAllKeyRanges(ctx)
+	if err != nil {
+		return err
+	}
+	for _, krg := range krs {
+		if krg.ShardID == shardId {
+			return spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "shard %s still owns key range %s, drain it first", shardId, krg.KeyRangeID)
+		}
+	}
+
 	delete(lc.DataShardCfgs, shardId)
 	delete(lc.WorldShardCfgs, shardId)
 
@@ -186,7 +201,11 @@ func (lc *LocalCoordinator) DropKeyRange(ctx context.Context, id string) error {
 	spqrlog.Zero.Info().
 		Str("kr", id).
 		Msg("dropping key range")
-	return lc.qdb.DropKeyRange(ctx, id)
+	if err := lc.qdb.DropKeyRange(ctx, id); err != nil {
+		return err
+	}
+	statistics.DropKeyRangeStatistic(id)
+	return nil
 }
 
 // TODO : unit tests
@@ -195,7 +214,11 @@ func (lc *LocalCoordinator) DropKeyRangeAll(ctx context.Context) error {
 	defer lc.mu.Unlock()
 
 	spqrlog.Zero.Info().Msg("dropping all key ranges")
-	return lc.qdb.DropKeyRangeAll(ctx)
+	if err := lc.qdb.DropKeyRangeAll(ctx); err != nil {
+		return err
+	}
+	statistics.DropKeyRangeStatistics()
+	return nil
 }
 
 // TODO : unit tests
@@ -488,6 +511,106 @@ func (qr *LocalCoordinator) GetShard(ctx context.Context, shardID string) (*data
 	return nil, ErrNotCoordinator
 }
 
+func (qr *LocalCoordinator) CheckReferenceRelation(ctx context.Context, relName string) ([]*distributions.ReferenceRelationShardState, error) {
+	return nil, ErrNotCoordinator
+}
+
+func (qr *LocalCoordinator) DrainShard(ctx context.Context, shardId string) error {
+	return ErrNotCoordinator
+}
+
+func (qr *LocalCoordinator) StartDrain(ctx context.Context, shardId string) (*operations.Operation, error) {
+	return nil, ErrNotCoordinator
+}
+
+func (qr *LocalCoordinator) StartMove(ctx context.Context, req *kr.MoveKeyRange) (*operations.Operation, error) {
+	return nil, ErrNotCoordinator
+}
+
+func (qr *LocalCoordinator) StartSplit(ctx context.Context, req *kr.SplitKeyRange) (*operations.Operation, error) {
+	return nil, ErrNotCoordinator
+}
+
+func (qr *LocalCoordinator) StartUnite(ctx context.Context, req *kr.UniteKeyRange) (*operations.Operation, error) {
+	return nil, ErrNotCoordinator
+}
+
+func (qr *LocalCoordinator) GetOperation(ctx context.Context, id string) (*operations.Operation, error) {
+	return nil, ErrNotCoordinator
+}
+
+func (qr *LocalCoordinator) ListOperations(ctx context.Context) ([]*operations.Operation, error) {
+	return nil, ErrNotCoordinator
+}
+
+func (qr *LocalCoordinator) CancelOperation(ctx context.Context, id string) error {
+	return ErrNotCoordinator
+}
+
+// TODO : unit tests
+func (lc *LocalCoordinator) CreateSequence(ctx context.Context, seq *sequences.Sequence) error {
+	return lc.qdb.CreateSequence(ctx, sequences.SequenceToDB(seq))
+}
+
+// TODO : unit tests
+func (lc *LocalCoordinator) DropSequence(ctx context.Context, name string) error {
+	return lc.qdb.DropSequence(ctx, name)
+}
+
+// TODO : unit tests
+func (lc *LocalCoordinator) ListSequences(ctx context.Context) ([]*sequences.Sequence, error) {
+	seqs, err := lc.qdb.ListSequences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*sequences.Sequence, len(seqs))
+	for i, seq := range seqs {
+		res[i] = sequences.SequenceFromDB(seq)
+	}
+	return res, nil
+}
+
+// NextSequenceRange allocates sequence values from local QDB. Routers, working
+// with coordinator, have to request values from it instead.
+// TODO : unit tests
+func (lc *LocalCoordinator) NextSequenceRange(ctx context.Context, name string, count int64) (int64, error) {
+	return lc.qdb.NextSequenceRange(ctx, name, count)
+}
+
+// RecordSchemaChange appends schema change to local QDB. Routers, working
+// with coordinator, record changes in its schema history instead.
+// TODO : unit tests
+func (lc *LocalCoordinator) RecordSchemaChange(ctx context.Context, query string) error {
+	_, err := lc.qdb.AppendSchemaChange(ctx, query)
+	return err
+}
+
+// TODO : unit tests
+func (lc *LocalCoordinator) ListSchemaChanges(ctx context.Context) ([]*schema.SchemaChange, error) {
+	changes, err := lc.qdb.ListSchemaChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*schema.SchemaChange, len(changes))
+	for i, change := range changes {
+		res[i] = schema.SchemaChangeFromDB(change)
+	}
+	return res, nil
+}
+
+func (lc *LocalCoordinator) CheckSchema(ctx context.Context, relName string) ([]*schema.RelationSchemaState, error) {
+	return nil, ErrNotCoordinator
+}
+
+func (lc *LocalCoordinator) CopySchema(ctx context.Context, fromShardId string, to *datashards.DataShard) error {
+	return ErrNotCoordinator
+}
+
+// ReplaySchema does nothing, schema history is replayed on new shards by coordinator
+func (lc *LocalCoordinator) ReplaySchema(ctx context.Context, to *datashards.DataShard) error {
+	return nil
+}
+
 func (lc *LocalCoordinator) ShareKeyRange(id string) error {
 	return lc.qdb.ShareKeyRange(id)
 }
@@ -2,7 +2,9 @@ package datashard
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/pg-sharding/spqr/pkg/config"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/pg-sharding/spqr/pkg/auth"
 	"github.com/pg-sharding/spqr/pkg/txstatus"
+	"github.com/pg-sharding/spqr/router/statistics"
 )
 
 // TODO : unit tests
@@ -40,6 +43,8 @@ type Conn struct {
 
 	sync_in  int64
 	sync_out int64
+	// start of the oldest request, which is not synced yet
+	syncStart time.Time
 
 	tx_served int64
 
@@ -47,7 +52,7 @@ type Conn struct {
 
 	status txstatus.TXStatus
 
-	mp map[uint64]*shard.PreparedStatementDescriptor
+	prepStmts *prepStmtCache
 }
 
 func (sh *Conn) Close() error {
@@ -104,9 +109,10 @@ func (sh *Conn) Send(query pgproto3.FrontendMessage) error {
 	/* handle copy properly */
 
 	switch query.(type) {
-	case *pgproto3.Query:
-		sh.sync_in++
-	case *pgproto3.Sync:
+	case *pgproto3.Query, *pgproto3.Sync:
+		if sh.sync_in == sh.sync_out {
+			sh.syncStart = time.Now()
+		}
 		sh.sync_in++
 	default:
 	}
@@ -128,6 +134,10 @@ func (sh *Conn) Receive() (pgproto3.BackendMessage, error) {
 	switch v := msg.(type) {
 	case *pgproto3.ReadyForQuery:
 		sh.sync_out++
+		if sh.sync_out == sh.sync_in && !sh.syncStart.IsZero() {
+			statistics.RecordHostLatency(sh.dedicated.Hostname(), time.Since(sh.syncStart))
+			sh.syncStart = time.Time{}
+		}
 		sh.status = txstatus.TXStatus(v.TxStatus)
 		if sh.status == txstatus.TXIDLE {
 			sh.tx_served++
@@ -199,7 +209,8 @@ func NewShard(
 		ps:       shard.ParameterSet{},
 		sync_in:  1, /* +1 for startup message */
 		sync_out: 0,
-		mp:       map[uint64]*shard.PreparedStatementDescriptor{},
+
+		prepStmts: newPrepStmtCache(beRule.PreparedStatementsCacheSize),
 	}
 
 	dtSh.dedicated = pgi
@@ -314,6 +325,8 @@ func (sh *Conn) Cleanup(rule *config.FrontendRule) error {
 		if err := sh.fire("DISCARD ALL"); err != nil {
 			return err
 		}
+		/* DISCARD ALL deallocates all prepared statements */
+		sh.prepStmts.reset()
 	}
 
 	return nil
@@ -327,13 +340,60 @@ func (sh *Conn) TxStatus() txstatus.TXStatus {
 	return sh.status
 }
 
-// TODO : unit tests
 func (srv *Conn) HasPrepareStatement(hash uint64) (bool, *shard.PreparedStatementDescriptor) {
-	rd, ok := srv.mp[hash]
+	rd, ok := srv.prepStmts.get(hash)
 	return ok, rd
 }
 
-// TODO : unit tests
+// PrepareStatement saves statement, deployed on connection. Least recently used
+// statements are closed on server, if cache size limit is exceeded.
 func (srv *Conn) PrepareStatement(hash uint64, rd *shard.PreparedStatementDescriptor) {
-	srv.mp[hash] = rd
+	for _, evicted := range srv.prepStmts.put(hash, rd) {
+		if err := srv.closePreparedStatement(evicted.Name); err != nil {
+			spqrlog.Zero.Error().
+				Uint("shard", srv.ID()).
+				Str("name", evicted.Name).
+				Err(err).
+				Msg("failed to close evicted prepared statement")
+		}
+	}
+}
+
+// TODO : unit tests
+func (srv *Conn) closePreparedStatement(name string) error {
+	spqrlog.Zero.Debug().
+		Uint("shard", srv.ID()).
+		Str("name", name).
+		Msg("closing evicted prepared statement")
+
+	if err := srv.Send(&pgproto3.Close{
+		ObjectType: 'S',
+		Name:       name,
+	}); err != nil {
+		return err
+	}
+	if err := srv.Send(&pgproto3.Sync{}); err != nil {
+		return err
+	}
+
+	for {
+		msg, err := srv.Receive()
+		if err != nil {
+			return err
+		}
+		switch v := msg.(type) {
+		case *pgproto3.ErrorResponse:
+			err = errors.New(v.Message)
+		case *pgproto3.ReadyForQuery:
+			return err
+		}
+	}
+}
+
+func (srv *Conn) PrepStmtCacheHits() int64 {
+	return srv.prepStmts.hits.Load()
+}
+
+func (srv *Conn) PrepStmtCacheMisses() int64 {
+	return srv.prepStmts.misses.Load()
 }
@@ -0,0 +1,76 @@
+package datashard
+
+import (
+	"container/list"
+	"sync/atomic"
+
+	"github.com/pg-sharding/spqr/pkg/shard"
+)
+
+type prepStmtCacheEntry struct {
+	hash uint64
+	rd   *shard.PreparedStatementDescriptor
+}
+
+// prepStmtCache keeps prepared statements, deployed on server connection,
+// in least recently used order.
+type prepStmtCache struct {
+	size    int
+	entries map[uint64]*list.Element
+	lru     *list.List
+
+	/* counters are read by SHOW backend_connections concurrently with the connection owner */
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// newPrepStmtCache creates cache, which holds at most size statements.
+// Zero size disables the limit.
+func newPrepStmtCache(size int) *prepStmtCache {
+	return &prepStmtCache{
+		size:    size,
+		entries: map[uint64]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+func (c *prepStmtCache) get(hash uint64) (*shard.PreparedStatementDescriptor, bool) {
+	e, ok := c.entries[hash]
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+	c.hits.Add(1)
+	c.lru.MoveToFront(e)
+	return e.Value.(*prepStmtCacheEntry).rd, true
+}
+
+// put stores statement and returns descriptors of evicted statements,
+// which should be closed on server connection.
+func (c *prepStmtCache) put(hash uint64, rd *shard.PreparedStatementDescriptor) []*shard.PreparedStatementDescriptor {
+	if e, ok := c.entries[hash]; ok {
+		e.Value.(*prepStmtCacheEntry).rd = rd
+		c.lru.MoveToFront(e)
+		return nil
+	}
+
+	c.entries[hash] = c.lru.PushFront(&prepStmtCacheEntry{
+		hash: hash,
+		rd:   rd,
+	})
+
+	var evicted []*shard.PreparedStatementDescriptor
+	for c.size > 0 && c.lru.Len() > c.size {
+		e := c.lru.Back()
+		entry := c.lru.Remove(e).(*prepStmtCacheEntry)
+		delete(c.entries, entry.hash)
+		evicted = append(evicted, entry.rd)
+	}
+	return evicted
+}
+
+// reset forgets all statements, for example after DISCARD ALL
+func (c *prepStmtCache) reset() {
+	c.entries = map[uint64]*list.Element{}
+	c.lru.Init()
+}
@@ -0,0 +1,83 @@
+package datashard
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/pg-sharding/spqr/pkg/config"
+	mockinst "github.com/pg-sharding/spqr/pkg/mock/conn"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepStmtCacheLRU(t *testing.T) {
+	assert := assert.New(t)
+
+	c := newPrepStmtCache(2)
+
+	assert.Empty(c.put(1, &shard.PreparedStatementDescriptor{Name: "1"}))
+	assert.Empty(c.put(2, &shard.PreparedStatementDescriptor{Name: "2"}))
+
+	/* 1 becomes most recently used */
+	_, ok := c.get(1)
+	assert.True(ok)
+
+	evicted := c.put(3, &shard.PreparedStatementDescriptor{Name: "3"})
+	assert.Equal(1, len(evicted))
+	assert.Equal("2", evicted[0].Name)
+
+	_, ok = c.get(2)
+	assert.False(ok)
+
+	assert.Equal(int64(1), c.hits.Load())
+	assert.Equal(int64(1), c.misses.Load())
+
+	c.reset()
+	_, ok = c.get(1)
+	assert.False(ok)
+}
+
+func TestPrepStmtCacheUnlimited(t *testing.T) {
+	assert := assert.New(t)
+
+	c := newPrepStmtCache(0)
+	for i := uint64(0); i < 100; i++ {
+		assert.Empty(c.put(i, &shard.PreparedStatementDescriptor{}))
+	}
+	assert.Equal(100, c.lru.Len())
+}
+
+func TestPrepareStatementClosesEvicted(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	ins := mockinst.NewMockDBInstance(ctrl)
+	ins.EXPECT().Hostname().AnyTimes().Return("h1")
+
+	sh := &Conn{
+		beRule:    &config.BackendRule{},
+		dedicated: ins,
+		prepStmts: newPrepStmtCache(1),
+	}
+
+	gomock.InOrder(
+		ins.EXPECT().Send(&pgproto3.Close{ObjectType: 'S', Name: "1"}).Return(nil),
+		ins.EXPECT().Send(&pgproto3.Sync{}).Return(nil),
+		ins.EXPECT().Receive().Return(&pgproto3.CloseComplete{}, nil),
+		ins.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: 'I'}, nil),
+	)
+
+	sh.PrepareStatement(1, &shard.PreparedStatementDescriptor{Name: "1"})
+	sh.PrepareStatement(2, &shard.PreparedStatementDescriptor{Name: "2"})
+
+	ok, _ := sh.HasPrepareStatement(1)
+	assert.False(ok)
+	ok, rd := sh.HasPrepareStatement(2)
+	assert.True(ok)
+	assert.Equal("2", rd.Name)
+
+	assert.Equal(int64(1), sh.PrepStmtCacheHits())
+	assert.Equal(int64(1), sh.PrepStmtCacheMisses())
+	assert.Equal(int64(0), sh.Sync())
+}
@@ -7,11 +7,14 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/pg-sharding/spqr/coordinator"
 	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/models/datashards"
 	"github.com/pg-sharding/spqr/pkg/models/distributions"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
 	"github.com/pg-sharding/spqr/qdb"
 	"io"
+	"net"
 	"os"
 	"strings"
 	"sync"
@@ -55,6 +58,32 @@ func createConnString(shardID string) string {
 	return fmt.Sprintf("user=%s host=%s port=%s dbname=%s password=%s", sd.User, host, port, sd.DB, sd.Password)
 }
 
+// shardConnString builds connection string of the shard. Shards, which are not
+// listed in shard data config, e.g. ones being added, are connected to hosts
+// of their definition with default credentials.
+func shardConnString(shard *datashards.DataShard) (string, error) {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	var hosts []string
+	if shard.Cfg != nil {
+		hosts = shard.Cfg.Hosts
+	}
+	if shards == nil {
+		return "", spqrerror.New(spqrerror.SPQR_TRANSFER_ERROR, "shard data config is not loaded")
+	}
+	sd, ok := shards.ShardConnect(shard.ID, hosts)
+	if !ok {
+		return "", spqrerror.Newf(spqrerror.SPQR_TRANSFER_ERROR, "connection parameters of shard \"%s\" are not found in shard data config", shard.ID)
+	}
+	// TODO find_master
+	host, port, err := net.SplitHostPort(sd.Hosts[0])
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("user=%s host=%s port=%s dbname=%s password=%s", sd.User, host, port, sd.DB, sd.Password), nil
+}
+
 func LoadConfig(path string) error {
 	var err error
 	lock.Lock()
@@ -71,6 +100,148 @@ func LoadConfig(path string) error {
 	return nil
 }
 
+func ensureConfig() {
+	if shards == nil {
+		err := LoadConfig(config.CoordinatorConfig().ShardDataCfg)
+		if err != nil {
+			spqrlog.Zero.Error().Err(err).Msg("error loading config")
+		}
+	}
+}
+
+// ShardDataSize returns size of the shard database in bytes
+// TODO : unit tests
+func ShardDataSize(ctx context.Context, shardId string) (int64, error) {
+	ensureConfig()
+
+	conn, err := pgx.Connect(ctx, createConnString(shardId))
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = conn.Close(ctx) }()
+
+	var size int64
+	if err := conn.QueryRow(ctx, `SELECT pg_database_size(current_database())`).Scan(&size); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// rowQuerier is implemented by pgx.Conn and pgx.Tx
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// ReferenceRelationChecksum returns number of rows and checksum of reference relation contents on the shard
+func ReferenceRelationChecksum(ctx context.Context, shardId string, relName string) (int64, string, error) {
+	ensureConfig()
+
+	conn, err := pgx.Connect(ctx, createConnString(shardId))
+	if err != nil {
+		return 0, "", err
+	}
+	defer func() { _ = conn.Close(ctx) }()
+
+	return referenceRelationChecksum(ctx, conn, relName)
+}
+
+func referenceRelationChecksum(ctx context.Context, conn rowQuerier, relName string) (int64, string, error) {
+	var rows int64
+	var checksum string
+	if err := conn.QueryRow(ctx, fmt.Sprintf(`SELECT count(*), coalesce(md5(string_agg(t::text, ',' ORDER BY t::text)), '') FROM %s as t`,
+		pgx.Identifier{strings.ToLower(relName)}.Sanitize())).Scan(&rows, &checksum); err != nil {
+		return 0, "", err
+	}
+	return rows, checksum, nil
+}
+
+// relationSchemaQuery summarizes columns, constraints and indexes of relations
+const relationSchemaQuery = `
+SELECT c.relname, md5(
+	coalesce((SELECT string_agg(a.attname || ' ' || format_type(a.atttypid, a.atttypmod) ||
+			CASE WHEN a.attnotnull THEN ' NOT NULL' ELSE '' END ||
+			coalesce(' DEFAULT ' || pg_get_expr(d.adbin, d.adrelid), ''), ', ' ORDER BY a.attnum)
+		FROM pg_attribute a LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+		WHERE a.attrelid = c.oid AND a.attnum > 0 AND NOT a.attisdropped), '') || ';' ||
+	coalesce((SELECT string_agg(pg_get_constraintdef(con.oid), ', ' ORDER BY pg_get_constraintdef(con.oid))
+		FROM pg_constraint con WHERE con.conrelid = c.oid), '') || ';' ||
+	coalesce((SELECT string_agg(pg_get_indexdef(i.indexrelid), ', ' ORDER BY pg_get_indexdef(i.indexrelid))
+		FROM pg_index i WHERE i.indrelid = c.oid), ''))
+FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = 'public' AND c.relkind IN ('r', 'p') AND c.relname = ANY($1)`
+
+// RelationSchemaChecksums returns checksums of relations definitions on the shard.
+// Relations, which do not exist on the shard, are omitted.
+// TODO : unit tests
+func RelationSchemaChecksums(ctx context.Context, shardId string, relNames []string) (map[string]string, error) {
+	ensureConfig()
+
+	conn, err := pgx.Connect(ctx, createConnString(shardId))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close(ctx) }()
+
+	// TODO get actual schema
+	names := make([]string, len(relNames))
+	for i, name := range relNames {
+		names[i] = strings.ToLower(name)
+	}
+
+	rows, err := conn.Query(ctx, relationSchemaQuery, names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	checksums := map[string]string{}
+	for rows.Next() {
+		var name, checksum string
+		if err := rows.Scan(&name, &checksum); err != nil {
+			return nil, err
+		}
+		checksums[name] = checksum
+	}
+	return checksums, rows.Err()
+}
+
+// KeyRangeDataSize returns size of the key range rows of all distributed relations on the shard in bytes
+// TODO : unit tests
+func KeyRangeDataSize(ctx context.Context, shardId string, krg *kr.KeyRange, ds *distributions.Distribution, cr coordinator.Coordinator) (int64, error) {
+	ensureConfig()
+
+	conn, err := pgx.Connect(ctx, createConnString(shardId))
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = conn.Close(ctx) }()
+
+	upperBound, err := resolveNextBound(ctx, krg, cr)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, rel := range ds.Relations {
+		// TODO get actual schema
+		res := conn.QueryRow(ctx, fmt.Sprintf(`SELECT count(*) > 0 as table_exists FROM information_schema.tables WHERE table_name = '%s' AND table_schema = 'public'`, strings.ToLower(rel.Name)))
+		tableExists := false
+		if err = res.Scan(&tableExists); err != nil {
+			return 0, err
+		}
+		if !tableExists {
+			continue
+		}
+		var size int64
+		if err := conn.QueryRow(ctx, fmt.Sprintf(`SELECT coalesce(sum(pg_column_size(t.*)), 0) FROM %s as t WHERE %s`,
+			strings.ToLower(rel.Name), kr.GetKRCondition(ds, rel, krg, upperBound, "t"))).Scan(&size); err != nil {
+			return 0, err
+		}
+		total += size
+	}
+	return total, nil
+}
+
 /*
 MoveKeys performs physical key-range move from one datashard to another.
 It is assumed that passed key range is already locked on every online spqr-router.
@@ -96,12 +267,7 @@ func MoveKeys(ctx context.Context, fromId, toId string, krg *kr.KeyRange, ds *di
 			return err
 		}
 	}
-	if shards == nil {
-		err := LoadConfig(config.CoordinatorConfig().ShardDataCfg)
-		if err != nil {
-			spqrlog.Zero.Error().Err(err).Msg("error loading config")
-		}
-	}
+	ensureConfig()
 
 	from, err := pgx.Connect(ctx, createConnString(fromId))
 	if err != nil {
@@ -1,9 +1,14 @@
 package datatransfers
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	pgx "github.com/jackc/pgx/v5"
+	mock "github.com/pg-sharding/spqr/pkg/mock/pgx"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,3 +30,40 @@ func TestConnectCreds(t *testing.T) {
 	wg.Wait()
 	assert.True(true)
 }
+
+type checksumRow struct {
+	rows     int64
+	checksum string
+	err      error
+}
+
+func (r *checksumRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	*dest[0].(*int64) = r.rows
+	*dest[1].(*string) = r.checksum
+	return nil
+}
+
+func TestReferenceRelationChecksum(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	conn := mock.NewMockrowQuerier(ctrl)
+	conn.EXPECT().QueryRow(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string, _ ...any) pgx.Row {
+		// relation name is quoted and lower-cased
+		assert.Contains(sql, `FROM "currencies" as t`)
+		return &checksumRow{rows: 3, checksum: "abc"}
+	})
+
+	rows, checksum, err := referenceRelationChecksum(context.TODO(), conn, "Currencies")
+	assert.NoError(err)
+	assert.Equal(int64(3), rows)
+	assert.Equal("abc", checksum)
+
+	conn.EXPECT().QueryRow(gomock.Any(), gomock.Any()).Return(&checksumRow{err: errors.New("relation does not exist")})
+
+	_, _, err = referenceRelationChecksum(context.TODO(), conn, "currencies")
+	assert.Error(err)
+}
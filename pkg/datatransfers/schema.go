@@ -0,0 +1,311 @@
+package datatransfers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pg-sharding/spqr/pkg/models/datashards"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+)
+
+// schemaRelations selects copied relations of public schema, in order of their creation
+const schemaRelations = `
+WITH rels AS (
+	SELECT c.oid FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+	WHERE n.nspname = 'public' AND c.relkind IN ('r', 'p') AND c.relname = ANY($1)
+)`
+
+// schemaRelationsExistQuery reports if any of copied relations exists
+const schemaRelationsExistQuery = schemaRelations + `
+SELECT EXISTS (SELECT 1 FROM rels)`
+
+// schemaTypesQuery lists user defined enums, domains and composite
+// types of columns, or of column arrays, of copied relations
+const schemaTypesQuery = schemaRelations + `
+SELECT CASE t.typtype
+	WHEN 'e' THEN format('CREATE TYPE %I.%I AS ENUM (%s)', n.nspname, t.typname,
+		(SELECT string_agg(quote_literal(e.enumlabel), ', ' ORDER BY e.enumsortorder) FROM pg_enum e WHERE e.enumtypid = t.oid))
+	WHEN 'd' THEN format('CREATE DOMAIN %I.%I AS %s', n.nspname, t.typname, format_type(t.typbasetype, t.typtypmod)) ||
+		CASE WHEN t.typnotnull THEN ' NOT NULL' ELSE '' END ||
+		coalesce(' DEFAULT ' || t.typdefault, '') ||
+		coalesce((SELECT string_agg(format(' CONSTRAINT %I %s', con.conname, pg_get_constraintdef(con.oid)), '' ORDER BY con.conname)
+			FROM pg_constraint con WHERE con.contypid = t.oid), '')
+	ELSE format('CREATE TYPE %I.%I AS (%s)', n.nspname, t.typname,
+		(SELECT string_agg(format('%I %s', a.attname, format_type(a.atttypid, a.atttypmod)), ', ' ORDER BY a.attnum)
+			FROM pg_attribute a WHERE a.attrelid = t.typrelid AND a.attnum > 0 AND NOT a.attisdropped))
+	END
+FROM pg_type t JOIN pg_namespace n ON n.oid = t.typnamespace
+WHERE t.typtype IN ('e', 'd', 'c') AND n.nspname NOT IN ('pg_catalog', 'information_schema')
+	AND t.oid IN (
+		SELECT CASE WHEN at.typcategory = 'A' THEN at.typelem ELSE at.oid END
+		FROM pg_attribute a JOIN pg_type at ON at.oid = a.atttypid
+		WHERE a.attrelid IN (SELECT oid FROM rels) AND a.attnum > 0 AND NOT a.attisdropped)
+	/* row types of relations themselves are created along with them */
+	AND NOT EXISTS (SELECT 1 FROM pg_class tc WHERE tc.oid = t.typrelid AND tc.relkind <> 'c')
+ORDER BY t.oid`
+
+// schemaSequencesQuery lists sequences of serial columns and their ownership.
+// Sequences of identity columns are created along with relations.
+const schemaSequencesQuery = schemaRelations + `
+SELECT format('CREATE SEQUENCE %I.%I', sn.nspname, s.relname),
+	format('ALTER SEQUENCE %I.%I OWNED BY %I.%I.%I', sn.nspname, s.relname, n.nspname, c.relname, a.attname)
+FROM pg_depend d
+	JOIN pg_class s ON s.oid = d.objid AND s.relkind = 'S'
+	JOIN pg_namespace sn ON sn.oid = s.relnamespace
+	JOIN pg_class c ON c.oid = d.refobjid
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+	JOIN pg_attribute a ON a.attrelid = c.oid AND a.attnum = d.refobjsubid
+WHERE d.classid = 'pg_class'::regclass AND d.refclassid = 'pg_class'::regclass
+	AND d.deptype = 'a' AND d.refobjid IN (SELECT oid FROM rels)
+ORDER BY s.oid`
+
+const schemaTablesQuery = schemaRelations + `
+SELECT format('CREATE TABLE %I.%I (%s)', n.nspname, c.relname,
+	coalesce((SELECT string_agg(format('%I %s', a.attname, format_type(a.atttypid, a.atttypmod)) ||
+			CASE WHEN a.attcollation <> 0 AND a.attcollation <> t.typcollation THEN
+				(SELECT format(' COLLATE %I.%I', cn.nspname, co.collname) FROM pg_collation co
+					JOIN pg_namespace cn ON cn.oid = co.collnamespace WHERE co.oid = a.attcollation)
+			ELSE '' END ||
+			CASE a.attidentity WHEN 'a' THEN ' GENERATED ALWAYS AS IDENTITY' WHEN 'd' THEN ' GENERATED BY DEFAULT AS IDENTITY' ELSE '' END ||
+			CASE WHEN a.attgenerated = 's' THEN ' GENERATED ALWAYS AS (' || pg_get_expr(ad.adbin, ad.adrelid) || ') STORED'
+				ELSE coalesce(' DEFAULT ' || pg_get_expr(ad.adbin, ad.adrelid), '') END ||
+			CASE WHEN a.attnotnull THEN ' NOT NULL' ELSE '' END, ', ' ORDER BY a.attnum)
+		FROM pg_attribute a JOIN pg_type t ON t.oid = a.atttypid
+			LEFT JOIN pg_attrdef ad ON ad.adrelid = a.attrelid AND ad.adnum = a.attnum
+		WHERE a.attrelid = c.oid AND a.attnum > 0 AND NOT a.attisdropped), '')) ||
+	CASE WHEN c.relkind = 'p' THEN ' PARTITION BY ' || pg_get_partkeydef(c.oid) ELSE '' END
+FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.oid IN (SELECT oid FROM rels)
+ORDER BY c.oid`
+
+// schemaConstraintsQuery lists constraints of relations, foreign keys are
+// listed last, so that referenced relations have their keys already
+const schemaConstraintsQuery = schemaRelations + `
+SELECT format('ALTER TABLE %I.%I ADD CONSTRAINT %I %s', n.nspname, c.relname, con.conname, pg_get_constraintdef(con.oid)),
+	con.contype = 'f'
+FROM pg_constraint con
+	JOIN pg_class c ON c.oid = con.conrelid
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE con.conrelid IN (SELECT oid FROM rels) AND con.contype IN ('p', 'u', 'c', 'x', 'f')
+ORDER BY con.contype = 'f', c.oid, con.conname`
+
+// schemaIndexesQuery lists indexes, which are not created by constraints
+const schemaIndexesQuery = schemaRelations + `
+SELECT pg_get_indexdef(i.indexrelid)
+FROM pg_index i
+WHERE i.indrelid IN (SELECT oid FROM rels)
+	AND NOT EXISTS (SELECT 1 FROM pg_constraint con
+		WHERE con.conindid = i.indexrelid AND con.conrelid = i.indrelid AND con.contype IN ('p', 'u', 'x'))
+ORDER BY i.indexrelid`
+
+// querier is implemented by pgx.Conn and pgx.Tx
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func queryStrings(ctx context.Context, conn querier, query string, args ...any) ([]string, error) {
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// SchemaDDL builds DDL statements, which create relations of public schema
+// with given names, as they are defined on the shard: types of their columns,
+// sequences of serial columns, relations, constraints and indexes.
+// Statements are listed in order they should be applied.
+func SchemaDDL(ctx context.Context, conn querier, relNames []string) ([]string, error) {
+	names := make([]string, len(relNames))
+	for i, name := range relNames {
+		names[i] = strings.ToLower(name)
+	}
+
+	types, err := queryStrings(ctx, conn, schemaTypesQuery, names)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.Query(ctx, schemaSequencesQuery, names)
+	if err != nil {
+		return nil, err
+	}
+	var seqs, owned []string
+	for rows.Next() {
+		var create, own string
+		if err := rows.Scan(&create, &own); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		seqs = append(seqs, create)
+		owned = append(owned, own)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	tables, err := queryStrings(ctx, conn, schemaTablesQuery, names)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err = conn.Query(ctx, schemaConstraintsQuery, names)
+	if err != nil {
+		return nil, err
+	}
+	var constraints, foreignKeys []string
+	for rows.Next() {
+		var def string
+		var fk bool
+		if err := rows.Scan(&def, &fk); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if fk {
+			foreignKeys = append(foreignKeys, def)
+		} else {
+			constraints = append(constraints, def)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	indexes, err := queryStrings(ctx, conn, schemaIndexesQuery, names)
+	if err != nil {
+		return nil, err
+	}
+
+	var ddl []string
+	for _, part := range [][]string{types, seqs, tables, constraints, indexes, foreignKeys, owned} {
+		ddl = append(ddl, part...)
+	}
+	return ddl, nil
+}
+
+// CopySchema creates relations with given names on shard to, as they are
+// defined on shard from. Statements are applied in one transaction,
+// so the schema is either copied completely, or not copied at all.
+// TODO : unit tests
+func CopySchema(ctx context.Context, from, to *datashards.DataShard, relNames []string) error {
+	ensureConfig()
+
+	fromConnString, err := shardConnString(from)
+	if err != nil {
+		return err
+	}
+	toConnString, err := shardConnString(to)
+	if err != nil {
+		return err
+	}
+
+	fromConn, err := pgx.Connect(ctx, fromConnString)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fromConn.Close(ctx) }()
+
+	toConn, err := pgx.Connect(ctx, toConnString)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = toConn.Close(ctx) }()
+
+	ddl, err := SchemaDDL(ctx, fromConn, relNames)
+	if err != nil {
+		return err
+	}
+
+	tx, err := toConn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	for _, stmt := range ddl {
+		spqrlog.Zero.Debug().
+			Str("from", from.ID).
+			Str("to", to.ID).
+			Str("statement", stmt).
+			Msg("copying schema")
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// ReplaySchema applies statements of schema history on shard to, if it has none
+// of relations with given names yet, and reports if history was applied. Shard,
+// which already has some of them, e.g. was prepared manually, is left as is.
+// Consecutive statements, which may run inside transaction block, are applied
+// in one transaction, others, e.g. CREATE INDEX CONCURRENTLY, one by one.
+// TODO : unit tests
+func ReplaySchema(ctx context.Context, to *datashards.DataShard, relNames []string, queries []string, allowsTx func(query string) bool) (bool, error) {
+	ensureConfig()
+
+	connString, err := shardConnString(to)
+	if err != nil {
+		return false, err
+	}
+	conn, err := pgx.Connect(ctx, connString)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = conn.Close(ctx) }()
+
+	names := make([]string, len(relNames))
+	for i, name := range relNames {
+		names[i] = strings.ToLower(name)
+	}
+	var exists bool
+	if err := conn.QueryRow(ctx, schemaRelationsExistQuery, names).Scan(&exists); err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	var tx pgx.Tx
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+	commit := func() error {
+		if tx == nil {
+			return nil
+		}
+		err := tx.Commit(ctx)
+		tx = nil
+		return err
+	}
+
+	for _, query := range queries {
+		spqrlog.Zero.Debug().
+			Str("to", to.ID).
+			Str("statement", query).
+			Msg("replaying schema change")
+		if !allowsTx(query) {
+			if err := commit(); err != nil {
+				return false, err
+			}
+			if _, err := conn.Exec(ctx, query); err != nil {
+				return false, err
+			}
+			continue
+		}
+		if tx == nil {
+			if tx, err = conn.Begin(ctx); err != nil {
+				return false, err
+			}
+		}
+		if _, err := tx.Exec(ctx, query); err != nil {
+			return false, err
+		}
+	}
+	return true, commit()
+}
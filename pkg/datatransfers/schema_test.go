@@ -0,0 +1,169 @@
+package datatransfers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/models/datashards"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRows returns rows of string and bool values
+type fakeRows struct {
+	rows [][]any
+	pos  int
+}
+
+var _ pgx.Rows = &fakeRows{}
+
+func (r *fakeRows) Close()                                       {}
+func (r *fakeRows) Err() error                                   { return nil }
+func (r *fakeRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *fakeRows) RawValues() [][]byte                          { return nil }
+func (r *fakeRows) Conn() *pgx.Conn                              { return nil }
+
+func (r *fakeRows) Values() ([]any, error) {
+	return r.rows[r.pos-1], nil
+}
+
+func (r *fakeRows) Next() bool {
+	r.pos++
+	return r.pos <= len(r.rows)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	row := r.rows[r.pos-1]
+	if len(dest) != len(row) {
+		return fmt.Errorf("expected %d values, got %d", len(row), len(dest))
+	}
+	for i, v := range row {
+		switch d := dest[i].(type) {
+		case *string:
+			*d = v.(string)
+		case *bool:
+			*d = v.(bool)
+		default:
+			return fmt.Errorf("unexpected destination %T", d)
+		}
+	}
+	return nil
+}
+
+// fakeQuerier returns prepared rows of catalog queries
+type fakeQuerier struct {
+	results map[string][][]any
+	args    [][]any
+}
+
+func (q *fakeQuerier) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	q.args = append(q.args, args)
+	rows, ok := q.results[sql]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query %s", sql)
+	}
+	return &fakeRows{rows: rows}, nil
+}
+
+func TestSchemaDDLOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	conn := &fakeQuerier{
+		results: map[string][][]any{
+			schemaTypesQuery: {
+				{"CREATE TYPE public.status AS ENUM ('new', 'done')"},
+			},
+			schemaSequencesQuery: {
+				{"CREATE SEQUENCE public.orders_id_seq", "ALTER SEQUENCE public.orders_id_seq OWNED BY public.orders.id"},
+			},
+			schemaTablesQuery: {
+				{"CREATE TABLE public.orders (id bigint DEFAULT nextval('orders_id_seq'::regclass) NOT NULL, status public.status)"},
+				{"CREATE TABLE public.items (order_id bigint)"},
+			},
+			schemaConstraintsQuery: {
+				{"ALTER TABLE public.orders ADD CONSTRAINT orders_pkey PRIMARY KEY (id)", false},
+				{"ALTER TABLE public.items ADD CONSTRAINT items_order_id_fkey FOREIGN KEY (order_id) REFERENCES orders(id)", true},
+				{"ALTER TABLE public.items ADD CONSTRAINT items_check CHECK (order_id > 0)", false},
+			},
+			schemaIndexesQuery: {
+				{"CREATE INDEX items_order_id ON public.items USING btree (order_id)"},
+			},
+		},
+	}
+
+	ddl, err := SchemaDDL(context.TODO(), conn, []string{"Orders", "items"})
+	assert.NoError(err)
+
+	/* types and sequences precede relations, which use them; foreign keys
+	 * are added after keys of all relations; sequence ownership needs columns */
+	assert.Equal([]string{
+		"CREATE TYPE public.status AS ENUM ('new', 'done')",
+		"CREATE SEQUENCE public.orders_id_seq",
+		"CREATE TABLE public.orders (id bigint DEFAULT nextval('orders_id_seq'::regclass) NOT NULL, status public.status)",
+		"CREATE TABLE public.items (order_id bigint)",
+		"ALTER TABLE public.orders ADD CONSTRAINT orders_pkey PRIMARY KEY (id)",
+		"ALTER TABLE public.items ADD CONSTRAINT items_check CHECK (order_id > 0)",
+		"CREATE INDEX items_order_id ON public.items USING btree (order_id)",
+		"ALTER TABLE public.items ADD CONSTRAINT items_order_id_fkey FOREIGN KEY (order_id) REFERENCES orders(id)",
+		"ALTER SEQUENCE public.orders_id_seq OWNED BY public.orders.id",
+	}, ddl)
+
+	/* relation names are compared with catalog as unquoted identifiers */
+	for _, args := range conn.args {
+		assert.Equal([]any{[]string{"orders", "items"}}, args)
+	}
+
+	delete(conn.results, schemaIndexesQuery)
+	_, err = SchemaDDL(context.TODO(), conn, []string{"orders"})
+	assert.Error(err)
+}
+
+func TestShardConnString(t *testing.T) {
+	assert := assert.New(t)
+
+	lock.Lock()
+	prev := shards
+	shards = &config.DatatransferConnections{
+		ShardsData: map[string]*config.ShardConnect{
+			"sh1": {
+				Hosts:    []string{"sh1-host:6432"},
+				DB:       "db1",
+				User:     "user1",
+				Password: "pwd1",
+			},
+		},
+	}
+	lock.Unlock()
+	defer func() {
+		lock.Lock()
+		shards = prev
+		lock.Unlock()
+	}()
+
+	/* hosts of listed shard are taken from config */
+	connString, err := shardConnString(datashards.NewDataShard("sh1", &config.Shard{Hosts: []string{"other:5432"}}))
+	assert.NoError(err)
+	assert.Equal("user=user1 host=sh1-host port=6432 dbname=db1 password=pwd1", connString)
+
+	/* new shard can not be connected without default credentials */
+	_, err = shardConnString(datashards.NewDataShard("sh3", &config.Shard{Hosts: []string{"sh3-host:6432"}}))
+	assert.Error(err)
+
+	shards.Default = &config.ShardConnect{
+		DB:       "db1",
+		User:     "user2",
+		Password: "pwd2",
+	}
+	connString, err = shardConnString(datashards.NewDataShard("sh3", &config.Shard{Hosts: []string{"sh3-host:6432"}}))
+	assert.NoError(err)
+	assert.Equal("user=user2 host=sh3-host port=6432 dbname=db1 password=pwd2", connString)
+
+	_, err = shardConnString(datashards.NewDataShard("sh3", &config.Shard{}))
+	assert.Error(err)
+	_, err = shardConnString(datashards.NewDataShard("sh3", &config.Shard{Hosts: []string{"sh3-host"}}))
+	assert.Error(err)
+}
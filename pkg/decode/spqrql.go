@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/pg-sharding/spqr/pkg/models/distributions"
 	protos "github.com/pg-sharding/spqr/pkg/protos"
 )
 
@@ -14,18 +15,29 @@ func KeyRange(krg *protos.KeyRangeInfo) string {
 }
 
 // Distribution returns query to create given distribution
+// Distribution of reference relations is created implicitly, so it is not dumped.
 func Distribution(ds *protos.Distribution) string {
+	if ds.Id == distributions.REPLICATED {
+		return ""
+	}
 	return fmt.Sprintf("CREATE DISTRIBUTION %s COLUMN TYPES %s;", ds.Id, strings.Join(ds.ColumnTypes, ", "))
 }
 
 // DistributedRelation return query to attach relation to distribution
 func DistributedRelation(rel *protos.DistributedRelation, ds string) string {
+	if ds == distributions.REPLICATED {
+		return fmt.Sprintf("CREATE REFERENCE RELATION %s;", rel.Name)
+	}
 	elems := make([]string, len(rel.DistributionKey))
 	for j, el := range rel.DistributionKey {
+		col := el.Column
+		if el.Expression != "" {
+			col = fmt.Sprintf("%s(%s)", el.Expression, el.Column)
+		}
 		if el.HashFunction != "" {
-			elems[j] = fmt.Sprintf("%s HASH FUNCTION %s", el.Column, el.HashFunction)
+			elems[j] = fmt.Sprintf("%s HASH FUNCTION %s", col, el.HashFunction)
 		} else {
-			elems[j] = el.Column
+			elems[j] = col
 		}
 
 	}
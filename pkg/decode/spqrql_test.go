@@ -44,6 +44,12 @@ func TestDistribution(t *testing.T) {
 			ColumnTypes: []string{"integer", "varchar"},
 		}))
 
+	// distribution of reference relations is created implicitly
+	assert.Equal("",
+		Distribution(&protos.Distribution{
+			Id: "REPLICATED",
+		}))
+
 	// order is preserved
 	assert.Equal("CREATE DISTRIBUTION ds1 COLUMN TYPES varchar, integer;",
 		Distribution(&protos.Distribution{
@@ -80,4 +86,19 @@ func TestDistributedRelation(t *testing.T) {
 			},
 		}, "ds1"),
 	)
+
+	// expression-based key
+	assert.Equal("ALTER DISTRIBUTION ds1 ATTACH RELATION rel DISTRIBUTION KEY lower(email) HASH FUNCTION murmur;",
+		DistributedRelation(&protos.DistributedRelation{
+			Name:            "rel",
+			DistributionKey: []*protos.DistributionKeyEntry{{Column: "email", HashFunction: "murmur", Expression: "lower"}},
+		}, "ds1"),
+	)
+
+	// reference relation
+	assert.Equal("CREATE REFERENCE RELATION currencies;",
+		DistributedRelation(&protos.DistributedRelation{
+			Name: "currencies",
+		}, "REPLICATED"),
+	)
 }
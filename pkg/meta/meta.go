@@ -9,6 +9,9 @@ import (
 	"github.com/pg-sharding/spqr/pkg/config"
 	"github.com/pg-sharding/spqr/pkg/connectiterator"
 	"github.com/pg-sharding/spqr/pkg/models/distributions"
+	"github.com/pg-sharding/spqr/pkg/models/operations"
+	"github.com/pg-sharding/spqr/pkg/models/schema"
+	"github.com/pg-sharding/spqr/pkg/models/sequences"
 	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 	"github.com/pg-sharding/spqr/pkg/models/tasks"
 	"github.com/pg-sharding/spqr/pkg/models/topology"
@@ -29,6 +32,9 @@ type EntityMgr interface {
 	datashards.ShardsMgr
 	distributions.DistributionMgr
 	tasks.TaskMgr
+	operations.OperationMgr
+	sequences.SequenceMgr
+	schema.SchemaMgr
 
 	ShareKeyRange(id string) error
 
@@ -138,6 +144,11 @@ func processDrop(ctx context.Context, dstmt spqrparser.Statement, isCascade bool
 			return err
 		}
 		return cli.DropTaskGroup(ctx)
+	case *spqrparser.SequenceSelector:
+		if err := mngr.DropSequence(ctx, stmt.Name); err != nil {
+			return err
+		}
+		return cli.DropSequence(ctx, stmt.Name)
 	default:
 		return fmt.Errorf("unknown drop statement")
 	}
@@ -147,6 +158,9 @@ func processDrop(ctx context.Context, dstmt spqrparser.Statement, isCascade bool
 func processCreate(ctx context.Context, astmt spqrparser.Statement, mngr EntityMgr, cli *clientinteractor.PSQLInteractor) error {
 	switch stmt := astmt.(type) {
 	case *spqrparser.DistributionDefinition:
+		if stmt.ID == distributions.REPLICATED {
+			return spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "distribution name %s is reserved for reference relations", stmt.ID)
+		}
 		distribution := distributions.NewDistribution(stmt.ID, stmt.ColTypes)
 
 		distributions, err := mngr.ListDistributions(ctx)
@@ -168,17 +182,43 @@ func processCreate(ctx context.Context, astmt spqrparser.Statement, mngr EntityM
 	case *spqrparser.ShardingRuleDefinition:
 		return cli.ReportError(spqrerror.ShardingKeysRemoved)
 	case *spqrparser.KeyRangeDefinition:
+		if stmt.Distribution == distributions.REPLICATED {
+			return spqrerror.New(spqrerror.SPQR_INVALID_REQUEST, "reference relations distribution can not have key ranges")
+		}
 		req := kr.KeyRangeFromSQL(stmt)
 		if err := mngr.CreateKeyRange(ctx, req); err != nil {
 			spqrlog.Zero.Error().Err(err).Msg("Error when adding key range")
 			return cli.ReportError(err)
 		}
 		return cli.CreateKeyRange(ctx, req)
+	case *spqrparser.ReferenceRelationDefinition:
+		if err := createReferenceRelation(ctx, stmt.TableName, mngr); err != nil {
+			return err
+		}
+		return cli.CreateReferenceRelation(ctx, stmt.TableName)
+	case *spqrparser.SequenceDefinition:
+		seq := &sequences.Sequence{
+			Name:         stmt.Name,
+			RelationName: stmt.RelationName,
+			ColumnName:   stmt.ColumnName,
+		}
+		if err := createSequence(ctx, seq, mngr); err != nil {
+			return err
+		}
+		return cli.CreateSequence(ctx, seq)
 	case *spqrparser.ShardDefinition:
 		dataShard := datashards.NewDataShard(stmt.Id, &config.Shard{
 			Hosts: stmt.Hosts,
 			Type:  config.DataShard,
 		})
+		/* schema is prepared first, so that shard is never registered without it */
+		if stmt.SchemaFrom != "" {
+			if err := mngr.CopySchema(ctx, stmt.SchemaFrom, dataShard); err != nil {
+				return err
+			}
+		} else if err := mngr.ReplaySchema(ctx, dataShard); err != nil {
+			return err
+		}
 		if err := mngr.AddDataShard(ctx, dataShard); err != nil {
 			return err
 		}
@@ -188,6 +228,52 @@ func processCreate(ctx context.Context, astmt spqrparser.Statement, mngr EntityM
 	}
 }
 
+// createReferenceRelation attaches relation to the distribution of reference relations,
+// creating the distribution on first use
+func createReferenceRelation(ctx context.Context, relName string, mngr EntityMgr) error {
+	dss, err := mngr.ListDistributions(ctx)
+	if err != nil {
+		return err
+	}
+	exists := false
+	for _, ds := range dss {
+		if ds.Id == distributions.REPLICATED {
+			exists = true
+			break
+		}
+	}
+	if !exists {
+		if err := mngr.CreateDistribution(ctx, distributions.NewDistribution(distributions.REPLICATED, nil)); err != nil {
+			return err
+		}
+	}
+
+	return mngr.AlterDistributionAttach(ctx, distributions.REPLICATED, []*distributions.DistributedRelation{
+		{
+			Name: relName,
+		},
+	})
+}
+
+// createSequence checks, that sequence does not clash with existing ones
+// by name or by filled relation column, and creates it
+func createSequence(ctx context.Context, seq *sequences.Sequence, mngr EntityMgr) error {
+	seqs, err := mngr.ListSequences(ctx)
+	if err != nil {
+		return err
+	}
+	for _, s := range seqs {
+		if s.Name == seq.Name {
+			return spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "sequence %s already exists", seq.Name)
+		}
+		if seq.RelationName != "" && s.RelationName == seq.RelationName && s.ColumnName == seq.ColumnName {
+			return spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "column %s of relation %s is already filled by sequence %s", seq.ColumnName, seq.RelationName, s.Name)
+		}
+	}
+
+	return mngr.CreateSequence(ctx, seq)
+}
+
 func processAlter(ctx context.Context, astmt spqrparser.Statement, mngr EntityMgr, cli *clientinteractor.PSQLInteractor) error {
 	switch stmt := astmt.(type) {
 	case *spqrparser.AlterDistribution:
@@ -203,6 +289,11 @@ func processAlterDistribution(ctx context.Context, astmt spqrparser.Statement, m
 		rels := []*distributions.DistributedRelation{}
 
 		for _, drel := range stmt.Relations {
+			for _, e := range drel.DistributionKey {
+				if _, err := distributions.KeyExpressionByName(e.Expression); err != nil {
+					return spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "unsupported distribution key expression \"%s\" of relation \"%s\"", e.Expression, drel.Name)
+				}
+			}
 			rels = append(rels, distributions.DistributedRelationFromSQL(drel))
 		}
 
@@ -249,6 +340,14 @@ func Proc(ctx context.Context, tstmt spqrparser.Statement, mgr EntityMgr, ci con
 			Krid:    stmt.KeyRangeID,
 		}
 
+		if stmt.NoWait {
+			op, err := mgr.StartMove(ctx, move)
+			if err != nil {
+				return cli.ReportError(err)
+			}
+			return cli.StartOperation(ctx, op)
+		}
+
 		if err := mgr.Move(ctx, move); err != nil {
 			return cli.ReportError(err)
 		}
@@ -286,6 +385,12 @@ func Proc(ctx context.Context, tstmt spqrparser.Statement, mgr EntityMgr, ci con
 		return cli.UnlockKeyRange(ctx, stmt.KeyRangeID)
 	case *spqrparser.Show:
 		return ProcessShow(ctx, stmt, mgr, ci, cli)
+	case *spqrparser.ShowKeyRange:
+		krs, err := ResolveKeyRange(ctx, mgr, stmt.Distribution, "", stmt.Keys)
+		if err != nil {
+			return err
+		}
+		return cli.ResolvedKeyRanges(ctx, stmt.Keys, krs)
 	case *spqrparser.Kill:
 		return ProcessKill(ctx, stmt, mgr, ci, cli)
 	case *spqrparser.SplitKeyRange:
@@ -294,6 +399,13 @@ func Proc(ctx context.Context, tstmt spqrparser.Statement, mgr EntityMgr, ci con
 			SourceID: stmt.KeyRangeFromID,
 			Krid:     stmt.KeyRangeID,
 		}
+		if stmt.NoWait {
+			op, err := mgr.StartSplit(ctx, splitKeyRange)
+			if err != nil {
+				return err
+			}
+			return cli.StartOperation(ctx, op)
+		}
 		if err := mgr.Split(ctx, splitKeyRange); err != nil {
 			return err
 		}
@@ -303,10 +415,47 @@ func Proc(ctx context.Context, tstmt spqrparser.Statement, mgr EntityMgr, ci con
 			BaseKeyRangeId:      stmt.KeyRangeIDL,
 			AppendageKeyRangeId: stmt.KeyRangeIDR,
 		}
+		if stmt.NoWait {
+			op, err := mgr.StartUnite(ctx, uniteKeyRange)
+			if err != nil {
+				return err
+			}
+			return cli.StartOperation(ctx, op)
+		}
 		if err := mgr.Unite(ctx, uniteKeyRange); err != nil {
 			return err
 		}
 		return cli.MergeKeyRanges(ctx, uniteKeyRange)
+	case *spqrparser.CancelOperation:
+		if err := mgr.CancelOperation(ctx, stmt.ID); err != nil {
+			return err
+		}
+		return cli.CancelOperation(ctx, stmt.ID)
+	case *spqrparser.DrainShard:
+		if stmt.NoWait {
+			op, err := mgr.StartDrain(ctx, stmt.ID)
+			if err != nil {
+				return cli.ReportError(err)
+			}
+			return cli.StartOperation(ctx, op)
+		}
+
+		if err := mgr.DrainShard(ctx, stmt.ID); err != nil {
+			return cli.ReportError(err)
+		}
+		return cli.DrainShard(ctx, stmt.ID)
+	case *spqrparser.CheckReferenceRelation:
+		states, err := mgr.CheckReferenceRelation(ctx, stmt.RelationName)
+		if err != nil {
+			return err
+		}
+		return cli.CheckReferenceRelation(ctx, stmt.RelationName, states)
+	case *spqrparser.CheckSchema:
+		states, err := mgr.CheckSchema(ctx, stmt.RelationName)
+		if err != nil {
+			return err
+		}
+		return cli.CheckSchema(ctx, states)
 	case *spqrparser.Alter:
 		return processAlter(ctx, stmt.Element, mgr, cli)
 	default:
@@ -425,6 +574,24 @@ func ProcessShow(ctx context.Context, stmt *spqrparser.Show, mngr EntityMgr, ci
 			return err
 		}
 		return cli.Tasks(ctx, group.Tasks)
+	case spqrparser.OperationsStr:
+		ops, err := mngr.ListOperations(ctx)
+		if err != nil {
+			return err
+		}
+		return cli.Operations(ctx, ops)
+	case spqrparser.SequencesStr:
+		seqs, err := mngr.ListSequences(ctx)
+		if err != nil {
+			return err
+		}
+		return cli.Sequences(ctx, seqs)
+	case spqrparser.SchemaChangesStr:
+		changes, err := mngr.ListSchemaChanges(ctx)
+		if err != nil {
+			return err
+		}
+		return cli.SchemaChanges(ctx, changes)
 	default:
 		return unknownCoordinatorCommand
 	}
@@ -0,0 +1,98 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/pg-sharding/spqr/pkg/models/distributions"
+	"github.com/pg-sharding/spqr/pkg/models/hashfunction"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
+)
+
+// keyFunctions are applied to distribution key value before matching it with key ranges
+type keyFunctions struct {
+	expr distributions.KeyExpressionType
+	hf   hashfunction.HashFunctionType
+}
+
+func relationKeyFunctions(rel *distributions.DistributedRelation) (keyFunctions, error) {
+	if len(rel.DistributionKey) == 0 {
+		return keyFunctions{expr: distributions.KeyExpressionNone, hf: hashfunction.HashFunctionIdent}, nil
+	}
+	expr, err := distributions.KeyExpressionByName(rel.DistributionKey[0].Expression)
+	if err != nil {
+		return keyFunctions{}, err
+	}
+	hf, err := hashfunction.HashFunctionByName(rel.DistributionKey[0].HashFunction)
+	if err != nil {
+		return keyFunctions{}, err
+	}
+	return keyFunctions{expr: expr, hf: hf}, nil
+}
+
+// distributionKeyFunctions returns key expression and hash function, which are
+// applied to distribution key values. If relation is not specified, all relations
+// attached to the distribution must use the same ones.
+// TODO: multi-column routing. This works only for one-dim routing
+func distributionKeyFunctions(ds *distributions.Distribution, relName string) (keyFunctions, error) {
+	if relName != "" {
+		rel, ok := ds.Relations[relName]
+		if !ok {
+			return keyFunctions{}, spqrerror.Newf(spqrerror.SPQR_NO_DISTRIBUTION, "relation \"%s\" is not attached to distribution \"%s\"", relName, ds.Id)
+		}
+		return relationKeyFunctions(rel)
+	}
+
+	res := keyFunctions{expr: distributions.KeyExpressionNone, hf: hashfunction.HashFunctionIdent}
+	first := true
+	for _, rel := range ds.Relations {
+		fns, err := relationKeyFunctions(rel)
+		if err != nil {
+			return keyFunctions{}, err
+		}
+		if !first && fns != res {
+			return keyFunctions{}, spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "relations of distribution \"%s\" use different key expressions or hash functions, specify relation", ds.Id)
+		}
+		res = fns
+		first = false
+	}
+	return res, nil
+}
+
+// ResolveKeyRange finds key ranges of the distribution for each of the keys,
+// applying distribution key expression and hash function the same way the router does.
+func ResolveKeyRange(ctx context.Context, mngr EntityMgr, dsId string, relName string, keys []string) ([]*kr.KeyRange, error) {
+	ds, err := mngr.GetDistribution(ctx, dsId)
+	if err != nil {
+		return nil, err
+	}
+
+	fns, err := distributionKeyFunctions(ds, relName)
+	if err != nil {
+		return nil, err
+	}
+
+	krs, err := mngr.ListKeyRanges(ctx, ds.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*kr.KeyRange, len(keys))
+	for i, key := range keys {
+		value, err := distributions.ApplyKeyExpression(key, fns.expr)
+		if err != nil {
+			return nil, err
+		}
+		hashedKey, err := hashfunction.ApplyHashFunction([]byte(value), fns.hf)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = kr.MatchKeyRange(hashedKey, krs)
+		if res[i] == nil {
+			return nil, spqrerror.Newf(spqrerror.SPQR_KEYRANGE_ERROR, "failed to match key \"%s\" with any key range of distribution \"%s\"", key, ds.Id)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,132 @@
+package meta_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pg-sharding/spqr/pkg/coord/local"
+	"github.com/pg-sharding/spqr/pkg/meta"
+	"github.com/pg-sharding/spqr/qdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareResolveMgr(t *testing.T, relations map[string]*qdb.DistributedRelation) meta.EntityMgr {
+	assert := assert.New(t)
+
+	db, _ := qdb.NewMemQDB("")
+
+	assert.NoError(db.CreateDistribution(context.TODO(), &qdb.Distribution{
+		ID:        "ds1",
+		ColTypes:  []string{qdb.ColumnTypeVarchar},
+		Relations: relations,
+	}))
+	assert.NoError(db.CreateKeyRange(context.TODO(), &qdb.KeyRange{
+		ShardID:        "sh1",
+		DistributionId: "ds1",
+		KeyRangeID:     "kr1",
+		LowerBound:     []byte("a"),
+	}))
+	assert.NoError(db.CreateKeyRange(context.TODO(), &qdb.KeyRange{
+		ShardID:        "sh2",
+		DistributionId: "ds1",
+		KeyRangeID:     "kr2",
+		LowerBound:     []byte("m"),
+	}))
+
+	return local.NewLocalCoordinator(db)
+}
+
+func TestResolveKeyRange(t *testing.T) {
+	assert := assert.New(t)
+
+	mgr := prepareResolveMgr(t, map[string]*qdb.DistributedRelation{
+		"orders": {
+			Name: "orders",
+			DistributionKey: []qdb.DistributionKeyEntry{
+				{Column: "id"},
+			},
+		},
+		"users": {
+			Name: "users",
+			DistributionKey: []qdb.DistributionKeyEntry{
+				{Column: "email", Expression: "lower"},
+			},
+		},
+	})
+
+	for _, tt := range []struct {
+		name string
+		rel  string
+		keys []string
+		exp  []string
+		err  bool
+	}{
+		{
+			name: "plain key",
+			rel:  "orders",
+			keys: []string{"b", "x"},
+			exp:  []string{"kr1", "kr2"},
+		},
+		{
+			name: "expression key",
+			rel:  "users",
+			keys: []string{"B", "X"},
+			exp:  []string{"kr1", "kr2"},
+		},
+		{
+			name: "expression is not applied to other relation",
+			rel:  "orders",
+			keys: []string{"X"},
+			err:  true,
+		},
+		{
+			name: "relation is not attached",
+			rel:  "items",
+			keys: []string{"b"},
+			err:  true,
+		},
+		{
+			name: "relations use different key expressions",
+			keys: []string{"b"},
+			err:  true,
+		},
+	} {
+		krs, err := meta.ResolveKeyRange(context.TODO(), mgr, "ds1", tt.rel, tt.keys)
+		if tt.err {
+			assert.Error(err, tt.name)
+			continue
+		}
+		assert.NoError(err, tt.name)
+
+		ids := make([]string, 0, len(krs))
+		for _, kr := range krs {
+			ids = append(ids, kr.ID)
+		}
+		assert.Equal(tt.exp, ids, tt.name)
+	}
+}
+
+func TestResolveKeyRangeWholeDistribution(t *testing.T) {
+	assert := assert.New(t)
+
+	/* all relations use the same key expression, relation may be omitted */
+	mgr := prepareResolveMgr(t, map[string]*qdb.DistributedRelation{
+		"users": {
+			Name: "users",
+			DistributionKey: []qdb.DistributionKeyEntry{
+				{Column: "email", Expression: "lower"},
+			},
+		},
+		"logins": {
+			Name: "logins",
+			DistributionKey: []qdb.DistributionKeyEntry{
+				{Column: "email", Expression: "lower"},
+			},
+		},
+	})
+
+	krs, err := meta.ResolveKeyRange(context.TODO(), mgr, "ds1", "", []string{"X"})
+	assert.NoError(err)
+	assert.Len(krs, 1)
+	assert.Equal("kr2", krs[0].ID)
+}
@@ -7,6 +7,7 @@ package mock
 import (
 	tls "crypto/tls"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	pgproto3 "github.com/jackc/pgx/v5/pgproto3"
@@ -121,6 +122,20 @@ func (mr *MockDBInstanceMockRecorder) Send(query interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockDBInstance)(nil).Send), query)
 }
 
+// SetDeadline mocks base method.
+func (m *MockDBInstance) SetDeadline(t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeadline", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDeadline indicates an expected call of SetDeadline.
+func (mr *MockDBInstanceMockRecorder) SetDeadline(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeadline", reflect.TypeOf((*MockDBInstance)(nil).SetDeadline), t)
+}
+
 // SetStatus mocks base method.
 func (m *MockDBInstance) SetStatus(status conn.InstanceStatus) {
 	m.ctrl.T.Helper()
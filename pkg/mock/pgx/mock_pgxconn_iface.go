@@ -3,3 +3,53 @@
 
 // Package mock is a generated GoMock package.
 package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	pgx "github.com/jackc/pgx/v5"
+)
+
+// MockrowQuerier is a mock of rowQuerier interface.
+type MockrowQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockrowQuerierMockRecorder
+}
+
+// MockrowQuerierMockRecorder is the mock recorder for MockrowQuerier.
+type MockrowQuerierMockRecorder struct {
+	mock *MockrowQuerier
+}
+
+// NewMockrowQuerier creates a new mock instance.
+func NewMockrowQuerier(ctrl *gomock.Controller) *MockrowQuerier {
+	mock := &MockrowQuerier{ctrl: ctrl}
+	mock.recorder = &MockrowQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrowQuerier) EXPECT() *MockrowQuerierMockRecorder {
+	return m.recorder
+}
+
+// QueryRow mocks base method.
+func (m *MockrowQuerier) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, sql}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryRow", varargs...)
+	ret0, _ := ret[0].(pgx.Row)
+	return ret0
+}
+
+// QueryRow indicates an expected call of QueryRow.
+func (mr *MockrowQuerierMockRecorder) QueryRow(ctx, sql interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, sql}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRow", reflect.TypeOf((*MockrowQuerier)(nil).QueryRow), varargs...)
+}
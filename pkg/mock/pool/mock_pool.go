@@ -149,6 +149,20 @@ func (mr *MockConnectionKepperMockRecorder) Rule() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rule", reflect.TypeOf((*MockConnectionKepper)(nil).Rule))
 }
 
+// Stats mocks base method.
+func (m *MockConnectionKepper) Stats() pool.PoolStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(pool.PoolStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockConnectionKepperMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockConnectionKepper)(nil).Stats))
+}
+
 // UsedConnectionCount mocks base method.
 func (m *MockConnectionKepper) UsedConnectionCount() int {
 	m.ctrl.T.Helper()
@@ -257,6 +271,18 @@ func (mr *MockPoolMockRecorder) IdleConnectionCount() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdleConnectionCount", reflect.TypeOf((*MockPool)(nil).IdleConnectionCount))
 }
 
+// Invalidate mocks base method.
+func (m *MockPool) Invalidate() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Invalidate")
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockPoolMockRecorder) Invalidate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockPool)(nil).Invalidate))
+}
+
 // List mocks base method.
 func (m *MockPool) List() []shard.Shard {
 	m.ctrl.T.Helper()
@@ -327,6 +353,20 @@ func (mr *MockPoolMockRecorder) Rule() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rule", reflect.TypeOf((*MockPool)(nil).Rule))
 }
 
+// Stats mocks base method.
+func (m *MockPool) Stats() pool.PoolStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(pool.PoolStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockPoolMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockPool)(nil).Stats))
+}
+
 // UsedConnectionCount mocks base method.
 func (m *MockPool) UsedConnectionCount() int {
 	m.ctrl.T.Helper()
@@ -463,6 +503,18 @@ func (mr *MockMultiShardPoolMockRecorder) IdleConnectionCount() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdleConnectionCount", reflect.TypeOf((*MockMultiShardPool)(nil).IdleConnectionCount))
 }
 
+// InitReserve mocks base method.
+func (m *MockMultiShardPool) InitReserve(rule *config.FrontendRule) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InitReserve", rule)
+}
+
+// InitReserve indicates an expected call of InitReserve.
+func (mr *MockMultiShardPoolMockRecorder) InitReserve(rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitReserve", reflect.TypeOf((*MockMultiShardPool)(nil).InitReserve), rule)
+}
+
 // InitRule mocks base method.
 func (m *MockMultiShardPool) InitRule(rule *config.BackendRule) error {
 	m.ctrl.T.Helper()
@@ -477,6 +529,18 @@ func (mr *MockMultiShardPoolMockRecorder) InitRule(rule interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitRule", reflect.TypeOf((*MockMultiShardPool)(nil).InitRule), rule)
 }
 
+// InvalidateHost mocks base method.
+func (m *MockMultiShardPool) InvalidateHost(host string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateHost", host)
+}
+
+// InvalidateHost indicates an expected call of InvalidateHost.
+func (mr *MockMultiShardPoolMockRecorder) InvalidateHost(host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateHost", reflect.TypeOf((*MockMultiShardPool)(nil).InvalidateHost), host)
+}
+
 // List mocks base method.
 func (m *MockMultiShardPool) List() []shard.Shard {
 	m.ctrl.T.Helper()
@@ -547,6 +611,32 @@ func (mr *MockMultiShardPoolMockRecorder) Rule() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rule", reflect.TypeOf((*MockMultiShardPool)(nil).Rule))
 }
 
+// Stats mocks base method.
+func (m *MockMultiShardPool) Stats() pool.PoolStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(pool.PoolStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockMultiShardPoolMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockMultiShardPool)(nil).Stats))
+}
+
+// Stop mocks base method.
+func (m *MockMultiShardPool) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockMultiShardPoolMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockMultiShardPool)(nil).Stop))
+}
+
 // UsedConnectionCount mocks base method.
 func (m *MockMultiShardPool) UsedConnectionCount() int {
 	m.ctrl.T.Helper()
@@ -561,6 +651,18 @@ func (mr *MockMultiShardPoolMockRecorder) UsedConnectionCount() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsedConnectionCount", reflect.TypeOf((*MockMultiShardPool)(nil).UsedConnectionCount))
 }
 
+// WarmupHost mocks base method.
+func (m *MockMultiShardPool) WarmupHost(shardKey kr.ShardKey, host string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WarmupHost", shardKey, host)
+}
+
+// WarmupHost indicates an expected call of WarmupHost.
+func (mr *MockMultiShardPoolMockRecorder) WarmupHost(shardKey, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarmupHost", reflect.TypeOf((*MockMultiShardPool)(nil).WarmupHost), shardKey, host)
+}
+
 // MockPoolIterator is a mock of PoolIterator interface.
 type MockPoolIterator struct {
 	ctrl     *gomock.Controller
@@ -720,6 +822,18 @@ func (mr *MockDBPoolMockRecorder) IdleConnectionCount() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdleConnectionCount", reflect.TypeOf((*MockDBPool)(nil).IdleConnectionCount))
 }
 
+// InitReserve mocks base method.
+func (m *MockDBPool) InitReserve(rule *config.FrontendRule) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InitReserve", rule)
+}
+
+// InitReserve indicates an expected call of InitReserve.
+func (mr *MockDBPoolMockRecorder) InitReserve(rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitReserve", reflect.TypeOf((*MockDBPool)(nil).InitReserve), rule)
+}
+
 // InitRule mocks base method.
 func (m *MockDBPool) InitRule(rule *config.BackendRule) error {
 	m.ctrl.T.Helper()
@@ -734,6 +848,18 @@ func (mr *MockDBPoolMockRecorder) InitRule(rule interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitRule", reflect.TypeOf((*MockDBPool)(nil).InitRule), rule)
 }
 
+// InvalidateHost mocks base method.
+func (m *MockDBPool) InvalidateHost(host string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateHost", host)
+}
+
+// InvalidateHost indicates an expected call of InvalidateHost.
+func (mr *MockDBPoolMockRecorder) InvalidateHost(host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateHost", reflect.TypeOf((*MockDBPool)(nil).InvalidateHost), host)
+}
+
 // List mocks base method.
 func (m *MockDBPool) List() []shard.Shard {
 	m.ctrl.T.Helper()
@@ -818,6 +944,32 @@ func (mr *MockDBPoolMockRecorder) ShardMapping() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardMapping", reflect.TypeOf((*MockDBPool)(nil).ShardMapping))
 }
 
+// Stats mocks base method.
+func (m *MockDBPool) Stats() pool.PoolStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(pool.PoolStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockDBPoolMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockDBPool)(nil).Stats))
+}
+
+// Stop mocks base method.
+func (m *MockDBPool) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockDBPoolMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockDBPool)(nil).Stop))
+}
+
 // UsedConnectionCount mocks base method.
 func (m *MockDBPool) UsedConnectionCount() int {
 	m.ctrl.T.Helper()
@@ -831,3 +983,27 @@ func (mr *MockDBPoolMockRecorder) UsedConnectionCount() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsedConnectionCount", reflect.TypeOf((*MockDBPool)(nil).UsedConnectionCount))
 }
+
+// Warmup mocks base method.
+func (m *MockDBPool) Warmup() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Warmup")
+}
+
+// Warmup indicates an expected call of Warmup.
+func (mr *MockDBPoolMockRecorder) Warmup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warmup", reflect.TypeOf((*MockDBPool)(nil).Warmup))
+}
+
+// WarmupHost mocks base method.
+func (m *MockDBPool) WarmupHost(shardKey kr.ShardKey, host string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WarmupHost", shardKey, host)
+}
+
+// WarmupHost indicates an expected call of WarmupHost.
+func (mr *MockDBPoolMockRecorder) WarmupHost(shardKey, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarmupHost", reflect.TypeOf((*MockDBPool)(nil).WarmupHost), shardKey, host)
+}
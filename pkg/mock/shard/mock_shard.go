@@ -82,6 +82,34 @@ func (mr *MockShardinfoMockRecorder) InstanceHostname() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceHostname", reflect.TypeOf((*MockShardinfo)(nil).InstanceHostname))
 }
 
+// PrepStmtCacheHits mocks base method.
+func (m *MockShardinfo) PrepStmtCacheHits() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepStmtCacheHits")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// PrepStmtCacheHits indicates an expected call of PrepStmtCacheHits.
+func (mr *MockShardinfoMockRecorder) PrepStmtCacheHits() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepStmtCacheHits", reflect.TypeOf((*MockShardinfo)(nil).PrepStmtCacheHits))
+}
+
+// PrepStmtCacheMisses mocks base method.
+func (m *MockShardinfo) PrepStmtCacheMisses() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepStmtCacheMisses")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// PrepStmtCacheMisses indicates an expected call of PrepStmtCacheMisses.
+func (mr *MockShardinfoMockRecorder) PrepStmtCacheMisses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepStmtCacheMisses", reflect.TypeOf((*MockShardinfo)(nil).PrepStmtCacheMisses))
+}
+
 // ShardKeyName mocks base method.
 func (m *MockShardinfo) ShardKeyName() string {
 	m.ctrl.T.Helper()
@@ -217,6 +245,34 @@ func (mr *MockCoordShardinfoMockRecorder) InstanceHostname() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceHostname", reflect.TypeOf((*MockCoordShardinfo)(nil).InstanceHostname))
 }
 
+// PrepStmtCacheHits mocks base method.
+func (m *MockCoordShardinfo) PrepStmtCacheHits() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepStmtCacheHits")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// PrepStmtCacheHits indicates an expected call of PrepStmtCacheHits.
+func (mr *MockCoordShardinfoMockRecorder) PrepStmtCacheHits() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepStmtCacheHits", reflect.TypeOf((*MockCoordShardinfo)(nil).PrepStmtCacheHits))
+}
+
+// PrepStmtCacheMisses mocks base method.
+func (m *MockCoordShardinfo) PrepStmtCacheMisses() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepStmtCacheMisses")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// PrepStmtCacheMisses indicates an expected call of PrepStmtCacheMisses.
+func (mr *MockCoordShardinfoMockRecorder) PrepStmtCacheMisses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepStmtCacheMisses", reflect.TypeOf((*MockCoordShardinfo)(nil).PrepStmtCacheMisses))
+}
+
 // Router mocks base method.
 func (m *MockCoordShardinfo) Router() string {
 	m.ctrl.T.Helper()
@@ -557,6 +613,34 @@ func (mr *MockShardMockRecorder) Params() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockShard)(nil).Params))
 }
 
+// PrepStmtCacheHits mocks base method.
+func (m *MockShard) PrepStmtCacheHits() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepStmtCacheHits")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// PrepStmtCacheHits indicates an expected call of PrepStmtCacheHits.
+func (mr *MockShardMockRecorder) PrepStmtCacheHits() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepStmtCacheHits", reflect.TypeOf((*MockShard)(nil).PrepStmtCacheHits))
+}
+
+// PrepStmtCacheMisses mocks base method.
+func (m *MockShard) PrepStmtCacheMisses() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepStmtCacheMisses")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// PrepStmtCacheMisses indicates an expected call of PrepStmtCacheMisses.
+func (mr *MockShardMockRecorder) PrepStmtCacheMisses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepStmtCacheMisses", reflect.TypeOf((*MockShard)(nil).PrepStmtCacheMisses))
+}
+
 // PrepareStatement mocks base method.
 func (m *MockShard) PrepareStatement(hash uint64, rd *shard.PreparedStatementDescriptor) {
 	m.ctrl.T.Helper()
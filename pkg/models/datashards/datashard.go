@@ -8,6 +8,8 @@ import (
 type DataShard struct {
 	ID  string
 	Cfg *config.Shard
+	// Draining shard does not accept new key ranges
+	Draining bool
 }
 
 func NewDataShard(name string, cfg *config.Shard) *DataShard {
@@ -19,14 +21,17 @@ func NewDataShard(name string, cfg *config.Shard) *DataShard {
 
 func DataShardToProto(shard *DataShard) *proto.Shard {
 	return &proto.Shard{
-		Hosts: shard.Cfg.Hosts,
-		Id:    shard.ID,
+		Hosts:    shard.Cfg.Hosts,
+		Id:       shard.ID,
+		Draining: shard.Draining,
 	}
 }
 
 func DataShardFromProto(shard *proto.Shard) *DataShard {
-	return NewDataShard(shard.Id, &config.Shard{
+	res := NewDataShard(shard.Id, &config.Shard{
 		Hosts: shard.Hosts,
 		Type:  config.DataShard,
 	})
+	res.Draining = shard.Draining
+	return res
 }
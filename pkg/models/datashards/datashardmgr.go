@@ -10,4 +10,6 @@ type ShardsMgr interface {
 	ListShards(ctx context.Context) ([]*DataShard, error)
 	GetShard(ctx context.Context, shardID string) (*DataShard, error)
 	DropShard(ctx context.Context, id string) error
+	// DrainShard moves all key ranges out of the shard and marks it as not accepting new ones
+	DrainShard(ctx context.Context, id string) error
 }
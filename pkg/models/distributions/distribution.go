@@ -9,6 +9,8 @@ import (
 type DistributionKeyEntry struct {
 	Column       string
 	HashFunction string
+	// Expression is function of column, which values are distributed, e.g. lower
+	Expression string
 }
 
 type DistributedRelation struct {
@@ -25,6 +27,7 @@ func DistributedRelationFromDB(rel *qdb.DistributedRelation) *DistributedRelatio
 		rdistr.DistributionKey = append(rdistr.DistributionKey, DistributionKeyEntry{
 			Column:       e.Column,
 			HashFunction: e.HashFunction,
+			Expression:   e.Expression,
 		})
 	}
 
@@ -40,6 +43,7 @@ func DistributedRelationToDB(rel *DistributedRelation) *qdb.DistributedRelation
 		rdistr.DistributionKey = append(rdistr.DistributionKey, qdb.DistributionKeyEntry{
 			Column:       e.Column,
 			HashFunction: e.HashFunction,
+			Expression:   e.Expression,
 		})
 	}
 
@@ -55,6 +59,7 @@ func DistributedRelatitonToProto(rel *DistributedRelation) *proto.DistributedRel
 		rdistr.DistributionKey = append(rdistr.DistributionKey, &proto.DistributionKeyEntry{
 			Column:       e.Column,
 			HashFunction: e.HashFunction,
+			Expression:   e.Expression,
 		})
 	}
 
@@ -70,6 +75,7 @@ func DistributedRelationFromProto(rel *proto.DistributedRelation) *DistributedRe
 		rdistr.DistributionKey = append(rdistr.DistributionKey, DistributionKeyEntry{
 			Column:       e.Column,
 			HashFunction: e.HashFunction,
+			Expression:   e.Expression,
 		})
 	}
 
@@ -85,6 +91,7 @@ func DistributedRelationFromSQL(rel *spqrparser.DistributedRelation) *Distribute
 		rdistr.DistributionKey = append(rdistr.DistributionKey, DistributionKeyEntry{
 			Column:       e.Column,
 			HashFunction: e.HashFunction,
+			Expression:   e.Expression,
 		})
 	}
 
@@ -14,4 +14,7 @@ type DistributionMgr interface {
 
 	AlterDistributionAttach(ctx context.Context, id string, rels []*DistributedRelation) error
 	AlterDistributionDetach(ctx context.Context, id string, relName string) error
+
+	// CheckReferenceRelation collects contents summary of reference relation on every shard
+	CheckReferenceRelation(ctx context.Context, relName string) ([]*ReferenceRelationShardState, error)
 }
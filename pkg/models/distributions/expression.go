@@ -0,0 +1,48 @@
+package distributions
+
+import (
+	"fmt"
+	"strings"
+)
+
+type KeyExpressionType int
+
+/* Functions of column, which can be used in distribution key.
+* Router evaluates them on column values, so only functions
+* with router-side implementation are supported. */
+const (
+	KeyExpressionNone  = KeyExpressionType(0)
+	KeyExpressionLower = KeyExpressionType(1)
+	KeyExpressionUpper = KeyExpressionType(2)
+)
+
+var (
+	errNoSuchKeyExpression = fmt.Errorf("no such distribution key expression")
+)
+
+func KeyExpressionByName(name string) (KeyExpressionType, error) {
+	switch strings.ToLower(name) {
+	case "":
+		return KeyExpressionNone, nil
+	case "lower":
+		return KeyExpressionLower, nil
+	case "upper":
+		return KeyExpressionUpper, nil
+	default:
+		return KeyExpressionNone, errNoSuchKeyExpression
+	}
+}
+
+// ApplyKeyExpression computes distribution key expression on column value
+func ApplyKeyExpression(value string, e KeyExpressionType) (string, error) {
+	switch e {
+	case KeyExpressionNone:
+		return value, nil
+	case KeyExpressionLower:
+		return strings.ToLower(value), nil
+	case KeyExpressionUpper:
+		return strings.ToUpper(value), nil
+	default:
+		return "", errNoSuchKeyExpression
+	}
+}
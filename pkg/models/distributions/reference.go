@@ -0,0 +1,43 @@
+package distributions
+
+import (
+	proto "github.com/pg-sharding/spqr/pkg/protos"
+)
+
+// REPLICATED is a distribution of reference relations.
+// Reference relations are copied to every shard, so they have
+// no distribution key and no key ranges.
+const REPLICATED = "REPLICATED"
+
+// ReferenceRelationShardState summarizes contents of reference relation on a shard
+type ReferenceRelationShardState struct {
+	ShardId  string
+	Rows     int64
+	Checksum string
+}
+
+func ReferenceRelationShardStateToProto(st *ReferenceRelationShardState) *proto.ReferenceRelationShardState {
+	return &proto.ReferenceRelationShardState{
+		ShardId:  st.ShardId,
+		Rows:     st.Rows,
+		Checksum: st.Checksum,
+	}
+}
+
+func ReferenceRelationShardStateFromProto(st *proto.ReferenceRelationShardState) *ReferenceRelationShardState {
+	return &ReferenceRelationShardState{
+		ShardId:  st.ShardId,
+		Rows:     st.Rows,
+		Checksum: st.Checksum,
+	}
+}
+
+// ReferenceRelationConsistent reports whether reference relation contents are equal on all shards
+func ReferenceRelationConsistent(states []*ReferenceRelationShardState) bool {
+	for _, st := range states {
+		if st.Rows != states[0].Rows || st.Checksum != states[0].Checksum {
+			return false
+		}
+	}
+	return true
+}
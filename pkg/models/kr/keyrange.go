@@ -50,6 +50,21 @@ func CmpRangesEqual(kr []byte, other []byte) bool {
 	return false
 }
 
+// MatchKeyRange returns key range with the greatest lower bound,
+// which is less or equal to the key, or nil if there is no such key range
+func MatchKeyRange(key []byte, krs []*KeyRange) *KeyRange {
+	var matched *KeyRange
+
+	for _, krkey := range krs {
+		if CmpRangesLessEqual(krkey.LowerBound, key) &&
+			(matched == nil || CmpRangesLessEqual(matched.LowerBound, krkey.LowerBound)) {
+			matched = krkey
+		}
+	}
+
+	return matched
+}
+
 // TODO : unit tests
 func KeyRangeFromDB(kr *qdb.KeyRange) *KeyRange {
 	return &KeyRange{
@@ -108,6 +123,41 @@ func (kr *KeyRange) ToProto() *proto.KeyRangeInfo {
 	}
 }
 
+// ResolvedToProto converts key ranges, matched by keys, to proto representation
+// TODO : unit tests
+func ResolvedToProto(keys []string, krs []*KeyRange) []*proto.ResolvedKeyRange {
+	res := make([]*proto.ResolvedKeyRange, len(krs))
+	for i, krg := range krs {
+		res[i] = &proto.ResolvedKeyRange{
+			Key:     keys[i],
+			Krid:    krg.ID,
+			ShardId: krg.ShardID,
+		}
+	}
+	return res
+}
+
+// QueryCountsToProto converts per-key-range query counters to protobuf
+func QueryCountsToProto(counts map[string]uint64) []*proto.KeyRangeStats {
+	res := make([]*proto.KeyRangeStats, 0, len(counts))
+	for krId, count := range counts {
+		res = append(res, &proto.KeyRangeStats{
+			Krid:       krId,
+			QueryCount: count,
+		})
+	}
+	return res
+}
+
+// QueryCountsFromProto converts per-key-range query counters from protobuf
+func QueryCountsFromProto(stats []*proto.KeyRangeStats) map[string]uint64 {
+	res := make(map[string]uint64, len(stats))
+	for _, s := range stats {
+		res[s.Krid] += s.QueryCount
+	}
+	return res
+}
+
 // GetKRCondition returns SQL condition for elements of distributed relation between two key ranges
 // TODO support multidimensional key ranges
 func GetKRCondition(ds *distributions.Distribution, rel *distributions.DistributedRelation, kRange *KeyRange, upperBound KeyRangeBound, prefix string) string {
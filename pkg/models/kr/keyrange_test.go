@@ -82,3 +82,30 @@ func TestGetKRCondition(t *testing.T) {
 	}
 
 }
+
+func TestMatchKeyRange(t *testing.T) {
+	assert := assert.New(t)
+
+	krs := []*kr.KeyRange{
+		{ID: "kr2", LowerBound: []byte("10"), ShardID: "sh2"},
+		{ID: "kr1", LowerBound: []byte("1"), ShardID: "sh1"},
+		{ID: "kr3", LowerBound: []byte("100"), ShardID: "sh1"},
+	}
+
+	for _, c := range []struct {
+		key      string
+		expected string
+	}{
+		{key: "1", expected: "kr1"},
+		{key: "9", expected: "kr1"},
+		{key: "10", expected: "kr2"},
+		{key: "42", expected: "kr2"},
+		{key: "100500", expected: "kr3"},
+	} {
+		matched := kr.MatchKeyRange([]byte(c.key), krs)
+		assert.NotNil(matched, "key %s", c.key)
+		assert.Equal(c.expected, matched.ID, "key %s", c.key)
+	}
+
+	assert.Nil(kr.MatchKeyRange([]byte("0"), krs))
+}
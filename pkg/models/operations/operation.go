@@ -0,0 +1,164 @@
+package operations
+
+import (
+	"time"
+
+	protos "github.com/pg-sharding/spqr/pkg/protos"
+	"github.com/pg-sharding/spqr/qdb"
+)
+
+type Status string
+
+const (
+	StatusPlanned   = Status(qdb.OperationPlanned)
+	StatusRunning   = Status(qdb.OperationRunning)
+	StatusDone      = Status(qdb.OperationDone)
+	StatusFailed    = Status(qdb.OperationFailed)
+	StatusCancelled = Status(qdb.OperationCancelled)
+)
+
+const (
+	KindMove  = "move"
+	KindSplit = "split"
+	KindUnite = "unite"
+	KindDrain = "drain"
+	// KindBalance tracks task group, executed by the balancer
+	KindBalance = "balance"
+)
+
+// Operation is a long-running admin command, executed by the coordinator
+// in background. Its state is persisted in QDB.
+type Operation struct {
+	ID          string
+	Kind        string
+	Description string
+	Status      Status
+	// Progress is a percentage of completed work
+	Progress   int32
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Error      string
+	// KeyRangeIDs are key ranges, modified by the operation
+	KeyRangeIDs []string
+	// Steps is the total amount of work of the operation,
+	// if its progress is counted in steps
+	Steps int32
+}
+
+// Finished reports whether operation reached a terminal status.
+func (op *Operation) Finished() bool {
+	switch op.Status {
+	case StatusDone, StatusFailed, StatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func timeToUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func timeFromUnix(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// TODO : unit tests
+func OperationFromDB(op *qdb.Operation) *Operation {
+	return &Operation{
+		ID:          op.ID,
+		Kind:        op.Kind,
+		Description: op.Description,
+		Status:      Status(op.Status),
+		Progress:    op.Progress,
+		StartedAt:   timeFromUnix(op.StartedAt),
+		FinishedAt:  timeFromUnix(op.FinishedAt),
+		Error:       op.Error,
+		KeyRangeIDs: op.KeyRangeIDs,
+		Steps:       op.Steps,
+	}
+}
+
+// TODO : unit tests
+func OperationToDB(op *Operation) *qdb.Operation {
+	return &qdb.Operation{
+		ID:          op.ID,
+		Kind:        op.Kind,
+		Description: op.Description,
+		Status:      qdb.OperationStatus(op.Status),
+		Progress:    op.Progress,
+		StartedAt:   timeToUnix(op.StartedAt),
+		FinishedAt:  timeToUnix(op.FinishedAt),
+		Error:       op.Error,
+		KeyRangeIDs: op.KeyRangeIDs,
+		Steps:       op.Steps,
+	}
+}
+
+// TODO : unit tests
+func OperationFromProto(op *protos.Operation) *Operation {
+	return &Operation{
+		ID:          op.Id,
+		Kind:        op.Kind,
+		Description: op.Description,
+		Status:      StatusFromProto(op.Status),
+		Progress:    op.Progress,
+		StartedAt:   timeFromUnix(op.StartedAt),
+		FinishedAt:  timeFromUnix(op.FinishedAt),
+		Error:       op.Error,
+	}
+}
+
+// TODO : unit tests
+func OperationToProto(op *Operation) *protos.Operation {
+	return &protos.Operation{
+		Id:          op.ID,
+		Kind:        op.Kind,
+		Description: op.Description,
+		Status:      StatusToProto(op.Status),
+		Progress:    op.Progress,
+		StartedAt:   timeToUnix(op.StartedAt),
+		FinishedAt:  timeToUnix(op.FinishedAt),
+		Error:       op.Error,
+	}
+}
+
+func StatusToProto(s Status) protos.OperationStatus {
+	switch s {
+	case StatusPlanned:
+		return protos.OperationStatus_PLANNED
+	case StatusRunning:
+		return protos.OperationStatus_RUNNING
+	case StatusDone:
+		return protos.OperationStatus_DONE
+	case StatusFailed:
+		return protos.OperationStatus_FAILED
+	case StatusCancelled:
+		return protos.OperationStatus_CANCELLED
+	default:
+		panic("incorrect operation status")
+	}
+}
+
+func StatusFromProto(s protos.OperationStatus) Status {
+	switch s {
+	case protos.OperationStatus_PLANNED:
+		return StatusPlanned
+	case protos.OperationStatus_RUNNING:
+		return StatusRunning
+	case protos.OperationStatus_DONE:
+		return StatusDone
+	case protos.OperationStatus_FAILED:
+		return StatusFailed
+	case protos.OperationStatus_CANCELLED:
+		return StatusCancelled
+	default:
+		panic("incorrect operation status")
+	}
+}
@@ -0,0 +1,22 @@
+package operations
+
+import (
+	"context"
+
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+)
+
+type OperationMgr interface {
+	// StartMove, StartSplit and StartUnite schedule key range modification
+	// and return without waiting for it to complete.
+	StartMove(ctx context.Context, req *kr.MoveKeyRange) (*Operation, error)
+	StartSplit(ctx context.Context, req *kr.SplitKeyRange) (*Operation, error)
+	StartUnite(ctx context.Context, req *kr.UniteKeyRange) (*Operation, error)
+	// StartDrain schedules moving all key ranges out of the shard
+	StartDrain(ctx context.Context, shardId string) (*Operation, error)
+
+	GetOperation(ctx context.Context, id string) (*Operation, error)
+	ListOperations(ctx context.Context) ([]*Operation, error)
+	// CancelOperation cancels operation, which has not been started yet
+	CancelOperation(ctx context.Context, id string) error
+}
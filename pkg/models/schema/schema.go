@@ -0,0 +1,91 @@
+package schema
+
+import (
+	protos "github.com/pg-sharding/spqr/pkg/protos"
+	"github.com/pg-sharding/spqr/qdb"
+)
+
+// SchemaChange is DDL statement, which was applied on all shards.
+// Changes are numbered in order they were applied, and are replayed
+// in this order on newly added shard.
+type SchemaChange struct {
+	ID    int64
+	Query string
+}
+
+// RelationSchemaState summarizes definition of relation on a shard.
+// Checksum is empty, if the relation does not exist on the shard.
+type RelationSchemaState struct {
+	ShardId      string
+	RelationName string
+	Checksum     string
+}
+
+// TODO : unit tests
+func SchemaChangeFromDB(change *qdb.SchemaChange) *SchemaChange {
+	return &SchemaChange{
+		ID:    change.ID,
+		Query: change.Query,
+	}
+}
+
+// TODO : unit tests
+func SchemaChangeFromProto(change *protos.SchemaChange) *SchemaChange {
+	return &SchemaChange{
+		ID:    change.Id,
+		Query: change.Query,
+	}
+}
+
+// TODO : unit tests
+func SchemaChangeToProto(change *SchemaChange) *protos.SchemaChange {
+	return &protos.SchemaChange{
+		Id:    change.ID,
+		Query: change.Query,
+	}
+}
+
+// TODO : unit tests
+func RelationSchemaStateFromProto(st *protos.RelationSchemaState) *RelationSchemaState {
+	return &RelationSchemaState{
+		ShardId:      st.ShardId,
+		RelationName: st.RelationName,
+		Checksum:     st.Checksum,
+	}
+}
+
+// TODO : unit tests
+func RelationSchemaStateToProto(st *RelationSchemaState) *protos.RelationSchemaState {
+	return &protos.RelationSchemaState{
+		ShardId:      st.ShardId,
+		RelationName: st.RelationName,
+		Checksum:     st.Checksum,
+	}
+}
+
+// InconsistentRelations returns names of relations, which definitions
+// differ between shards, in order of their first state
+func InconsistentRelations(states []*RelationSchemaState) []string {
+	first := map[string]string{}
+	var order []string
+	diverged := map[string]bool{}
+	for _, st := range states {
+		checksum, ok := first[st.RelationName]
+		if !ok {
+			first[st.RelationName] = st.Checksum
+			order = append(order, st.RelationName)
+			continue
+		}
+		if checksum != st.Checksum {
+			diverged[st.RelationName] = true
+		}
+	}
+
+	var res []string
+	for _, rel := range order {
+		if diverged[rel] {
+			res = append(res, rel)
+		}
+	}
+	return res
+}
@@ -0,0 +1,21 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/pg-sharding/spqr/pkg/models/datashards"
+)
+
+type SchemaMgr interface {
+	// RecordSchemaChange adds DDL statement, applied on all shards, to schema history
+	RecordSchemaChange(ctx context.Context, query string) error
+	ListSchemaChanges(ctx context.Context) ([]*SchemaChange, error)
+	// CheckSchema collects definition summary of relation on every shard,
+	// or of all distributed and reference relations, if relName is empty
+	CheckSchema(ctx context.Context, relName string) ([]*RelationSchemaState, error)
+	// CopySchema creates distributed and reference relations on shard to,
+	// which is not registered yet, as they are defined on shard fromShardId
+	CopySchema(ctx context.Context, fromShardId string, to *datashards.DataShard) error
+	// ReplaySchema applies schema history on shard to, which is not registered yet
+	ReplaySchema(ctx context.Context, to *datashards.DataShard) error
+}
@@ -0,0 +1,53 @@
+package sequences
+
+import (
+	protos "github.com/pg-sharding/spqr/pkg/protos"
+	"github.com/pg-sharding/spqr/qdb"
+)
+
+// Sequence is a cluster-wide sequence, which values are allocated
+// by the coordinator, so they are unique across all shards.
+type Sequence struct {
+	Name string
+	// RelationName and ColumnName define relation column, which is filled
+	// with sequence values, if INSERT omits it. Both are empty for
+	// sequences, used only via nextval().
+	RelationName string
+	ColumnName   string
+}
+
+// TODO : unit tests
+func SequenceFromDB(seq *qdb.Sequence) *Sequence {
+	return &Sequence{
+		Name:         seq.Name,
+		RelationName: seq.RelationName,
+		ColumnName:   seq.ColumnName,
+	}
+}
+
+// TODO : unit tests
+func SequenceToDB(seq *Sequence) *qdb.Sequence {
+	return &qdb.Sequence{
+		Name:         seq.Name,
+		RelationName: seq.RelationName,
+		ColumnName:   seq.ColumnName,
+	}
+}
+
+// TODO : unit tests
+func SequenceFromProto(seq *protos.Sequence) *Sequence {
+	return &Sequence{
+		Name:         seq.Name,
+		RelationName: seq.RelationName,
+		ColumnName:   seq.ColumnName,
+	}
+}
+
+// TODO : unit tests
+func SequenceToProto(seq *Sequence) *protos.Sequence {
+	return &protos.Sequence{
+		Name:         seq.Name,
+		RelationName: seq.RelationName,
+		ColumnName:   seq.ColumnName,
+	}
+}
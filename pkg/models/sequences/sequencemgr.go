@@ -0,0 +1,12 @@
+package sequences
+
+import "context"
+
+type SequenceMgr interface {
+	CreateSequence(ctx context.Context, seq *Sequence) error
+	DropSequence(ctx context.Context, name string) error
+	ListSequences(ctx context.Context) ([]*Sequence, error)
+	// NextSequenceRange reserves count consecutive values of sequence
+	// and returns the first of them.
+	NextSequenceRange(ctx context.Context, name string, count int64) (int64, error)
+}
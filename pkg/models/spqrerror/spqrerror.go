@@ -21,6 +21,11 @@ const (
 	SPQR_ROUTER_ERROR        = "SPQRL"
 	SPQR_METADATA_CORRUPTION = "SPQRZ"
 	SPQR_INVALID_REQUEST     = "SPQRJ"
+	SPQR_OPERATION_ERROR     = "SPQRP"
+	SPQR_QUERY_WAIT_TIMEOUT  = "SPQRW"
+	SPQR_SEQUENCE_ERROR      = "SPQRQ"
+	SPQR_QUERY_TIMEOUT       = "SPQRA"
+	SPQR_IDLE_TX_TIMEOUT     = "SPQRB"
 )
 
 var existingErrorCodeMap = map[string]string{
@@ -41,6 +46,11 @@ var existingErrorCodeMap = map[string]string{
 	SPQR_ROUTER_ERROR:        "Router error",
 	SPQR_METADATA_CORRUPTION: "routing metadata corrupted",
 	SPQR_INVALID_REQUEST:     "Invalid Request",
+	SPQR_OPERATION_ERROR:     "Operation error",
+	SPQR_QUERY_WAIT_TIMEOUT:  "query_wait_timeout",
+	SPQR_SEQUENCE_ERROR:      "Sequence error",
+	SPQR_QUERY_TIMEOUT:       "query_timeout",
+	SPQR_IDLE_TX_TIMEOUT:     "idle_transaction_timeout",
 }
 
 var ShardingKeysRemoved = New(SPQR_INVALID_REQUEST, "sharding rules are removed from SPQR, see https://github.com/pg-sharding/spqr/blob/master/docs/Syntax.md")
@@ -13,6 +13,9 @@ type Task struct {
 	Bound       []byte
 	KrIdTemp    string
 	State       TaskState
+	// JoinType defines how moved part is merged with the destination key range.
+	// Tasks with the same KrIdFrom form a chain of moves of one key range.
+	JoinType JoinType
 }
 
 type TaskState int
@@ -32,10 +35,29 @@ const (
 )
 
 type TaskGroup struct {
-	Tasks    []*Task
+	Tasks []*Task
+	// JoinType is the join type of tasks, which do not have their own one.
+	// Kept for compatibility with single-move task groups.
 	JoinType JoinType
 }
 
+// Chains splits tasks of the group into chains of moves of one key range,
+// preserving the order of tasks
+func (g *TaskGroup) Chains() [][]*Task {
+	res := make([][]*Task, 0)
+	idx := make(map[string]int)
+	for _, task := range g.Tasks {
+		i, ok := idx[task.KrIdFrom]
+		if !ok {
+			i = len(res)
+			idx[task.KrIdFrom] = i
+			res = append(res, make([]*Task, 0))
+		}
+		res[i] = append(res[i], task)
+	}
+	return res
+}
+
 func TaskGroupToProto(group *TaskGroup) *protos.TaskGroup {
 	return &protos.TaskGroup{
 		Tasks: func() []*protos.Task {
@@ -58,6 +80,7 @@ func TaskToProto(task *Task) *protos.Task {
 		KeyRangeIdTemp: task.KrIdTemp,
 		Bound:          task.Bound,
 		Status:         TaskStateToProto(task.State),
+		JoinType:       JoinTypeToProto(task.JoinType),
 	}
 }
 
@@ -106,6 +129,9 @@ func TaskGroupFromProto(group *protos.TaskGroup) *TaskGroup {
 			res := make([]*Task, len(group.Tasks))
 			for i, t := range group.Tasks {
 				res[i] = TaskFromProto(t)
+				if res[i].JoinType == JoinNone {
+					res[i].JoinType = JoinTypeFromProto(group.JoinType)
+				}
 			}
 			return res
 		}(),
@@ -122,6 +148,7 @@ func TaskFromProto(task *protos.Task) *Task {
 		KrIdTemp:    task.KeyRangeIdTemp,
 		Bound:       task.Bound,
 		State:       TaskStateFromProto(task.Status),
+		JoinType:    JoinTypeFromProto(task.JoinType),
 	}
 }
 
@@ -173,6 +200,7 @@ func TaskToDb(task *Task) *qdb.Task {
 		KrIdTemp:    task.KrIdTemp,
 		Bound:       task.Bound,
 		State:       int(task.State),
+		JoinType:    int(task.JoinType),
 	}
 }
 
@@ -182,6 +210,9 @@ func TaskGroupFromDb(group *qdb.TaskGroup) *TaskGroup {
 			res := make([]*Task, len(group.Tasks))
 			for i, task := range group.Tasks {
 				res[i] = TaskFromDb(task)
+				if res[i].JoinType == JoinNone {
+					res[i].JoinType = JoinType(group.JoinType)
+				}
 			}
 			return res
 		}(),
@@ -198,5 +229,6 @@ func TaskFromDb(task *qdb.Task) *Task {
 		KrIdTemp:    task.KrIdTemp,
 		Bound:       task.Bound,
 		State:       TaskState(task.State),
+		JoinType:    JoinType(task.JoinType),
 	}
 }
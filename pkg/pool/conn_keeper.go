@@ -2,6 +2,7 @@ package pool
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 
@@ -20,6 +21,16 @@ type ConnectionKepperData struct {
 	IdleConnCount int64
 	QueueSize     int64
 
+	ClosedByLifetime    int64
+	ClosedByIdleTimeout int64
+	FailedChecks        int64
+	WarmedUp            int64
+
+	WaitingClients int64
+	WaitTimeouts   int64
+	AvgWait        time.Duration
+	MaxWait        time.Duration
+
 	m sync.RWMutex
 }
 
@@ -35,7 +46,18 @@ func NewConnectionKepperData(info *protos.PoolInfo) *ConnectionKepperData {
 		ConnCount:     info.ConnCount,
 		IdleConnCount: info.IdleConnCount,
 		QueueSize:     info.QueueSize,
-		m:             sync.RWMutex{},
+
+		ClosedByLifetime:    info.ClosedByLifetime,
+		ClosedByIdleTimeout: info.ClosedByIdleTimeout,
+		FailedChecks:        info.FailedChecks,
+		WarmedUp:            info.WarmedUp,
+
+		WaitingClients: info.WaitingClients,
+		WaitTimeouts:   info.WaitTimeouts,
+		AvgWait:        time.Duration(info.AvgWaitUs) * time.Microsecond,
+		MaxWait:        time.Duration(info.MaxWaitUs) * time.Microsecond,
+
+		m: sync.RWMutex{},
 	}
 }
 
@@ -94,3 +116,20 @@ func (r *ConnectionKepperData) Rule() *config.BackendRule {
 		Usr: r.Usr,
 	}
 }
+
+func (r *ConnectionKepperData) Stats() PoolStats {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return PoolStats{
+		ClosedByLifetime:    r.ClosedByLifetime,
+		ClosedByIdleTimeout: r.ClosedByIdleTimeout,
+		FailedChecks:        r.FailedChecks,
+		WarmedUp:            r.WarmedUp,
+
+		WaitingClients: r.WaitingClients,
+		WaitTimeouts:   r.WaitTimeouts,
+		AvgWait:        r.AvgWait,
+		MaxWait:        r.MaxWait,
+	}
+}
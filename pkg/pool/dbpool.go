@@ -1,14 +1,17 @@
 package pool
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"math/rand"
 	"net"
+	"sort"
 
 	"github.com/pg-sharding/spqr/pkg/config"
 	"github.com/pg-sharding/spqr/pkg/conn"
 	"github.com/pg-sharding/spqr/pkg/datashard"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 	"github.com/pg-sharding/spqr/pkg/shard"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
 	"github.com/pg-sharding/spqr/pkg/tsa"
@@ -21,18 +24,37 @@ type InstancePoolImpl struct {
 	shardMapping map[string]*config.Shard
 
 	checker tsa.TSAChecker
+	// health is nil, if background health checks are disabled
+	health tsa.HealthChecker
+	// unsubscribe stops invalidation of connections on host role change
+	unsubscribe func()
+
+	beRule   *config.BackendRule
+	selector *hostSelector
 }
 
 var _ DBPool = &InstancePoolImpl{}
 
+// isQueryWaitTimeout reports if connection was not acquired, because pool was full for too long
+func isQueryWaitTimeout(err error) bool {
+	var spqrErr *spqrerror.SpqrError
+	return errors.As(err, &spqrErr) && spqrErr.ErrorCode == spqrerror.SPQR_QUERY_WAIT_TIMEOUT
+}
+
+// traverseHostsMatchCB returns first host connection accepted by callback.
+// If there is no such connection, query wait timeout error is returned, if any host pool timed out.
 // TODO : unit tests
 func (s *InstancePoolImpl) traverseHostsMatchCB(
 	clid uint,
-	key kr.ShardKey, hosts []string, cb func(shard.Shard) bool) shard.Shard {
+	key kr.ShardKey, hosts []string, cb func(shard.Shard) bool) (shard.Shard, error) {
 
+	var waitErr error
 	for _, host := range hosts {
 		sh, err := s.pool.Connection(clid, key, host)
 		if err != nil {
+			if isQueryWaitTimeout(err) {
+				waitErr = err
+			}
 			spqrlog.Zero.Error().
 				Err(err).
 				Str("host", host).
@@ -46,10 +68,10 @@ func (s *InstancePoolImpl) traverseHostsMatchCB(
 			continue
 		}
 
-		return sh
+		return sh, nil
 	}
 
-	return nil
+	return nil, waitErr
 }
 
 // TODO : unit tests
@@ -57,7 +79,7 @@ func (s *InstancePoolImpl) SelectReadOnlyShardHost(
 	clid uint,
 	key kr.ShardKey, hosts []string) (shard.Shard, error) {
 	total_msg := ""
-	sh := s.traverseHostsMatchCB(clid, key, hosts, func(shard shard.Shard) bool {
+	sh, err := s.traverseHostsMatchCB(clid, key, hosts, func(shard shard.Shard) bool {
 		if ch, reason, err := s.checker.CheckTSA(shard); err != nil {
 			total_msg += fmt.Sprintf("host %s: ", shard.Instance().Hostname()) + err.Error()
 			_ = s.pool.Discard(shard)
@@ -72,6 +94,9 @@ func (s *InstancePoolImpl) SelectReadOnlyShardHost(
 	if sh != nil {
 		return sh, nil
 	}
+	if err != nil {
+		return nil, err
+	}
 
 	return nil, fmt.Errorf("shard %s failed to find replica within %s", key.Name, total_msg)
 }
@@ -82,7 +107,7 @@ func (s *InstancePoolImpl) SelectReadWriteShardHost(
 	key kr.ShardKey, hosts []string) (shard.Shard, error) {
 
 	total_msg := ""
-	sh := s.traverseHostsMatchCB(clid, key, hosts, func(shard shard.Shard) bool {
+	sh, err := s.traverseHostsMatchCB(clid, key, hosts, func(shard shard.Shard) bool {
 
 		if ch, reason, err := s.checker.CheckTSA(shard); err != nil {
 			total_msg += fmt.Sprintf("host %s: ", shard.Instance().Hostname()) + err.Error()
@@ -98,10 +123,55 @@ func (s *InstancePoolImpl) SelectReadWriteShardHost(
 	if sh != nil {
 		return sh, nil
 	}
+	if err != nil {
+		return nil, err
+	}
 
 	return nil, fmt.Errorf("shard %s failed to find primary within %s", key.Name, total_msg)
 }
 
+// selectHosts drops hosts, which health checker considers unusable with target session attrs,
+// and puts hosts of the expected role first. If there are no usable hosts, all of them are returned,
+// as health checker state may be stale.
+func (s *InstancePoolImpl) selectHosts(hosts []string, targetSessionAttrs string) []string {
+	if s.health == nil {
+		return hosts
+	}
+
+	usable := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if s.health.Usable(host, targetSessionAttrs) {
+			usable = append(usable, host)
+		}
+	}
+	if len(usable) == 0 {
+		spqrlog.Zero.Debug().
+			Strs("hosts", hosts).
+			Str("tsa", targetSessionAttrs).
+			Msg("no usable hosts according to health checker, trying all of them")
+		return hosts
+	}
+
+	var wantRecovery bool
+	switch targetSessionAttrs {
+	case config.TargetSessionAttrsRW:
+		wantRecovery = false
+	case config.TargetSessionAttrsRO, config.TargetSessionAttrsPS:
+		wantRecovery = true
+	default:
+		return usable
+	}
+	sort.SliceStable(usable, func(i, j int) bool {
+		return s.expectedRole(usable[i], wantRecovery) && !s.expectedRole(usable[j], wantRecovery)
+	})
+	return usable
+}
+
+func (s *InstancePoolImpl) expectedRole(host string, wantRecovery bool) bool {
+	st, ok := s.health.HostState(host)
+	return ok && st.InRecovery == wantRecovery
+}
+
 // TODO : unit tests
 func (s *InstancePoolImpl) Connection(
 	clid uint,
@@ -112,20 +182,28 @@ func (s *InstancePoolImpl) Connection(
 		Str("shard", key.Name).
 		Str("tsa", targetSessionAttrs).
 		Msg("acquiring new instance connection for client to shard with target session attrs")
-	hosts := make([]string, len(s.shardMapping[key.Name].Hosts))
-	copy(hosts, s.shardMapping[key.Name].Hosts)
-	rand.Shuffle(len(hosts), func(i, j int) {
-		hosts[j], hosts[i] = hosts[i], hosts[j]
-	})
+	policy := config.HostSelectionRandom
+	switch targetSessionAttrs {
+	case config.TargetSessionAttrsRO, config.TargetSessionAttrsPS:
+		if s.beRule != nil && s.beRule.HostSelectionPolicy != "" {
+			policy = s.beRule.HostSelectionPolicy
+		}
+	}
+	hosts := s.selector.order(policy, key.Name, s.shardMapping[key.Name])
+	hosts = s.selectHosts(hosts, targetSessionAttrs)
 
 	switch targetSessionAttrs {
 	case "":
 		fallthrough
 	case config.TargetSessionAttrsAny:
 		total_msg := ""
+		var waitErr error
 		for _, host := range hosts {
 			shard, err := s.pool.Connection(clid, key, host)
 			if err != nil {
+				if isQueryWaitTimeout(err) {
+					waitErr = err
+				}
 				total_msg += fmt.Sprintf("host %s: ", host) + err.Error()
 
 				spqrlog.Zero.Error().
@@ -137,6 +215,9 @@ func (s *InstancePoolImpl) Connection(
 			}
 			return shard, nil
 		}
+		if waitErr != nil {
+			return nil, waitErr
+		}
 		return nil, fmt.Errorf("failed to get connection to any shard host within %s", total_msg)
 	case config.TargetSessionAttrsRO:
 		return s.SelectReadOnlyShardHost(clid, key, hosts)
@@ -154,9 +235,22 @@ func (s *InstancePoolImpl) Connection(
 }
 
 func (s *InstancePoolImpl) InitRule(rule *config.BackendRule) error {
+	s.beRule = rule
 	return s.pool.InitRule(rule)
 }
 
+// usedConnections returns number of connections to the host, acquired by clients
+func (s *InstancePoolImpl) usedConnections(host string) int {
+	used := 0
+	_ = s.pool.ForEachPool(func(p Pool) error {
+		if p.Hostname() == host {
+			used += p.UsedConnectionCount()
+		}
+		return nil
+	})
+	return used
+}
+
 func (s *InstancePoolImpl) ShardMapping() map[string]*config.Shard {
 	return s.shardMapping
 }
@@ -197,33 +291,84 @@ func (s *InstancePoolImpl) Cut(host string) []shard.Shard {
 	return s.pool.Cut(host)
 }
 
+// InvalidateHost drops connections to the host and its cached role
+func (s *InstancePoolImpl) InvalidateHost(host string) {
+	s.checker.Invalidate(host)
+	s.pool.InvalidateHost(host)
+}
+
+func (s *InstancePoolImpl) InitReserve(rule *config.FrontendRule) {
+	s.pool.InitReserve(rule)
+}
+
+func (s *InstancePoolImpl) WarmupHost(shardKey kr.ShardKey, host string) {
+	s.pool.WarmupHost(shardKey, host)
+}
+
+// TODO : unit tests
+func (s *InstancePoolImpl) Warmup() {
+	for shardName, shardCfg := range s.shardMapping {
+		for _, host := range shardCfg.Hosts {
+			s.pool.WarmupHost(kr.ShardKey{Name: shardName}, host)
+		}
+	}
+}
+
 func (s *InstancePoolImpl) Discard(sh shard.Shard) error {
 	return s.pool.Discard(sh)
 }
 
-func NewDBPool(mapping map[string]*config.Shard) DBPool {
-	allocator := func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
-		shard := mapping[shardKey.Name]
+// NewConnectionAllocator returns function, which opens connections to hosts of shards from mapping
+func NewConnectionAllocator(mapping map[string]*config.Shard) ConnectionAllocFn {
+	return func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		return ConnectShard(context.Background(), shardKey, mapping[shardKey.Name], host, rule)
+	}
+}
 
-		addr, _, _ := net.SplitHostPort(host)
-		tlsconfig, err := shard.TLS.Init(addr)
-		if err != nil {
-			return nil, err
-		}
-		pgi, err := conn.NewInstanceConn(host, shardKey.Name, tlsconfig)
-		if err != nil {
-			return nil, err
-		}
-		shardC, err := datashard.NewShard(shardKey, pgi, mapping[shardKey.Name], rule)
-		if err != nil {
-			return nil, err
-		}
-		return shardC, nil
+// ConnectShard opens connection to the host of the shard. Dial and startup
+// are bounded by the context deadline, which is left set on the connection.
+func ConnectShard(ctx context.Context, shardKey kr.ShardKey, shardCfg *config.Shard, host string, rule *config.BackendRule) (shard.Shard, error) {
+	addr, _, _ := net.SplitHostPort(host)
+	tlsconfig, err := shardCfg.TLS.Init(addr)
+	if err != nil {
+		return nil, err
 	}
+	pgi, err := conn.NewInstanceConnContext(ctx, host, shardKey.Name, tlsconfig)
+	if err != nil {
+		return nil, err
+	}
+	shardC, err := datashard.NewShard(shardKey, pgi, shardCfg, rule)
+	if err != nil {
+		_ = pgi.Close()
+		return nil, err
+	}
+	return shardC, nil
+}
 
-	return &InstancePoolImpl{
-		pool:         NewPool(allocator),
+// NewDBPool creates pool of connections to shards from mapping.
+// health may be nil, then hosts are tried in random order.
+// localZone is availability zone of the router, used by local zone host selection policy.
+func NewDBPool(mapping map[string]*config.Shard, health tsa.HealthChecker, localZone string) DBPool {
+	dbPool := &InstancePoolImpl{
+		pool:         NewPool(NewConnectionAllocator(mapping)),
 		shardMapping: mapping,
 		checker:      tsa.NewTSAChecker(),
+		health:       health,
+	}
+	dbPool.selector = newHostSelector(localZone, dbPool.usedConnections)
+	if health != nil {
+		dbPool.unsubscribe = health.OnRoleChange(func(st tsa.HostState) {
+			dbPool.InvalidateHost(st.Host)
+		})
+	}
+	return dbPool
+}
+
+// Stop detaches pool from health checker and stops host pools,
+// it is called when route of the pool is dropped
+func (s *InstancePoolImpl) Stop() {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
 	}
+	s.pool.Stop()
 }
@@ -0,0 +1,115 @@
+package pool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pg-sharding/spqr/pkg/config"
+	mockinst "github.com/pg-sharding/spqr/pkg/mock/conn"
+	mockshard "github.com/pg-sharding/spqr/pkg/mock/shard"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/pg-sharding/spqr/pkg/tsa"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectHostsLagAware(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := tsa.NewHealthChecker(&config.HostHealthCheck{
+		MaxReplicaLagMs: 1000,
+		FallThreshold:   1,
+	}, nil, nil)
+	hc.Report("sh1", "primary", false, 0, nil)
+	hc.Report("sh1", "replica", true, 100*time.Millisecond, nil)
+	hc.Report("sh1", "lagging", true, 5*time.Second, nil)
+	hc.Report("sh1", "down", true, 0, errors.New("connection refused"))
+
+	p := &InstancePoolImpl{health: hc}
+	hosts := []string{"primary", "lagging", "down", "replica", "unknown"}
+
+	// lagging replica is not used for reads, replicas go first
+	assert.Equal([]string{"replica", "primary", "unknown"}, p.selectHosts(hosts, config.TargetSessionAttrsRO))
+	assert.Equal([]string{"replica", "primary", "unknown"}, p.selectHosts(hosts, config.TargetSessionAttrsPS))
+	// lag does not matter for writes, primary goes first
+	assert.Equal([]string{"primary", "lagging", "replica", "unknown"}, p.selectHosts(hosts, config.TargetSessionAttrsRW))
+	assert.Equal([]string{"primary", "lagging", "replica", "unknown"}, p.selectHosts(hosts, config.TargetSessionAttrsAny))
+
+	// all hosts are tried, if none is usable
+	assert.Equal([]string{"lagging", "down"}, p.selectHosts([]string{"lagging", "down"}, config.TargetSessionAttrsRO))
+
+	// health checks are disabled
+	p = &InstancePoolImpl{}
+	assert.Equal(hosts, p.selectHosts(hosts, config.TargetSessionAttrsRO))
+}
+
+func TestDBPoolInvalidateOnRoleChange(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	newConn := func(id uint, host string) *mockshard.MockShard {
+		ins := mockinst.NewMockDBInstance(ctrl)
+		ins.EXPECT().Hostname().AnyTimes().Return(host)
+
+		sh := mockshard.NewMockShard(ctrl)
+		sh.EXPECT().Instance().AnyTimes().Return(ins)
+		sh.EXPECT().ID().AnyTimes().Return(id)
+		sh.EXPECT().TxStatus().AnyTimes().Return(txstatus.TXIDLE)
+		sh.EXPECT().Sync().AnyTimes().Return(int64(0))
+		return sh
+	}
+
+	demoted := newConn(1, "h1")
+	demoted.EXPECT().Close().Times(1)
+	// connection to other host is closed, when pool is stopped
+	other := newConn(2, "h2")
+	other.EXPECT().Close().Times(1)
+	afterStop := newConn(3, "h1")
+
+	conns := []shard.Shard{demoted, other, afterStop}
+
+	hc := tsa.NewHealthChecker(&config.HostHealthCheck{}, nil, nil)
+	hc.Report("sh1", "h1", false, 0, nil)
+
+	p := NewDBPool(map[string]*config.Shard{}, hc, "").(*InstancePoolImpl)
+	p.pool = NewPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		var conn shard.Shard
+		conn, conns = conns[0], conns[1:]
+		return conn, nil
+	})
+	assert.NoError(p.InitRule(&config.BackendRule{}))
+
+	idle := func() int {
+		cnt := 0
+		_ = p.ForEachPool(func(hp Pool) error {
+			cnt += hp.IdleConnectionCount()
+			return nil
+		})
+		return cnt
+	}
+
+	for _, host := range []string{"h1", "h2"} {
+		sh, err := p.pool.Connection(1, kr.ShardKey{Name: "sh1"}, host)
+		assert.NoError(err)
+		assert.NoError(p.Put(sh))
+	}
+	assert.Equal(2, idle())
+
+	// primary is demoted
+	hc.Report("sh1", "h1", true, 0, nil)
+	assert.Equal(1, idle())
+
+	p.Stop()
+	assert.Equal(0, idle())
+
+	sh, err := p.pool.Connection(1, kr.ShardKey{Name: "sh1"}, "h1")
+	assert.NoError(err)
+	assert.NoError(p.Put(sh))
+
+	// host is promoted back, but pool is not subscribed anymore
+	hc.Report("sh1", "h1", false, 0, nil)
+	assert.Equal(1, idle())
+}
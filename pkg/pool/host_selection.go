@@ -0,0 +1,85 @@
+package pool
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/router/statistics"
+)
+
+// hostSelector orders shard hosts according to host selection policy
+type hostSelector struct {
+	mu sync.Mutex
+	// next host index for round robin, by shard
+	rrNext map[string]int
+
+	localZone string
+
+	usedConnections func(host string) int
+	latency         func(host string) (float64, bool)
+}
+
+func newHostSelector(localZone string, usedConnections func(host string) int) *hostSelector {
+	return &hostSelector{
+		rrNext:          map[string]int{},
+		localZone:       localZone,
+		usedConnections: usedConnections,
+		latency: func(host string) (float64, bool) {
+			d, ok := statistics.GetHostLatency(host)
+			return float64(d), ok
+		},
+	}
+}
+
+// order returns shard hosts in order they should be tried with the policy
+// TODO : unit tests
+func (hs *hostSelector) order(policy config.HostSelectionPolicy, shardName string, shardCfg *config.Shard) []string {
+	hosts := make([]string, len(shardCfg.Hosts))
+	copy(hosts, shardCfg.Hosts)
+	if len(hosts) == 0 {
+		return hosts
+	}
+
+	switch policy {
+	case config.HostSelectionRoundRobin:
+		hs.mu.Lock()
+		start := hs.rrNext[shardName] % len(hosts)
+		hs.rrNext[shardName] = start + 1
+		hs.mu.Unlock()
+		return append(hosts[start:], hosts[:start]...)
+	}
+
+	rand.Shuffle(len(hosts), func(i, j int) {
+		hosts[j], hosts[i] = hosts[i], hosts[j]
+	})
+
+	switch policy {
+	case config.HostSelectionLeastConnections:
+		used := make(map[string]int, len(hosts))
+		for _, host := range hosts {
+			used[host] = hs.usedConnections(host)
+		}
+		sort.SliceStable(hosts, func(i, j int) bool {
+			return used[hosts[i]] < used[hosts[j]]
+		})
+	case config.HostSelectionLowestLatency:
+		latency := make(map[string]float64, len(hosts))
+		for _, host := range hosts {
+			// hosts without observations go first, so that their latency is measured
+			latency[host], _ = hs.latency(host)
+		}
+		sort.SliceStable(hosts, func(i, j int) bool {
+			return latency[hosts[i]] < latency[hosts[j]]
+		})
+	case config.HostSelectionLocalZone:
+		if hs.localZone == "" {
+			break
+		}
+		sort.SliceStable(hosts, func(i, j int) bool {
+			return shardCfg.HostZones[hosts[i]] == hs.localZone && shardCfg.HostZones[hosts[j]] != hs.localZone
+		})
+	}
+	return hosts
+}
@@ -0,0 +1,69 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostSelectionRoundRobin(t *testing.T) {
+	assert := assert.New(t)
+
+	hs := newHostSelector("", nil)
+	cfg := &config.Shard{Hosts: []string{"h1", "h2", "h3"}}
+
+	assert.Equal([]string{"h1", "h2", "h3"}, hs.order(config.HostSelectionRoundRobin, "sh1", cfg))
+	assert.Equal([]string{"h2", "h3", "h1"}, hs.order(config.HostSelectionRoundRobin, "sh1", cfg))
+	assert.Equal([]string{"h3", "h1", "h2"}, hs.order(config.HostSelectionRoundRobin, "sh1", cfg))
+	assert.Equal([]string{"h1", "h2", "h3"}, hs.order(config.HostSelectionRoundRobin, "sh1", cfg))
+	// shards are cycled independently
+	assert.Equal([]string{"h1", "h2", "h3"}, hs.order(config.HostSelectionRoundRobin, "sh2", cfg))
+}
+
+func TestHostSelectionLeastConnections(t *testing.T) {
+	assert := assert.New(t)
+
+	used := map[string]int{"h1": 5, "h2": 0, "h3": 2}
+	hs := newHostSelector("", func(host string) int {
+		return used[host]
+	})
+	cfg := &config.Shard{Hosts: []string{"h1", "h2", "h3"}}
+
+	assert.Equal([]string{"h2", "h3", "h1"}, hs.order(config.HostSelectionLeastConnections, "sh1", cfg))
+}
+
+func TestHostSelectionLowestLatency(t *testing.T) {
+	assert := assert.New(t)
+
+	latency := map[string]float64{"h1": 10, "h2": 30}
+	hs := newHostSelector("", nil)
+	hs.latency = func(host string) (float64, bool) {
+		v, ok := latency[host]
+		return v, ok
+	}
+	cfg := &config.Shard{Hosts: []string{"h1", "h2", "h3"}}
+
+	// h3 was never observed and goes first
+	assert.Equal([]string{"h3", "h1", "h2"}, hs.order(config.HostSelectionLowestLatency, "sh1", cfg))
+}
+
+func TestHostSelectionLocalZone(t *testing.T) {
+	assert := assert.New(t)
+
+	hs := newHostSelector("zone-b", nil)
+	cfg := &config.Shard{
+		Hosts: []string{"h1", "h2", "h3"},
+		HostZones: map[string]string{
+			"h1": "zone-a",
+			"h2": "zone-b",
+			"h3": "zone-c",
+		},
+	}
+
+	for i := 0; i < 10; i++ {
+		hosts := hs.order(config.HostSelectionLocalZone, "sh1", cfg)
+		assert.Equal("h2", hosts[0])
+		assert.ElementsMatch([]string{"h1", "h2", "h3"}, hosts)
+	}
+}
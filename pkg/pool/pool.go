@@ -1,6 +1,8 @@
 package pool
 
 import (
+	"time"
+
 	"github.com/pg-sharding/spqr/pkg/config"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
 	"github.com/pg-sharding/spqr/pkg/shard"
@@ -11,6 +13,20 @@ const (
 	defaultInstanceConnectionRetries = 10
 )
 
+// PoolStats holds counters of connections, closed or opened by pool reaper,
+// and metrics of clients, waiting for connection
+type PoolStats struct {
+	ClosedByLifetime    int64
+	ClosedByIdleTimeout int64
+	FailedChecks        int64
+	WarmedUp            int64
+
+	WaitingClients int64
+	WaitTimeouts   int64
+	AvgWait        time.Duration
+	MaxWait        time.Duration
+}
+
 type ConnectionKepper interface {
 	Put(host shard.Shard) error
 	Discard(sh shard.Shard) error
@@ -25,6 +41,8 @@ type ConnectionKepper interface {
 	List() []shard.Shard
 
 	Rule() *config.BackendRule
+
+	Stats() PoolStats
 }
 
 /* dedicated host connection pool */
@@ -33,6 +51,8 @@ type Pool interface {
 	shard.ShardIterator
 
 	Connection(clid uint, shardKey kr.ShardKey) (shard.Shard, error)
+	// Invalidate closes idle connections and discards used ones, when they are put back
+	Invalidate()
 }
 
 type MultiShardPool interface {
@@ -44,6 +64,14 @@ type MultiShardPool interface {
 
 	InitRule(rule *config.BackendRule) error
 	Cut(host string) []shard.Shard
+	// InvalidateHost invalidates connections to the host, e.g. after its role changed
+	InvalidateHost(host string)
+	// WarmupHost opens connections to the host up to min pool size of backend rule
+	WarmupHost(shardKey kr.ShardKey, host string)
+	// InitReserve sets reserved capacity of frontend rule for pools of hosts
+	InitReserve(rule *config.FrontendRule)
+	// Stop releases resources of the pool, pool should not be used afterwards
+	Stop()
 }
 
 type PoolIterator interface {
@@ -58,4 +86,6 @@ type DBPool interface {
 	MultiShardPool
 
 	ShardMapping() map[string]*config.Shard
+	// Warmup opens connections to all hosts of shard mapping up to min pool size
+	Warmup()
 }
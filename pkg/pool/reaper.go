@@ -0,0 +1,197 @@
+package pool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+)
+
+const (
+	reaperInterval = time.Second
+	// idle connection is checked, if it was not used or checked for this long
+	serverCheckDelay = 30 * time.Second
+)
+
+type connMeta struct {
+	created     time.Time
+	lastUsed    time.Time
+	lastChecked time.Time
+}
+
+// expired reports if connection exceeded server lifetime, h.mu must be held
+func (h *shardPool) expired(id uint, now time.Time) bool {
+	m, ok := h.meta[id]
+	if !ok || h.beRule.ServerLifetimeSec <= 0 {
+		return false
+	}
+	return now.Sub(m.created) >= time.Duration(h.beRule.ServerLifetimeSec)*time.Second
+}
+
+// runReaper maintains pool until done is closed
+func (h *shardPool) runReaper(done <-chan struct{}) {
+	ticker := time.NewTicker(reaperInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			h.reap(time.Now())
+			h.warmup()
+		}
+	}
+}
+
+// reap closes idle connections, which exceeded server lifetime or idle timeout,
+// and runs server check query on connections, which were idle for a while.
+// Idle timeout does not close connections below min pool size.
+func (h *shardPool) reap(now time.Time) {
+	h.mu.Lock()
+
+	idleTimeout := time.Duration(h.beRule.ServerIdleTimeoutSec) * time.Second
+	minPoolSize := h.beRule.MinPoolSize
+	checkQuery := h.beRule.ServerCheckQuery
+
+	total := len(h.pool) + len(h.active) + len(h.checking)
+
+	var closing, checking []shard.Shard
+	idle := make([]shard.Shard, 0, len(h.pool))
+
+	for _, sh := range h.pool {
+		m, ok := h.meta[sh.ID()]
+		if !ok {
+			m = &connMeta{created: now, lastUsed: now}
+			h.meta[sh.ID()] = m
+		}
+
+		lastSeen := m.lastUsed
+		if m.lastChecked.After(lastSeen) {
+			lastSeen = m.lastChecked
+		}
+
+		switch {
+		case h.expired(sh.ID(), now):
+			h.stats.ClosedByLifetime++
+		case idleTimeout > 0 && now.Sub(m.lastUsed) >= idleTimeout && total-len(closing) > minPoolSize:
+			h.stats.ClosedByIdleTimeout++
+		case checkQuery != "" && now.Sub(lastSeen) >= serverCheckDelay:
+			h.checking[sh.ID()] = sh
+			checking = append(checking, sh)
+			continue
+		default:
+			idle = append(idle, sh)
+			continue
+		}
+
+		delete(h.meta, sh.ID())
+		closing = append(closing, sh)
+	}
+	h.pool = idle
+
+	h.mu.Unlock()
+
+	/* do not hold mutex while closing and checking connections */
+	for _, sh := range closing {
+		spqrlog.Zero.Debug().
+			Uint("shard", sh.ID()).
+			Str("host", h.host).
+			Msg("reaper: closing expired connection")
+		_ = sh.Close()
+	}
+
+	for _, sh := range checking {
+		err := runCheckQuery(sh, checkQuery)
+
+		h.mu.Lock()
+		delete(h.checking, sh.ID())
+		_, invalidated := h.invalidated[sh.ID()]
+		delete(h.invalidated, sh.ID())
+
+		if err == nil && !invalidated {
+			h.meta[sh.ID()].lastChecked = now
+			h.pool = append(h.pool, sh)
+			h.mu.Unlock()
+			continue
+		}
+		if err != nil {
+			h.stats.FailedChecks++
+		}
+		delete(h.meta, sh.ID())
+		h.mu.Unlock()
+
+		if err != nil {
+			spqrlog.Zero.Warn().
+				Uint("shard", sh.ID()).
+				Str("host", h.host).
+				Err(err).
+				Msg("reaper: server check query failed, closing connection")
+		}
+		_ = sh.Close()
+	}
+}
+
+// warmup opens idle connections, until pool has min pool size connections
+func (h *shardPool) warmup() {
+	h.warming.Lock()
+	defer h.warming.Unlock()
+
+	for {
+		h.mu.Lock()
+		rule := h.beRule
+		shardKey := h.shardKey
+		total := len(h.pool) + len(h.active) + len(h.checking)
+		h.mu.Unlock()
+
+		if shardKey.Name == "" || total >= rule.MinPoolSize || total >= h.ConnectionLimit {
+			return
+		}
+
+		sh, err := h.alloc(shardKey, h.host, rule)
+		if err != nil {
+			spqrlog.Zero.Error().
+				Str("host", h.host).
+				Err(err).
+				Msg("failed to warm up connection pool")
+			return
+		}
+
+		now := time.Now()
+		h.mu.Lock()
+		h.meta[sh.ID()] = &connMeta{created: now, lastUsed: now}
+		h.pool = append(h.pool, sh)
+		h.stats.WarmedUp++
+		h.mu.Unlock()
+	}
+}
+
+// runCheckQuery runs query on idle connection, connection should be idle afterwards
+func runCheckQuery(sh shard.Shard, query string) error {
+	if err := sh.Send(&pgproto3.Query{
+		String: query,
+	}); err != nil {
+		return err
+	}
+
+	for {
+		msg, err := sh.Receive()
+		if err != nil {
+			return err
+		}
+
+		switch qt := msg.(type) {
+		case *pgproto3.ErrorResponse:
+			/* connection is closed on failure, no need to wait for ready for query */
+			return fmt.Errorf("server check query failed: %s", qt.Message)
+		case *pgproto3.ReadyForQuery:
+			if txstatus.TXStatus(qt.TxStatus) != txstatus.TXIDLE {
+				return fmt.Errorf("connection unsync after server check query")
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/pg-sharding/spqr/pkg/config"
+	mockinst "github.com/pg-sharding/spqr/pkg/mock/conn"
+	mockshard "github.com/pg-sharding/spqr/pkg/mock/shard"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockConn(ctrl *gomock.Controller, id uint) *mockshard.MockShard {
+	ins := mockinst.NewMockDBInstance(ctrl)
+	ins.EXPECT().Hostname().AnyTimes().Return("h1")
+
+	sh := mockshard.NewMockShard(ctrl)
+	sh.EXPECT().Instance().AnyTimes().Return(ins)
+	sh.EXPECT().ID().AnyTimes().Return(id)
+	sh.EXPECT().TxStatus().AnyTimes().Return(txstatus.TXIDLE)
+	return sh
+}
+
+func TestReapServerLifetime(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	conn := newMockConn(ctrl, 1)
+	conn.EXPECT().Close().Times(1)
+
+	shp := newShardPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		return conn, nil
+	}, "h1", &config.BackendRule{
+		ServerLifetimeSec: 60,
+	})
+
+	sh, err := shp.Connection(1, kr.ShardKey{Name: "sh1"})
+	assert.NoError(err)
+	assert.NoError(shp.Put(sh))
+
+	shp.reap(time.Now())
+	assert.Equal(1, shp.IdleConnectionCount())
+
+	shp.reap(time.Now().Add(time.Minute))
+	assert.Equal(0, shp.IdleConnectionCount())
+	assert.Equal(int64(1), shp.Stats().ClosedByLifetime)
+}
+
+func TestPutExpiredConnection(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	conn := newMockConn(ctrl, 1)
+	conn.EXPECT().Close().Times(1)
+
+	shp := newShardPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		return conn, nil
+	}, "h1", &config.BackendRule{
+		ServerLifetimeSec: 60,
+	})
+
+	sh, err := shp.Connection(1, kr.ShardKey{Name: "sh1"})
+	assert.NoError(err)
+
+	shp.meta[sh.ID()].created = time.Now().Add(-time.Hour)
+
+	assert.NoError(shp.Put(sh))
+	assert.Equal(0, shp.IdleConnectionCount())
+	assert.Equal(0, shp.UsedConnectionCount())
+	assert.Equal(int64(1), shp.Stats().ClosedByLifetime)
+}
+
+func TestReapIdleTimeoutKeepsMinPoolSize(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	conns := []*mockshard.MockShard{
+		newMockConn(ctrl, 1),
+		newMockConn(ctrl, 2),
+		newMockConn(ctrl, 3),
+	}
+	conns[0].EXPECT().Close().Times(1)
+	conns[1].EXPECT().Close().Times(1)
+
+	next := 0
+	shp := newShardPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		next++
+		return conns[next-1], nil
+	}, "h1", &config.BackendRule{
+		ServerIdleTimeoutSec: 10,
+		MinPoolSize:          1,
+	})
+	shp.shardKey = kr.ShardKey{Name: "sh1"}
+
+	for i := 0; i < 3; i++ {
+		_, err := shp.Connection(uint(i), kr.ShardKey{Name: "sh1"})
+		assert.NoError(err)
+	}
+	for _, c := range conns {
+		assert.NoError(shp.Put(c))
+	}
+
+	shp.reap(time.Now().Add(time.Minute))
+
+	assert.Equal(1, shp.IdleConnectionCount())
+	assert.Equal(int64(2), shp.Stats().ClosedByIdleTimeout)
+
+	/* pool already has min pool size connections */
+	shp.warmup()
+	assert.Equal(3, next)
+}
+
+func TestReapFailedCheck(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	conn := newMockConn(ctrl, 1)
+	conn.EXPECT().Send(&pgproto3.Query{String: "SELECT 1"}).Times(1)
+	conn.EXPECT().Receive().Return(&pgproto3.ErrorResponse{Message: "terminating connection"}, nil)
+	conn.EXPECT().Close().Times(1)
+
+	shp := newShardPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		return conn, nil
+	}, "h1", &config.BackendRule{
+		ServerCheckQuery: "SELECT 1",
+	})
+
+	sh, err := shp.Connection(1, kr.ShardKey{Name: "sh1"})
+	assert.NoError(err)
+	assert.NoError(shp.Put(sh))
+
+	/* recently used connections are not checked */
+	shp.reap(time.Now())
+	assert.Equal(1, shp.IdleConnectionCount())
+
+	shp.reap(time.Now().Add(time.Minute))
+	assert.Equal(0, shp.IdleConnectionCount())
+	assert.Equal(int64(1), shp.Stats().FailedChecks)
+}
+
+func TestWarmup(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	var id uint
+	shp := newShardPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		assert.Equal("sh1", shardKey.Name)
+		id++
+		return newMockConn(ctrl, id), nil
+	}, "h1", &config.BackendRule{
+		MinPoolSize:     5,
+		ConnectionLimit: 3,
+	})
+
+	/* shard of the host is unknown */
+	shp.warmup()
+	assert.Equal(0, shp.IdleConnectionCount())
+
+	shp.shardKey = kr.ShardKey{Name: "sh1"}
+	shp.warmup()
+
+	/* capped by connection limit */
+	assert.Equal(3, shp.IdleConnectionCount())
+	assert.Equal(int64(3), shp.Stats().WarmedUp)
+}
+
+func TestRunReaperStops(t *testing.T) {
+	assert := assert.New(t)
+
+	shp := newShardPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		return nil, nil
+	}, "h1", &config.BackendRule{})
+
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		shp.runReaper(done)
+		close(exited)
+	}()
+
+	close(done)
+	assert.Eventually(func() bool {
+		select {
+		case <-exited:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestCPoolStop(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	conn := newMockConn(ctrl, 1)
+	conn.EXPECT().Close().Times(1)
+
+	c := NewPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		return conn, nil
+	}).(*cPool)
+	assert.NoError(c.InitRule(&config.BackendRule{}))
+
+	sh, err := c.Connection(1, kr.ShardKey{Name: "sh1"}, "h1")
+	assert.NoError(err)
+	assert.NoError(c.Put(sh))
+
+	c.Stop()
+	// repeated stop is harmless
+	c.Stop()
+
+	select {
+	case <-c.done:
+	default:
+		assert.Fail("pool reapers are not stopped")
+	}
+	assert.NoError(c.ForEachPool(func(p Pool) error {
+		assert.Equal(0, p.IdleConnectionCount())
+		return nil
+	}))
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/pg-sharding/spqr/pkg/config"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 	"github.com/pg-sharding/spqr/pkg/shard"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
 	"github.com/pg-sharding/spqr/pkg/txstatus"
@@ -20,8 +21,31 @@ type shardPool struct {
 	pool []shard.Shard
 
 	queue chan struct{}
+	// tokens of reserved capacity, available to clients waiting longer than reserve timeout.
+	// Reserved capacity is a part of connection limit, taken from the queue.
+	reserve        chan struct{}
+	reserveSize    int
+	reserveTimeout time.Duration
+	// number of tokens to move from queue to reserve (or back, if negative),
+	// when they are released, used when reserve is resized while tokens are in use
+	reserveDebt int
+	// connections, acquired with reserve tokens
+	reserved map[uint]struct{}
 
 	active map[uint]shard.Shard
+	// used connections, which should not be reused
+	invalidated map[uint]struct{}
+	// idle connections, taken out of pool by reaper to run check query
+	checking map[uint]shard.Shard
+	meta     map[uint]*connMeta
+
+	// shard of the host, used to open connections on warmup
+	shardKey kr.ShardKey
+	warming  sync.Mutex
+
+	stats     PoolStats
+	waits     int64
+	waitTotal time.Duration
 
 	alloc ConnectionAllocFn
 
@@ -38,6 +62,10 @@ type shardPool struct {
 var _ Pool = &shardPool{}
 
 func NewShardPool(allocFn ConnectionAllocFn, host string, beRule *config.BackendRule) Pool {
+	return newShardPool(allocFn, host, beRule)
+}
+
+func newShardPool(allocFn ConnectionAllocFn, host string, beRule *config.BackendRule) *shardPool {
 	connLimit := defaultInstanceConnectionLimit
 	connRetries := defaultInstanceConnectionRetries
 	if beRule.ConnectionLimit != 0 {
@@ -52,6 +80,10 @@ func NewShardPool(allocFn ConnectionAllocFn, host string, beRule *config.Backend
 		mu:                         sync.Mutex{},
 		pool:                       nil,
 		active:                     make(map[uint]shard.Shard),
+		invalidated:                make(map[uint]struct{}),
+		checking:                   make(map[uint]shard.Shard),
+		meta:                       make(map[uint]*connMeta),
+		reserved:                   make(map[uint]struct{}),
 		alloc:                      allocFn,
 		beRule:                     beRule,
 		host:                       host,
@@ -65,6 +97,7 @@ func NewShardPool(allocFn ConnectionAllocFn, host string, beRule *config.Backend
 	for tok := 0; tok < connLimit; tok++ {
 		ret.queue <- struct{}{}
 	}
+	ret.reserve = make(chan struct{}, connLimit)
 
 	spqrlog.Zero.Debug().
 		Uint("pool", spqrlog.GetPointer(ret)).
@@ -83,9 +116,71 @@ func (h *shardPool) RouterName() string {
 }
 
 func (h *shardPool) Rule() *config.BackendRule {
+	h.mu.Lock()
+	defer h.mu.Unlock()
 	return h.beRule
 }
 
+func (h *shardPool) setRule(rule *config.BackendRule) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.beRule = rule
+}
+
+// setReserve sets reserved capacity of the pool. At least one connection
+// is always left to clients, which do not use reserve.
+func (h *shardPool) setReserve(size int, timeout time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if size < 0 {
+		size = 0
+	}
+	if size > h.ConnectionLimit-1 {
+		spqrlog.Zero.Warn().
+			Str("host", h.host).
+			Int("reserve pool size", size).
+			Int("connection limit", h.ConnectionLimit).
+			Msg("reserve pool size is capped by connection limit")
+		size = h.ConnectionLimit - 1
+	}
+
+	h.reserveDebt += size - h.reserveSize
+	h.reserveSize = size
+	h.reserveTimeout = timeout
+
+	/* move free tokens now, tokens in use are moved when released */
+	for ; h.reserveDebt > 0; h.reserveDebt-- {
+		select {
+		case <-h.queue:
+			h.reserve <- struct{}{}
+			continue
+		default:
+		}
+		break
+	}
+	for ; h.reserveDebt < 0; h.reserveDebt++ {
+		select {
+		case <-h.reserve:
+			h.queue <- struct{}{}
+			continue
+		default:
+		}
+		break
+	}
+}
+
+func (h *shardPool) Stats() PoolStats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	stats := h.stats
+	if h.waits > 0 {
+		stats.AvgWait = h.waitTotal / time.Duration(h.waits)
+	}
+	return stats
+}
+
 func (h *shardPool) Cut(host string) []shard.Shard {
 	h.mu.Lock()
 	defer h.mu.Unlock()
@@ -114,13 +209,56 @@ func (s *shardPool) QueueResidualSize() int {
 	return len(s.queue)
 }
 
-// TODO : unit tests
-func (h *shardPool) Connection(
-	clid uint,
-	shardKey kr.ShardKey) (shard.Shard, error) {
+// acquireToken takes connection token of the pool, waiting for it if pool is full.
+// Reports if token of reserved capacity was taken.
+func (h *shardPool) acquireToken(clid uint, timeout time.Duration) (bool, error) {
+	select {
+	case <-h.queue:
+		return false, nil
+	default:
+	}
+
+	start := time.Now()
 
-	if err := func() error {
-		for rep := 0; rep < h.ConnectionRetries; rep++ {
+	h.mu.Lock()
+	h.stats.WaitingClients++
+	h.mu.Unlock()
+
+	fromReserve, err := h.waitToken(clid, timeout)
+	wait := time.Since(start)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.stats.WaitingClients--
+	if err != nil {
+		h.stats.WaitTimeouts++
+		return false, err
+	}
+	h.waits++
+	h.waitTotal += wait
+	if wait > h.stats.MaxWait {
+		h.stats.MaxWait = wait
+	}
+	return fromReserve, nil
+}
+
+// waitToken waits for connection token. With query wait timeout clients block on the
+// token channel for the whole wait, so they are served in order of arrival.
+// Without it connection retries of the backend rule are used.
+func (h *shardPool) waitToken(clid uint, timeout time.Duration) (bool, error) {
+	h.mu.Lock()
+	var reserveTimer <-chan time.Time
+	if h.reserveSize > 0 {
+		reserveTimer = time.After(h.reserveTimeout)
+	}
+	h.mu.Unlock()
+	/* nil channel blocks forever, until reserve timeout passes */
+	var reserve chan struct{}
+
+	if timeout == 0 {
+		/* only sleeps count as retries */
+		for rep := 0; rep < h.ConnectionRetries; {
 			select {
 			// TODO: configure waits using backend rule
 			case <-time.After(time.Duration(h.ConnectionRetrySleepSlice) * time.Millisecond * time.Duration(1+rand.Int31()%int32(h.ConnectionRetryRandomSleep))):
@@ -128,26 +266,132 @@ func (h *shardPool) Connection(
 					Uint("client", clid).
 					Str("host", h.host).
 					Msg("still waiting for backend connection to host")
+				rep++
+			case <-reserveTimer:
+				if h.queueToken() {
+					return false, nil
+				}
+				reserveTimer, reserve = nil, h.reserve
 			case <-h.queue:
-				return nil
+				return false, nil
+			case <-reserve:
+				return h.reserveToken(), nil
 			}
 		}
 
-		return fmt.Errorf("failed to get connection to host %s due to too much concurrent connections", h.host)
-	}(); err != nil {
+		return false, fmt.Errorf("failed to get connection to host %s due to too much concurrent connections", h.host)
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	spqrlog.Zero.Debug().
+		Uint("client", clid).
+		Str("host", h.host).
+		Msg("waiting for backend connection to host")
+
+	for {
+		select {
+		case <-reserveTimer:
+			if h.queueToken() {
+				return false, nil
+			}
+			reserveTimer, reserve = nil, h.reserve
+		case <-h.queue:
+			return false, nil
+		case <-reserve:
+			if !h.reserveToken() {
+				return false, nil
+			}
+			spqrlog.Zero.Info().
+				Uint("client", clid).
+				Str("host", h.host).
+				Msg("using reserved connection to host")
+			return true, nil
+		case <-deadline.C:
+			return false, spqrerror.Newf(spqrerror.SPQR_QUERY_WAIT_TIMEOUT,
+				"query_wait_timeout: no connection to host %s became available within %s", h.host, timeout)
+		}
+	}
+}
+
+// queueToken takes free token of the queue without waiting. Select picks
+// any of ready channels, so queue is checked before reserve is used.
+func (h *shardPool) queueToken() bool {
+	select {
+	case <-h.queue:
+		return true
+	default:
+		return false
+	}
+}
+
+// reserveToken is called with token of reserve taken. If the queue has free token
+// too, the queue token is used and the reserve token is returned. Reports if
+// reserve token is kept.
+func (h *shardPool) reserveToken() bool {
+	if !h.queueToken() {
+		return true
+	}
+	h.mu.Lock()
+	h.returnToken(true)
+	h.mu.Unlock()
+	return false
+}
+
+// releaseToken returns token of connection to the queue it was taken from, h.mu must be held
+func (h *shardPool) releaseToken(id uint) {
+	_, fromReserve := h.reserved[id]
+	delete(h.reserved, id)
+	h.returnToken(fromReserve)
+}
+
+// returnToken returns token to the queue it was taken from, unless
+// reserve was resized and token should be moved, h.mu must be held
+func (h *shardPool) returnToken(fromReserve bool) {
+	switch {
+	case fromReserve && h.reserveDebt < 0:
+		h.reserveDebt++
+		h.queue <- struct{}{}
+	case fromReserve:
+		h.reserve <- struct{}{}
+	case h.reserveDebt > 0:
+		h.reserveDebt--
+		h.reserve <- struct{}{}
+	default:
+		h.queue <- struct{}{}
+	}
+}
+
+// TODO : unit tests
+func (h *shardPool) Connection(
+	clid uint,
+	shardKey kr.ShardKey) (shard.Shard, error) {
+
+	h.mu.Lock()
+	timeout := time.Duration(h.beRule.QueryWaitTimeoutSec) * time.Second
+	h.mu.Unlock()
+
+	fromReserve, err := h.acquireToken(clid, timeout)
+	if err != nil {
 		return nil, err
 	}
 
 	var sh shard.Shard
+	var rule *config.BackendRule
 
 	/* reuse cached connection, if any */
 	{
 		/* TDB: per-bucket lock */
 		h.mu.Lock()
+		rule = h.beRule
 
 		if len(h.pool) > 0 {
 			sh, h.pool = h.pool[0], h.pool[1:]
 			h.active[sh.ID()] = sh
+			if fromReserve {
+				h.reserved[sh.ID()] = struct{}{}
+			}
 			h.mu.Unlock()
 			spqrlog.Zero.Debug().
 				Uint("client", clid).
@@ -161,18 +405,24 @@ func (h *shardPool) Connection(
 	}
 
 	// do not hold lock on poolRW while allocate new connection
-	var err error
-	sh, err = h.alloc(shardKey, h.host, h.beRule)
+	sh, err = h.alloc(shardKey, h.host, rule)
 	if err != nil {
 		// return acquired token
-		h.queue <- struct{}{}
+		h.mu.Lock()
+		h.returnToken(fromReserve)
+		h.mu.Unlock()
 		return nil, err
 	}
 
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	now := time.Now()
 	h.active[sh.ID()] = sh
+	h.meta[sh.ID()] = &connMeta{created: now, lastUsed: now}
+	if fromReserve {
+		h.reserved[sh.ID()] = struct{}{}
+	}
 
 	return sh, nil
 }
@@ -196,9 +446,11 @@ func (h *shardPool) Discard(sh shard.Shard) error {
 	}
 
 	/* acquired tok, release it */
-	h.queue <- struct{}{}
+	h.releaseToken(sh.ID())
 
 	delete(h.active, sh.ID())
+	delete(h.invalidated, sh.ID())
+	delete(h.meta, sh.ID())
 
 	return err
 }
@@ -214,7 +466,18 @@ func (h *shardPool) Put(sh shard.Shard) error {
 		return h.Discard(sh)
 	}
 
+	now := time.Now()
+
 	h.mu.Lock()
+	_, invalidated := h.invalidated[sh.ID()]
+	expired := h.expired(sh.ID(), now)
+	if expired {
+		h.stats.ClosedByLifetime++
+	}
+	if invalidated || expired {
+		h.mu.Unlock()
+		return h.Discard(sh)
+	}
 	defer h.mu.Unlock()
 
 	if _, ok := h.active[sh.ID()]; !ok {
@@ -223,14 +486,43 @@ func (h *shardPool) Put(sh shard.Shard) error {
 	}
 
 	/* acquired tok, release it */
-	h.queue <- struct{}{}
+	h.releaseToken(sh.ID())
 
 	delete(h.active, sh.ID())
+	if m, ok := h.meta[sh.ID()]; ok {
+		m.lastUsed = now
+	}
 
 	h.pool = append(h.pool, sh)
 	return nil
 }
 
+func (h *shardPool) Invalidate() {
+	h.mu.Lock()
+	idle := h.pool
+	h.pool = nil
+	for _, sh := range idle {
+		delete(h.meta, sh.ID())
+	}
+	for id := range h.active {
+		h.invalidated[id] = struct{}{}
+	}
+	for id := range h.checking {
+		h.invalidated[id] = struct{}{}
+	}
+	h.mu.Unlock()
+
+	spqrlog.Zero.Info().
+		Str("host", h.host).
+		Int("idle", len(idle)).
+		Msg("invalidating connections to host")
+
+	/* do not hold mutex while closing connections */
+	for _, sh := range idle {
+		_ = sh.Close()
+	}
+}
+
 // TODO : unit tests
 func (h *shardPool) ForEach(cb func(sh shard.Shardinfo) error) error {
 	h.mu.Lock()
@@ -269,12 +561,18 @@ type cPool struct {
 	alloc ConnectionAllocFn
 
 	beRule *config.BackendRule
+	frRule *config.FrontendRule
+
+	// done is closed, when pool is stopped
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 func NewPool(allocFn ConnectionAllocFn) MultiShardPool {
 	return &cPool{
 		pools: sync.Map{},
 		alloc: allocFn,
+		done:  make(chan struct{}),
 	}
 }
 
@@ -309,16 +607,45 @@ func (c *cPool) List() []shard.Shard {
 	return ret
 }
 
+// getPool returns pool of the host, creating it and starting its reaper, if needed
 // TODO : unit tests
-func (c *cPool) Connection(clid uint, shardKey kr.ShardKey, host string) (shard.Shard, error) {
-	var pool Pool
-	if val, ok := c.pools.Load(host); !ok {
-		pool = NewShardPool(c.alloc, host, c.beRule)
-		c.pools.Store(host, pool)
-	} else {
-		pool = val.(Pool)
+func (c *cPool) getPool(shardKey kr.ShardKey, host string) *shardPool {
+	if val, ok := c.pools.Load(host); ok {
+		return val.(*shardPool)
+	}
+	pool := newShardPool(c.alloc, host, c.beRule)
+	pool.shardKey = shardKey
+	if c.frRule != nil {
+		pool.setReserve(c.frRule.ReservePoolSize, time.Duration(c.frRule.ReservePoolTimeoutSec)*time.Second)
 	}
-	return pool.Connection(clid, shardKey)
+	if val, loaded := c.pools.LoadOrStore(host, pool); loaded {
+		return val.(*shardPool)
+	}
+	/* reaper runs until the pool is stopped */
+	go pool.runReaper(c.done)
+	return pool
+}
+
+// Stop stops reapers of host pools and closes idle connections,
+// used connections are closed when they are put back
+func (c *cPool) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.done)
+	})
+	c.pools.Range(func(key, value any) bool {
+		value.(Pool).Invalidate()
+		return true
+	})
+}
+
+// TODO : unit tests
+func (c *cPool) Connection(clid uint, shardKey kr.ShardKey, host string) (shard.Shard, error) {
+	return c.getPool(shardKey, host).Connection(clid, shardKey)
+}
+
+// TODO : unit tests
+func (c *cPool) WarmupHost(shardKey kr.ShardKey, host string) {
+	c.getPool(shardKey, host).warmup()
 }
 
 // TODO : unit tests
@@ -327,6 +654,12 @@ func (c *cPool) Cut(host string) []shard.Shard {
 	return rt.([]shard.Shard)
 }
 
+func (c *cPool) InvalidateHost(host string) {
+	if val, ok := c.pools.Load(host); ok {
+		val.(Pool).Invalidate()
+	}
+}
+
 // TODO : unit tests
 func (c *cPool) Put(host shard.Shard) error {
 	if val, ok := c.pools.Load(host.Instance().Hostname()); ok {
@@ -347,9 +680,27 @@ func (c *cPool) Discard(sh shard.Shard) error {
 	}
 }
 
+// InitReserve sets frontend rule, reserved capacity of which is used by pools of hosts
+func (c *cPool) InitReserve(rule *config.FrontendRule) {
+	c.frRule = rule
+	if rule == nil {
+		return
+	}
+	/* existing pools are resized, e.g. after config reload */
+	c.pools.Range(func(key, value any) bool {
+		value.(*shardPool).setReserve(rule.ReservePoolSize, time.Duration(rule.ReservePoolTimeoutSec)*time.Second)
+		return true
+	})
+}
+
 // TODO : unit tests
 func (c *cPool) InitRule(rule *config.BackendRule) error {
 	c.beRule = rule
+	/* existing pools pick up new rule, e.g. after config reload */
+	c.pools.Range(func(key, value any) bool {
+		value.(*shardPool).setRule(rule)
+		return true
+	})
 	return nil
 }
 
@@ -58,6 +58,52 @@ func TestShardPoolConnectionAcquirePut(t *testing.T) {
 	assert.Equal(1, shp.IdleConnectionCount())
 }
 
+func TestShardPoolInvalidate(t *testing.T) {
+
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	ins := mockinst.NewMockDBInstance(ctrl)
+	ins.EXPECT().Hostname().AnyTimes().Return("h1")
+
+	idleconn := mockshard.NewMockShard(ctrl)
+	idleconn.EXPECT().Instance().AnyTimes().Return(ins)
+	idleconn.EXPECT().ID().AnyTimes().Return(uint(1))
+	idleconn.EXPECT().TxStatus().AnyTimes().Return(txstatus.TXIDLE)
+	idleconn.EXPECT().Close().Times(1)
+
+	usedconn := mockshard.NewMockShard(ctrl)
+	usedconn.EXPECT().Instance().AnyTimes().Return(ins)
+	usedconn.EXPECT().ID().AnyTimes().Return(uint(2))
+	usedconn.EXPECT().TxStatus().AnyTimes().Return(txstatus.TXIDLE)
+	usedconn.EXPECT().Close().Times(1)
+
+	conns := []shard.Shard{idleconn, usedconn}
+	shp := pool.NewShardPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		var conn shard.Shard
+		conn, conns = conns[0], conns[1:]
+		return conn, nil
+	}, "h1", &config.BackendRule{
+		ConnectionLimit: 2,
+	})
+
+	conn1, err := shp.Connection(10, kr.ShardKey{Name: "sh1"})
+	assert.NoError(err)
+	conn2, err := shp.Connection(20, kr.ShardKey{Name: "sh1"})
+	assert.NoError(err)
+	assert.NoError(shp.Put(conn1))
+	assert.Equal(1, shp.IdleConnectionCount())
+
+	shp.Invalidate()
+
+	// idle connection is closed, used one is closed when put back
+	assert.Equal(0, shp.IdleConnectionCount())
+	assert.NoError(shp.Put(conn2))
+	assert.Equal(0, shp.IdleConnectionCount())
+	assert.Equal(0, shp.UsedConnectionCount())
+	assert.Equal(2, shp.QueueResidualSize())
+}
+
 func TestShardPoolConnectionAcquireDiscard(t *testing.T) {
 
 	assert := assert.New(t)
@@ -0,0 +1,218 @@
+package pool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcquireTokenWaitTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	shp := newShardPool(nil, "h1", &config.BackendRule{
+		ConnectionLimit: 1,
+	})
+
+	_, err := shp.acquireToken(1, 10*time.Millisecond)
+	assert.NoError(err)
+
+	_, err = shp.acquireToken(2, 10*time.Millisecond)
+	var spqrErr *spqrerror.SpqrError
+	assert.True(errors.As(err, &spqrErr))
+	assert.Equal(spqrerror.SPQR_QUERY_WAIT_TIMEOUT, spqrErr.ErrorCode)
+
+	stats := shp.Stats()
+	assert.Equal(int64(1), stats.WaitTimeouts)
+	assert.Equal(int64(0), stats.WaitingClients)
+}
+
+func TestAcquireTokenFIFO(t *testing.T) {
+	assert := assert.New(t)
+
+	shp := newShardPool(nil, "h1", &config.BackendRule{
+		ConnectionLimit: 1,
+	})
+
+	_, err := shp.acquireToken(1, time.Second)
+	assert.NoError(err)
+
+	order := make(chan uint, 2)
+	for _, clid := range []uint{2, 3} {
+		go func(clid uint) {
+			if _, err := shp.acquireToken(clid, time.Second); err == nil {
+				order <- clid
+			}
+		}(clid)
+		/* let client enqueue before the next one */
+		assert.Eventually(func() bool {
+			return shp.Stats().WaitingClients == int64(clid-1)
+		}, time.Second, time.Millisecond)
+	}
+
+	shp.queue <- struct{}{}
+	assert.Equal(uint(2), <-order)
+	shp.queue <- struct{}{}
+	assert.Equal(uint(3), <-order)
+
+	stats := shp.Stats()
+	assert.Equal(int64(0), stats.WaitingClients)
+	assert.Greater(stats.MaxWait, time.Duration(0))
+	assert.Greater(stats.AvgWait, time.Duration(0))
+}
+
+func TestConnectionFromReserve(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	conns := []shard.Shard{newMockConn(ctrl, 1), newMockConn(ctrl, 2)}
+	next := 0
+	shp := newShardPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		next++
+		return conns[next-1], nil
+	}, "h1", &config.BackendRule{
+		ConnectionLimit:     2,
+		QueryWaitTimeoutSec: 1,
+	})
+	shp.setReserve(1, 0)
+	assert.Equal(1, len(shp.queue))
+	assert.Equal(1, len(shp.reserve))
+
+	sh1, err := shp.Connection(1, kr.ShardKey{Name: "sh1"})
+	assert.NoError(err)
+
+	/* pool is full, reserve is used */
+	sh2, err := shp.Connection(2, kr.ShardKey{Name: "sh1"})
+	assert.NoError(err)
+	assert.Equal(0, len(shp.queue))
+	assert.Equal(0, len(shp.reserve))
+
+	assert.NoError(shp.Put(sh2))
+	assert.Equal(1, len(shp.reserve))
+	assert.Equal(0, len(shp.queue))
+
+	assert.NoError(shp.Put(sh1))
+	assert.Equal(1, len(shp.queue))
+}
+
+func TestSetReserveCapped(t *testing.T) {
+	assert := assert.New(t)
+
+	shp := newShardPool(nil, "h1", &config.BackendRule{
+		ConnectionLimit: 3,
+	})
+
+	/* reserve is a part of connection limit */
+	shp.setReserve(5, 0)
+	assert.Equal(1, len(shp.queue))
+	assert.Equal(2, len(shp.reserve))
+
+	shp.setReserve(0, 0)
+	assert.Equal(3, len(shp.queue))
+	assert.Equal(0, len(shp.reserve))
+}
+
+func TestSetReserveTokensInUse(t *testing.T) {
+	assert := assert.New(t)
+
+	shp := newShardPool(nil, "h1", &config.BackendRule{
+		ConnectionLimit: 3,
+	})
+
+	for clid := uint(0); clid < 3; clid++ {
+		_, err := shp.acquireToken(clid, time.Second)
+		assert.NoError(err)
+	}
+
+	/* no free tokens, they are moved to reserve when released */
+	shp.setReserve(2, 0)
+	assert.Equal(0, len(shp.reserve))
+
+	shp.mu.Lock()
+	for tok := 0; tok < 3; tok++ {
+		shp.returnToken(false)
+	}
+	shp.mu.Unlock()
+	assert.Equal(1, len(shp.queue))
+	assert.Equal(2, len(shp.reserve))
+
+	/* shrink reserve, while one of its tokens is in use */
+	fromReserve, err := shp.waitToken(4, time.Second)
+	assert.NoError(err)
+	assert.False(fromReserve)
+	fromReserve, err = shp.waitToken(5, time.Second)
+	assert.NoError(err)
+	assert.True(fromReserve)
+
+	shp.setReserve(0, 0)
+	assert.Equal(1, len(shp.queue))
+	assert.Equal(0, len(shp.reserve))
+
+	shp.mu.Lock()
+	shp.returnToken(true)
+	shp.returnToken(false)
+	shp.mu.Unlock()
+	assert.Equal(3, len(shp.queue))
+	assert.Equal(0, len(shp.reserve))
+}
+
+func TestWaitTokenReserveKeepsRetries(t *testing.T) {
+	assert := assert.New(t)
+
+	shp := newShardPool(nil, "h1", &config.BackendRule{
+		ConnectionLimit:   2,
+		ConnectionRetries: 1,
+	})
+	shp.ConnectionRetrySleepSlice = 200
+	shp.ConnectionRetryRandomSleep = 1
+	shp.setReserve(1, 0)
+
+	_, err := shp.acquireToken(1, 0)
+	assert.NoError(err)
+
+	/* reserve timeout does not use up the only retry */
+	fromReserve, err := shp.acquireToken(2, 0)
+	assert.NoError(err)
+	assert.True(fromReserve)
+}
+
+func TestInitReserveResizesPools(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	conn := newMockConn(ctrl, 1)
+	conn.EXPECT().Close().Times(1)
+	c := NewPool(func(shardKey kr.ShardKey, host string, rule *config.BackendRule) (shard.Shard, error) {
+		return conn, nil
+	}).(*cPool)
+	defer c.Stop()
+	assert.NoError(c.InitRule(&config.BackendRule{
+		ConnectionLimit: 3,
+	}))
+	c.InitReserve(&config.FrontendRule{ReservePoolSize: 1})
+
+	sh, err := c.Connection(1, kr.ShardKey{Name: "sh1"}, "h1")
+	assert.NoError(err)
+
+	val, ok := c.pools.Load("h1")
+	assert.True(ok)
+	shp := val.(*shardPool)
+	assert.Equal(1, len(shp.queue))
+	assert.Equal(1, len(shp.reserve))
+
+	/* config reload */
+	c.InitReserve(&config.FrontendRule{ReservePoolSize: 2})
+	assert.Equal(0, len(shp.queue))
+	assert.Equal(2, len(shp.reserve))
+
+	/* used connection returns token to the queue */
+	assert.NoError(c.Put(sh))
+	assert.Equal(1, len(shp.queue))
+	assert.Equal(2, len(shp.reserve))
+}
@@ -118,6 +118,8 @@ type BackendConnectionsInfo struct {
 	Sync                int64  `protobuf:"varint,6,opt,name=sync,proto3" json:"sync,omitempty"`
 	TxServed            int64  `protobuf:"varint,7,opt,name=tx_served,json=txServed,proto3" json:"tx_served,omitempty"`
 	TxStatus            int64  `protobuf:"varint,8,opt,name=tx_status,json=txStatus,proto3" json:"tx_status,omitempty"`
+	PrepStmtCacheHits   int64  `protobuf:"varint,9,opt,name=prep_stmt_cache_hits,json=prepStmtCacheHits,proto3" json:"prep_stmt_cache_hits,omitempty"`
+	PrepStmtCacheMisses int64  `protobuf:"varint,10,opt,name=prep_stmt_cache_misses,json=prepStmtCacheMisses,proto3" json:"prep_stmt_cache_misses,omitempty"`
 }
 
 func (x *BackendConnectionsInfo) Reset() {
@@ -208,6 +210,20 @@ func (x *BackendConnectionsInfo) GetTxStatus() int64 {
 	return 0
 }
 
+func (x *BackendConnectionsInfo) GetPrepStmtCacheHits() int64 {
+	if x != nil {
+		return x.PrepStmtCacheHits
+	}
+	return 0
+}
+
+func (x *BackendConnectionsInfo) GetPrepStmtCacheMisses() int64 {
+	if x != nil {
+		return x.PrepStmtCacheMisses
+	}
+	return 0
+}
+
 var File_protos_backend_connections_proto protoreflect.FileDescriptor
 
 var file_protos_backend_connections_proto_rawDesc = []byte{
@@ -220,7 +236,7 @@ var file_protos_backend_connections_proto_rawDesc = []byte{
 	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x42, 0x61, 0x63,
 	0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x22, 0x88, 0x02, 0x0a, 0x16, 0x42,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x6e, 0x73, 0x22, 0xee, 0x02, 0x0a, 0x16, 0x42,
 	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
 	0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
@@ -237,16 +253,22 @@ var file_protos_backend_connections_proto_rawDesc = []byte{
 	0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
 	0x74, 0x78, 0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x73,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x78, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x7d, 0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x60, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x73,
-	0x70, 0x71, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x73, 0x70, 0x71, 0x72, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x70, 0x5f, 0x73, 0x74,
+	0x6d, 0x74, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x68, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x65, 0x70, 0x53, 0x74, 0x6d, 0x74, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x48, 0x69, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x70, 0x5f, 0x73,
+	0x74, 0x6d, 0x74, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x70, 0x72, 0x65, 0x70, 0x53, 0x74, 0x6d, 0x74,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x32, 0x7d, 0x0a, 0x19, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x73, 0x70,
+	0x71, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
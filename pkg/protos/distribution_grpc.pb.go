@@ -26,6 +26,7 @@ const (
 	DistributionService_AlterDistributionDetach_FullMethodName = "/spqr.DistributionService/AlterDistributionDetach"
 	DistributionService_GetDistribution_FullMethodName         = "/spqr.DistributionService/GetDistribution"
 	DistributionService_GetRelationDistribution_FullMethodName = "/spqr.DistributionService/GetRelationDistribution"
+	DistributionService_CheckReferenceRelation_FullMethodName  = "/spqr.DistributionService/CheckReferenceRelation"
 )
 
 // DistributionServiceClient is the client API for DistributionService service.
@@ -39,6 +40,7 @@ type DistributionServiceClient interface {
 	AlterDistributionDetach(ctx context.Context, in *AlterDistributionDetachRequest, opts ...grpc.CallOption) (*AlterDistributionDetachReply, error)
 	GetDistribution(ctx context.Context, in *GetDistributionRequest, opts ...grpc.CallOption) (*GetDistributionReply, error)
 	GetRelationDistribution(ctx context.Context, in *GetRelationDistributionRequest, opts ...grpc.CallOption) (*GetRelationDistributionReply, error)
+	CheckReferenceRelation(ctx context.Context, in *CheckReferenceRelationRequest, opts ...grpc.CallOption) (*CheckReferenceRelationReply, error)
 }
 
 type distributionServiceClient struct {
@@ -112,6 +114,15 @@ func (c *distributionServiceClient) GetRelationDistribution(ctx context.Context,
 	return out, nil
 }
 
+func (c *distributionServiceClient) CheckReferenceRelation(ctx context.Context, in *CheckReferenceRelationRequest, opts ...grpc.CallOption) (*CheckReferenceRelationReply, error) {
+	out := new(CheckReferenceRelationReply)
+	err := c.cc.Invoke(ctx, DistributionService_CheckReferenceRelation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DistributionServiceServer is the server API for DistributionService service.
 // All implementations must embed UnimplementedDistributionServiceServer
 // for forward compatibility
@@ -123,6 +134,7 @@ type DistributionServiceServer interface {
 	AlterDistributionDetach(context.Context, *AlterDistributionDetachRequest) (*AlterDistributionDetachReply, error)
 	GetDistribution(context.Context, *GetDistributionRequest) (*GetDistributionReply, error)
 	GetRelationDistribution(context.Context, *GetRelationDistributionRequest) (*GetRelationDistributionReply, error)
+	CheckReferenceRelation(context.Context, *CheckReferenceRelationRequest) (*CheckReferenceRelationReply, error)
 	mustEmbedUnimplementedDistributionServiceServer()
 }
 
@@ -151,6 +163,9 @@ func (UnimplementedDistributionServiceServer) GetDistribution(context.Context, *
 func (UnimplementedDistributionServiceServer) GetRelationDistribution(context.Context, *GetRelationDistributionRequest) (*GetRelationDistributionReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRelationDistribution not implemented")
 }
+func (UnimplementedDistributionServiceServer) CheckReferenceRelation(context.Context, *CheckReferenceRelationRequest) (*CheckReferenceRelationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckReferenceRelation not implemented")
+}
 func (UnimplementedDistributionServiceServer) mustEmbedUnimplementedDistributionServiceServer() {}
 
 // UnsafeDistributionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -290,6 +305,24 @@ func _DistributionService_GetRelationDistribution_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DistributionService_CheckReferenceRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckReferenceRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DistributionServiceServer).CheckReferenceRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DistributionService_CheckReferenceRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DistributionServiceServer).CheckReferenceRelation(ctx, req.(*CheckReferenceRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DistributionService_ServiceDesc is the grpc.ServiceDesc for DistributionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -325,6 +358,10 @@ var DistributionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRelationDistribution",
 			Handler:    _DistributionService_GetRelationDistribution_Handler,
 		},
+		{
+			MethodName: "CheckReferenceRelation",
+			Handler:    _DistributionService_CheckReferenceRelation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/distribution.proto",
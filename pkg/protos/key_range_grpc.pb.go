@@ -31,6 +31,7 @@ const (
 	KeyRangeService_MergeKeyRange_FullMethodName    = "/spqr.KeyRangeService/MergeKeyRange"
 	KeyRangeService_MoveKeyRange_FullMethodName     = "/spqr.KeyRangeService/MoveKeyRange"
 	KeyRangeService_ResolveKeyRange_FullMethodName  = "/spqr.KeyRangeService/ResolveKeyRange"
+	KeyRangeService_GetKeyRangeStats_FullMethodName = "/spqr.KeyRangeService/GetKeyRangeStats"
 )
 
 // KeyRangeServiceClient is the client API for KeyRangeService service.
@@ -49,6 +50,7 @@ type KeyRangeServiceClient interface {
 	MergeKeyRange(ctx context.Context, in *MergeKeyRangeRequest, opts ...grpc.CallOption) (*ModifyReply, error)
 	MoveKeyRange(ctx context.Context, in *MoveKeyRangeRequest, opts ...grpc.CallOption) (*ModifyReply, error)
 	ResolveKeyRange(ctx context.Context, in *ResolveKeyRangeRequest, opts ...grpc.CallOption) (*ResolveKeyRangeReply, error)
+	GetKeyRangeStats(ctx context.Context, in *GetKeyRangeStatsRequest, opts ...grpc.CallOption) (*GetKeyRangeStatsReply, error)
 }
 
 type keyRangeServiceClient struct {
@@ -167,6 +169,15 @@ func (c *keyRangeServiceClient) ResolveKeyRange(ctx context.Context, in *Resolve
 	return out, nil
 }
 
+func (c *keyRangeServiceClient) GetKeyRangeStats(ctx context.Context, in *GetKeyRangeStatsRequest, opts ...grpc.CallOption) (*GetKeyRangeStatsReply, error) {
+	out := new(GetKeyRangeStatsReply)
+	err := c.cc.Invoke(ctx, KeyRangeService_GetKeyRangeStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KeyRangeServiceServer is the server API for KeyRangeService service.
 // All implementations must embed UnimplementedKeyRangeServiceServer
 // for forward compatibility
@@ -183,6 +194,7 @@ type KeyRangeServiceServer interface {
 	MergeKeyRange(context.Context, *MergeKeyRangeRequest) (*ModifyReply, error)
 	MoveKeyRange(context.Context, *MoveKeyRangeRequest) (*ModifyReply, error)
 	ResolveKeyRange(context.Context, *ResolveKeyRangeRequest) (*ResolveKeyRangeReply, error)
+	GetKeyRangeStats(context.Context, *GetKeyRangeStatsRequest) (*GetKeyRangeStatsReply, error)
 	mustEmbedUnimplementedKeyRangeServiceServer()
 }
 
@@ -226,6 +238,9 @@ func (UnimplementedKeyRangeServiceServer) MoveKeyRange(context.Context, *MoveKey
 func (UnimplementedKeyRangeServiceServer) ResolveKeyRange(context.Context, *ResolveKeyRangeRequest) (*ResolveKeyRangeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveKeyRange not implemented")
 }
+func (UnimplementedKeyRangeServiceServer) GetKeyRangeStats(context.Context, *GetKeyRangeStatsRequest) (*GetKeyRangeStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyRangeStats not implemented")
+}
 func (UnimplementedKeyRangeServiceServer) mustEmbedUnimplementedKeyRangeServiceServer() {}
 
 // UnsafeKeyRangeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -455,6 +470,24 @@ func _KeyRangeService_ResolveKeyRange_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KeyRangeService_GetKeyRangeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeyRangeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyRangeServiceServer).GetKeyRangeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyRangeService_GetKeyRangeStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyRangeServiceServer).GetKeyRangeStats(ctx, req.(*GetKeyRangeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KeyRangeService_ServiceDesc is the grpc.ServiceDesc for KeyRangeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -510,6 +543,10 @@ var KeyRangeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveKeyRange",
 			Handler:    _KeyRangeService_ResolveKeyRange_Handler,
 		},
+		{
+			MethodName: "GetKeyRangeStats",
+			Handler:    _KeyRangeService_GetKeyRangeStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/key_range.proto",
@@ -110,14 +110,22 @@ type PoolInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	DB            string `protobuf:"bytes,2,opt,name=DB,proto3" json:"DB,omitempty"`
-	Usr           string `protobuf:"bytes,3,opt,name=Usr,proto3" json:"Usr,omitempty"`
-	Host          string `protobuf:"bytes,4,opt,name=Host,proto3" json:"Host,omitempty"`
-	ConnCount     int64  `protobuf:"varint,5,opt,name=ConnCount,proto3" json:"ConnCount,omitempty"`
-	IdleConnCount int64  `protobuf:"varint,6,opt,name=IdleConnCount,proto3" json:"IdleConnCount,omitempty"`
-	QueueSize     int64  `protobuf:"varint,7,opt,name=QueueSize,proto3" json:"QueueSize,omitempty"`
-	RouterName    string `protobuf:"bytes,8,opt,name=RouterName,proto3" json:"RouterName,omitempty"`
+	Id                  string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	DB                  string `protobuf:"bytes,2,opt,name=DB,proto3" json:"DB,omitempty"`
+	Usr                 string `protobuf:"bytes,3,opt,name=Usr,proto3" json:"Usr,omitempty"`
+	Host                string `protobuf:"bytes,4,opt,name=Host,proto3" json:"Host,omitempty"`
+	ConnCount           int64  `protobuf:"varint,5,opt,name=ConnCount,proto3" json:"ConnCount,omitempty"`
+	IdleConnCount       int64  `protobuf:"varint,6,opt,name=IdleConnCount,proto3" json:"IdleConnCount,omitempty"`
+	QueueSize           int64  `protobuf:"varint,7,opt,name=QueueSize,proto3" json:"QueueSize,omitempty"`
+	RouterName          string `protobuf:"bytes,8,opt,name=RouterName,proto3" json:"RouterName,omitempty"`
+	ClosedByLifetime    int64  `protobuf:"varint,9,opt,name=ClosedByLifetime,proto3" json:"ClosedByLifetime,omitempty"`
+	ClosedByIdleTimeout int64  `protobuf:"varint,10,opt,name=ClosedByIdleTimeout,proto3" json:"ClosedByIdleTimeout,omitempty"`
+	FailedChecks        int64  `protobuf:"varint,11,opt,name=FailedChecks,proto3" json:"FailedChecks,omitempty"`
+	WarmedUp            int64  `protobuf:"varint,12,opt,name=WarmedUp,proto3" json:"WarmedUp,omitempty"`
+	WaitingClients      int64  `protobuf:"varint,13,opt,name=WaitingClients,proto3" json:"WaitingClients,omitempty"`
+	WaitTimeouts        int64  `protobuf:"varint,14,opt,name=WaitTimeouts,proto3" json:"WaitTimeouts,omitempty"`
+	AvgWaitUs           int64  `protobuf:"varint,15,opt,name=AvgWaitUs,proto3" json:"AvgWaitUs,omitempty"`
+	MaxWaitUs           int64  `protobuf:"varint,16,opt,name=MaxWaitUs,proto3" json:"MaxWaitUs,omitempty"`
 }
 
 func (x *PoolInfo) Reset() {
@@ -208,6 +216,62 @@ func (x *PoolInfo) GetRouterName() string {
 	return ""
 }
 
+func (x *PoolInfo) GetClosedByLifetime() int64 {
+	if x != nil {
+		return x.ClosedByLifetime
+	}
+	return 0
+}
+
+func (x *PoolInfo) GetClosedByIdleTimeout() int64 {
+	if x != nil {
+		return x.ClosedByIdleTimeout
+	}
+	return 0
+}
+
+func (x *PoolInfo) GetFailedChecks() int64 {
+	if x != nil {
+		return x.FailedChecks
+	}
+	return 0
+}
+
+func (x *PoolInfo) GetWarmedUp() int64 {
+	if x != nil {
+		return x.WarmedUp
+	}
+	return 0
+}
+
+func (x *PoolInfo) GetWaitingClients() int64 {
+	if x != nil {
+		return x.WaitingClients
+	}
+	return 0
+}
+
+func (x *PoolInfo) GetWaitTimeouts() int64 {
+	if x != nil {
+		return x.WaitTimeouts
+	}
+	return 0
+}
+
+func (x *PoolInfo) GetAvgWaitUs() int64 {
+	if x != nil {
+		return x.AvgWaitUs
+	}
+	return 0
+}
+
+func (x *PoolInfo) GetMaxWaitUs() int64 {
+	if x != nil {
+		return x.MaxWaitUs
+	}
+	return 0
+}
+
 var File_protos_pools_proto protoreflect.FileDescriptor
 
 var file_protos_pools_proto_rawDesc = []byte{
@@ -217,7 +281,7 @@ var file_protos_pools_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x08, 0x50, 0x6f,
+	0x66, 0x6f, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xf8, 0x03, 0x0a, 0x08, 0x50, 0x6f,
 	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x73, 0x72, 0x18, 0x03, 0x20,
@@ -230,14 +294,32 @@ var file_protos_pools_proto_rawDesc = []byte{
 	0x12, 0x1c, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e,
 	0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x4d,
-	0x0a, 0x0b, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x70, 0x71,
-	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
-	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0c, 0x5a,
-	0x0a, 0x73, 0x70, 0x71, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x28, 0x09, 0x52, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x79, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x42, 0x79, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x42, 0x79, 0x49, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x42,
+	0x79, 0x49, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x57, 0x61, 0x72, 0x6d, 0x65, 0x64, 0x55, 0x70, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x57, 0x61, 0x72, 0x6d, 0x65, 0x64, 0x55, 0x70, 0x12, 0x26, 0x0a, 0x0e,
+	0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x57, 0x61, 0x69, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x76, 0x67, 0x57,
+	0x61, 0x69, 0x74, 0x55, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x41, 0x76, 0x67,
+	0x57, 0x61, 0x69, 0x74, 0x55, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x78, 0x57, 0x61, 0x69,
+	0x74, 0x55, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x4d, 0x61, 0x78, 0x57, 0x61,
+	0x69, 0x74, 0x55, 0x73, 0x32, 0x4d, 0x0a, 0x0b, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73,
+	0x12, 0x16, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x73, 0x70, 0x71, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,880 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v3.21.12
+// source: protos/schema.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SchemaChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *SchemaChange) Reset() {
+	*x = SchemaChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaChange) ProtoMessage() {}
+
+func (x *SchemaChange) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaChange.ProtoReflect.Descriptor instead.
+func (*SchemaChange) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SchemaChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SchemaChange) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type RecordSchemaChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *RecordSchemaChangeRequest) Reset() {
+	*x = RecordSchemaChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordSchemaChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSchemaChangeRequest) ProtoMessage() {}
+
+func (x *RecordSchemaChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSchemaChangeRequest.ProtoReflect.Descriptor instead.
+func (*RecordSchemaChangeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecordSchemaChangeRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type RecordSchemaChangeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecordSchemaChangeReply) Reset() {
+	*x = RecordSchemaChangeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordSchemaChangeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSchemaChangeReply) ProtoMessage() {}
+
+func (x *RecordSchemaChangeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSchemaChangeReply.ProtoReflect.Descriptor instead.
+func (*RecordSchemaChangeReply) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{2}
+}
+
+type ListSchemaChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSchemaChangesRequest) Reset() {
+	*x = ListSchemaChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSchemaChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchemaChangesRequest) ProtoMessage() {}
+
+func (x *ListSchemaChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchemaChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchemaChangesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{3}
+}
+
+type ListSchemaChangesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*SchemaChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ListSchemaChangesReply) Reset() {
+	*x = ListSchemaChangesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSchemaChangesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchemaChangesReply) ProtoMessage() {}
+
+func (x *ListSchemaChangesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchemaChangesReply.ProtoReflect.Descriptor instead.
+func (*ListSchemaChangesReply) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSchemaChangesReply) GetChanges() []*SchemaChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type RelationSchemaState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShardId      string `protobuf:"bytes,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	RelationName string `protobuf:"bytes,2,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"`
+	Checksum     string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (x *RelationSchemaState) Reset() {
+	*x = RelationSchemaState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationSchemaState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationSchemaState) ProtoMessage() {}
+
+func (x *RelationSchemaState) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationSchemaState.ProtoReflect.Descriptor instead.
+func (*RelationSchemaState) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RelationSchemaState) GetShardId() string {
+	if x != nil {
+		return x.ShardId
+	}
+	return ""
+}
+
+func (x *RelationSchemaState) GetRelationName() string {
+	if x != nil {
+		return x.RelationName
+	}
+	return ""
+}
+
+func (x *RelationSchemaState) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+type CheckSchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationName string `protobuf:"bytes,1,opt,name=relation_name,json=relationName,proto3" json:"relation_name,omitempty"`
+}
+
+func (x *CheckSchemaRequest) Reset() {
+	*x = CheckSchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSchemaRequest) ProtoMessage() {}
+
+func (x *CheckSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSchemaRequest.ProtoReflect.Descriptor instead.
+func (*CheckSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckSchemaRequest) GetRelationName() string {
+	if x != nil {
+		return x.RelationName
+	}
+	return ""
+}
+
+type CheckSchemaReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	States []*RelationSchemaState `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+}
+
+func (x *CheckSchemaReply) Reset() {
+	*x = CheckSchemaReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSchemaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSchemaReply) ProtoMessage() {}
+
+func (x *CheckSchemaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSchemaReply.ProtoReflect.Descriptor instead.
+func (*CheckSchemaReply) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckSchemaReply) GetStates() []*RelationSchemaState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type CopySchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromShardId string `protobuf:"bytes,1,opt,name=from_shard_id,json=fromShardId,proto3" json:"from_shard_id,omitempty"`
+	ToShard     *Shard `protobuf:"bytes,2,opt,name=to_shard,json=toShard,proto3" json:"to_shard,omitempty"`
+}
+
+func (x *CopySchemaRequest) Reset() {
+	*x = CopySchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopySchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopySchemaRequest) ProtoMessage() {}
+
+func (x *CopySchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopySchemaRequest.ProtoReflect.Descriptor instead.
+func (*CopySchemaRequest) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CopySchemaRequest) GetFromShardId() string {
+	if x != nil {
+		return x.FromShardId
+	}
+	return ""
+}
+
+func (x *CopySchemaRequest) GetToShard() *Shard {
+	if x != nil {
+		return x.ToShard
+	}
+	return nil
+}
+
+type CopySchemaReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CopySchemaReply) Reset() {
+	*x = CopySchemaReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopySchemaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopySchemaReply) ProtoMessage() {}
+
+func (x *CopySchemaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopySchemaReply.ProtoReflect.Descriptor instead.
+func (*CopySchemaReply) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{9}
+}
+
+type ReplaySchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ToShard *Shard `protobuf:"bytes,1,opt,name=to_shard,json=toShard,proto3" json:"to_shard,omitempty"`
+}
+
+func (x *ReplaySchemaRequest) Reset() {
+	*x = ReplaySchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaySchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaySchemaRequest) ProtoMessage() {}
+
+func (x *ReplaySchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaySchemaRequest.ProtoReflect.Descriptor instead.
+func (*ReplaySchemaRequest) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplaySchemaRequest) GetToShard() *Shard {
+	if x != nil {
+		return x.ToShard
+	}
+	return nil
+}
+
+type ReplaySchemaReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReplaySchemaReply) Reset() {
+	*x = ReplaySchemaReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_schema_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaySchemaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaySchemaReply) ProtoMessage() {}
+
+func (x *ReplaySchemaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_schema_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaySchemaReply.ProtoReflect.Descriptor instead.
+func (*ReplaySchemaReply) Descriptor() ([]byte, []int) {
+	return file_protos_schema_proto_rawDescGZIP(), []int{11}
+}
+
+var File_protos_schema_proto protoreflect.FileDescriptor
+
+var file_protos_schema_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x73, 0x70, 0x71, 0x72, 0x1a, 0x12, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x34, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x31, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x46, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x71,
+	0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x39, 0x0a, 0x12, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x71, 0x72,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x11,
+	0x43, 0x6f, 0x70, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x68,
+	0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x52, 0x07, 0x74, 0x6f, 0x53, 0x68, 0x61, 0x72, 0x64, 0x22, 0x11, 0x0a,
+	0x0f, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x3d, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x70, 0x71, 0x72,
+	0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x07, 0x74, 0x6f, 0x53, 0x68, 0x61, 0x72, 0x64, 0x22,
+	0x13, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x32, 0x85, 0x03, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x73,
+	0x70, 0x71, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x73, 0x70, 0x71, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
+	0x70, 0x71, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x12, 0x17, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x43, 0x6f, 0x70, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x73, 0x70, 0x71, 0x72, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x73, 0x70, 0x71, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a,
+	0x73, 0x70, 0x71, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_protos_schema_proto_rawDescOnce sync.Once
+	file_protos_schema_proto_rawDescData = file_protos_schema_proto_rawDesc
+)
+
+func file_protos_schema_proto_rawDescGZIP() []byte {
+	file_protos_schema_proto_rawDescOnce.Do(func() {
+		file_protos_schema_proto_rawDescData = protoimpl.X.CompressGZIP(file_protos_schema_proto_rawDescData)
+	})
+	return file_protos_schema_proto_rawDescData
+}
+
+var file_protos_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_protos_schema_proto_goTypes = []interface{}{
+	(*SchemaChange)(nil),              // 0: spqr.SchemaChange
+	(*RecordSchemaChangeRequest)(nil), // 1: spqr.RecordSchemaChangeRequest
+	(*RecordSchemaChangeReply)(nil),   // 2: spqr.RecordSchemaChangeReply
+	(*ListSchemaChangesRequest)(nil),  // 3: spqr.ListSchemaChangesRequest
+	(*ListSchemaChangesReply)(nil),    // 4: spqr.ListSchemaChangesReply
+	(*RelationSchemaState)(nil),       // 5: spqr.RelationSchemaState
+	(*CheckSchemaRequest)(nil),        // 6: spqr.CheckSchemaRequest
+	(*CheckSchemaReply)(nil),          // 7: spqr.CheckSchemaReply
+	(*CopySchemaRequest)(nil),         // 8: spqr.CopySchemaRequest
+	(*CopySchemaReply)(nil),           // 9: spqr.CopySchemaReply
+	(*ReplaySchemaRequest)(nil),       // 10: spqr.ReplaySchemaRequest
+	(*ReplaySchemaReply)(nil),         // 11: spqr.ReplaySchemaReply
+	(*Shard)(nil),                     // 12: spqr.Shard
+}
+var file_protos_schema_proto_depIdxs = []int32{
+	0,  // 0: spqr.ListSchemaChangesReply.changes:type_name -> spqr.SchemaChange
+	5,  // 1: spqr.CheckSchemaReply.states:type_name -> spqr.RelationSchemaState
+	12, // 2: spqr.CopySchemaRequest.to_shard:type_name -> spqr.Shard
+	12, // 3: spqr.ReplaySchemaRequest.to_shard:type_name -> spqr.Shard
+	1,  // 4: spqr.SchemaService.RecordSchemaChange:input_type -> spqr.RecordSchemaChangeRequest
+	3,  // 5: spqr.SchemaService.ListSchemaChanges:input_type -> spqr.ListSchemaChangesRequest
+	6,  // 6: spqr.SchemaService.CheckSchema:input_type -> spqr.CheckSchemaRequest
+	8,  // 7: spqr.SchemaService.CopySchema:input_type -> spqr.CopySchemaRequest
+	10, // 8: spqr.SchemaService.ReplaySchema:input_type -> spqr.ReplaySchemaRequest
+	2,  // 9: spqr.SchemaService.RecordSchemaChange:output_type -> spqr.RecordSchemaChangeReply
+	4,  // 10: spqr.SchemaService.ListSchemaChanges:output_type -> spqr.ListSchemaChangesReply
+	7,  // 11: spqr.SchemaService.CheckSchema:output_type -> spqr.CheckSchemaReply
+	9,  // 12: spqr.SchemaService.CopySchema:output_type -> spqr.CopySchemaReply
+	11, // 13: spqr.SchemaService.ReplaySchema:output_type -> spqr.ReplaySchemaReply
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_protos_schema_proto_init() }
+func file_protos_schema_proto_init() {
+	if File_protos_schema_proto != nil {
+		return
+	}
+	file_protos_shard_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_protos_schema_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordSchemaChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordSchemaChangeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSchemaChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSchemaChangesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationSchemaState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSchemaReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopySchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopySchemaReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaySchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_schema_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaySchemaReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protos_schema_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_schema_proto_goTypes,
+		DependencyIndexes: file_protos_schema_proto_depIdxs,
+		MessageInfos:      file_protos_schema_proto_msgTypes,
+	}.Build()
+	File_protos_schema_proto = out.File
+	file_protos_schema_proto_rawDesc = nil
+	file_protos_schema_proto_goTypes = nil
+	file_protos_schema_proto_depIdxs = nil
+}
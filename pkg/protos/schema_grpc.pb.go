@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: protos/schema.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SchemaService_RecordSchemaChange_FullMethodName = "/spqr.SchemaService/RecordSchemaChange"
+	SchemaService_ListSchemaChanges_FullMethodName  = "/spqr.SchemaService/ListSchemaChanges"
+	SchemaService_CheckSchema_FullMethodName        = "/spqr.SchemaService/CheckSchema"
+	SchemaService_CopySchema_FullMethodName         = "/spqr.SchemaService/CopySchema"
+	SchemaService_ReplaySchema_FullMethodName       = "/spqr.SchemaService/ReplaySchema"
+)
+
+// SchemaServiceClient is the client API for SchemaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SchemaServiceClient interface {
+	RecordSchemaChange(ctx context.Context, in *RecordSchemaChangeRequest, opts ...grpc.CallOption) (*RecordSchemaChangeReply, error)
+	ListSchemaChanges(ctx context.Context, in *ListSchemaChangesRequest, opts ...grpc.CallOption) (*ListSchemaChangesReply, error)
+	CheckSchema(ctx context.Context, in *CheckSchemaRequest, opts ...grpc.CallOption) (*CheckSchemaReply, error)
+	CopySchema(ctx context.Context, in *CopySchemaRequest, opts ...grpc.CallOption) (*CopySchemaReply, error)
+	ReplaySchema(ctx context.Context, in *ReplaySchemaRequest, opts ...grpc.CallOption) (*ReplaySchemaReply, error)
+}
+
+type schemaServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchemaServiceClient(cc grpc.ClientConnInterface) SchemaServiceClient {
+	return &schemaServiceClient{cc}
+}
+
+func (c *schemaServiceClient) RecordSchemaChange(ctx context.Context, in *RecordSchemaChangeRequest, opts ...grpc.CallOption) (*RecordSchemaChangeReply, error) {
+	out := new(RecordSchemaChangeReply)
+	err := c.cc.Invoke(ctx, SchemaService_RecordSchemaChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) ListSchemaChanges(ctx context.Context, in *ListSchemaChangesRequest, opts ...grpc.CallOption) (*ListSchemaChangesReply, error) {
+	out := new(ListSchemaChangesReply)
+	err := c.cc.Invoke(ctx, SchemaService_ListSchemaChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) CheckSchema(ctx context.Context, in *CheckSchemaRequest, opts ...grpc.CallOption) (*CheckSchemaReply, error) {
+	out := new(CheckSchemaReply)
+	err := c.cc.Invoke(ctx, SchemaService_CheckSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) CopySchema(ctx context.Context, in *CopySchemaRequest, opts ...grpc.CallOption) (*CopySchemaReply, error) {
+	out := new(CopySchemaReply)
+	err := c.cc.Invoke(ctx, SchemaService_CopySchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) ReplaySchema(ctx context.Context, in *ReplaySchemaRequest, opts ...grpc.CallOption) (*ReplaySchemaReply, error) {
+	out := new(ReplaySchemaReply)
+	err := c.cc.Invoke(ctx, SchemaService_ReplaySchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchemaServiceServer is the server API for SchemaService service.
+// All implementations must embed UnimplementedSchemaServiceServer
+// for forward compatibility
+type SchemaServiceServer interface {
+	RecordSchemaChange(context.Context, *RecordSchemaChangeRequest) (*RecordSchemaChangeReply, error)
+	ListSchemaChanges(context.Context, *ListSchemaChangesRequest) (*ListSchemaChangesReply, error)
+	CheckSchema(context.Context, *CheckSchemaRequest) (*CheckSchemaReply, error)
+	CopySchema(context.Context, *CopySchemaRequest) (*CopySchemaReply, error)
+	ReplaySchema(context.Context, *ReplaySchemaRequest) (*ReplaySchemaReply, error)
+	mustEmbedUnimplementedSchemaServiceServer()
+}
+
+// UnimplementedSchemaServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSchemaServiceServer struct {
+}
+
+func (UnimplementedSchemaServiceServer) RecordSchemaChange(context.Context, *RecordSchemaChangeRequest) (*RecordSchemaChangeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSchemaChange not implemented")
+}
+func (UnimplementedSchemaServiceServer) ListSchemaChanges(context.Context, *ListSchemaChangesRequest) (*ListSchemaChangesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemaChanges not implemented")
+}
+func (UnimplementedSchemaServiceServer) CheckSchema(context.Context, *CheckSchemaRequest) (*CheckSchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) CopySchema(context.Context, *CopySchemaRequest) (*CopySchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopySchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) ReplaySchema(context.Context, *ReplaySchemaRequest) (*ReplaySchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaySchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) mustEmbedUnimplementedSchemaServiceServer() {}
+
+// UnsafeSchemaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchemaServiceServer will
+// result in compilation errors.
+type UnsafeSchemaServiceServer interface {
+	mustEmbedUnimplementedSchemaServiceServer()
+}
+
+func RegisterSchemaServiceServer(s grpc.ServiceRegistrar, srv SchemaServiceServer) {
+	s.RegisterService(&SchemaService_ServiceDesc, srv)
+}
+
+func _SchemaService_RecordSchemaChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSchemaChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).RecordSchemaChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaService_RecordSchemaChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).RecordSchemaChange(ctx, req.(*RecordSchemaChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_ListSchemaChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemaChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).ListSchemaChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaService_ListSchemaChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).ListSchemaChanges(ctx, req.(*ListSchemaChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_CheckSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).CheckSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaService_CheckSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).CheckSchema(ctx, req.(*CheckSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_CopySchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopySchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).CopySchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaService_CopySchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).CopySchema(ctx, req.(*CopySchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_ReplaySchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaySchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).ReplaySchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchemaService_ReplaySchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).ReplaySchema(ctx, req.(*ReplaySchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchemaService_ServiceDesc is the grpc.ServiceDesc for SchemaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchemaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "spqr.SchemaService",
+	HandlerType: (*SchemaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordSchemaChange",
+			Handler:    _SchemaService_RecordSchemaChange_Handler,
+		},
+		{
+			MethodName: "ListSchemaChanges",
+			Handler:    _SchemaService_ListSchemaChanges_Handler,
+		},
+		{
+			MethodName: "CheckSchema",
+			Handler:    _SchemaService_CheckSchema_Handler,
+		},
+		{
+			MethodName: "CopySchema",
+			Handler:    _SchemaService_CopySchema_Handler,
+		},
+		{
+			MethodName: "ReplaySchema",
+			Handler:    _SchemaService_ReplaySchema_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/schema.proto",
+}
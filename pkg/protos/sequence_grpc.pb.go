@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: protos/sequence.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SequenceService_CreateSequence_FullMethodName    = "/spqr.SequenceService/CreateSequence"
+	SequenceService_DropSequence_FullMethodName      = "/spqr.SequenceService/DropSequence"
+	SequenceService_ListSequences_FullMethodName     = "/spqr.SequenceService/ListSequences"
+	SequenceService_NextSequenceRange_FullMethodName = "/spqr.SequenceService/NextSequenceRange"
+)
+
+// SequenceServiceClient is the client API for SequenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SequenceServiceClient interface {
+	CreateSequence(ctx context.Context, in *CreateSequenceRequest, opts ...grpc.CallOption) (*CreateSequenceReply, error)
+	DropSequence(ctx context.Context, in *DropSequenceRequest, opts ...grpc.CallOption) (*DropSequenceReply, error)
+	ListSequences(ctx context.Context, in *ListSequencesRequest, opts ...grpc.CallOption) (*ListSequencesReply, error)
+	NextSequenceRange(ctx context.Context, in *NextSequenceRangeRequest, opts ...grpc.CallOption) (*NextSequenceRangeReply, error)
+}
+
+type sequenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSequenceServiceClient(cc grpc.ClientConnInterface) SequenceServiceClient {
+	return &sequenceServiceClient{cc}
+}
+
+func (c *sequenceServiceClient) CreateSequence(ctx context.Context, in *CreateSequenceRequest, opts ...grpc.CallOption) (*CreateSequenceReply, error) {
+	out := new(CreateSequenceReply)
+	err := c.cc.Invoke(ctx, SequenceService_CreateSequence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sequenceServiceClient) DropSequence(ctx context.Context, in *DropSequenceRequest, opts ...grpc.CallOption) (*DropSequenceReply, error) {
+	out := new(DropSequenceReply)
+	err := c.cc.Invoke(ctx, SequenceService_DropSequence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sequenceServiceClient) ListSequences(ctx context.Context, in *ListSequencesRequest, opts ...grpc.CallOption) (*ListSequencesReply, error) {
+	out := new(ListSequencesReply)
+	err := c.cc.Invoke(ctx, SequenceService_ListSequences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sequenceServiceClient) NextSequenceRange(ctx context.Context, in *NextSequenceRangeRequest, opts ...grpc.CallOption) (*NextSequenceRangeReply, error) {
+	out := new(NextSequenceRangeReply)
+	err := c.cc.Invoke(ctx, SequenceService_NextSequenceRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SequenceServiceServer is the server API for SequenceService service.
+// All implementations must embed UnimplementedSequenceServiceServer
+// for forward compatibility
+type SequenceServiceServer interface {
+	CreateSequence(context.Context, *CreateSequenceRequest) (*CreateSequenceReply, error)
+	DropSequence(context.Context, *DropSequenceRequest) (*DropSequenceReply, error)
+	ListSequences(context.Context, *ListSequencesRequest) (*ListSequencesReply, error)
+	NextSequenceRange(context.Context, *NextSequenceRangeRequest) (*NextSequenceRangeReply, error)
+	mustEmbedUnimplementedSequenceServiceServer()
+}
+
+// UnimplementedSequenceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSequenceServiceServer struct {
+}
+
+func (UnimplementedSequenceServiceServer) CreateSequence(context.Context, *CreateSequenceRequest) (*CreateSequenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSequence not implemented")
+}
+func (UnimplementedSequenceServiceServer) DropSequence(context.Context, *DropSequenceRequest) (*DropSequenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropSequence not implemented")
+}
+func (UnimplementedSequenceServiceServer) ListSequences(context.Context, *ListSequencesRequest) (*ListSequencesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSequences not implemented")
+}
+func (UnimplementedSequenceServiceServer) NextSequenceRange(context.Context, *NextSequenceRangeRequest) (*NextSequenceRangeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextSequenceRange not implemented")
+}
+func (UnimplementedSequenceServiceServer) mustEmbedUnimplementedSequenceServiceServer() {}
+
+// UnsafeSequenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SequenceServiceServer will
+// result in compilation errors.
+type UnsafeSequenceServiceServer interface {
+	mustEmbedUnimplementedSequenceServiceServer()
+}
+
+func RegisterSequenceServiceServer(s grpc.ServiceRegistrar, srv SequenceServiceServer) {
+	s.RegisterService(&SequenceService_ServiceDesc, srv)
+}
+
+func _SequenceService_CreateSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SequenceServiceServer).CreateSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SequenceService_CreateSequence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SequenceServiceServer).CreateSequence(ctx, req.(*CreateSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SequenceService_DropSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SequenceServiceServer).DropSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SequenceService_DropSequence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SequenceServiceServer).DropSequence(ctx, req.(*DropSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SequenceService_ListSequences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSequencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SequenceServiceServer).ListSequences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SequenceService_ListSequences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SequenceServiceServer).ListSequences(ctx, req.(*ListSequencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SequenceService_NextSequenceRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextSequenceRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SequenceServiceServer).NextSequenceRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SequenceService_NextSequenceRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SequenceServiceServer).NextSequenceRange(ctx, req.(*NextSequenceRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SequenceService_ServiceDesc is the grpc.ServiceDesc for SequenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SequenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "spqr.SequenceService",
+	HandlerType: (*SequenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSequence",
+			Handler:    _SequenceService_CreateSequence_Handler,
+		},
+		{
+			MethodName: "DropSequence",
+			Handler:    _SequenceService_DropSequence_Handler,
+		},
+		{
+			MethodName: "ListSequences",
+			Handler:    _SequenceService_ListSequences_Handler,
+		},
+		{
+			MethodName: "NextSequenceRange",
+			Handler:    _SequenceService_NextSequenceRange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/sequence.proto",
+}
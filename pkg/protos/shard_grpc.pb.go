@@ -23,6 +23,7 @@ const (
 	ShardService_AddDataShard_FullMethodName  = "/spqr.ShardService/AddDataShard"
 	ShardService_AddWorldShard_FullMethodName = "/spqr.ShardService/AddWorldShard"
 	ShardService_GetShard_FullMethodName      = "/spqr.ShardService/GetShard"
+	ShardService_DrainShard_FullMethodName    = "/spqr.ShardService/DrainShard"
 )
 
 // ShardServiceClient is the client API for ShardService service.
@@ -33,6 +34,7 @@ type ShardServiceClient interface {
 	AddDataShard(ctx context.Context, in *AddShardRequest, opts ...grpc.CallOption) (*AddShardReply, error)
 	AddWorldShard(ctx context.Context, in *AddWorldShardRequest, opts ...grpc.CallOption) (*AddShardReply, error)
 	GetShard(ctx context.Context, in *ShardRequest, opts ...grpc.CallOption) (*ShardReply, error)
+	DrainShard(ctx context.Context, in *DrainShardRequest, opts ...grpc.CallOption) (*DrainShardReply, error)
 }
 
 type shardServiceClient struct {
@@ -79,6 +81,15 @@ func (c *shardServiceClient) GetShard(ctx context.Context, in *ShardRequest, opt
 	return out, nil
 }
 
+func (c *shardServiceClient) DrainShard(ctx context.Context, in *DrainShardRequest, opts ...grpc.CallOption) (*DrainShardReply, error) {
+	out := new(DrainShardReply)
+	err := c.cc.Invoke(ctx, ShardService_DrainShard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShardServiceServer is the server API for ShardService service.
 // All implementations must embed UnimplementedShardServiceServer
 // for forward compatibility
@@ -87,6 +98,7 @@ type ShardServiceServer interface {
 	AddDataShard(context.Context, *AddShardRequest) (*AddShardReply, error)
 	AddWorldShard(context.Context, *AddWorldShardRequest) (*AddShardReply, error)
 	GetShard(context.Context, *ShardRequest) (*ShardReply, error)
+	DrainShard(context.Context, *DrainShardRequest) (*DrainShardReply, error)
 	mustEmbedUnimplementedShardServiceServer()
 }
 
@@ -106,6 +118,9 @@ func (UnimplementedShardServiceServer) AddWorldShard(context.Context, *AddWorldS
 func (UnimplementedShardServiceServer) GetShard(context.Context, *ShardRequest) (*ShardReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
 }
+func (UnimplementedShardServiceServer) DrainShard(context.Context, *DrainShardRequest) (*DrainShardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainShard not implemented")
+}
 func (UnimplementedShardServiceServer) mustEmbedUnimplementedShardServiceServer() {}
 
 // UnsafeShardServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +206,24 @@ func _ShardService_GetShard_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShardService_DrainShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShardServiceServer).DrainShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShardService_DrainShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShardServiceServer).DrainShard(ctx, req.(*DrainShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ShardService_ServiceDesc is the grpc.ServiceDesc for ShardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +247,10 @@ var ShardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetShard",
 			Handler:    _ShardService_GetShard_Handler,
 		},
+		{
+			MethodName: "DrainShard",
+			Handler:    _ShardService_DrainShard_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/shard.proto",
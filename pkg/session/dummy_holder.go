@@ -9,6 +9,8 @@ type DummySessionParamHandler struct {
 	behaviour    string
 	key          string
 	rh           routehint.RouteHint
+	ryw          bool
+	snapshot     bool
 }
 
 // BindParamFormatCodes implements SessionParamsHolder.
@@ -83,3 +85,23 @@ func (t *DummySessionParamHandler) ShardingKey() string {
 }
 
 var _ SessionParamsHolder = &DummySessionParamHandler{}
+
+// ReadYourWrites implements session.SessionParamsHolder.
+func (t *DummySessionParamHandler) ReadYourWrites() bool {
+	return t.ryw
+}
+
+// SetReadYourWrites implements session.SessionParamsHolder.
+func (t *DummySessionParamHandler) SetReadYourWrites(ryw bool) {
+	t.ryw = ryw
+}
+
+// ConsistentSnapshot implements session.SessionParamsHolder.
+func (t *DummySessionParamHandler) ConsistentSnapshot() bool {
+	return t.snapshot
+}
+
+// SetConsistentSnapshot implements session.SessionParamsHolder.
+func (t *DummySessionParamHandler) SetConsistentSnapshot(snapshot bool) {
+	t.snapshot = snapshot
+}
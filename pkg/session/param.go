@@ -22,6 +22,15 @@ type SessionParamsHolder interface {
 
 	RouteHint() routehint.RouteHint
 	SetRouteHint(routehint.RouteHint)
+
+	// ReadYourWrites reports if reads should see writes of previous transactions
+	ReadYourWrites() bool
+	SetReadYourWrites(bool)
+
+	// ConsistentSnapshot reports if repeatable read transactions should
+	// see consistent snapshot of all shards
+	ConsistentSnapshot() bool
+	SetConsistentSnapshot(bool)
 }
 
 const (
@@ -29,4 +38,6 @@ const (
 	SPQR_DEFAULT_ROUTE_BEHAVIOUR = "__spqr__default_route_behaviour"
 	SPQR_SHARDING_KEY            = "__spqr__sharding_key"
 	SPQR_SCATTER_QUERY           = "__spqr__scatter_query"
+	SPQR_READ_YOUR_WRITES        = "__spqr__read_your_writes"
+	SPQR_CONSISTENT_SNAPSHOT     = "__spqr__consistent_snapshot"
 )
@@ -35,6 +35,8 @@ type Shardinfo interface {
 	Sync() int64
 	TxServed() int64
 	TxStatus() txstatus.TXStatus
+	PrepStmtCacheHits() int64
+	PrepStmtCacheMisses() int64
 }
 
 type CoordShardinfo interface {
@@ -43,6 +45,8 @@ type CoordShardinfo interface {
 }
 
 type PreparedStatementDescriptor struct {
+	// Name is the name of statement on server connection
+	Name      string
 	NoData    bool
 	ParamDesc *pgproto3.ParameterDescription
 	RowDesc   *pgproto3.RowDescription
@@ -0,0 +1,421 @@
+package tsa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+)
+
+const (
+	defaultHealthCheckInterval = time.Second
+	defaultFallThreshold       = 3
+	defaultRiseThreshold       = 2
+)
+
+// replica lag is zero, if replica has replayed everything it has received
+const healthCheckQuery = `SELECT pg_is_in_recovery(),
+	CASE WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE coalesce(extract(epoch FROM now() - pg_last_xact_replay_timestamp()) * 1000, 0) END`
+
+type HostState struct {
+	Shard string
+	Host  string
+
+	Alive          bool
+	InRecovery     bool
+	ReplicationLag time.Duration
+
+	LastCheck time.Time
+	LastError string
+
+	// consecutive probe results, used for hysteresis
+	failures  int
+	successes int
+	// role is learned with the first successful probe
+	roleKnown bool
+}
+
+type HealthChecker interface {
+	// HostState returns last known state of the host,
+	// ok is false if the host was never checked
+	HostState(host string) (state HostState, ok bool)
+	// Usable reports if connections to the host may be used with target session attrs
+	Usable(host string, targetSessionAttrs string) bool
+	// OnRoleChange registers callback, called when host is promoted or demoted.
+	// Returned function unregisters the callback.
+	OnRoleChange(cb func(st HostState)) func()
+}
+
+// HostConnectFn opens a new connection to the host of the shard,
+// connecting must not outlive the context
+type HostConnectFn func(ctx context.Context, shardName string, shardCfg *config.Shard, host string) (shard.Shard, error)
+
+// BackgroundHealthChecker periodically probes all hosts from the shard mapping.
+// Host is marked down after fall threshold consecutive failed probes and marked up
+// again after rise threshold consecutive successful ones.
+// Every host is probed over its own connection, kept between probes.
+type BackgroundHealthChecker struct {
+	mu      sync.RWMutex
+	hosts   map[string]*HostState
+	mapping map[string]*config.Shard
+
+	connect HostConnectFn
+	connMu  sync.Mutex
+	conns   map[string]shard.Shard
+
+	cbMu          sync.Mutex
+	cbSeq         uint64
+	roleCallbacks map[uint64]func(st HostState)
+
+	interval      time.Duration
+	timeout       time.Duration
+	fallThreshold int
+	riseThreshold int
+	maxLag        time.Duration
+}
+
+var _ HealthChecker = &BackgroundHealthChecker{}
+
+func NewHealthChecker(cfg *config.HostHealthCheck, mapping map[string]*config.Shard, connect HostConnectFn) *BackgroundHealthChecker {
+	hc := &BackgroundHealthChecker{
+		hosts:         map[string]*HostState{},
+		roleCallbacks: map[uint64]func(st HostState){},
+		mapping:       mapping,
+		connect:       connect,
+		conns:         map[string]shard.Shard{},
+		interval:      defaultHealthCheckInterval,
+		fallThreshold: defaultFallThreshold,
+		riseThreshold: defaultRiseThreshold,
+		maxLag:        time.Duration(cfg.MaxReplicaLagMs) * time.Millisecond,
+	}
+	if cfg.IntervalMs > 0 {
+		hc.interval = time.Duration(cfg.IntervalMs) * time.Millisecond
+	}
+	hc.timeout = hc.interval
+	if cfg.TimeoutMs > 0 {
+		hc.timeout = time.Duration(cfg.TimeoutMs) * time.Millisecond
+	}
+	if cfg.FallThreshold > 0 {
+		hc.fallThreshold = cfg.FallThreshold
+	}
+	if cfg.RiseThreshold > 0 {
+		hc.riseThreshold = cfg.RiseThreshold
+	}
+	return hc
+}
+
+// Run probes hosts until context is done, probe connections are closed on exit
+func (hc *BackgroundHealthChecker) Run(ctx context.Context) {
+	ticker := time.NewTicker(hc.interval)
+	defer ticker.Stop()
+	defer hc.closeConns(func(string) bool { return true })
+
+	for {
+		hc.CheckAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll probes all hosts of the shard mapping concurrently.
+// Every probe is limited by the check timeout, so slow host does not delay the others.
+func (hc *BackgroundHealthChecker) CheckAll(ctx context.Context) {
+	hc.mu.RLock()
+	mapping := hc.mapping
+	hc.mu.RUnlock()
+
+	wg := sync.WaitGroup{}
+	for shardName, shardCfg := range mapping {
+		for _, host := range shardCfg.Hosts {
+			wg.Add(1)
+			go func(shardName string, shardCfg *config.Shard, host string) {
+				defer wg.Done()
+				hc.check(ctx, shardName, shardCfg, host)
+			}(shardName, shardCfg, host)
+		}
+	}
+	wg.Wait()
+}
+
+func (hc *BackgroundHealthChecker) check(ctx context.Context, shardName string, shardCfg *config.Shard, host string) {
+	ctx, cancel := context.WithTimeout(ctx, hc.timeout)
+	defer cancel()
+
+	inRecovery, lag, err := hc.probe(ctx, shardName, shardCfg, host)
+	hc.Report(shardName, host, inRecovery, lag, err)
+}
+
+// probe checks host over its probe connection. Connection is closed
+// after failed probe and is opened again by the next one.
+func (hc *BackgroundHealthChecker) probe(ctx context.Context, shardName string, shardCfg *config.Shard, host string) (bool, time.Duration, error) {
+	sh, err := hc.probeConn(ctx, shardName, shardCfg, host)
+	if err != nil {
+		return false, 0, err
+	}
+	inRecovery, lag, err := CheckHostHealthContext(ctx, sh)
+	if err != nil {
+		hc.connMu.Lock()
+		if hc.conns[host] == sh {
+			delete(hc.conns, host)
+		}
+		hc.connMu.Unlock()
+		_ = sh.Close()
+	}
+	return inRecovery, lag, err
+}
+
+// probeConn returns probe connection of the host, opening it if needed
+func (hc *BackgroundHealthChecker) probeConn(ctx context.Context, shardName string, shardCfg *config.Shard, host string) (shard.Shard, error) {
+	hc.connMu.Lock()
+	sh, ok := hc.conns[host]
+	hc.connMu.Unlock()
+	if ok {
+		return sh, nil
+	}
+
+	sh, err := hc.connect(ctx, shardName, shardCfg, host)
+	if err != nil {
+		return nil, err
+	}
+
+	hc.connMu.Lock()
+	defer hc.connMu.Unlock()
+	hc.mu.RLock()
+	probed := hostInMapping(hc.mapping, host)
+	hc.mu.RUnlock()
+	if !probed {
+		/* host was removed by config reload while connecting */
+		_ = sh.Close()
+		return nil, fmt.Errorf("host %s is not in shard mapping", host)
+	}
+	hc.conns[host] = sh
+	return sh, nil
+}
+
+// SetMapping replaces shard mapping of probed hosts, e.g. on config reload.
+// States and probe connections of hosts, which are not in the new mapping, are dropped.
+func (hc *BackgroundHealthChecker) SetMapping(mapping map[string]*config.Shard) {
+	hc.mu.Lock()
+	hc.mapping = mapping
+	for host := range hc.hosts {
+		if !hostInMapping(mapping, host) {
+			delete(hc.hosts, host)
+		}
+	}
+	hc.mu.Unlock()
+
+	hc.closeConns(func(host string) bool {
+		return !hostInMapping(mapping, host)
+	})
+}
+
+// closeConns closes probe connections of hosts matching the filter
+func (hc *BackgroundHealthChecker) closeConns(filter func(host string) bool) {
+	hc.connMu.Lock()
+	defer hc.connMu.Unlock()
+
+	for host, sh := range hc.conns {
+		if filter(host) {
+			_ = sh.Close()
+			delete(hc.conns, host)
+		}
+	}
+}
+
+func hostInMapping(mapping map[string]*config.Shard, host string) bool {
+	for _, shardCfg := range mapping {
+		for _, h := range shardCfg.Hosts {
+			if h == host {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Report updates host state with the result of a probe
+func (hc *BackgroundHealthChecker) Report(shardName, host string, inRecovery bool, lag time.Duration, err error) {
+	if changed, st := hc.update(shardName, host, inRecovery, lag, err); changed {
+		spqrlog.Zero.Warn().
+			Str("shard", shardName).
+			Str("host", host).
+			Bool("in recovery", st.InRecovery).
+			Msg("health checker: host role changed")
+
+		hc.cbMu.Lock()
+		callbacks := make([]func(st HostState), 0, len(hc.roleCallbacks))
+		for _, cb := range hc.roleCallbacks {
+			callbacks = append(callbacks, cb)
+		}
+		hc.cbMu.Unlock()
+		for _, cb := range callbacks {
+			cb(st)
+		}
+	}
+}
+
+// update records probe result and reports if role of the host has changed
+func (hc *BackgroundHealthChecker) update(shardName, host string, inRecovery bool, lag time.Duration, err error) (bool, HostState) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	st, ok := hc.hosts[host]
+	if !ok {
+		st = &HostState{
+			Shard: shardName,
+			Host:  host,
+			Alive: true,
+		}
+		hc.hosts[host] = st
+	}
+	st.LastCheck = time.Now()
+
+	if err != nil {
+		st.LastError = err.Error()
+		st.successes = 0
+		st.failures++
+		if st.Alive && st.failures >= hc.fallThreshold {
+			st.Alive = false
+			spqrlog.Zero.Warn().
+				Str("shard", shardName).
+				Str("host", host).
+				Err(err).
+				Msg("health checker: host is down")
+		}
+		return false, *st
+	}
+
+	changed := st.roleKnown && st.InRecovery != inRecovery
+	st.roleKnown = true
+	st.LastError = ""
+	st.failures = 0
+	st.successes++
+	st.InRecovery = inRecovery
+	st.ReplicationLag = lag
+	if !st.Alive && st.successes >= hc.riseThreshold {
+		st.Alive = true
+		spqrlog.Zero.Info().
+			Str("shard", shardName).
+			Str("host", host).
+			Msg("health checker: host is up")
+	}
+	return changed, *st
+}
+
+func (hc *BackgroundHealthChecker) OnRoleChange(cb func(st HostState)) func() {
+	hc.cbMu.Lock()
+	defer hc.cbMu.Unlock()
+
+	hc.cbSeq++
+	id := hc.cbSeq
+	hc.roleCallbacks[id] = cb
+
+	return func() {
+		hc.cbMu.Lock()
+		defer hc.cbMu.Unlock()
+		delete(hc.roleCallbacks, id)
+	}
+}
+
+func (hc *BackgroundHealthChecker) HostState(host string) (HostState, bool) {
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+
+	st, ok := hc.hosts[host]
+	if !ok {
+		return HostState{}, false
+	}
+	return *st, true
+}
+
+// Usable reports false for hosts, which are down, and for replicas lagging more
+// than max replica lag, if read-only host is requested.
+// Hosts which were not checked yet are considered usable.
+func (hc *BackgroundHealthChecker) Usable(host string, targetSessionAttrs string) bool {
+	st, ok := hc.HostState(host)
+	if !ok {
+		return true
+	}
+	if !st.Alive {
+		return false
+	}
+	switch targetSessionAttrs {
+	case config.TargetSessionAttrsRO, config.TargetSessionAttrsPS:
+		if st.InRecovery && hc.maxLag > 0 && st.ReplicationLag > hc.maxLag {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckHostHealthContext is CheckHostHealth, which does not wait for the host
+// longer than deadline of the context
+func CheckHostHealthContext(ctx context.Context, sh shard.Shard) (bool, time.Duration, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return CheckHostHealth(sh)
+	}
+	if err := sh.Instance().SetDeadline(deadline); err != nil {
+		return false, 0, err
+	}
+	inRecovery, lag, err := CheckHostHealth(sh)
+	if err != nil {
+		return false, 0, err
+	}
+	return inRecovery, lag, sh.Instance().SetDeadline(time.Time{})
+}
+
+// CheckHostHealth returns recovery status and replication lag of the host
+func CheckHostHealth(sh shard.Shard) (bool, time.Duration, error) {
+	if err := sh.Send(&pgproto3.Query{
+		String: healthCheckQuery,
+	}); err != nil {
+		return false, 0, err
+	}
+
+	inRecovery := false
+	var lag time.Duration
+	gotRow := false
+
+	for {
+		msg, err := sh.Receive()
+		if err != nil {
+			return false, 0, err
+		}
+
+		switch qt := msg.(type) {
+		case *pgproto3.DataRow:
+			if len(qt.Values) != 2 {
+				return false, 0, fmt.Errorf("unexpected health check result %+v", qt.Values)
+			}
+			inRecovery = string(qt.Values[0]) == "t"
+			lagMs, err := strconv.ParseFloat(string(qt.Values[1]), 64)
+			if err != nil {
+				return false, 0, err
+			}
+			lag = time.Duration(lagMs * float64(time.Millisecond))
+			gotRow = true
+		case *pgproto3.ErrorResponse:
+			return false, 0, fmt.Errorf("health check failed: %s", qt.Message)
+		case *pgproto3.ReadyForQuery:
+			if txstatus.TXStatus(qt.TxStatus) != txstatus.TXIDLE {
+				return false, 0, fmt.Errorf("connection unsync while checking health")
+			}
+			if !gotRow {
+				return false, 0, fmt.Errorf("zero datarow recieved")
+			}
+			return inRecovery, lag, nil
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package tsa_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/pg-sharding/spqr/pkg/config"
+	mockinst "github.com/pg-sharding/spqr/pkg/mock/conn"
+	mocksh "github.com/pg-sharding/spqr/pkg/mock/shard"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/pg-sharding/spqr/pkg/tsa"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckHostHealthReplica(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	sh := mocksh.NewMockShard(ctrl)
+	sh.EXPECT().Send(gomock.Any()).Times(1)
+	sh.EXPECT().Receive().Return(&pgproto3.RowDescription{}, nil)
+	sh.EXPECT().Receive().Return(&pgproto3.DataRow{
+		Values: [][]byte{[]byte("t"), []byte("1500.5")},
+	}, nil)
+	sh.EXPECT().Receive().Return(&pgproto3.CommandComplete{}, nil)
+	sh.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXIDLE),
+	}, nil)
+
+	inRecovery, lag, err := tsa.CheckHostHealth(sh)
+
+	assert.NoError(err)
+	assert.True(inRecovery)
+	assert.Equal(1500*time.Millisecond+500*time.Microsecond, lag)
+}
+
+func TestCheckHostHealthError(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	sh := mocksh.NewMockShard(ctrl)
+	sh.EXPECT().Send(gomock.Any()).Times(1)
+	sh.EXPECT().Receive().Return(&pgproto3.ErrorResponse{Message: "boom"}, nil)
+
+	_, _, err := tsa.CheckHostHealth(sh)
+
+	assert.Error(err)
+}
+
+func TestHealthCheckerHysteresis(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := tsa.NewHealthChecker(&config.HostHealthCheck{
+		FallThreshold: 2,
+		RiseThreshold: 2,
+	}, nil, nil)
+
+	assert.True(hc.Usable("h1", config.TargetSessionAttrsRW))
+
+	hc.Report("sh1", "h1", false, 0, fmt.Errorf("connection refused"))
+	assert.True(hc.Usable("h1", config.TargetSessionAttrsRW))
+
+	hc.Report("sh1", "h1", false, 0, fmt.Errorf("connection refused"))
+	assert.False(hc.Usable("h1", config.TargetSessionAttrsRW))
+
+	hc.Report("sh1", "h1", false, 0, nil)
+	assert.False(hc.Usable("h1", config.TargetSessionAttrsRW))
+
+	hc.Report("sh1", "h1", false, 0, nil)
+	assert.True(hc.Usable("h1", config.TargetSessionAttrsRW))
+}
+
+func TestHealthCheckerReplicaLag(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := tsa.NewHealthChecker(&config.HostHealthCheck{
+		MaxReplicaLagMs: 1000,
+	}, nil, nil)
+
+	hc.Report("sh1", "h1", true, 5*time.Second, nil)
+	hc.Report("sh1", "h2", true, 10*time.Millisecond, nil)
+
+	assert.False(hc.Usable("h1", config.TargetSessionAttrsRO))
+	assert.False(hc.Usable("h1", config.TargetSessionAttrsPS))
+	assert.True(hc.Usable("h1", config.TargetSessionAttrsAny))
+	assert.True(hc.Usable("h2", config.TargetSessionAttrsRO))
+
+	st, ok := hc.HostState("h1")
+	assert.True(ok)
+	assert.True(st.InRecovery)
+	assert.Equal(5*time.Second, st.ReplicationLag)
+}
+
+func TestHealthCheckerRoleChange(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := tsa.NewHealthChecker(&config.HostHealthCheck{}, nil, nil)
+
+	changed := []tsa.HostState{}
+	hc.OnRoleChange(func(st tsa.HostState) {
+		changed = append(changed, st)
+	})
+
+	hc.Report("sh1", "h1", false, 0, nil)
+	hc.Report("sh1", "h1", false, 0, fmt.Errorf("connection refused"))
+	hc.Report("sh1", "h1", false, 0, nil)
+	assert.Empty(changed)
+
+	hc.Report("sh1", "h1", true, 0, nil)
+	assert.Len(changed, 1)
+	assert.Equal("h1", changed[0].Host)
+	assert.True(changed[0].InRecovery)
+}
+
+func TestHealthCheckerRoleChangeUnsubscribe(t *testing.T) {
+	assert := assert.New(t)
+
+	hc := tsa.NewHealthChecker(&config.HostHealthCheck{}, nil, nil)
+
+	first, second := 0, 0
+	unsubscribe := hc.OnRoleChange(func(st tsa.HostState) {
+		first++
+	})
+	hc.OnRoleChange(func(st tsa.HostState) {
+		second++
+	})
+
+	hc.Report("sh1", "h1", false, 0, nil)
+	hc.Report("sh1", "h1", true, 0, nil)
+	assert.Equal(1, first)
+	assert.Equal(1, second)
+
+	unsubscribe()
+	// repeated unsubscribe is harmless
+	unsubscribe()
+
+	hc.Report("sh1", "h1", false, 0, nil)
+	assert.Equal(1, first)
+	assert.Equal(2, second)
+}
+
+// newProbeShard makes shard answer health check query given number of times
+func newProbeShard(ctrl *gomock.Controller, probes int) *mocksh.MockShard {
+	ins := mockinst.NewMockDBInstance(ctrl)
+	ins.EXPECT().SetDeadline(gomock.Any()).Return(nil).Times(2 * probes)
+
+	sh := mocksh.NewMockShard(ctrl)
+	sh.EXPECT().Instance().Return(ins).AnyTimes()
+	sh.EXPECT().Send(gomock.Any()).Return(nil).Times(probes)
+	for i := 0; i < probes; i++ {
+		gomock.InOrder(
+			sh.EXPECT().Receive().Return(&pgproto3.DataRow{
+				Values: [][]byte{[]byte("f"), []byte("0")},
+			}, nil),
+			sh.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{
+				TxStatus: byte(txstatus.TXIDLE),
+			}, nil),
+		)
+	}
+	return sh
+}
+
+func TestHealthCheckerKeepsProbeConnection(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	sh := newProbeShard(ctrl, 2)
+	sh.EXPECT().Close().Return(nil).Times(1)
+
+	var connects atomic.Int32
+	hc := tsa.NewHealthChecker(&config.HostHealthCheck{}, map[string]*config.Shard{
+		"sh1": {Hosts: []string{"h1"}},
+	}, func(ctx context.Context, shardName string, shardCfg *config.Shard, host string) (shard.Shard, error) {
+		connects.Add(1)
+		return sh, nil
+	})
+
+	hc.CheckAll(context.Background())
+	hc.CheckAll(context.Background())
+
+	assert.Equal(int32(1), connects.Load())
+	st, ok := hc.HostState("h1")
+	assert.True(ok)
+	assert.True(st.Alive)
+
+	/* probe connection is closed, when host is removed from mapping */
+	hc.SetMapping(map[string]*config.Shard{})
+	_, ok = hc.HostState("h1")
+	assert.False(ok)
+}
+
+func TestHealthCheckerReconnectsAfterFailure(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	ins := mockinst.NewMockDBInstance(ctrl)
+	ins.EXPECT().SetDeadline(gomock.Any()).Return(nil).Times(1)
+	broken := mocksh.NewMockShard(ctrl)
+	broken.EXPECT().Instance().Return(ins).AnyTimes()
+	broken.EXPECT().Send(gomock.Any()).Return(nil).Times(1)
+	broken.EXPECT().Receive().Return(nil, fmt.Errorf("i/o timeout"))
+	broken.EXPECT().Close().Return(nil).Times(1)
+
+	healthy := newProbeShard(ctrl, 1)
+
+	conns := []shard.Shard{broken, healthy}
+	hc := tsa.NewHealthChecker(&config.HostHealthCheck{}, map[string]*config.Shard{
+		"sh1": {Hosts: []string{"h1"}},
+	}, func(ctx context.Context, shardName string, shardCfg *config.Shard, host string) (shard.Shard, error) {
+		sh := conns[0]
+		conns = conns[1:]
+		return sh, nil
+	})
+
+	hc.CheckAll(context.Background())
+	st, _ := hc.HostState("h1")
+	assert.Equal("i/o timeout", st.LastError)
+
+	hc.CheckAll(context.Background())
+	st, _ = hc.HostState("h1")
+	assert.Equal("", st.LastError)
+	assert.Empty(conns)
+}
+
+func TestHealthCheckerSlowHost(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	fast := newProbeShard(ctrl, 1)
+
+	hc := tsa.NewHealthChecker(&config.HostHealthCheck{
+		TimeoutMs: 50,
+	}, map[string]*config.Shard{
+		"sh1": {Hosts: []string{"slow", "fast"}},
+	}, func(ctx context.Context, shardName string, shardCfg *config.Shard, host string) (shard.Shard, error) {
+		if host == "slow" {
+			/* host does not answer, connecting is cut by the probe timeout */
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return fast, nil
+	})
+
+	start := time.Now()
+	hc.CheckAll(context.Background())
+	assert.Less(time.Since(start), time.Second)
+
+	st, ok := hc.HostState("slow")
+	assert.True(ok)
+	assert.Equal(context.DeadlineExceeded.Error(), st.LastError)
+
+	st, ok = hc.HostState("fast")
+	assert.True(ok)
+	assert.Equal("", st.LastError)
+}
@@ -0,0 +1,96 @@
+package tsa
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+)
+
+// LSN is position in write-ahead log
+type LSN uint64
+
+// ParseLSN parses textual representation of pg_lsn, e.g. 16/B374D848
+func ParseLSN(s string) (LSN, error) {
+	hi, lo, ok := strings.Cut(s, "/")
+	if !ok {
+		return 0, fmt.Errorf("invalid lsn \"%s\"", s)
+	}
+	h, err := strconv.ParseUint(hi, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid lsn \"%s\": %w", s, err)
+	}
+	l, err := strconv.ParseUint(lo, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid lsn \"%s\": %w", s, err)
+	}
+	return LSN(h<<32 | l), nil
+}
+
+func (l LSN) String() string {
+	return fmt.Sprintf("%X/%X", uint64(l)>>32, uint64(l)&0xFFFFFFFF)
+}
+
+// CurrentWalLSN returns current WAL write position of primary
+func CurrentWalLSN(sh shard.Shard) (LSN, error) {
+	lsn, ok, err := queryLSN(sh, "SELECT pg_current_wal_lsn()")
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("host %s returned no wal position", sh.Instance().Hostname())
+	}
+	return lsn, nil
+}
+
+// ReplayLSN returns WAL position replayed by replica.
+// ok is false, if host is not in recovery.
+func ReplayLSN(sh shard.Shard) (LSN, bool, error) {
+	return queryLSN(sh, "SELECT pg_last_wal_replay_lsn()")
+}
+
+// queryLSN runs query returning single pg_lsn value, ok is false for NULL result
+func queryLSN(sh shard.Shard, query string) (LSN, bool, error) {
+	if err := sh.Send(&pgproto3.Query{
+		String: query,
+	}); err != nil {
+		return 0, false, err
+	}
+
+	var lsn LSN
+	ok := false
+	var qErr error
+
+	for {
+		msg, err := sh.Receive()
+		if err != nil {
+			return 0, false, err
+		}
+
+		switch v := msg.(type) {
+		case *pgproto3.DataRow:
+			if len(v.Values) != 1 {
+				qErr = fmt.Errorf("unexpected lsn row %+v", v.Values)
+				continue
+			}
+			if v.Values[0] == nil {
+				continue
+			}
+			lsn, qErr = ParseLSN(string(v.Values[0]))
+			ok = qErr == nil
+		case *pgproto3.ErrorResponse:
+			qErr = fmt.Errorf("failed to get lsn: %s", v.Message)
+		case *pgproto3.ReadyForQuery:
+			if txstatus.TXStatus(v.TxStatus) != txstatus.TXIDLE {
+				return 0, false, fmt.Errorf("connection unsync while getting lsn")
+			}
+			if qErr != nil {
+				return 0, false, qErr
+			}
+			return lsn, ok, nil
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package tsa_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgproto3"
+	mocksh "github.com/pg-sharding/spqr/pkg/mock/shard"
+	"github.com/pg-sharding/spqr/pkg/tsa"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLSN(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tt := range []struct {
+		lsn string
+		exp tsa.LSN
+		err bool
+	}{
+		{lsn: "0/0", exp: 0},
+		{lsn: "16/B374D848", exp: tsa.LSN(0x16B374D848)},
+		{lsn: "FFFFFFFF/FFFFFFFF", exp: tsa.LSN(0xFFFFFFFFFFFFFFFF)},
+		{lsn: "16B374D848", err: true},
+		{lsn: "1/XYZ", err: true},
+		{lsn: "100000000/0", err: true},
+	} {
+		lsn, err := tsa.ParseLSN(tt.lsn)
+		if tt.err {
+			assert.Error(err, tt.lsn)
+			continue
+		}
+		assert.NoError(err, tt.lsn)
+		assert.Equal(tt.exp, lsn, tt.lsn)
+		assert.Equal(tt.lsn, lsn.String())
+	}
+
+	a, _ := tsa.ParseLSN("1/FFFFFFFF")
+	b, _ := tsa.ParseLSN("2/0")
+	assert.Less(a, b)
+}
+
+func TestReplayLSN(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	sh := mocksh.NewMockShard(ctrl)
+
+	sh.EXPECT().Send(&pgproto3.Query{String: "SELECT pg_last_wal_replay_lsn()"}).Times(2)
+	gomock.InOrder(
+		sh.EXPECT().Receive().Return(&pgproto3.RowDescription{}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.DataRow{Values: [][]byte{[]byte("0/3000060")}}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.CommandComplete{}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: byte(txstatus.TXIDLE)}, nil),
+		/* primary is not in recovery */
+		sh.EXPECT().Receive().Return(&pgproto3.RowDescription{}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.DataRow{Values: [][]byte{nil}}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.CommandComplete{}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: byte(txstatus.TXIDLE)}, nil),
+	)
+
+	lsn, ok, err := tsa.ReplayLSN(sh)
+	assert.NoError(err)
+	assert.True(ok)
+	assert.Equal(tsa.LSN(0x3000060), lsn)
+
+	_, ok, err = tsa.ReplayLSN(sh)
+	assert.NoError(err)
+	assert.False(ok)
+}
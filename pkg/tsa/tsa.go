@@ -13,6 +13,8 @@ import (
 
 type TSAChecker interface {
 	CheckTSA(sh shard.Shard) (bool, string, error)
+	// Invalidate drops cached result for the host
+	Invalidate(host string)
 }
 
 type CacheEntry struct {
@@ -54,6 +56,13 @@ func (ctsa *CachedTSAChecker) CheckTSA(sh shard.Shard) (bool, string, error) {
 	return res, comment, nil
 }
 
+func (ctsa *CachedTSAChecker) Invalidate(host string) {
+	ctsa.mu.Lock()
+	defer ctsa.mu.Unlock()
+
+	delete(ctsa.cache, host)
+}
+
 /* target session attr utility */
 
 func CheckTSA(sh shard.Shard) (bool, string, error) {
@@ -7,6 +7,7 @@ import (
 	"net"
 	"path"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -58,6 +59,10 @@ const (
 	relationMappingNamespace = "/relation_mappings/"
 	taskGroupPath            = "/move_task_group"
 	transactionNamespace     = "/transfer_txs/"
+	operationsNamespace      = "/operations/"
+	sequenceNamespace        = "/sequences/"
+	sequenceValueNamespace   = "/sequence_values/"
+	schemaChangesNamespace   = "/schema_changes/"
 
 	CoordKeepAliveTtl = 3
 	keyspace          = "key_space"
@@ -100,6 +105,23 @@ func transferTxNodePath(key string) string {
 	return path.Join(transactionNamespace, key)
 }
 
+func operationNodePath(key string) string {
+	return path.Join(operationsNamespace, key)
+}
+
+func sequenceNodePath(key string) string {
+	return path.Join(sequenceNamespace, key)
+}
+
+func sequenceValueNodePath(key string) string {
+	return path.Join(sequenceValueNamespace, key)
+}
+
+// schemaChangeNodePath pads change number, so keys are sorted in order of changes
+func schemaChangeNodePath(id int64) string {
+	return path.Join(schemaChangesNamespace, fmt.Sprintf("%020d", id))
+}
+
 // ==============================================================================
 //                                 KEY RANGES
 // ==============================================================================
@@ -851,9 +873,10 @@ func (q *EtcdQDB) GetShard(ctx context.Context, id string) (*Shard, error) {
 		ID: id,
 	}
 
-	for _, shard := range resp.Kvs {
-		// The Port field is always for a while.
-		shardInfo.Hosts = append(shardInfo.Hosts, string(shard.Value))
+	for _, kv := range resp.Kvs {
+		if err := json.Unmarshal(kv.Value, shardInfo); err != nil {
+			return nil, err
+		}
 	}
 
 	return shardInfo, nil
@@ -1234,3 +1257,286 @@ func (q *EtcdQDB) DeleteKeyRangeMove(ctx context.Context, moveId string) error {
 
 	return err
 }
+
+// ==============================================================================
+//                                 OPERATIONS
+// ==============================================================================
+
+// TODO : unit tests
+func (q *EtcdQDB) RecordOperation(ctx context.Context, op *Operation) error {
+	spqrlog.Zero.Debug().
+		Str("id", op.ID).
+		Str("status", string(op.Status)).
+		Msg("etcdqdb: record operation")
+
+	rawOperation, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	resp, err := q.cli.Put(ctx, operationNodePath(op.ID), string(rawOperation))
+	if err != nil {
+		return err
+	}
+
+	spqrlog.Zero.Debug().
+		Interface("response", resp).
+		Msg("etcdqdb: record operation")
+
+	return nil
+}
+
+// TODO : unit tests
+func (q *EtcdQDB) GetOperation(ctx context.Context, id string) (*Operation, error) {
+	spqrlog.Zero.Debug().
+		Str("id", id).
+		Msg("etcdqdb: get operation")
+
+	resp, err := q.cli.Get(ctx, operationNodePath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Kvs) == 0 {
+		return nil, spqrerror.Newf(spqrerror.SPQR_OPERATION_ERROR, "no operation with id %s", id)
+	}
+
+	var op *Operation
+	if err := json.Unmarshal(resp.Kvs[0].Value, &op); err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// TODO : unit tests
+func (q *EtcdQDB) ListOperations(ctx context.Context) ([]*Operation, error) {
+	spqrlog.Zero.Debug().
+		Msg("etcdqdb: list operations")
+
+	resp, err := q.cli.Get(ctx, operationsNamespace, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]*Operation, 0, len(resp.Kvs))
+
+	for _, kv := range resp.Kvs {
+		var op *Operation
+		if err := json.Unmarshal(kv.Value, &op); err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, op)
+	}
+
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].StartedAt < ops[j].StartedAt
+	})
+
+	return ops, nil
+}
+
+// TODO : unit tests
+func (q *EtcdQDB) DeleteOperation(ctx context.Context, id string) error {
+	spqrlog.Zero.Debug().
+		Str("id", id).
+		Msg("etcdqdb: delete operation")
+
+	_, err := q.cli.Delete(ctx, operationNodePath(id))
+	return err
+}
+
+// ==============================================================================
+//                                 SEQUENCES
+// ==============================================================================
+
+// TODO : unit tests
+func (q *EtcdQDB) CreateSequence(ctx context.Context, seq *Sequence) error {
+	spqrlog.Zero.Debug().
+		Str("name", seq.Name).
+		Msg("etcdqdb: create sequence")
+
+	rawSeq, err := json.Marshal(seq)
+	if err != nil {
+		return err
+	}
+
+	resp, err := q.cli.Put(ctx, sequenceNodePath(seq.Name), string(rawSeq))
+	if err != nil {
+		return err
+	}
+
+	spqrlog.Zero.Debug().
+		Interface("response", resp).
+		Msg("etcdqdb: create sequence")
+
+	return nil
+}
+
+// TODO : unit tests
+func (q *EtcdQDB) ListSequences(ctx context.Context) ([]*Sequence, error) {
+	spqrlog.Zero.Debug().
+		Msg("etcdqdb: list sequences")
+
+	resp, err := q.cli.Get(ctx, sequenceNamespace, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	seqs := make([]*Sequence, 0, len(resp.Kvs))
+
+	for _, kv := range resp.Kvs {
+		var seq *Sequence
+		if err := json.Unmarshal(kv.Value, &seq); err != nil {
+			return nil, err
+		}
+
+		seqs = append(seqs, seq)
+	}
+
+	sort.Slice(seqs, func(i, j int) bool {
+		return seqs[i].Name < seqs[j].Name
+	})
+
+	return seqs, nil
+}
+
+// DropSequence drops sequence definition. Its value is kept, so sequence,
+// created again with the same name, never hands out issued values.
+// TODO : unit tests
+func (q *EtcdQDB) DropSequence(ctx context.Context, name string) error {
+	spqrlog.Zero.Debug().
+		Str("name", name).
+		Msg("etcdqdb: drop sequence")
+
+	resp, err := q.cli.Delete(ctx, sequenceNodePath(name))
+	if err != nil {
+		return err
+	}
+	if resp.Deleted == 0 {
+		return spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "sequence %s not found", name)
+	}
+
+	return nil
+}
+
+// NextSequenceRange increments sequence value with compare-and-swap,
+// so concurrent reservations never overlap.
+// TODO : unit tests
+func (q *EtcdQDB) NextSequenceRange(ctx context.Context, name string, count int64) (int64, error) {
+	spqrlog.Zero.Debug().
+		Str("name", name).
+		Int64("count", count).
+		Msg("etcdqdb: next sequence range")
+
+	if count <= 0 {
+		return 0, spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "invalid number of sequence values %d", count)
+	}
+
+	key := sequenceValueNodePath(name)
+	for {
+		resp, err := q.cli.Txn(ctx).Then(
+			clientv3.OpGet(sequenceNodePath(name)),
+			clientv3.OpGet(key),
+		).Commit()
+		if err != nil {
+			return 0, err
+		}
+		if len(resp.Responses[0].GetResponseRange().Kvs) == 0 {
+			return 0, spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "sequence %s not found", name)
+		}
+
+		var last int64
+		cmp := clientv3util.KeyMissing(key)
+		if kvs := resp.Responses[1].GetResponseRange().Kvs; len(kvs) != 0 {
+			if last, err = strconv.ParseInt(string(kvs[0].Value), 10, 64); err != nil {
+				return 0, err
+			}
+			cmp = clientv3.Compare(clientv3.ModRevision(key), "=", kvs[0].ModRevision)
+		}
+
+		txResp, err := q.cli.Txn(ctx).If(cmp).Then(
+			clientv3.OpPut(key, strconv.FormatInt(last+count, 10)),
+		).Commit()
+		if err != nil {
+			return 0, err
+		}
+		if txResp.Succeeded {
+			return last + 1, nil
+		}
+		/* somebody else reserved values concurrently, retry */
+	}
+}
+
+// ==============================================================================
+//                                SCHEMA CHANGES
+// ==============================================================================
+
+// AppendSchemaChange puts change after the last one with compare-and-swap,
+// so concurrent changes never get the same number.
+// TODO : unit tests
+func (q *EtcdQDB) AppendSchemaChange(ctx context.Context, query string) (*SchemaChange, error) {
+	spqrlog.Zero.Debug().
+		Str("query", query).
+		Msg("etcdqdb: append schema change")
+
+	for {
+		resp, err := q.cli.Get(ctx, schemaChangesNamespace, clientv3.WithPrefix(),
+			clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend), clientv3.WithLimit(1))
+		if err != nil {
+			return nil, err
+		}
+
+		change := &SchemaChange{
+			ID:    1,
+			Query: query,
+		}
+		if len(resp.Kvs) != 0 {
+			var last *SchemaChange
+			if err := json.Unmarshal(resp.Kvs[0].Value, &last); err != nil {
+				return nil, err
+			}
+			change.ID = last.ID + 1
+		}
+
+		changeJson, err := json.Marshal(change)
+		if err != nil {
+			return nil, err
+		}
+
+		key := schemaChangeNodePath(change.ID)
+		txResp, err := q.cli.Txn(ctx).If(clientv3util.KeyMissing(key)).Then(
+			clientv3.OpPut(key, string(changeJson)),
+		).Commit()
+		if err != nil {
+			return nil, err
+		}
+		if txResp.Succeeded {
+			return change, nil
+		}
+		/* somebody else appended change concurrently, retry */
+	}
+}
+
+// TODO : unit tests
+func (q *EtcdQDB) ListSchemaChanges(ctx context.Context) ([]*SchemaChange, error) {
+	spqrlog.Zero.Debug().
+		Msg("etcdqdb: list schema changes")
+
+	resp, err := q.cli.Get(ctx, schemaChangesNamespace, clientv3.WithPrefix(),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*SchemaChange, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var change *SchemaChange
+		if err := json.Unmarshal(kv.Value, &change); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
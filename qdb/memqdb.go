@@ -27,6 +27,10 @@ type MemQDB struct {
 	Transactions         map[string]*DataTransferTransaction `json:"transactions"`
 	Coordinator          string                              `json:"coordinator"`
 	TaskGroup            *TaskGroup                          `json:"taskGroup"`
+	Operations           map[string]*Operation               `json:"operations"`
+	Sequences            map[string]*Sequence                `json:"sequences"`
+	SequenceValues       map[string]int64                    `json:"sequence_values"`
+	SchemaChanges        []*SchemaChange                     `json:"schema_changes"`
 
 	backupPath string
 	/* caches */
@@ -44,6 +48,9 @@ func NewMemQDB(backupPath string) (*MemQDB, error) {
 		RelationDistribution: map[string]string{},
 		Routers:              map[string]*Router{},
 		Transactions:         map[string]*DataTransferTransaction{},
+		Operations:           map[string]*Operation{},
+		Sequences:            map[string]*Sequence{},
+		SequenceValues:       map[string]int64{},
 
 		backupPath: backupPath,
 	}, nil
@@ -552,8 +559,8 @@ func (q *MemQDB) GetShard(_ context.Context, id string) (*Shard, error) {
 	q.mu.RLock()
 	defer q.mu.RUnlock()
 
-	if _, ok := q.Shards[id]; ok {
-		return &Shard{ID: id}, nil
+	if sh, ok := q.Shards[id]; ok {
+		return &Shard{ID: id, Hosts: sh.Hosts, Draining: sh.Draining}, nil
 	}
 
 	return nil, spqrerror.Newf(spqrerror.SPQR_NO_DATASHARD, "unknown shard %s", id)
@@ -734,3 +741,153 @@ func (q *MemQDB) RemoveTaskGroup(_ context.Context) error {
 	q.TaskGroup = nil
 	return nil
 }
+
+// ==============================================================================
+//                                 OPERATIONS
+// ==============================================================================
+
+// TODO : unit tests
+func (q *MemQDB) RecordOperation(_ context.Context, op *Operation) error {
+	spqrlog.Zero.Debug().Str("id", op.ID).Str("status", string(op.Status)).Msg("memqdb: record operation")
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return ExecuteCommands(q.DumpState, NewUpdateCommand(q.Operations, op.ID, op))
+}
+
+// TODO : unit tests
+func (q *MemQDB) GetOperation(_ context.Context, id string) (*Operation, error) {
+	spqrlog.Zero.Debug().Str("id", id).Msg("memqdb: get operation")
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	op, ok := q.Operations[id]
+	if !ok {
+		return nil, spqrerror.Newf(spqrerror.SPQR_OPERATION_ERROR, "no operation with id %s", id)
+	}
+	return op, nil
+}
+
+// TODO : unit tests
+func (q *MemQDB) ListOperations(_ context.Context) ([]*Operation, error) {
+	spqrlog.Zero.Debug().Msg("memqdb: list operations")
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	ops := make([]*Operation, 0, len(q.Operations))
+	for _, op := range q.Operations {
+		ops = append(ops, op)
+	}
+
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].StartedAt < ops[j].StartedAt
+	})
+
+	return ops, nil
+}
+
+// TODO : unit tests
+func (q *MemQDB) DeleteOperation(_ context.Context, id string) error {
+	spqrlog.Zero.Debug().Str("id", id).Msg("memqdb: delete operation")
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return ExecuteCommands(q.DumpState, NewDeleteCommand(q.Operations, id))
+}
+
+// ==============================================================================
+//                                 SEQUENCES
+// ==============================================================================
+
+// TODO : unit tests
+func (q *MemQDB) CreateSequence(_ context.Context, seq *Sequence) error {
+	spqrlog.Zero.Debug().Str("name", seq.Name).Msg("memqdb: create sequence")
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return ExecuteCommands(q.DumpState, NewUpdateCommand(q.Sequences, seq.Name, seq))
+}
+
+// TODO : unit tests
+func (q *MemQDB) ListSequences(_ context.Context) ([]*Sequence, error) {
+	spqrlog.Zero.Debug().Msg("memqdb: list sequences")
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	ret := make([]*Sequence, 0, len(q.Sequences))
+	for _, seq := range q.Sequences {
+		ret = append(ret, seq)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+
+	return ret, nil
+}
+
+// DropSequence drops sequence definition. Its value is kept, so sequence,
+// created again with the same name, never hands out issued values.
+func (q *MemQDB) DropSequence(_ context.Context, name string) error {
+	spqrlog.Zero.Debug().Str("name", name).Msg("memqdb: drop sequence")
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.Sequences[name]; !ok {
+		return spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "sequence %s not found", name)
+	}
+
+	return ExecuteCommands(q.DumpState, NewDeleteCommand(q.Sequences, name))
+}
+
+func (q *MemQDB) NextSequenceRange(_ context.Context, name string, count int64) (int64, error) {
+	spqrlog.Zero.Debug().Str("name", name).Int64("count", count).Msg("memqdb: next sequence range")
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.Sequences[name]; !ok {
+		return 0, spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "sequence %s not found", name)
+	}
+	if count <= 0 {
+		return 0, spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "invalid number of sequence values %d", count)
+	}
+
+	first := q.SequenceValues[name] + 1
+	if err := ExecuteCommands(q.DumpState, NewUpdateCommand(q.SequenceValues, name, first+count-1)); err != nil {
+		return 0, err
+	}
+	return first, nil
+}
+
+// ==============================================================================
+//                                SCHEMA CHANGES
+// ==============================================================================
+
+// TODO : unit tests
+func (q *MemQDB) AppendSchemaChange(_ context.Context, query string) (*SchemaChange, error) {
+	spqrlog.Zero.Debug().Str("query", query).Msg("memqdb: append schema change")
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	change := &SchemaChange{
+		ID:    int64(len(q.SchemaChanges)) + 1,
+		Query: query,
+	}
+	q.SchemaChanges = append(q.SchemaChanges, change)
+	if err := q.DumpState(); err != nil {
+		q.SchemaChanges = q.SchemaChanges[:len(q.SchemaChanges)-1]
+		return nil, err
+	}
+	return change, nil
+}
+
+// TODO : unit tests
+func (q *MemQDB) ListSchemaChanges(_ context.Context) ([]*SchemaChange, error) {
+	spqrlog.Zero.Debug().Msg("memqdb: list schema changes")
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	ret := make([]*SchemaChange, len(q.SchemaChanges))
+	copy(ret, q.SchemaChanges)
+	return ret, nil
+}
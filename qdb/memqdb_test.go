@@ -205,3 +205,128 @@ func TestKeyRanges(t *testing.T) {
 	}))
 
 }
+
+func TestOperations(t *testing.T) {
+
+	assert := assert.New(t)
+
+	memqdb, err := qdb.RestoreQDB(MemQDBPath)
+	assert.NoError(err)
+
+	ctx := context.TODO()
+
+	_, err = memqdb.GetOperation(ctx, "op1")
+	assert.Error(err)
+
+	assert.NoError(memqdb.RecordOperation(ctx, &qdb.Operation{
+		ID:        "op2",
+		Kind:      "move",
+		Status:    qdb.OperationPlanned,
+		StartedAt: 2,
+	}))
+	assert.NoError(memqdb.RecordOperation(ctx, &qdb.Operation{
+		ID:        "op1",
+		Kind:      "split",
+		Status:    qdb.OperationPlanned,
+		StartedAt: 1,
+	}))
+	assert.NoError(memqdb.RecordOperation(ctx, &qdb.Operation{
+		ID:         "op1",
+		Kind:       "split",
+		Status:     qdb.OperationDone,
+		Progress:   100,
+		StartedAt:  1,
+		FinishedAt: 3,
+	}))
+
+	op, err := memqdb.GetOperation(ctx, "op1")
+	assert.NoError(err)
+	assert.Equal(qdb.OperationDone, op.Status)
+
+	ops, err := memqdb.ListOperations(ctx)
+	assert.NoError(err)
+	assert.Equal(2, len(ops))
+	assert.Equal("op1", ops[0].ID)
+	assert.Equal("op2", ops[1].ID)
+}
+
+func TestSequences(t *testing.T) {
+
+	assert := assert.New(t)
+
+	memqdb, err := qdb.RestoreQDB(MemQDBPath)
+	assert.NoError(err)
+
+	ctx := context.TODO()
+
+	_, err = memqdb.NextSequenceRange(ctx, "seq", 10)
+	assert.Error(err)
+
+	assert.NoError(memqdb.CreateSequence(ctx, &qdb.Sequence{
+		Name:         "seq",
+		RelationName: "orders",
+		ColumnName:   "id",
+	}))
+
+	first, err := memqdb.NextSequenceRange(ctx, "seq", 10)
+	assert.NoError(err)
+	assert.Equal(int64(1), first)
+
+	first, err = memqdb.NextSequenceRange(ctx, "seq", 5)
+	assert.NoError(err)
+	assert.Equal(int64(11), first)
+
+	/* definition update does not reset the value */
+	assert.NoError(memqdb.CreateSequence(ctx, &qdb.Sequence{
+		Name: "seq",
+	}))
+	first, err = memqdb.NextSequenceRange(ctx, "seq", 1)
+	assert.NoError(err)
+	assert.Equal(int64(16), first)
+
+	seqs, err := memqdb.ListSequences(ctx)
+	assert.NoError(err)
+	assert.Equal([]*qdb.Sequence{{Name: "seq"}}, seqs)
+
+	assert.NoError(memqdb.DropSequence(ctx, "seq"))
+	assert.Error(memqdb.DropSequence(ctx, "seq"))
+	_, err = memqdb.NextSequenceRange(ctx, "seq", 1)
+	assert.Error(err)
+
+	/* values of dropped sequence are not handed out again */
+	assert.NoError(memqdb.CreateSequence(ctx, &qdb.Sequence{
+		Name: "seq",
+	}))
+	first, err = memqdb.NextSequenceRange(ctx, "seq", 1)
+	assert.NoError(err)
+	assert.Equal(int64(17), first)
+}
+
+func TestSchemaChanges(t *testing.T) {
+
+	assert := assert.New(t)
+
+	memqdb, err := qdb.NewMemQDB(MemQDBPath)
+	assert.NoError(err)
+
+	ctx := context.TODO()
+
+	changes, err := memqdb.ListSchemaChanges(ctx)
+	assert.NoError(err)
+	assert.Empty(changes)
+
+	change, err := memqdb.AppendSchemaChange(ctx, "CREATE TABLE orders (id int)")
+	assert.NoError(err)
+	assert.Equal(&qdb.SchemaChange{ID: 1, Query: "CREATE TABLE orders (id int)"}, change)
+
+	change, err = memqdb.AppendSchemaChange(ctx, "CREATE INDEX ON orders (id)")
+	assert.NoError(err)
+	assert.Equal(int64(2), change.ID)
+
+	changes, err = memqdb.ListSchemaChanges(ctx)
+	assert.NoError(err)
+	assert.Equal([]*qdb.SchemaChange{
+		{ID: 1, Query: "CREATE TABLE orders (id int)"},
+		{ID: 2, Query: "CREATE INDEX ON orders (id)"},
+	}, changes)
+}
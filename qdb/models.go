@@ -59,6 +59,8 @@ func (r Router) Addr() string {
 type Shard struct {
 	ID    string   `json:"id"`
 	Hosts []string `json:"hosts"`
+	// Draining shard does not accept new key ranges
+	Draining bool `json:"draining"`
 }
 
 func NewShard(ID string, hosts []string) *Shard {
@@ -77,6 +79,7 @@ var (
 type DistributionKeyEntry struct {
 	Column       string `json:"column"`
 	HashFunction string `json:"hash"`
+	Expression   string `json:"expression,omitempty"`
 }
 
 type DistributedRelation struct {
@@ -109,9 +112,47 @@ type Task struct {
 	Bound       []byte `json:"bound"`
 	KrIdTemp    string `json:"kr_id_temp"`
 	State       int    `json:"state"`
+	JoinType    int    `json:"join_type"`
 }
 
 type TaskGroup struct {
 	Tasks    []*Task `json:"tasks"`
 	JoinType int     `json:"join_type"`
 }
+
+type OperationStatus string
+
+const (
+	OperationPlanned   = OperationStatus("PLANNED")
+	OperationRunning   = OperationStatus("RUNNING")
+	OperationDone      = OperationStatus("DONE")
+	OperationFailed    = OperationStatus("FAILED")
+	OperationCancelled = OperationStatus("CANCELLED")
+)
+
+type Operation struct {
+	ID          string          `json:"id"`
+	Kind        string          `json:"kind"`
+	Description string          `json:"description"`
+	Status      OperationStatus `json:"status"`
+	Progress    int32           `json:"progress"`
+	StartedAt   int64           `json:"started_at,omitempty"`
+	FinishedAt  int64           `json:"finished_at,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	KeyRangeIDs []string        `json:"key_range_ids,omitempty"`
+	Steps       int32           `json:"steps,omitempty"`
+}
+
+type Sequence struct {
+	Name string `json:"name"`
+	// relation column, filled by sequence values when omitted in INSERT
+	RelationName string `json:"relation_name,omitempty"`
+	ColumnName   string `json:"column_name,omitempty"`
+}
+
+// SchemaChange is DDL statement, applied on all shards
+type SchemaChange struct {
+	// number of change in schema history, starting from 1
+	ID    int64  `json:"id"`
+	Query string `json:"query"`
+}
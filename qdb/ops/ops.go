@@ -10,8 +10,10 @@ import (
 
 // TODO : unit tests
 func CreateKeyRangeWithChecks(ctx context.Context, qdb qdb.QDB, keyRange *kr.KeyRange) error {
-	if _, err := qdb.GetShard(ctx, keyRange.ShardID); err != nil {
+	if sh, err := qdb.GetShard(ctx, keyRange.ShardID); err != nil {
 		return err
+	} else if sh.Draining {
+		return spqrerror.Newf(spqrerror.SPQR_KEYRANGE_ERROR, "shard %s is draining and does not accept key ranges", keyRange.ShardID)
 	}
 
 	if _, err := qdb.GetKeyRange(ctx, keyRange.ID); err == nil {
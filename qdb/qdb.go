@@ -18,6 +18,15 @@ type ShardingSchemaKeeper interface {
 	DeleteKeyRangeMove(ctx context.Context, moveId string) error
 }
 
+// Keep track of asynchronous operations, started by the coordinator.
+type OperationKeeper interface {
+	// RecordOperation creates or overwrites operation state
+	RecordOperation(ctx context.Context, op *Operation) error
+	GetOperation(ctx context.Context, id string) (*Operation, error)
+	ListOperations(ctx context.Context) ([]*Operation, error)
+	DeleteOperation(ctx context.Context, id string) error
+}
+
 type TopolodyKeeper interface {
 	AddRouter(ctx context.Context, r *Router) error
 	DeleteRouter(ctx context.Context, rID string) error
@@ -81,6 +90,17 @@ type QDB interface {
 
 	UpdateCoordinator(ctx context.Context, address string) error
 	GetCoordinator(ctx context.Context) (string, error)
+
+	CreateSequence(ctx context.Context, seq *Sequence) error
+	ListSequences(ctx context.Context) ([]*Sequence, error)
+	DropSequence(ctx context.Context, name string) error
+	// NextSequenceRange reserves count consecutive values of sequence
+	// and returns the first of them. Values start from 1.
+	NextSequenceRange(ctx context.Context, name string, count int64) (int64, error)
+
+	// AppendSchemaChange adds DDL statement to the end of schema history
+	AppendSchemaChange(ctx context.Context, query string) (*SchemaChange, error)
+	ListSchemaChanges(ctx context.Context) ([]*SchemaChange, error)
 }
 
 // XQDB means extended QDB
@@ -93,6 +113,8 @@ type XQDB interface {
 	// data move state
 	ShardingSchemaKeeper
 	DistributedXactKepper
+	// asynchronous operations state
+	OperationKeeper
 
 	TryCoordinatorLock(ctx context.Context) error
 }
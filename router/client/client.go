@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/rand"
 	"sync"
+	"time"
 
 	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 
@@ -32,6 +33,8 @@ var NotRouted = fmt.Errorf("client not routed")
 type PreparedStatementMapper interface {
 	PreparedStatementQueryByName(name string) string
 	StorePreparedStatement(name, query string)
+	DeallocatePreparedStatement(name string)
+	DeallocateAllPreparedStatements()
 }
 
 type RouterClient interface {
@@ -64,6 +67,9 @@ type RouterClient interface {
 
 	CancelMsg() *pgproto3.CancelRequest
 
+	/* deadline of client message receive, zero time disables it */
+	SetReceiveDeadline(t time.Time) error
+
 	ReplyParseComplete() error
 	ReplyBindComplete() error
 	ReplyCommandComplete(commandTag string) error
@@ -160,6 +166,34 @@ func (cl *PsqlClient) SetDefaultRouteBehaviour(b string) {
 	cl.internalParamSet[session.SPQR_DEFAULT_ROUTE_BEHAVIOUR] = b
 }
 
+// ReadYourWrites implements RouterClient.
+func (cl *PsqlClient) ReadYourWrites() bool {
+	return cl.internalParamSet[session.SPQR_READ_YOUR_WRITES] == "on"
+}
+
+// SetReadYourWrites implements RouterClient.
+func (cl *PsqlClient) SetReadYourWrites(ryw bool) {
+	if ryw {
+		cl.internalParamSet[session.SPQR_READ_YOUR_WRITES] = "on"
+	} else {
+		cl.internalParamSet[session.SPQR_READ_YOUR_WRITES] = "off"
+	}
+}
+
+// ConsistentSnapshot implements RouterClient.
+func (cl *PsqlClient) ConsistentSnapshot() bool {
+	return cl.internalParamSet[session.SPQR_CONSISTENT_SNAPSHOT] == "on"
+}
+
+// SetConsistentSnapshot implements RouterClient.
+func (cl *PsqlClient) SetConsistentSnapshot(snapshot bool) {
+	if snapshot {
+		cl.internalParamSet[session.SPQR_CONSISTENT_SNAPSHOT] = "on"
+	} else {
+		cl.internalParamSet[session.SPQR_CONSISTENT_SNAPSHOT] = "off"
+	}
+}
+
 // TODO : implement, unit tests
 // ReceiveCtx implements RouterClient.
 func (*PsqlClient) ReceiveCtx(ctx context.Context) (pgproto3.FrontendMessage, error) {
@@ -304,6 +338,14 @@ func (cl *PsqlClient) StorePreparedStatement(name, query string) {
 	cl.prepStmts[name] = query
 }
 
+func (cl *PsqlClient) DeallocatePreparedStatement(name string) {
+	delete(cl.prepStmts, name)
+}
+
+func (cl *PsqlClient) DeallocateAllPreparedStatements() {
+	cl.prepStmts = map[string]string{}
+}
+
 func (cl *PsqlClient) PreparedStatementQueryByName(name string) string {
 	if v, ok := cl.prepStmts[name]; ok {
 		return v
@@ -510,10 +552,11 @@ func (cl *PsqlClient) Unroute() error {
 	return nil
 }
 
-/* This method can be called concurrently with Unroute() */
+/* This method can be called concurrently with Unroute() and with running query,
+* which holds read lock of client */
 func (cl *PsqlClient) Cancel() error {
-	cl.mu.Lock()
-	defer cl.mu.Unlock()
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
 
 	if cl.server == nil {
 		/* TBD: raise error here sometimes? */
@@ -802,6 +845,10 @@ func (cl *PsqlClient) Receive() (pgproto3.FrontendMessage, error) {
 	return msg, err
 }
 
+func (cl *PsqlClient) SetReceiveDeadline(t time.Time) error {
+	return cl.conn.SetReadDeadline(t)
+}
+
 func (cl *PsqlClient) Send(msg pgproto3.BackendMessage) error {
 	spqrlog.Zero.Debug().
 		Uint("client", cl.ID()).
@@ -1,12 +1,18 @@
 package frontend
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
 	"github.com/pg-sharding/spqr/pkg/workloadlog"
 	"github.com/pg-sharding/spqr/router/client"
 	"github.com/pg-sharding/spqr/router/poolmgr"
@@ -18,11 +24,25 @@ type Qinteractor interface{}
 
 type QinteractorImpl struct{}
 
+// rewriteSequences substitutes values of distributed sequences into simple query,
+// before it is routed. Prepared statements are executed many times and
+// can not be rewritten, they are rejected by qr.CheckPreparedSequences.
+func rewriteSequences(qr qrouter.QueryRouter, q *pgproto3.Query) error {
+	query, err := qr.RewriteSequences(context.TODO(), q.String)
+	if err != nil {
+		return err
+	}
+	q.String = query
+	return nil
+}
+
 // ProcessMessage: process client iteration, until next transaction status idle
 func ProcessMessage(qr qrouter.QueryRouter, cmngr poolmgr.PoolMgr, rst relay.RelayStateMgr, msg pgproto3.FrontendMessage) error {
 	ph := relay.NewSimpleProtoStateHandler(cmngr)
 
-	if rst.Client().Rule().PoolMode != config.PoolModeTransaction {
+	switch rst.Client().Rule().PoolMode {
+	case config.PoolModeTransaction, config.PoolModeStatement:
+	default:
 		switch q := msg.(type) {
 		case *pgproto3.Terminate:
 			return nil
@@ -40,6 +60,9 @@ func ProcessMessage(qr qrouter.QueryRouter, cmngr poolmgr.PoolMgr, rst relay.Rel
 			// copy interface
 			cpQ := *q
 			q = &cpQ
+			if err := qr.CheckPreparedSequences(context.TODO(), q.Query); err != nil {
+				return err
+			}
 			if err := relay.ProcQueryAdvanced(rst, q.Query, ph, func() error {
 				rst.AddQuery(q)
 				_, err := rst.ProcessMessageBuf(true, true, false, rst.ConnMgr())
@@ -64,10 +87,18 @@ func ProcessMessage(qr qrouter.QueryRouter, cmngr poolmgr.PoolMgr, rst relay.Rel
 			cpQ := *q
 			q = &cpQ
 			return rst.ProcessMessage(q, false, true, cmngr)
+		case *pgproto3.Close:
+			// copy interface
+			cpQ := *q
+			q = &cpQ
+			return rst.ProcessMessage(q, false, true, cmngr)
 		case *pgproto3.Query:
 			// copy interface
 			cpQ := *q
 			q = &cpQ
+			if err := rewriteSequences(qr, q); err != nil {
+				return err
+			}
 			if err := relay.ProcQueryAdvanced(rst, q.String, ph, func() error {
 				rst.AddQuery(q)
 
@@ -99,6 +130,9 @@ func ProcessMessage(qr qrouter.QueryRouter, cmngr poolmgr.PoolMgr, rst relay.Rel
 		// copy interface
 		cpQ := *q
 		q = &cpQ
+		if err := qr.CheckPreparedSequences(context.TODO(), q.Query); err != nil {
+			return err
+		}
 
 		rst.AddExtendedProtocMessage(q)
 		return nil
@@ -129,12 +163,22 @@ func ProcessMessage(qr qrouter.QueryRouter, cmngr poolmgr.PoolMgr, rst relay.Rel
 		cpQ := *q
 		q = &cpQ
 
+		rst.AddExtendedProtocMessage(q)
+		return nil
+	case *pgproto3.Close:
+		// copy interface
+		cpQ := *q
+		q = &cpQ
+
 		rst.AddExtendedProtocMessage(q)
 		return nil
 	case *pgproto3.Query:
 		// copy interface
 		cpQ := *q
 		q = &cpQ
+		if err := rewriteSequences(qr, q); err != nil {
+			return err
+		}
 		if err := relay.ProcQueryAdvanced(rst, q.String, ph, func() error {
 			rst.AddQuery(q)
 			// this call compeletes relay, sends RFQ
@@ -150,6 +194,16 @@ func ProcessMessage(qr qrouter.QueryRouter, cmngr poolmgr.PoolMgr, rst relay.Rel
 	}
 }
 
+// idleTransactionTimeout returns time, client may stay idle inside transaction,
+// before it is terminated. Zero means no limit.
+func idleTransactionTimeout(cl client.RouterClient) time.Duration {
+	rule := cl.Rule()
+	if rule == nil {
+		return 0
+	}
+	return time.Duration(rule.IdleTransactionTimeoutSec) * time.Second
+}
+
 func Frontend(qr qrouter.QueryRouter, cl client.RouterClient, cmngr poolmgr.PoolMgr, rcfg *config.Router, writer workloadlog.WorkloadLog) error {
 	spqrlog.Zero.Info().
 		Str("user", cl.Usr()).
@@ -167,9 +221,36 @@ func Frontend(qr qrouter.QueryRouter, cl client.RouterClient, cmngr poolmgr.Pool
 	var msg pgproto3.FrontendMessage
 	var err error
 
+	deadlineSet := false
+
 	for {
+		idleTimeout := time.Duration(0)
+		if rst.TxActive() || rst.TxStatus() == txstatus.TXERR {
+			idleTimeout = idleTransactionTimeout(cl)
+		}
+		if idleTimeout > 0 || deadlineSet {
+			deadline := time.Time{}
+			if idleTimeout > 0 {
+				deadline = time.Now().Add(idleTimeout)
+			}
+			if err := cl.SetReceiveDeadline(deadline); err != nil {
+				return err
+			}
+			deadlineSet = idleTimeout > 0
+		}
+
 		msg, err = cl.Receive()
 		if err != nil {
+			var netErr net.Error
+			if idleTimeout > 0 && errors.As(err, &netErr) && netErr.Timeout() {
+				spqrlog.Zero.Warn().
+					Uint("client", cl.ID()).
+					Dur("timeout", idleTimeout).
+					Msg("terminating client due to idle transaction timeout")
+				/* transaction is discarded along with server connections, when client is closed */
+				_ = cl.ReplyErr(spqrerror.New(spqrerror.SPQR_IDLE_TX_TIMEOUT, "terminating connection due to idle-in-transaction timeout"))
+				return nil
+			}
 			switch err {
 			case io.ErrUnexpectedEOF:
 				fallthrough
@@ -203,7 +284,13 @@ func Frontend(qr qrouter.QueryRouter, cl client.RouterClient, cmngr poolmgr.Pool
 				spqrlog.Zero.Error().
 					Uint("client", rst.Client().ID()).Int("tx-status", int(rst.TxStatus())).Err(err).
 					Msg("client iteration done with error")
-				if err := rst.UnRouteWithError(rst.ActiveShards(), fmt.Errorf("client processing error: %v, tx status %s", err, rst.TxStatus().String())); err != nil {
+				clErr := fmt.Errorf("client processing error: %v, tx status %s", err, rst.TxStatus().String())
+				/* keep error code, e.g. of query wait timeout */
+				var spqrErr *spqrerror.SpqrError
+				if errors.As(err, &spqrErr) {
+					clErr = spqrerror.Newf(spqrErr.ErrorCode, "client processing error: %v, tx status %s", err, rst.TxStatus().String())
+				}
+				if err := rst.UnRouteWithError(rst.ActiveShards(), clErr); err != nil {
 					return err
 				}
 			}
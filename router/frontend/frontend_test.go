@@ -1,20 +1,27 @@
 package frontend_test
 
 import (
+	"context"
+	"errors"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/pg-sharding/lyx/lyx"
 	"github.com/pg-sharding/spqr/pkg/config"
+	mockinst "github.com/pg-sharding/spqr/pkg/mock/conn"
 	mocksh "github.com/pg-sharding/spqr/pkg/mock/shard"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 	"github.com/pg-sharding/spqr/pkg/shard"
 	"github.com/pg-sharding/spqr/pkg/txstatus"
+	"github.com/pg-sharding/spqr/router/client"
 	"github.com/pg-sharding/spqr/router/frontend"
 	mockcl "github.com/pg-sharding/spqr/router/mock/client"
 	mockqr "github.com/pg-sharding/spqr/router/mock/qrouter"
 	mocksrv "github.com/pg-sharding/spqr/router/mock/server"
+	"github.com/pg-sharding/spqr/router/relay"
 	"github.com/pg-sharding/spqr/router/route"
 	"github.com/pg-sharding/spqr/router/routingstate"
 
@@ -24,6 +31,14 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// expectNoSequences makes query router keep queries as is
+func expectNoSequences(qr *mockqr.MockQueryRouter) {
+	qr.EXPECT().RewriteSequences(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, query string) (string, error) {
+		return query, nil
+	}).AnyTimes()
+	qr.EXPECT().CheckPreparedSequences(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
 func TestFrontendSimpleEOF(t *testing.T) {
 
 	assert := assert.New(t)
@@ -31,6 +46,7 @@ func TestFrontendSimpleEOF(t *testing.T) {
 
 	cl := mockcl.NewMockRouterClient(ctrl)
 	qr := mockqr.NewMockQueryRouter(ctrl)
+	expectNoSequences(qr)
 	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
 
 	cl.EXPECT().Usr().AnyTimes().Return("user1")
@@ -54,6 +70,7 @@ func TestFrontendSimple(t *testing.T) {
 	cl := mockcl.NewMockRouterClient(ctrl)
 	srv := mocksrv.NewMockServer(ctrl)
 	qr := mockqr.NewMockQueryRouter(ctrl)
+	expectNoSequences(qr)
 	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
 
 	frrule := &config.FrontendRule{
@@ -113,7 +130,7 @@ func TestFrontendSimple(t *testing.T) {
 
 	route := route.NewRoute(beRule, frrule, map[string]*config.Shard{
 		"sh1": {},
-	})
+	}, nil, "")
 
 	cl.EXPECT().Route().AnyTimes().Return(route)
 
@@ -151,6 +168,507 @@ func TestFrontendSimple(t *testing.T) {
 	assert.NoError(err, "")
 }
 
+func TestFrontendSimpleQueryTimeout(t *testing.T) {
+
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	cl := mockcl.NewMockRouterClient(ctrl)
+	srv := mocksrv.NewMockServer(ctrl)
+	qr := mockqr.NewMockQueryRouter(ctrl)
+	expectNoSequences(qr)
+	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
+
+	frrule := &config.FrontendRule{
+		DB:              "db1",
+		Usr:             "user1",
+		QueryTimeoutSec: 1,
+	}
+
+	beRule := &config.BackendRule{}
+
+	srv.EXPECT().Datashards().AnyTimes().Return([]shard.Shard{})
+	srv.EXPECT().Name().AnyTimes().Return("serv1")
+
+	cl.EXPECT().Server().AnyTimes().Return(srv)
+
+	cl.EXPECT().Usr().AnyTimes().Return("user1")
+	cl.EXPECT().DB().AnyTimes().Return("db1")
+
+	cl.EXPECT().SetRouteHint(gomock.Any()).AnyTimes()
+	cl.EXPECT().BindParams().AnyTimes()
+
+	cl.EXPECT().ID().AnyTimes()
+
+	cl.EXPECT().Close().Times(1)
+	cl.EXPECT().Rule().AnyTimes().Return(
+		frrule,
+	)
+
+	cl.EXPECT().ReplyDebugNotice(gomock.Any()).AnyTimes().Return(nil)
+	cl.EXPECT().AssignServerConn(gomock.Any()).AnyTimes().Return(nil)
+
+	cl.EXPECT().RLock().AnyTimes()
+	cl.EXPECT().RUnlock().AnyTimes()
+
+	cmngr.EXPECT().ValidateReRoute(gomock.Any()).AnyTimes().Return(true)
+	cmngr.EXPECT().RouteCB(cl, gomock.Any()).AnyTimes()
+	cmngr.EXPECT().UnRouteCB(gomock.Any(), gomock.Any()).AnyTimes()
+	cmngr.EXPECT().TXBeginCB(gomock.Any()).AnyTimes()
+	cmngr.EXPECT().TXEndCB(gomock.Any()).AnyTimes()
+
+	qr.EXPECT().Route(gomock.Any(), gomock.Any(), gomock.Any()).Return(routingstate.ShardMatchState{
+		Route: &routingstate.DataShardRoute{
+			Shkey: kr.ShardKey{
+				Name: "sh1",
+			},
+		},
+	}, nil).Times(1)
+
+	route := route.NewRoute(beRule, frrule, map[string]*config.Shard{
+		"sh1": {},
+	}, nil, "")
+
+	cl.EXPECT().Route().AnyTimes().Return(route)
+
+	query := &pgproto3.Query{
+		String: "SELECT pg_sleep(10)",
+	}
+
+	cl.EXPECT().Receive().Times(1).Return(query, nil)
+
+	srv.EXPECT().Send(query).Times(1).Return(nil)
+
+	/* statement runs until router cancels it */
+	canceled := make(chan struct{})
+	srv.EXPECT().Cancel().Times(1).DoAndReturn(func() error {
+		close(canceled)
+		return nil
+	})
+	srv.EXPECT().Receive().Times(1).DoAndReturn(func() (pgproto3.BackendMessage, error) {
+		<-canceled
+		return &pgproto3.ErrorResponse{
+			Severity: "ERROR",
+			Code:     "57014",
+			Message:  "canceling statement due to user request",
+		}, nil
+	})
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXIDLE),
+	}, nil)
+
+	/* client gets timeout error instead of the cancel one */
+	cl.EXPECT().Send(&pgproto3.ErrorResponse{
+		Severity: "ERROR",
+		Code:     spqrerror.SPQR_QUERY_TIMEOUT,
+		Message:  "canceling statement due to query timeout",
+	}).Times(1).Return(nil)
+	cl.EXPECT().Send(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXIDLE),
+	}).Times(1).Return(nil)
+
+	cl.EXPECT().Receive().Times(1).Return(nil, io.EOF)
+
+	err := frontend.Frontend(qr, cl, cmngr, &config.Router{}, nil)
+
+	assert.NoError(err, "")
+}
+
+// timeoutError is returned by client connection, which read deadline is exceeded
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestFrontendIdleTransactionTimeout(t *testing.T) {
+
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	cl := mockcl.NewMockRouterClient(ctrl)
+	qr := mockqr.NewMockQueryRouter(ctrl)
+	expectNoSequences(qr)
+	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
+
+	frrule := &config.FrontendRule{
+		DB:                        "db1",
+		Usr:                       "user1",
+		PoolMode:                  config.PoolModeTransaction,
+		IdleTransactionTimeoutSec: 5,
+	}
+
+	cl.EXPECT().Usr().AnyTimes().Return("user1")
+	cl.EXPECT().DB().AnyTimes().Return("db1")
+	cl.EXPECT().ID().AnyTimes()
+	cl.EXPECT().Close().Times(1)
+	cl.EXPECT().Rule().AnyTimes().Return(frrule)
+	cl.EXPECT().SetRouteHint(gomock.Any()).AnyTimes()
+	cl.EXPECT().ReplyDebugNotice(gomock.Any()).AnyTimes().Return(nil)
+	cl.EXPECT().Server().AnyTimes().Return(nil)
+
+	cmngr.EXPECT().UnRouteCB(gomock.Any(), gomock.Any()).AnyTimes()
+	cmngr.EXPECT().TXEndCB(gomock.Any()).AnyTimes()
+
+	/* BEGIN is handled by router, no deadline is set outside of transaction */
+	cl.EXPECT().Receive().Times(1).Return(&pgproto3.Query{
+		String: "BEGIN",
+	}, nil)
+	cl.EXPECT().StartTx().Times(1)
+	cl.EXPECT().ReplyCommandComplete("BEGIN").Times(1).Return(nil)
+	cl.EXPECT().ReplyRFQ(txstatus.TXACT).AnyTimes().Return(nil)
+	cl.EXPECT().Send(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXACT),
+	}).AnyTimes().Return(nil)
+
+	/* client stays idle inside transaction */
+	cl.EXPECT().SetReceiveDeadline(gomock.Any()).Times(1).DoAndReturn(func(deadline time.Time) error {
+		assert.WithinDuration(time.Now().Add(5*time.Second), deadline, time.Second)
+		return nil
+	})
+	cl.EXPECT().Receive().Times(1).Return(nil, timeoutError{})
+
+	cl.EXPECT().ReplyErr(gomock.Any()).Times(1).DoAndReturn(func(err error) error {
+		var spqrErr *spqrerror.SpqrError
+		assert.True(errors.As(err, &spqrErr))
+		assert.Equal(spqrerror.SPQR_IDLE_TX_TIMEOUT, spqrErr.ErrorCode)
+		return nil
+	})
+
+	err := frontend.Frontend(qr, cl, cmngr, &config.Router{}, nil)
+
+	assert.NoError(err, "")
+}
+
+func TestFrontendSimpleFailoverRetry(t *testing.T) {
+
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	cl := mockcl.NewMockRouterClient(ctrl)
+	srv := mocksrv.NewMockServer(ctrl)
+	sh := mocksh.NewMockShard(ctrl)
+	ins := mockinst.NewMockDBInstance(ctrl)
+	qr := mockqr.NewMockQueryRouter(ctrl)
+	expectNoSequences(qr)
+	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
+
+	frrule := &config.FrontendRule{
+		DB:  "db1",
+		Usr: "user1",
+	}
+
+	beRule := &config.BackendRule{}
+
+	ins.EXPECT().Hostname().AnyTimes().Return("h1")
+	sh.EXPECT().Name().AnyTimes().Return("sh1")
+	sh.EXPECT().Instance().AnyTimes().Return(ins)
+	sh.EXPECT().ID().AnyTimes().Return(uint(1))
+	sh.EXPECT().SHKey().AnyTimes().Return(kr.ShardKey{Name: "sh1"})
+
+	srv.EXPECT().Datashards().AnyTimes().Return([]shard.Shard{sh})
+	srv.EXPECT().Name().AnyTimes().Return("serv1")
+
+	cl.EXPECT().Server().AnyTimes().Return(srv)
+
+	cl.EXPECT().Usr().AnyTimes().Return("user1")
+	cl.EXPECT().DB().AnyTimes().Return("db1")
+	cl.EXPECT().GetTsa().AnyTimes().Return(config.TargetSessionAttrsRW)
+
+	cl.EXPECT().SetRouteHint(gomock.Any()).AnyTimes()
+	cl.EXPECT().BindParams().AnyTimes()
+
+	cl.EXPECT().ID().AnyTimes()
+
+	cl.EXPECT().Close().Times(1)
+	cl.EXPECT().Rule().AnyTimes().Return(
+		frrule,
+	)
+	cl.EXPECT().ReadYourWrites().AnyTimes().Return(false)
+
+	cl.EXPECT().ReplyDebugNotice(gomock.Any()).AnyTimes().Return(nil)
+	cl.EXPECT().AssignServerConn(gomock.Any()).AnyTimes().Return(nil)
+
+	cl.EXPECT().RLock().AnyTimes()
+	cl.EXPECT().RUnlock().AnyTimes()
+
+	cmngr.EXPECT().ValidateReRoute(gomock.Any()).AnyTimes().Return(true)
+
+	// client is routed again after failover
+	cmngr.EXPECT().RouteCB(cl, gomock.Any()).Times(2)
+
+	cmngr.EXPECT().UnRouteCB(gomock.Any(), gomock.Any()).AnyTimes()
+
+	cmngr.EXPECT().TXBeginCB(gomock.Any()).AnyTimes()
+
+	cmngr.EXPECT().TXEndCB(gomock.Any()).AnyTimes()
+
+	qr.EXPECT().Route(gomock.Any(), gomock.Any(), gomock.Any()).Return(routingstate.ShardMatchState{
+		Route: &routingstate.DataShardRoute{
+			Shkey: kr.ShardKey{
+				Name: "sh1",
+			},
+		},
+	}, nil).Times(1)
+
+	route := route.NewRoute(beRule, frrule, map[string]*config.Shard{
+		"sh1": {},
+	}, nil, "")
+
+	cl.EXPECT().Route().AnyTimes().Return(route)
+
+	query := &pgproto3.Query{
+		String: "INSERT INTO t VALUES (1)",
+	}
+
+	cl.EXPECT().Receive().Times(1).Return(query, nil)
+
+	srv.EXPECT().Send(query).Times(2).Return(nil)
+
+	// demoted primary
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.ErrorResponse{
+		Code:    "25006",
+		Message: "cannot execute INSERT in a read-only transaction",
+	}, nil)
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXIDLE),
+	}, nil)
+
+	// new primary
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.CommandComplete{
+		CommandTag: []byte("INSERT 0 1"),
+	}, nil)
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXIDLE),
+	}, nil)
+
+	cl.EXPECT().ReplyNotice(gomock.Any()).Times(1).Return(nil)
+
+	// command complete and ready for query, no error
+	cl.EXPECT().Send(gomock.Any()).Times(2).Return(nil)
+
+	cl.EXPECT().Receive().Times(1).Return(nil, io.EOF)
+
+	err := frontend.Frontend(qr, cl, cmngr, &config.Router{}, nil)
+
+	assert.NoError(err, "")
+}
+
+func TestFrontendSimpleFailoverRetryOnce(t *testing.T) {
+
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	cl := mockcl.NewMockRouterClient(ctrl)
+	srv := mocksrv.NewMockServer(ctrl)
+	sh := mocksh.NewMockShard(ctrl)
+	ins := mockinst.NewMockDBInstance(ctrl)
+	qr := mockqr.NewMockQueryRouter(ctrl)
+	expectNoSequences(qr)
+	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
+
+	frrule := &config.FrontendRule{
+		DB:  "db1",
+		Usr: "user1",
+	}
+
+	beRule := &config.BackendRule{}
+
+	ins.EXPECT().Hostname().AnyTimes().Return("h1")
+	sh.EXPECT().Name().AnyTimes().Return("sh1")
+	sh.EXPECT().Instance().AnyTimes().Return(ins)
+	sh.EXPECT().ID().AnyTimes().Return(uint(1))
+	sh.EXPECT().SHKey().AnyTimes().Return(kr.ShardKey{Name: "sh1"})
+
+	srv.EXPECT().Datashards().AnyTimes().Return([]shard.Shard{sh})
+	srv.EXPECT().Name().AnyTimes().Return("serv1")
+
+	cl.EXPECT().Server().AnyTimes().Return(srv)
+
+	cl.EXPECT().Usr().AnyTimes().Return("user1")
+	cl.EXPECT().DB().AnyTimes().Return("db1")
+	cl.EXPECT().GetTsa().AnyTimes().Return(config.TargetSessionAttrsRW)
+
+	cl.EXPECT().SetRouteHint(gomock.Any()).AnyTimes()
+	cl.EXPECT().BindParams().AnyTimes()
+
+	cl.EXPECT().ID().AnyTimes()
+
+	cl.EXPECT().Close().Times(1)
+	cl.EXPECT().Rule().AnyTimes().Return(
+		frrule,
+	)
+	cl.EXPECT().ReadYourWrites().AnyTimes().Return(false)
+
+	cl.EXPECT().ReplyDebugNotice(gomock.Any()).AnyTimes().Return(nil)
+	cl.EXPECT().AssignServerConn(gomock.Any()).AnyTimes().Return(nil)
+
+	cl.EXPECT().RLock().AnyTimes()
+	cl.EXPECT().RUnlock().AnyTimes()
+
+	cmngr.EXPECT().ValidateReRoute(gomock.Any()).AnyTimes().Return(true)
+
+	// client is routed again after failover
+	cmngr.EXPECT().RouteCB(cl, gomock.Any()).Times(2)
+
+	cmngr.EXPECT().UnRouteCB(gomock.Any(), gomock.Any()).AnyTimes()
+
+	cmngr.EXPECT().TXBeginCB(gomock.Any()).AnyTimes()
+
+	cmngr.EXPECT().TXEndCB(gomock.Any()).AnyTimes()
+
+	qr.EXPECT().Route(gomock.Any(), gomock.Any(), gomock.Any()).Return(routingstate.ShardMatchState{
+		Route: &routingstate.DataShardRoute{
+			Shkey: kr.ShardKey{
+				Name: "sh1",
+			},
+		},
+	}, nil).Times(1)
+
+	route := route.NewRoute(beRule, frrule, map[string]*config.Shard{
+		"sh1": {},
+	}, nil, "")
+
+	cl.EXPECT().Route().AnyTimes().Return(route)
+
+	query := &pgproto3.Query{
+		String: "INSERT INTO t VALUES (1)",
+	}
+
+	cl.EXPECT().Receive().Times(1).Return(query, nil)
+
+	srv.EXPECT().Send(query).Times(2).Return(nil)
+
+	// demoted primary
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.ErrorResponse{
+		Code:    "25006",
+		Message: "cannot execute INSERT in a read-only transaction",
+	}, nil)
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXIDLE),
+	}, nil)
+
+	// new primary is demoted too, statement is not retried again
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.ErrorResponse{
+		Code:    "25006",
+		Message: "cannot execute INSERT in a read-only transaction",
+	}, nil)
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXIDLE),
+	}, nil)
+
+	cl.EXPECT().ReplyNotice(gomock.Any()).Times(1).Return(nil)
+
+	// error and ready for query are replied to client
+	cl.EXPECT().Send(&pgproto3.ErrorResponse{
+		Code:    "25006",
+		Message: "cannot execute INSERT in a read-only transaction",
+	}).Times(1).Return(nil)
+	cl.EXPECT().Send(gomock.Any()).Times(1).Return(nil)
+
+	cl.EXPECT().Receive().Times(1).Return(nil, io.EOF)
+
+	err := frontend.Frontend(qr, cl, cmngr, &config.Router{}, nil)
+
+	assert.NoError(err, "")
+}
+
+func TestFrontendStatementModeRejectsBegin(t *testing.T) {
+
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	cl := mockcl.NewMockRouterClient(ctrl)
+	qr := mockqr.NewMockQueryRouter(ctrl)
+	expectNoSequences(qr)
+	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
+
+	frrule := &config.FrontendRule{
+		DB:       "db1",
+		Usr:      "user1",
+		PoolMode: config.PoolModeStatement,
+	}
+
+	cl.EXPECT().Usr().AnyTimes().Return("user1")
+	cl.EXPECT().DB().AnyTimes().Return("db1")
+	cl.EXPECT().ID().AnyTimes()
+	cl.EXPECT().Close().Times(1)
+	cl.EXPECT().Rule().AnyTimes().Return(frrule)
+	cl.EXPECT().SetRouteHint(gomock.Any()).AnyTimes()
+
+	cmngr.EXPECT().UnRouteCB(gomock.Any(), gomock.Any()).AnyTimes()
+	cmngr.EXPECT().TXEndCB(gomock.Any()).AnyTimes()
+
+	cl.EXPECT().Receive().Times(1).Return(&pgproto3.Query{
+		String: "BEGIN",
+	}, nil)
+
+	cl.EXPECT().Send(&pgproto3.ErrorResponse{
+		Severity: "ERROR",
+		Code:     relay.ErrStatementPoolTx.ErrorCode,
+		Message:  relay.ErrStatementPoolTx.Error(),
+	}).Times(1).Return(nil)
+	cl.EXPECT().Send(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXIDLE),
+	}).Times(1).Return(nil)
+
+	cl.EXPECT().Receive().Times(1).Return(nil, io.EOF)
+
+	err := frontend.Frontend(qr, cl, cmngr, &config.Router{}, nil)
+
+	assert.NoError(err, "")
+}
+
+func TestFrontendXProtoRejectsPreparedSequences(t *testing.T) {
+
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	cl := mockcl.NewMockRouterClient(ctrl)
+	qr := mockqr.NewMockQueryRouter(ctrl)
+	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
+
+	frrule := &config.FrontendRule{
+		DB:       "db1",
+		Usr:      "user1",
+		PoolMode: config.PoolModeTransaction,
+	}
+
+	cl.EXPECT().Usr().AnyTimes().Return("user1")
+	cl.EXPECT().DB().AnyTimes().Return("db1")
+	cl.EXPECT().ID().AnyTimes()
+	cl.EXPECT().Close().Times(1)
+	cl.EXPECT().Rule().AnyTimes().Return(frrule)
+
+	cmngr.EXPECT().UnRouteCB(gomock.Any(), gomock.Any()).AnyTimes()
+	cmngr.EXPECT().TXEndCB(gomock.Any()).AnyTimes()
+
+	seqErr := spqrerror.New(spqrerror.SPQR_SEQUENCE_ERROR, "distributed sequence orders_id can not be used in prepared statement, use simple query protocol")
+	qr.EXPECT().CheckPreparedSequences(gomock.Any(), "INSERT INTO orders (customer) VALUES ($1)").Return(seqErr)
+
+	cl.EXPECT().Receive().Times(1).Return(&pgproto3.Parse{
+		Name:  "stmt",
+		Query: "INSERT INTO orders (customer) VALUES ($1)",
+	}, nil)
+
+	/* error is replied by pool manager, code of the error is kept */
+	cmngr.EXPECT().UnRouteWithError(cl, gomock.Any(), gomock.Any()).DoAndReturn(func(_ client.RouterClient, _ []kr.ShardKey, err error) error {
+		var spqrErr *spqrerror.SpqrError
+		assert.True(errors.As(err, &spqrErr))
+		assert.Equal(spqrerror.SPQR_SEQUENCE_ERROR, spqrErr.ErrorCode)
+		assert.Contains(err.Error(), "prepared statement")
+		return nil
+	}).Times(1)
+	cl.EXPECT().Reset().Times(1)
+	cl.EXPECT().Unroute().Times(1)
+
+	cl.EXPECT().Receive().Times(1).Return(nil, io.EOF)
+
+	err := frontend.Frontend(qr, cl, cmngr, &config.Router{}, nil)
+
+	assert.NoError(err, "")
+}
+
 func TestFrontendXProto(t *testing.T) {
 	assert := assert.New(t)
 	ctrl := gomock.NewController(t)
@@ -159,6 +677,7 @@ func TestFrontendXProto(t *testing.T) {
 	srv := mocksrv.NewMockServer(ctrl)
 	sh := mocksh.NewMockShard(ctrl)
 	qr := mockqr.NewMockQueryRouter(ctrl)
+	expectNoSequences(qr)
 	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
 
 	frrule := &config.FrontendRule{
@@ -222,7 +741,7 @@ func TestFrontendXProto(t *testing.T) {
 
 	route := route.NewRoute(beRule, frrule, map[string]*config.Shard{
 		"sh1": {},
-	})
+	}, nil, "")
 
 	// route to any route
 	cl.EXPECT().Route().AnyTimes().Return(route)
@@ -302,6 +821,109 @@ func TestFrontendXProto(t *testing.T) {
 	assert.NoError(err, "")
 }
 
+// newXProtoCloseMocks prepares client, which is not routed yet,
+// for extended protocol Close tests
+func newXProtoCloseMocks(ctrl *gomock.Controller) (*mockcl.MockRouterClient, *mockqr.MockQueryRouter, *mockcmgr.MockPoolMgr) {
+	cl := mockcl.NewMockRouterClient(ctrl)
+	qr := mockqr.NewMockQueryRouter(ctrl)
+	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
+
+	frrule := &config.FrontendRule{
+		DB:       "db1",
+		Usr:      "user1",
+		PoolMode: config.PoolModeTransaction,
+	}
+
+	cl.EXPECT().Usr().AnyTimes().Return("user1")
+	cl.EXPECT().DB().AnyTimes().Return("db1")
+	cl.EXPECT().ID().AnyTimes()
+	cl.EXPECT().Close().Times(1)
+	cl.EXPECT().Rule().AnyTimes().Return(frrule)
+	cl.EXPECT().ReplyDebugNotice(gomock.Any()).AnyTimes().Return(nil)
+	cl.EXPECT().RLock().AnyTimes()
+	cl.EXPECT().RUnlock().AnyTimes()
+	cl.EXPECT().ServerAcquireUse().AnyTimes()
+	cl.EXPECT().ServerReleaseUse().AnyTimes()
+
+	cmngr.EXPECT().ValidateReRoute(gomock.Any()).AnyTimes().Return(true)
+	cmngr.EXPECT().UnRouteCB(gomock.Any(), gomock.Any()).AnyTimes()
+	cmngr.EXPECT().TXBeginCB(gomock.Any()).AnyTimes()
+	cmngr.EXPECT().TXEndCB(gomock.Any()).AnyTimes()
+
+	return cl, qr, cmngr
+}
+
+func TestFrontendXProtoCloseCachedStatement(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	cl, qr, cmngr := newXProtoCloseMocks(ctrl)
+
+	cl.EXPECT().Server().AnyTimes().Return(nil)
+
+	cl.EXPECT().Receive().Times(1).Return(&pgproto3.Close{
+		ObjectType: 'S',
+		Name:       "stmtcache_1",
+	}, nil)
+	cl.EXPECT().Receive().Times(1).Return(&pgproto3.Sync{}, nil)
+
+	/* statement is known to router, close is answered without server */
+	cl.EXPECT().PreparedStatementQueryByName("stmtcache_1").AnyTimes().Return("select 1")
+	cl.EXPECT().DeallocatePreparedStatement("stmtcache_1").Times(1)
+	cl.EXPECT().Send(&pgproto3.CloseComplete{}).Times(1).Return(nil)
+	cl.EXPECT().ReplyRFQ(gomock.Any()).Times(1).Return(nil)
+
+	cl.EXPECT().Receive().Times(1).Return(nil, io.EOF)
+
+	err := frontend.Frontend(qr, cl, cmngr, &config.Router{}, nil)
+
+	assert.NoError(err, "")
+}
+
+func TestFrontendXProtoClosePortal(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	cl, qr, cmngr := newXProtoCloseMocks(ctrl)
+	srv := mocksrv.NewMockServer(ctrl)
+	sh := mocksh.NewMockShard(ctrl)
+
+	sh.EXPECT().ID().AnyTimes()
+	srv.EXPECT().Name().AnyTimes().Return("serv1")
+	srv.EXPECT().Datashards().AnyTimes().Return([]shard.Shard{sh})
+
+	cl.EXPECT().Server().AnyTimes().Return(srv)
+
+	cl.EXPECT().Receive().Times(1).Return(&pgproto3.Close{
+		ObjectType: 'P',
+		Name:       "portal_1",
+	}, nil)
+	cl.EXPECT().Receive().Times(1).Return(&pgproto3.Sync{}, nil)
+
+	/* portal lives on server connection, close is forwarded */
+	srv.EXPECT().Send(&pgproto3.Close{
+		ObjectType: 'P',
+		Name:       "portal_1",
+	}).Times(1).Return(nil)
+	srv.EXPECT().Send(&pgproto3.Sync{}).Times(1).Return(nil)
+
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.CloseComplete{}, nil)
+	srv.EXPECT().Receive().Times(1).Return(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXIDLE),
+	}, nil)
+
+	cl.EXPECT().Send(&pgproto3.CloseComplete{}).Times(1).Return(nil)
+	cl.EXPECT().Send(&pgproto3.ReadyForQuery{
+		TxStatus: byte(txstatus.TXIDLE),
+	}).Times(1).Return(nil)
+
+	cl.EXPECT().Receive().Times(1).Return(nil, io.EOF)
+
+	err := frontend.Frontend(qr, cl, cmngr, &config.Router{}, nil)
+
+	assert.NoError(err, "")
+}
+
 func TestFrontendSimpleCopyIn(t *testing.T) {
 	assert := assert.New(t)
 	ctrl := gomock.NewController(t)
@@ -309,6 +931,7 @@ func TestFrontendSimpleCopyIn(t *testing.T) {
 	cl := mockcl.NewMockRouterClient(ctrl)
 	srv := mocksrv.NewMockServer(ctrl)
 	qr := mockqr.NewMockQueryRouter(ctrl)
+	expectNoSequences(qr)
 	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
 
 	frrule := &config.FrontendRule{
@@ -335,6 +958,7 @@ func TestFrontendSimpleCopyIn(t *testing.T) {
 	cl.EXPECT().Rule().AnyTimes().Return(
 		frrule,
 	)
+	cl.EXPECT().ReadYourWrites().AnyTimes().Return(false)
 
 	cl.EXPECT().ReplyDebugNotice(gomock.Any()).AnyTimes().Return(nil)
 	cl.EXPECT().AssignServerConn(gomock.Any()).AnyTimes().Return(nil)
@@ -359,6 +983,7 @@ func TestFrontendSimpleCopyIn(t *testing.T) {
 		},
 		Where:  &lyx.AExprEmpty{},
 		IsFrom: true,
+		Stdio:  true,
 	}, gomock.Any()).Return(routingstate.ShardMatchState{
 		Route: &routingstate.DataShardRoute{
 			Shkey: kr.ShardKey{
@@ -369,7 +994,7 @@ func TestFrontendSimpleCopyIn(t *testing.T) {
 
 	route := route.NewRoute(beRule, frrule, map[string]*config.Shard{
 		"sh1": {},
-	})
+	}, nil, "")
 
 	cl.EXPECT().Route().AnyTimes().Return(route)
 
@@ -3,13 +3,17 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pg-sharding/spqr/pkg/models/datashards"
 	"github.com/pg-sharding/spqr/pkg/models/distributions"
+	"github.com/pg-sharding/spqr/pkg/models/schema"
+	"github.com/pg-sharding/spqr/pkg/models/sequences"
 	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 	"github.com/pg-sharding/spqr/pkg/models/tasks"
 
 	"github.com/pg-sharding/spqr/pkg/client"
+	"github.com/pg-sharding/spqr/pkg/coord/local"
 	"github.com/pg-sharding/spqr/pkg/meta"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
 	"github.com/pg-sharding/spqr/pkg/pool"
@@ -17,6 +21,7 @@ import (
 	"github.com/pg-sharding/spqr/pkg/shard"
 	"github.com/pg-sharding/spqr/router/qrouter"
 	"github.com/pg-sharding/spqr/router/rulerouter"
+	"github.com/pg-sharding/spqr/router/statistics"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -31,6 +36,8 @@ type LocalQrouterServer struct {
 	protos.UnimplementedDistributionServiceServer
 	protos.UnimplementedTasksServiceServer
 	protos.UnimplementedShardServiceServer
+	protos.UnimplementedSequenceServiceServer
+	protos.UnimplementedSchemaServiceServer
 	qr  qrouter.QueryRouter
 	mgr meta.EntityMgr
 	rr  rulerouter.RuleRouter
@@ -74,6 +81,11 @@ func (l *LocalQrouterServer) GetShard(ctx context.Context, request *protos.Shard
 	}, nil
 }
 
+// DrainShard is executed by coordinator only
+func (l *LocalQrouterServer) DrainShard(ctx context.Context, request *protos.DrainShardRequest) (*protos.DrainShardReply, error) {
+	return &protos.DrainShardReply{}, l.mgr.DrainShard(ctx, request.Id)
+}
+
 // CreateDistribution creates distribution in QDB
 // TODO: unit tests
 func (l *LocalQrouterServer) CreateDistribution(ctx context.Context, request *protos.CreateDistributionRequest) (*protos.CreateDistributionReply, error) {
@@ -161,6 +173,11 @@ func (l *LocalQrouterServer) GetRelationDistribution(ctx context.Context, reques
 	return &protos.GetRelationDistributionReply{Distribution: distributions.DistributionToProto(ds)}, err
 }
 
+// CheckReferenceRelation is executed by coordinator only
+func (l *LocalQrouterServer) CheckReferenceRelation(ctx context.Context, request *protos.CheckReferenceRelationRequest) (*protos.CheckReferenceRelationReply, error) {
+	return nil, local.ErrNotCoordinator
+}
+
 // TODO : unit tests
 func (l *LocalQrouterServer) OpenRouter(ctx context.Context, request *protos.OpenRouterRequest) (*protos.OpenRouterReply, error) {
 	l.qr.Initialize()
@@ -289,6 +306,24 @@ func (l *LocalQrouterServer) ListAllKeyRanges(ctx context.Context, request *prot
 	return &protos.KeyRangeReply{KeyRangesInfo: krs}, nil
 }
 
+// ResolveKeyRange finds key ranges, containing given keys
+// TODO : unit tests
+func (l *LocalQrouterServer) ResolveKeyRange(ctx context.Context, request *protos.ResolveKeyRangeRequest) (*protos.ResolveKeyRangeReply, error) {
+	krs, err := meta.ResolveKeyRange(ctx, l.mgr, request.DistributionId, request.Relation, request.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.ResolveKeyRangeReply{KeyRanges: kr.ResolvedToProto(request.Keys, krs)}, nil
+}
+
+// GetKeyRangeStats returns number of queries routed to each key range by this router
+// TODO : unit tests
+func (l *LocalQrouterServer) GetKeyRangeStats(ctx context.Context, request *protos.GetKeyRangeStatsRequest) (*protos.GetKeyRangeStatsReply, error) {
+	counts := statistics.GetKeyRangeQueryCounts(time.Now(), time.Duration(request.IntervalSec)*time.Second)
+	return &protos.GetKeyRangeStatsReply{Stats: kr.QueryCountsToProto(counts)}, nil
+}
+
 // TODO : unit tests
 func (l *LocalQrouterServer) LockKeyRange(ctx context.Context, request *protos.LockKeyRangeRequest) (*protos.ModifyReply, error) {
 	for _, id := range request.Id {
@@ -364,12 +399,15 @@ func ShardToProto(sh shard.Shardinfo) *protos.BackendConnectionsInfo {
 		Sync:                sh.Sync(),
 		TxServed:            sh.TxServed(),
 		TxStatus:            int64(sh.TxStatus()),
+		PrepStmtCacheHits:   sh.PrepStmtCacheHits(),
+		PrepStmtCacheMisses: sh.PrepStmtCacheMisses(),
 	}
 	return shardInfo
 }
 
 // TODO : unit tests
 func PoolToProto(p pool.Pool, router string) *protos.PoolInfo {
+	stats := p.Stats()
 	poolInfo := &protos.PoolInfo{
 		Id:            fmt.Sprintf("%p", p),
 		DB:            p.Rule().DB,
@@ -379,6 +417,16 @@ func PoolToProto(p pool.Pool, router string) *protos.PoolInfo {
 		ConnCount:     int64(p.UsedConnectionCount()),
 		IdleConnCount: int64(p.IdleConnectionCount()),
 		QueueSize:     int64(p.QueueResidualSize()),
+
+		ClosedByLifetime:    stats.ClosedByLifetime,
+		ClosedByIdleTimeout: stats.ClosedByIdleTimeout,
+		FailedChecks:        stats.FailedChecks,
+		WarmedUp:            stats.WarmedUp,
+
+		WaitingClients: stats.WaitingClients,
+		WaitTimeouts:   stats.WaitTimeouts,
+		AvgWaitUs:      stats.AvgWait.Microseconds(),
+		MaxWaitUs:      stats.MaxWait.Microseconds(),
 	}
 	return poolInfo
 }
@@ -448,6 +496,56 @@ func (l *LocalQrouterServer) RemoveTaskGroup(ctx context.Context, _ *protos.Remo
 	return &protos.RemoveTaskGroupReply{}, l.mgr.RemoveTaskGroup(ctx)
 }
 
+// TODO : unit tests
+func (l *LocalQrouterServer) CreateSequence(ctx context.Context, request *protos.CreateSequenceRequest) (*protos.CreateSequenceReply, error) {
+	return &protos.CreateSequenceReply{}, l.mgr.CreateSequence(ctx, sequences.SequenceFromProto(request.Sequence))
+}
+
+// TODO : unit tests
+func (l *LocalQrouterServer) DropSequence(ctx context.Context, request *protos.DropSequenceRequest) (*protos.DropSequenceReply, error) {
+	return &protos.DropSequenceReply{}, l.mgr.DropSequence(ctx, request.Name)
+}
+
+// TODO : unit tests
+func (l *LocalQrouterServer) ListSequences(ctx context.Context, _ *protos.ListSequencesRequest) (*protos.ListSequencesReply, error) {
+	seqs, err := l.mgr.ListSequences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*protos.Sequence, len(seqs))
+	for i, seq := range seqs {
+		res[i] = sequences.SequenceToProto(seq)
+	}
+	return &protos.ListSequencesReply{Sequences: res}, nil
+}
+
+// TODO : unit tests
+func (l *LocalQrouterServer) NextSequenceRange(ctx context.Context, request *protos.NextSequenceRangeRequest) (*protos.NextSequenceRangeReply, error) {
+	first, err := l.mgr.NextSequenceRange(ctx, request.Name, request.Count)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.NextSequenceRangeReply{First: first}, nil
+}
+
+// TODO : unit tests
+func (l *LocalQrouterServer) RecordSchemaChange(ctx context.Context, request *protos.RecordSchemaChangeRequest) (*protos.RecordSchemaChangeReply, error) {
+	return &protos.RecordSchemaChangeReply{}, l.mgr.RecordSchemaChange(ctx, request.Query)
+}
+
+// TODO : unit tests
+func (l *LocalQrouterServer) ListSchemaChanges(ctx context.Context, _ *protos.ListSchemaChangesRequest) (*protos.ListSchemaChangesReply, error) {
+	changes, err := l.mgr.ListSchemaChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*protos.SchemaChange, len(changes))
+	for i, change := range changes {
+		res[i] = schema.SchemaChangeToProto(change)
+	}
+	return &protos.ListSchemaChangesReply{Changes: res}, nil
+}
+
 func Register(server reflection.GRPCServer, qrouter qrouter.QueryRouter, mgr meta.EntityMgr, rr rulerouter.RuleRouter) {
 
 	lqr := &LocalQrouterServer{
@@ -467,6 +565,8 @@ func Register(server reflection.GRPCServer, qrouter qrouter.QueryRouter, mgr met
 	protos.RegisterPoolServiceServer(server, lqr)
 	protos.RegisterDistributionServiceServer(server, lqr)
 	protos.RegisterTasksServiceServer(server, lqr)
+	protos.RegisterSequenceServiceServer(server, lqr)
+	protos.RegisterSchemaServiceServer(server, lqr)
 }
 
 var _ protos.KeyRangeServiceServer = &LocalQrouterServer{}
@@ -478,3 +578,5 @@ var _ protos.PoolServiceServer = &LocalQrouterServer{}
 var _ protos.DistributionServiceServer = &LocalQrouterServer{}
 var _ protos.TasksServiceServer = &LocalQrouterServer{}
 var _ protos.ShardServiceServer = &LocalQrouterServer{}
+var _ protos.SequenceServiceServer = &LocalQrouterServer{}
+var _ protos.SchemaServiceServer = &LocalQrouterServer{}
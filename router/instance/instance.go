@@ -11,6 +11,7 @@ import (
 	"github.com/pg-sharding/spqr/pkg/coord/local"
 	"github.com/pg-sharding/spqr/pkg/meta"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/pkg/tsa"
 	"github.com/pg-sharding/spqr/pkg/workloadlog"
 	"github.com/pg-sharding/spqr/qdb"
 	"github.com/pg-sharding/spqr/router/console"
@@ -131,8 +132,20 @@ func NewRouter(ctx context.Context, rcfg *config.Router, ns string, persist bool
 	}
 	writ := workloadlog.NewLogger(batchSize, logFile)
 
+	// background host health checks
+	var health tsa.HealthChecker
+	if rcfg.HostHealthCheck.Enabled {
+		hc, err := rulerouter.NewHealthChecker(rcfg)
+		if err != nil {
+			return nil, fmt.Errorf("init host health checker: %w", err)
+		}
+		go hc.Run(ctx)
+		health = hc
+	}
+
 	// request router
-	rr := rulerouter.NewRouter(frTLS, rcfg, notifier)
+	rr := rulerouter.NewRouter(frTLS, rcfg, notifier, health)
+	go rr.WarmupPools(rcfg)
 
 	stchan := make(chan struct{})
 	localConsole, err := console.NewLocalInstanceConsole(lc, rr, stchan, writ)
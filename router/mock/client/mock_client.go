@@ -8,6 +8,7 @@ import (
 	context "context"
 	tls "crypto/tls"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	pgproto3 "github.com/jackc/pgx/v5/pgproto3"
@@ -42,6 +43,30 @@ func (m *MockPreparedStatementMapper) EXPECT() *MockPreparedStatementMapperMockR
 	return m.recorder
 }
 
+// DeallocateAllPreparedStatements mocks base method.
+func (m *MockPreparedStatementMapper) DeallocateAllPreparedStatements() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeallocateAllPreparedStatements")
+}
+
+// DeallocateAllPreparedStatements indicates an expected call of DeallocateAllPreparedStatements.
+func (mr *MockPreparedStatementMapperMockRecorder) DeallocateAllPreparedStatements() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeallocateAllPreparedStatements", reflect.TypeOf((*MockPreparedStatementMapper)(nil).DeallocateAllPreparedStatements))
+}
+
+// DeallocatePreparedStatement mocks base method.
+func (m *MockPreparedStatementMapper) DeallocatePreparedStatement(name string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeallocatePreparedStatement", name)
+}
+
+// DeallocatePreparedStatement indicates an expected call of DeallocatePreparedStatement.
+func (mr *MockPreparedStatementMapperMockRecorder) DeallocatePreparedStatement(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeallocatePreparedStatement", reflect.TypeOf((*MockPreparedStatementMapper)(nil).DeallocatePreparedStatement), name)
+}
+
 // PreparedStatementQueryByName mocks base method.
 func (m *MockPreparedStatementMapper) PreparedStatementQueryByName(name string) string {
 	m.ctrl.T.Helper()
@@ -229,6 +254,20 @@ func (mr *MockRouterClientMockRecorder) CommitActiveSet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitActiveSet", reflect.TypeOf((*MockRouterClient)(nil).CommitActiveSet))
 }
 
+// ConsistentSnapshot mocks base method.
+func (m *MockRouterClient) ConsistentSnapshot() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistentSnapshot")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ConsistentSnapshot indicates an expected call of ConsistentSnapshot.
+func (mr *MockRouterClientMockRecorder) ConsistentSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistentSnapshot", reflect.TypeOf((*MockRouterClient)(nil).ConsistentSnapshot))
+}
+
 // ConstructClientParams mocks base method.
 func (m *MockRouterClient) ConstructClientParams() *pgproto3.Query {
 	m.ctrl.T.Helper()
@@ -257,6 +296,30 @@ func (mr *MockRouterClientMockRecorder) DB() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DB", reflect.TypeOf((*MockRouterClient)(nil).DB))
 }
 
+// DeallocateAllPreparedStatements mocks base method.
+func (m *MockRouterClient) DeallocateAllPreparedStatements() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeallocateAllPreparedStatements")
+}
+
+// DeallocateAllPreparedStatements indicates an expected call of DeallocateAllPreparedStatements.
+func (mr *MockRouterClientMockRecorder) DeallocateAllPreparedStatements() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeallocateAllPreparedStatements", reflect.TypeOf((*MockRouterClient)(nil).DeallocateAllPreparedStatements))
+}
+
+// DeallocatePreparedStatement mocks base method.
+func (m *MockRouterClient) DeallocatePreparedStatement(name string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeallocatePreparedStatement", name)
+}
+
+// DeallocatePreparedStatement indicates an expected call of DeallocatePreparedStatement.
+func (mr *MockRouterClientMockRecorder) DeallocatePreparedStatement(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeallocatePreparedStatement", reflect.TypeOf((*MockRouterClient)(nil).DeallocatePreparedStatement), name)
+}
+
 // DefaultReply mocks base method.
 func (m *MockRouterClient) DefaultReply() error {
 	m.ctrl.T.Helper()
@@ -437,6 +500,20 @@ func (mr *MockRouterClientMockRecorder) RUnlock() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RUnlock", reflect.TypeOf((*MockRouterClient)(nil).RUnlock))
 }
 
+// ReadYourWrites mocks base method.
+func (m *MockRouterClient) ReadYourWrites() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadYourWrites")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ReadYourWrites indicates an expected call of ReadYourWrites.
+func (mr *MockRouterClientMockRecorder) ReadYourWrites() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadYourWrites", reflect.TypeOf((*MockRouterClient)(nil).ReadYourWrites))
+}
+
 // Receive mocks base method.
 func (m *MockRouterClient) Receive() (pgproto3.FrontendMessage, error) {
 	m.ctrl.T.Helper()
@@ -867,6 +944,18 @@ func (mr *MockRouterClientMockRecorder) SetBindParams(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBindParams", reflect.TypeOf((*MockRouterClient)(nil).SetBindParams), arg0)
 }
 
+// SetConsistentSnapshot mocks base method.
+func (m *MockRouterClient) SetConsistentSnapshot(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetConsistentSnapshot", arg0)
+}
+
+// SetConsistentSnapshot indicates an expected call of SetConsistentSnapshot.
+func (mr *MockRouterClientMockRecorder) SetConsistentSnapshot(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConsistentSnapshot", reflect.TypeOf((*MockRouterClient)(nil).SetConsistentSnapshot), arg0)
+}
+
 // SetDefaultRouteBehaviour mocks base method.
 func (m *MockRouterClient) SetDefaultRouteBehaviour(arg0 string) {
 	m.ctrl.T.Helper()
@@ -903,6 +992,32 @@ func (mr *MockRouterClientMockRecorder) SetParamFormatCodes(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParamFormatCodes", reflect.TypeOf((*MockRouterClient)(nil).SetParamFormatCodes), arg0)
 }
 
+// SetReadYourWrites mocks base method.
+func (m *MockRouterClient) SetReadYourWrites(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetReadYourWrites", arg0)
+}
+
+// SetReadYourWrites indicates an expected call of SetReadYourWrites.
+func (mr *MockRouterClientMockRecorder) SetReadYourWrites(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadYourWrites", reflect.TypeOf((*MockRouterClient)(nil).SetReadYourWrites), arg0)
+}
+
+// SetReceiveDeadline mocks base method.
+func (m *MockRouterClient) SetReceiveDeadline(t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReceiveDeadline", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReceiveDeadline indicates an expected call of SetReceiveDeadline.
+func (mr *MockRouterClientMockRecorder) SetReceiveDeadline(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReceiveDeadline", reflect.TypeOf((*MockRouterClient)(nil).SetReceiveDeadline), t)
+}
+
 // SetRouteHint mocks base method.
 func (m *MockRouterClient) SetRouteHint(arg0 routehint.RouteHint) {
 	m.ctrl.T.Helper()
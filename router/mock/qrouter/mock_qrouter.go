@@ -39,6 +39,20 @@ func (m *MockQueryRouter) EXPECT() *MockQueryRouterMockRecorder {
 	return m.recorder
 }
 
+// CheckPreparedSequences mocks base method.
+func (m *MockQueryRouter) CheckPreparedSequences(ctx context.Context, query string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPreparedSequences", ctx, query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPreparedSequences indicates an expected call of CheckPreparedSequences.
+func (mr *MockQueryRouterMockRecorder) CheckPreparedSequences(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPreparedSequences", reflect.TypeOf((*MockQueryRouter)(nil).CheckPreparedSequences), ctx, query)
+}
+
 // DataShardsRoutes mocks base method.
 func (m *MockQueryRouter) DataShardsRoutes() []*routingstate.DataShardRoute {
 	m.ctrl.T.Helper()
@@ -110,6 +124,35 @@ func (mr *MockQueryRouterMockRecorder) Mgr() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mgr", reflect.TypeOf((*MockQueryRouter)(nil).Mgr))
 }
 
+// RecordSchemaChange mocks base method.
+func (m *MockQueryRouter) RecordSchemaChange(ctx context.Context, query string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSchemaChange", ctx, query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSchemaChange indicates an expected call of RecordSchemaChange.
+func (mr *MockQueryRouterMockRecorder) RecordSchemaChange(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSchemaChange", reflect.TypeOf((*MockQueryRouter)(nil).RecordSchemaChange), ctx, query)
+}
+
+// RewriteSequences mocks base method.
+func (m *MockQueryRouter) RewriteSequences(ctx context.Context, query string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewriteSequences", ctx, query)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RewriteSequences indicates an expected call of RewriteSequences.
+func (mr *MockQueryRouterMockRecorder) RewriteSequences(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewriteSequences", reflect.TypeOf((*MockQueryRouter)(nil).RewriteSequences), ctx, query)
+}
+
 // Route mocks base method.
 func (m *MockQueryRouter) Route(ctx context.Context, stmt lyx.Node, sph session.SessionParamsHolder) (routingstate.RoutingState, error) {
 	m.ctrl.T.Helper()
@@ -29,7 +29,8 @@ type ParseState interface{}
 
 type ParseStateTXBegin struct {
 	ParseState
-	Options []lyx.TransactionModeItem
+	Options        []lyx.TransactionModeItem
+	IsolationLevel string
 }
 
 type ParseStateTXRollback struct {
@@ -99,6 +100,13 @@ type ParseStateExecute struct {
 	Name           string
 }
 
+type ParseStateDeallocate struct {
+	ParseState
+	Name string
+	// All is set for DEALLOCATE ALL
+	All bool
+}
+
 type ParseStateExplain struct {
 	ParseState
 	Query lyx.Node
@@ -182,6 +190,12 @@ func (qp *QParser) Parse(query string) (ParseState, string, error) {
 			Msg("parsed prep stmt")
 		qp.state = varStmt
 
+		return qp.state, comment, nil
+	case *lyx.DeallocateStmt:
+		qp.state = ParseStateDeallocate{
+			Name: q.Name,
+			All:  q.Name == "",
+		}
 		return qp.state, comment, nil
 	case *lyx.VariableShowStmt:
 		if strings.HasPrefix(q.Name, "__spqr__") {
@@ -239,7 +253,8 @@ func (qp *QParser) Parse(query string) (ParseState, string, error) {
 		switch q.Kind {
 		case lyx.TRANS_STMT_BEGIN:
 			qp.state = ParseStateTXBegin{
-				Options: q.Options,
+				Options:        q.Options,
+				IsolationLevel: q.IsolationLevel,
 			}
 			return qp.state, comment, nil
 		case lyx.TRANS_STMT_COMMIT:
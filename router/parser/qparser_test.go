@@ -47,3 +47,33 @@ func TestCopySingleShard(t *testing.T) {
 		assert.Equal(tt.exp, parserRes)
 	}
 }
+
+func TestDeallocate(t *testing.T) {
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   parser.ParseState
+	}
+	p := parser.QParser{}
+	for _, tt := range []tcase{
+		{
+			query: "DEALLOCATE stmt1",
+			exp:   parser.ParseStateDeallocate{Name: "stmt1"},
+		},
+		{
+			query: "DEALLOCATE PREPARE stmt1",
+			exp:   parser.ParseStateDeallocate{Name: "stmt1"},
+		},
+		{
+			query: "DEALLOCATE ALL",
+			exp:   parser.ParseStateDeallocate{All: true},
+		},
+	} {
+		parserRes, _, err := p.Parse(tt.query)
+
+		assert.NoError(err, "query %s", tt.query)
+
+		assert.Equal(tt.exp, parserRes, "query %s", tt.query)
+	}
+}
@@ -159,6 +159,21 @@ func (t *TxConnManager) TXEndCB(rst ConnectionKeeper) error {
 	return t.UnRouteCB(rst.Client(), ash)
 }
 
+// StmtConnManager releases server connection after every statement.
+// Transaction blocks are rejected by relay in statement pool mode,
+// so every statement ends its own transaction.
+type StmtConnManager struct {
+	TxConnManager
+}
+
+func NewStmtConnManager(rcfg *config.Router) *StmtConnManager {
+	return &StmtConnManager{
+		TxConnManager: TxConnManager{
+			ReplyNotice: rcfg.ShowNoticeMessages,
+		},
+	}
+}
+
 type SessConnManager struct {
 	ReplyNotice bool
 }
@@ -237,6 +252,8 @@ func MatchConnectionPooler(client client.RouterClient, rcfg *config.Router) (Poo
 		return NewSessConnManager(rcfg), nil
 	case config.PoolModeTransaction:
 		return NewTxConnManager(rcfg), nil
+	case config.PoolModeStatement:
+		return NewStmtConnManager(rcfg), nil
 	default:
 		for _, msg := range []pgproto3.BackendMessage{
 			&pgproto3.ErrorResponse{
@@ -0,0 +1,518 @@
+package qrouter
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/pg-sharding/lyx/lyx"
+	"github.com/pg-sharding/spqr/pkg/models/distributions"
+	"github.com/pg-sharding/spqr/pkg/models/hashfunction"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
+	"github.com/pg-sharding/spqr/qdb"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	CopyFormatText   = "text"
+	CopyFormatCSV    = "csv"
+	CopyFormatBinary = "binary"
+)
+
+var (
+	ErrCopyColumnsMissing = spqrerror.New(spqrerror.SPQR_INVALID_REQUEST, "COPY FROM STDIN to sharded relation requires column list")
+	ErrCopyNullKey        = spqrerror.New(spqrerror.SPQR_ROUTING_ERROR, "COPY row has NULL distribution key value")
+	ErrCopyMalformedRow   = spqrerror.New(spqrerror.SPQR_ROUTING_ERROR, "malformed COPY data")
+)
+
+// signature of binary COPY format header
+var copyBinarySignature = []byte("PGCOPY\n\377\r\n\000")
+
+// CopyOptions describe data stream of COPY FROM STDIN or COPY TO STDOUT statement
+type CopyOptions struct {
+	IsFrom    bool
+	Columns   []string
+	Format    string
+	Delimiter byte
+	Quote     byte
+	Escape    byte
+	Header    bool
+	// string, which represents NULL value in text and csv formats,
+	// csv format matches only unquoted string
+	Null string
+}
+
+// ParseCopyOptions takes column list and data format options of COPY FROM STDIN
+// or COPY TO STDOUT statement. Both parenthesized and legacy option syntax are supported.
+func ParseCopyOptions(stmt *lyx.Copy) (*CopyOptions, error) {
+	if !stmt.Stdio {
+		if stmt.IsFrom {
+			return nil, spqrerror.New(spqrerror.SPQR_NOT_IMPLEMENTED, "only COPY FROM STDIN is supported")
+		}
+		return nil, spqrerror.New(spqrerror.SPQR_NOT_IMPLEMENTED, "only COPY TO STDOUT is supported")
+	}
+
+	opts := &CopyOptions{
+		IsFrom:  stmt.IsFrom,
+		Columns: stmt.Columns,
+		Format:  CopyFormatText,
+	}
+
+	singleByte := func(opt *lyx.CopyOption) (byte, error) {
+		if len(opt.Value) != 1 {
+			return 0, spqrerror.Newf(spqrerror.SPQR_COMPLEX_QUERY, "COPY %s must be a single one-byte character", opt.Name)
+		}
+		return opt.Value[0], nil
+	}
+
+	var null *string
+	for _, opt := range stmt.Options {
+		var err error
+		switch opt.Name {
+		case "format":
+			opts.Format = strings.ToLower(opt.Value)
+		case "delimiter":
+			opts.Delimiter, err = singleByte(opt)
+		case "quote":
+			opts.Quote, err = singleByte(opt)
+		case "escape":
+			opts.Escape, err = singleByte(opt)
+		case "header":
+			switch strings.ToLower(opt.Value) {
+			case "false", "off":
+				opts.Header = false
+			default:
+				opts.Header = true
+			}
+		case "null":
+			null = &opt.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch opts.Format {
+	case CopyFormatText:
+		if opts.Delimiter == 0 {
+			opts.Delimiter = '\t'
+		}
+		opts.Null = `\N`
+	case CopyFormatCSV:
+		if opts.Delimiter == 0 {
+			opts.Delimiter = ','
+		}
+		if opts.Quote == 0 {
+			opts.Quote = '"'
+		}
+		if opts.Escape == 0 {
+			opts.Escape = opts.Quote
+		}
+	case CopyFormatBinary:
+	default:
+		return nil, spqrerror.Newf(spqrerror.SPQR_COMPLEX_QUERY, "unknown COPY format \"%s\"", opts.Format)
+	}
+	if null != nil {
+		opts.Null = *null
+	}
+
+	return opts, nil
+}
+
+// decodeCopyText decodes backslash escapes of text COPY format
+func decodeCopyText(raw []byte) string {
+	var sb strings.Builder
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '\\' || i+1 == len(raw) {
+			sb.WriteByte(raw[i])
+			continue
+		}
+		i++
+		switch c := raw[i]; c {
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case 'v':
+			sb.WriteByte('\v')
+		case 'x':
+			j := i + 1
+			for j < len(raw) && j < i+3 && strings.IndexByte("0123456789abcdefABCDEF", raw[j]) != -1 {
+				j++
+			}
+			if j == i+1 {
+				sb.WriteByte(c)
+				continue
+			}
+			v, _ := strconv.ParseUint(string(raw[i+1:j]), 16, 8)
+			sb.WriteByte(byte(v))
+			i = j - 1
+		default:
+			if c >= '0' && c <= '7' {
+				j := i
+				for j < len(raw) && j < i+3 && raw[j] >= '0' && raw[j] <= '7' {
+					j++
+				}
+				v, _ := strconv.ParseUint(string(raw[i:j]), 8, 8)
+				sb.WriteByte(byte(v))
+				i = j - 1
+				continue
+			}
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// CopyRowRouter splits data stream of COPY FROM STDIN into rows and
+// routes every row to the shard, which owns its distribution key value.
+type CopyRowRouter struct {
+	qr   QueryRouter
+	opts *CopyOptions
+
+	keyPos  int
+	colType string
+	hf      hashfunction.HashFunctionType
+	expr    distributions.KeyExpressionType
+	krs     []*kr.KeyRange
+	shards  []string
+
+	/* incomplete row from previous chunk of data */
+	buf []byte
+	/* header (and binary trailer) are sent to every shard */
+	headerDone bool
+}
+
+// NewCopyRowRouter creates router of COPY FROM STDIN rows of distributed relation.
+// Distribution key column must be present in COPY column list.
+func NewCopyRowRouter(ctx context.Context, qr QueryRouter, relName string, opts *CopyOptions) (*CopyRowRouter, error) {
+	ds, err := qr.Mgr().GetRelationDistribution(ctx, relName)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, ok := ds.Relations[relName]
+	if !ok {
+		return nil, spqrerror.Newf(spqrerror.SPQR_NO_DISTRIBUTION, "relation \"%s\" has no distribution key", relName)
+	}
+	distrKey := rel.DistributionKey
+	if len(distrKey) != 1 {
+		return nil, spqrerror.Newf(spqrerror.SPQR_NOT_IMPLEMENTED, "COPY FROM STDIN to relation \"%s\" with composite distribution key is not supported", relName)
+	}
+	if len(opts.Columns) == 0 {
+		return nil, ErrCopyColumnsMissing
+	}
+
+	keyPos := slices.Index(opts.Columns, distrKey[0].Column)
+	if keyPos == -1 {
+		return nil, spqrerror.Newf(spqrerror.SPQR_MISS_SHARDING_KEY, "COPY column list does not contain distribution key column \"%s\"", distrKey[0].Column)
+	}
+
+	hf, err := hashfunction.HashFunctionByName(distrKey[0].HashFunction)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := distributions.KeyExpressionByName(distrKey[0].Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	krs, err := qr.Mgr().ListKeyRanges(ctx, ds.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	colType := qdb.ColumnTypeVarchar
+	if len(ds.ColTypes) != 0 {
+		colType = ds.ColTypes[0]
+	}
+
+	r := &CopyRowRouter{
+		qr:      qr,
+		opts:    opts,
+		keyPos:  keyPos,
+		colType: colType,
+		hf:      hf,
+		expr:    expr,
+		krs:     krs,
+	}
+	for _, route := range qr.DataShardsRoutes() {
+		r.shards = append(r.shards, route.Shkey.Name)
+	}
+	return r, nil
+}
+
+// Route splits data into rows and groups them by shard name.
+// Incomplete row at the end of data is kept until next call.
+func (r *CopyRowRouter) Route(ctx context.Context, data []byte) (map[string][]byte, error) {
+	r.buf = append(r.buf, data...)
+
+	res := map[string][]byte{}
+	for len(r.buf) != 0 {
+		n, broadcast, key, err := r.nextRow(r.buf)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+		if err := r.add(ctx, res, r.buf[:n], broadcast, key); err != nil {
+			return nil, err
+		}
+		r.buf = r.buf[n:]
+	}
+
+	/* do not keep reference to caller data */
+	r.buf = append([]byte(nil), r.buf...)
+	return res, nil
+}
+
+// Done routes the last row of text data, which may lack trailing newline
+func (r *CopyRowRouter) Done(ctx context.Context) (map[string][]byte, error) {
+	res := map[string][]byte{}
+	if len(r.buf) == 0 {
+		return res, nil
+	}
+	if r.opts.Format == CopyFormatBinary {
+		return nil, ErrCopyMalformedRow
+	}
+
+	n, broadcast, key, err := r.nextRow(append(r.buf, '\n'))
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrCopyMalformedRow
+	}
+	if err := r.add(ctx, res, r.buf, broadcast, key); err != nil {
+		return nil, err
+	}
+	r.buf = nil
+	return res, nil
+}
+
+func (r *CopyRowRouter) add(ctx context.Context, res map[string][]byte, row []byte, broadcast bool, key string) error {
+	if broadcast {
+		for _, sh := range r.shards {
+			res[sh] = append(res[sh], row...)
+		}
+		return nil
+	}
+
+	val, err := distributions.ApplyKeyExpression(key, r.expr)
+	if err != nil {
+		return err
+	}
+	hashed, err := hashfunction.ApplyHashFunction([]byte(val), r.hf)
+	if err != nil {
+		return err
+	}
+	route, err := r.qr.DeparseKeyWithRangesInternal(ctx, string(hashed), r.krs)
+	if err != nil {
+		return err
+	}
+	res[route.Shkey.Name] = append(res[route.Shkey.Name], row...)
+	return nil
+}
+
+// nextRow returns length of first complete row in buf, or zero, if there is none.
+// Rows, which every shard should receive, are reported as broadcast.
+func (r *CopyRowRouter) nextRow(buf []byte) (int, bool, string, error) {
+	if r.opts.Format == CopyFormatBinary {
+		return r.nextBinaryRow(buf)
+	}
+
+	var n int
+	var key []byte
+	var null bool
+	if r.opts.Format == CopyFormatCSV {
+		n, key, null = r.nextCSVRow(buf)
+	} else {
+		n, key, null = r.nextTextRow(buf)
+	}
+	if n == 0 {
+		return 0, false, "", nil
+	}
+
+	if !r.headerDone {
+		r.headerDone = true
+		if r.opts.Header {
+			return n, true, "", nil
+		}
+	}
+	/* end-of-data marker */
+	if line := bytes.TrimRight(buf[:n], "\r\n"); string(line) == `\.` {
+		return n, true, "", nil
+	}
+	if null {
+		return 0, false, "", ErrCopyNullKey
+	}
+	if key == nil {
+		return 0, false, "", ErrCopyMalformedRow
+	}
+	return n, false, string(key), nil
+}
+
+func (r *CopyRowRouter) nextTextRow(buf []byte) (int, []byte, bool) {
+	end := bytes.IndexByte(buf, '\n')
+	if end == -1 {
+		return 0, nil, false
+	}
+	line := bytes.TrimSuffix(buf[:end], []byte{'\r'})
+
+	field, start := 0, 0
+	for i := 0; i <= len(line); i++ {
+		if i < len(line) && line[i] == '\\' {
+			i++
+			continue
+		}
+		if i < len(line) && line[i] != r.opts.Delimiter {
+			continue
+		}
+		if field == r.keyPos {
+			/* NULL string is matched before backslash escapes are decoded */
+			raw := line[start:i]
+			if string(raw) == r.opts.Null {
+				return end + 1, nil, true
+			}
+			return end + 1, []byte(decodeCopyText(raw)), false
+		}
+		field++
+		start = i + 1
+	}
+	return end + 1, nil, false
+}
+
+func (r *CopyRowRouter) nextCSVRow(buf []byte) (int, []byte, bool) {
+	quote, esc, delim := r.opts.Quote, r.opts.Escape, r.opts.Delimiter
+
+	field := 0
+	key := []byte{}
+	keyQuoted := false
+	inQuote := false
+	for i := 0; i < len(buf); i++ {
+		c := buf[i]
+		if inQuote {
+			if c == esc && (esc != quote || i+1 == len(buf) || buf[i+1] == quote) {
+				if i+1 == len(buf) {
+					/* can not tell escape from closing quote yet */
+					return 0, nil, false
+				}
+				if buf[i+1] == quote || buf[i+1] == esc {
+					if field == r.keyPos {
+						key = append(key, buf[i+1])
+					}
+					i++
+					continue
+				}
+			}
+			if c == quote {
+				inQuote = false
+				continue
+			}
+			if field == r.keyPos {
+				key = append(key, c)
+			}
+			continue
+		}
+
+		switch c {
+		case quote:
+			inQuote = true
+			if field == r.keyPos {
+				keyQuoted = true
+			}
+		case delim:
+			field++
+		case '\n':
+			if field < r.keyPos {
+				return i + 1, nil, false
+			}
+			if !keyQuoted {
+				key = bytes.TrimSuffix(key, []byte{'\r'})
+				if string(key) == r.opts.Null {
+					return i + 1, nil, true
+				}
+			}
+			return i + 1, key, false
+		default:
+			if field == r.keyPos {
+				key = append(key, c)
+			}
+		}
+	}
+	return 0, nil, false
+}
+
+func (r *CopyRowRouter) nextBinaryRow(buf []byte) (int, bool, string, error) {
+	if !r.headerDone {
+		/* signature, flags and header extension length */
+		if len(buf) < len(copyBinarySignature)+8 {
+			return 0, false, "", nil
+		}
+		if !bytes.Equal(buf[:len(copyBinarySignature)], copyBinarySignature) {
+			return 0, false, "", ErrCopyMalformedRow
+		}
+		n := len(copyBinarySignature) + 8 + int(binary.BigEndian.Uint32(buf[len(copyBinarySignature)+4:]))
+		if len(buf) < n {
+			return 0, false, "", nil
+		}
+		r.headerDone = true
+		return n, true, "", nil
+	}
+
+	if len(buf) < 2 {
+		return 0, false, "", nil
+	}
+	cnt := int(int16(binary.BigEndian.Uint16(buf)))
+	if cnt == -1 {
+		/* trailer */
+		return 2, true, "", nil
+	}
+	if r.keyPos >= cnt {
+		return 0, false, "", ErrCopyMalformedRow
+	}
+
+	off := 2
+	var key []byte
+	null := false
+	for f := 0; f < cnt; f++ {
+		if len(buf) < off+4 {
+			return 0, false, "", nil
+		}
+		l := int(int32(binary.BigEndian.Uint32(buf[off:])))
+		off += 4
+		if l < -1 {
+			return 0, false, "", ErrCopyMalformedRow
+		}
+		if l == -1 {
+			null = null || f == r.keyPos
+			continue
+		}
+		if len(buf) < off+l {
+			return 0, false, "", nil
+		}
+		if f == r.keyPos {
+			key = buf[off : off+l]
+		}
+		off += l
+	}
+
+	if null {
+		return 0, false, "", ErrCopyNullKey
+	}
+	val, err := binaryKeyValue(key, r.colType)
+	if err != nil {
+		return 0, false, "", err
+	}
+	return off, false, val, nil
+}
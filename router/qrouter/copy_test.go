@@ -0,0 +1,373 @@
+package qrouter_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/coord/local"
+	"github.com/pg-sharding/spqr/qdb"
+	"github.com/pg-sharding/spqr/router/qrouter"
+
+	"github.com/pg-sharding/lyx/lyx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCopyOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   *qrouter.CopyOptions
+		err   bool
+	}
+
+	for _, tt := range []tcase{
+		{
+			query: "COPY xx (i, j) FROM STDIN",
+			exp: &qrouter.CopyOptions{
+				IsFrom:    true,
+				Columns:   []string{"i", "j"},
+				Format:    qrouter.CopyFormatText,
+				Delimiter: '\t',
+				Null:      `\N`,
+			},
+		},
+		{
+			query: "copy public.xx (\"I\", j) from stdin with (format csv, header true, delimiter ';')",
+			exp: &qrouter.CopyOptions{
+				IsFrom:    true,
+				Columns:   []string{"I", "j"},
+				Format:    qrouter.CopyFormatCSV,
+				Delimiter: ';',
+				Quote:     '"',
+				Escape:    '"',
+				Header:    true,
+			},
+		},
+		{
+			query: "COPY xx (i) FROM STDIN WITH CSV HEADER DELIMITER AS '|' QUOTE '$' NULL AS 'none';",
+			exp: &qrouter.CopyOptions{
+				IsFrom:    true,
+				Columns:   []string{"i"},
+				Format:    qrouter.CopyFormatCSV,
+				Delimiter: '|',
+				Quote:     '$',
+				Escape:    '$',
+				Header:    true,
+				Null:      "none",
+			},
+		},
+		{
+			query: "COPY xx (i) FROM STDIN (NULL '', HEADER off)",
+			exp: &qrouter.CopyOptions{
+				IsFrom:    true,
+				Columns:   []string{"i"},
+				Format:    qrouter.CopyFormatText,
+				Delimiter: '\t',
+			},
+		},
+		{
+			query: "COPY xx (i) FROM STDIN (FORMAT binary)",
+			exp: &qrouter.CopyOptions{
+				IsFrom:  true,
+				Columns: []string{"i"},
+				Format:  qrouter.CopyFormatBinary,
+			},
+		},
+		{
+			query: "COPY xx TO STDOUT WITH CSV HEADER",
+			exp: &qrouter.CopyOptions{
+				Format:    qrouter.CopyFormatCSV,
+				Delimiter: ',',
+				Quote:     '"',
+				Escape:    '"',
+				Header:    true,
+			},
+		},
+		{
+			query: "COPY (SELECT * FROM xx WHERE j = ')') TO STDOUT (FORMAT binary)",
+			exp: &qrouter.CopyOptions{
+				Format: qrouter.CopyFormatBinary,
+			},
+		},
+		{
+			query: "COPY xx TO '/tmp/data'",
+			err:   true,
+		},
+		{
+			query: "COPY xx (i) FROM STDIN (FORMAT parquet)",
+			err:   true,
+		},
+		{
+			query: "COPY xx (i) FROM STDIN (DELIMITER '||')",
+			err:   true,
+		},
+		{
+			query: "COPY xx (i) FROM '/tmp/data'",
+			err:   true,
+		},
+	} {
+		stmt, err := lyx.Parse(tt.query)
+		assert.NoError(err, "query %s", tt.query)
+
+		opts, err := qrouter.ParseCopyOptions(stmt.(*lyx.Copy))
+		if tt.err {
+			assert.Error(err, "query %s", tt.query)
+			continue
+		}
+		assert.NoError(err, "query %s", tt.query)
+		assert.Equal(tt.exp, opts, "query %s", tt.query)
+	}
+}
+
+func parseCopyOptions(t *testing.T, query string) *qrouter.CopyOptions {
+	stmt, err := lyx.Parse(query)
+	assert.NoError(t, err)
+
+	opts, err := qrouter.ParseCopyOptions(stmt.(*lyx.Copy))
+	assert.NoError(t, err)
+	return opts
+}
+
+func prepareCopyRouter(t *testing.T) qrouter.QueryRouter {
+	assert := assert.New(t)
+
+	db, _ := qdb.NewMemQDB(MemQDBPath)
+	distribution := "dcopy"
+
+	_ = db.CreateDistribution(context.TODO(), &qdb.Distribution{
+		ID:       distribution,
+		ColTypes: []string{qdb.ColumnTypeVarchar},
+		Relations: map[string]*qdb.DistributedRelation{
+			"xx": {
+				Name: "xx",
+				DistributionKey: []qdb.DistributionKeyEntry{
+					{
+						Column: "k",
+					},
+				},
+			},
+			"yy": {
+				Name: "yy",
+				DistributionKey: []qdb.DistributionKeyEntry{
+					{
+						Column: "k",
+					},
+					{
+						Column: "l",
+					},
+				},
+			},
+		},
+	})
+
+	err := db.CreateKeyRange(context.TODO(), &qdb.KeyRange{
+		ShardID:        "sh1",
+		DistributionId: distribution,
+		KeyRangeID:     "id1",
+		LowerBound:     []byte("a"),
+	})
+	assert.NoError(err)
+
+	err = db.CreateKeyRange(context.TODO(), &qdb.KeyRange{
+		ShardID:        "sh2",
+		DistributionId: distribution,
+		KeyRangeID:     "id2",
+		LowerBound:     []byte("m"),
+	})
+	assert.NoError(err)
+
+	lc := local.NewLocalCoordinator(db)
+
+	pr, err := qrouter.NewProxyRouter(map[string]*config.Shard{
+		"sh1": {
+			Hosts: nil,
+		},
+		"sh2": {
+			Hosts: nil,
+		},
+	}, lc, &config.QRouter{})
+	assert.NoError(err)
+
+	return pr
+}
+
+func TestCopyRowRouterText(t *testing.T) {
+	assert := assert.New(t)
+
+	pr := prepareCopyRouter(t)
+
+	opts := parseCopyOptions(t, "COPY xx (id, k) FROM STDIN")
+
+	r, err := qrouter.NewCopyRowRouter(context.TODO(), pr, "xx", opts)
+	assert.NoError(err)
+
+	/* rows are split between chunks of data */
+	rows, err := r.Route(context.TODO(), []byte("1\tb\n2\tx\n3\t"))
+	assert.NoError(err)
+	assert.Equal(map[string][]byte{
+		"sh1": []byte("1\tb\n"),
+		"sh2": []byte("2\tx\n"),
+	}, rows)
+
+	rows, err = r.Route(context.TODO(), []byte("\\x63\n4\tz"))
+	assert.NoError(err)
+	assert.Equal(map[string][]byte{
+		"sh1": []byte("3\t\\x63\n"),
+	}, rows)
+
+	rows, err = r.Done(context.TODO())
+	assert.NoError(err)
+	assert.Equal(map[string][]byte{
+		"sh2": []byte("4\tz"),
+	}, rows)
+
+	_, err = r.Route(context.TODO(), []byte("5\t\\N\n"))
+	assert.ErrorIs(err, qrouter.ErrCopyNullKey)
+}
+
+func TestCopyRowRouterNullOption(t *testing.T) {
+	assert := assert.New(t)
+
+	pr := prepareCopyRouter(t)
+
+	r, err := qrouter.NewCopyRowRouter(context.TODO(), pr, "xx", parseCopyOptions(t, "COPY xx (id, k) FROM STDIN (NULL 'none')"))
+	assert.NoError(err)
+
+	/* default NULL string is regular value then, it is decoded to "N" */
+	rows, err := r.Route(context.TODO(), []byte("1\tb\n2\t\\Nx\n"))
+	assert.NoError(err)
+	assert.Equal(map[string][]byte{
+		"sh1": []byte("1\tb\n"),
+		"sh2": []byte("2\t\\Nx\n"),
+	}, rows)
+
+	_, err = r.Route(context.TODO(), []byte("3\tnone\n"))
+	assert.ErrorIs(err, qrouter.ErrCopyNullKey)
+
+	r, err = qrouter.NewCopyRowRouter(context.TODO(), pr, "xx", parseCopyOptions(t, "COPY xx (k, v) FROM STDIN WITH CSV NULL 'x'"))
+	assert.NoError(err)
+
+	/* quoted NULL string is not NULL */
+	rows, err = r.Route(context.TODO(), []byte("\"x\",1\nb,2\n"))
+	assert.NoError(err)
+	assert.Equal(map[string][]byte{
+		"sh1": []byte("b,2\n"),
+		"sh2": []byte("\"x\",1\n"),
+	}, rows)
+
+	_, err = r.Route(context.TODO(), []byte("x,3\n"))
+	assert.ErrorIs(err, qrouter.ErrCopyNullKey)
+}
+
+func TestCopyRowRouterCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	pr := prepareCopyRouter(t)
+
+	opts := parseCopyOptions(t, "COPY xx (k, v) FROM STDIN WITH (FORMAT csv, HEADER)")
+
+	r, err := qrouter.NewCopyRowRouter(context.TODO(), pr, "xx", opts)
+	assert.NoError(err)
+
+	rows, err := r.Route(context.TODO(), []byte("k,v\n\"x,\"\"y\",\"multi\nline\"\nb,2\n"))
+	assert.NoError(err)
+	assert.Equal(map[string][]byte{
+		"sh1": []byte("k,v\nb,2\n"),
+		"sh2": []byte("k,v\n\"x,\"\"y\",\"multi\nline\"\n"),
+	}, rows)
+
+	rows, err = r.Route(context.TODO(), []byte("\\.\n"))
+	assert.NoError(err)
+	assert.Equal(map[string][]byte{
+		"sh1": []byte("\\.\n"),
+		"sh2": []byte("\\.\n"),
+	}, rows)
+}
+
+func TestCopyRowRouterBinary(t *testing.T) {
+	assert := assert.New(t)
+
+	pr := prepareCopyRouter(t)
+
+	opts := parseCopyOptions(t, "COPY xx (k) FROM STDIN (FORMAT binary)")
+
+	r, err := qrouter.NewCopyRowRouter(context.TODO(), pr, "xx", opts)
+	assert.NoError(err)
+
+	header := append([]byte("PGCOPY\n\377\r\n\000"), 0, 0, 0, 0, 0, 0, 0, 0)
+	row := func(val string) []byte {
+		b := binary.BigEndian.AppendUint16(nil, 1)
+		b = binary.BigEndian.AppendUint32(b, uint32(len(val)))
+		return append(b, val...)
+	}
+	trailer := []byte{0xff, 0xff}
+
+	data := append(append([]byte{}, header...), row("b")...)
+	data = append(data, row("z")[:3]...)
+
+	rows, err := r.Route(context.TODO(), data)
+	assert.NoError(err)
+	assert.Equal(map[string][]byte{
+		"sh1": append(append([]byte{}, header...), row("b")...),
+		"sh2": header,
+	}, rows)
+
+	rows, err = r.Route(context.TODO(), append(row("z")[3:], trailer...))
+	assert.NoError(err)
+	assert.Equal(map[string][]byte{
+		"sh1": trailer,
+		"sh2": append(row("z"), trailer...),
+	}, rows)
+}
+
+func TestCopyRowRouterBinaryMalformed(t *testing.T) {
+	assert := assert.New(t)
+
+	pr := prepareCopyRouter(t)
+
+	r, err := qrouter.NewCopyRowRouter(context.TODO(), pr, "xx", parseCopyOptions(t, "COPY xx (k) FROM STDIN (FORMAT binary)"))
+	assert.NoError(err)
+
+	header := append([]byte("PGCOPY\n\377\r\n\000"), 0, 0, 0, 0, 0, 0, 0, 0)
+	_, err = r.Route(context.TODO(), header)
+	assert.NoError(err)
+
+	/* field length below -1 */
+	row := binary.BigEndian.AppendUint16(nil, 1)
+	row = binary.BigEndian.AppendUint32(row, uint32(0xfffffffe))
+	_, err = r.Route(context.TODO(), append(row, "b"...))
+	assert.ErrorIs(err, qrouter.ErrCopyMalformedRow)
+}
+
+func TestCopyRowRouterColumns(t *testing.T) {
+	assert := assert.New(t)
+
+	pr := prepareCopyRouter(t)
+
+	_, err := qrouter.NewCopyRowRouter(context.TODO(), pr, "xx", &qrouter.CopyOptions{Format: qrouter.CopyFormatText})
+	assert.ErrorIs(err, qrouter.ErrCopyColumnsMissing)
+
+	_, err = qrouter.NewCopyRowRouter(context.TODO(), pr, "xx", &qrouter.CopyOptions{Columns: []string{"id"}, Format: qrouter.CopyFormatText})
+	assert.Error(err)
+}
+
+func TestNewCopyRowRouter(t *testing.T) {
+	assert := assert.New(t)
+
+	pr := prepareCopyRouter(t)
+
+	r, err := qrouter.NewCopyRowRouter(context.TODO(), pr, "xx", &qrouter.CopyOptions{Columns: []string{"id", "k"}, Format: qrouter.CopyFormatText})
+	assert.NoError(err)
+	assert.NotNil(r)
+
+	/* relation is not distributed */
+	_, err = qrouter.NewCopyRowRouter(context.TODO(), pr, "zz", &qrouter.CopyOptions{Columns: []string{"k"}, Format: qrouter.CopyFormatText})
+	assert.Error(err)
+
+	/* composite distribution key */
+	_, err = qrouter.NewCopyRowRouter(context.TODO(), pr, "yy", &qrouter.CopyOptions{Columns: []string{"k", "l"}, Format: qrouter.CopyFormatText})
+	assert.Error(err)
+}
@@ -0,0 +1,76 @@
+package qrouter
+
+import (
+	"context"
+
+	"github.com/pg-sharding/lyx/lyx"
+
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/coord"
+)
+
+// IsSchemaChange reports if statement changes schema, so it should be
+// recorded in schema history. TRUNCATE is executed as DDL, but
+// does not change schema, as well as statements on cluster-wide objects.
+func IsSchemaChange(stmt lyx.Node) bool {
+	switch q := stmt.(type) {
+	case *lyx.CreateTable, *lyx.Index:
+		return true
+	case *lyx.Alter:
+		return !isClusterObject(q.ObjectType)
+	case *lyx.Drop:
+		return !isClusterObject(q.ObjectType)
+	default:
+		return false
+	}
+}
+
+// isClusterObject reports if DDL object type is not a part of database schema
+func isClusterObject(objectType string) bool {
+	switch objectType {
+	case "DATABASE", "TABLESPACE", "SYSTEM", "ROLE", "USER", "GROUP":
+		return true
+	default:
+		return false
+	}
+}
+
+// DDLAllowsTransaction reports if DDL statement may be executed inside
+// transaction block. DROP DATABASE, DROP TABLESPACE, ALTER SYSTEM and
+// concurrent index builds can not. ALTER DATABASE is not wrapped too,
+// because its SET TABLESPACE form can not.
+func DDLAllowsTransaction(stmt lyx.Node) bool {
+	switch q := stmt.(type) {
+	case *lyx.Index:
+		return !q.Concurrently
+	case *lyx.Drop:
+		switch q.ObjectType {
+		case "DATABASE", "TABLESPACE":
+			return false
+		}
+		return !q.Concurrently
+	case *lyx.Alter:
+		switch q.ObjectType {
+		case "DATABASE", "SYSTEM":
+			return false
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+// RecordSchemaChange records statement in schema history of coordinator,
+// when the router works with coordinator, or in local QDB otherwise.
+// TODO : unit tests
+func (qr *ProxyQrouter) RecordSchemaChange(ctx context.Context, query string) error {
+	if !config.RouterConfig().WithCoordinator {
+		return qr.mgr.RecordSchemaChange(ctx, query)
+	}
+
+	conn, err := qr.coordinatorConn(ctx)
+	if err != nil {
+		return err
+	}
+	return coord.NewAdapter(conn).RecordSchemaChange(ctx, query)
+}
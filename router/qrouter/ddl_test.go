@@ -0,0 +1,110 @@
+package qrouter_test
+
+import (
+	"testing"
+
+	"github.com/pg-sharding/lyx/lyx"
+	"github.com/pg-sharding/spqr/router/qrouter"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDDLAllowsTransaction(t *testing.T) {
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   bool
+	}
+
+	for _, tt := range []tcase{
+		{
+			query: "CREATE TABLE xx (i int)",
+			exp:   true,
+		},
+		{
+			query: "CREATE INDEX xx_i ON xx (i)",
+			exp:   true,
+		},
+		{
+			query: "create index concurrently xx_i on xx (i)",
+			exp:   false,
+		},
+		{
+			query: "DROP INDEX CONCURRENTLY xx_i",
+			exp:   false,
+		},
+		{
+			query: "DROP INDEX xx_i",
+			exp:   true,
+		},
+		{
+			query: "DROP DATABASE db1",
+			exp:   false,
+		},
+		{
+			query: "drop tablespace ts",
+			exp:   false,
+		},
+		{
+			query: "ALTER SYSTEM SET work_mem TO '1MB'",
+			exp:   false,
+		},
+		{
+			query: "ALTER DATABASE db1 SET TABLESPACE ts",
+			exp:   false,
+		},
+		{
+			query: "alter table xx add column concurrently int",
+			exp:   true,
+		},
+		{
+			query: "TRUNCATE xx",
+			exp:   true,
+		},
+	} {
+		stmt, err := lyx.Parse(tt.query)
+		assert.NoError(err, "query %s", tt.query)
+		assert.Equal(tt.exp, qrouter.DDLAllowsTransaction(stmt), "query %s", tt.query)
+	}
+}
+
+func TestIsSchemaChange(t *testing.T) {
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   bool
+	}
+
+	for _, tt := range []tcase{
+		{
+			query: "CREATE TABLE xx (i int)",
+			exp:   true,
+		},
+		{
+			query: "alter table xx add column j int",
+			exp:   true,
+		},
+		{
+			query: "DROP TABLE xx",
+			exp:   true,
+		},
+		{
+			query: "TRUNCATE xx",
+			exp:   false,
+		},
+		{
+			query: "DROP DATABASE db1",
+			exp:   false,
+		},
+		{
+			query: "SELECT * FROM xx",
+			exp:   false,
+		},
+	} {
+		stmt, err := lyx.Parse(tt.query)
+		assert.NoError(err, "query %s", tt.query)
+		assert.Equal(tt.exp, qrouter.IsSchemaChange(stmt), "query %s", tt.query)
+	}
+}
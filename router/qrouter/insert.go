@@ -0,0 +1,189 @@
+package qrouter
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pg-sharding/lyx/lyx"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
+	"github.com/pg-sharding/spqr/pkg/session"
+	"github.com/pg-sharding/spqr/router/routehint"
+	"github.com/pg-sharding/spqr/router/routingstate"
+)
+
+// InsertValues is text of INSERT statement with VALUES clause, split into tuples
+type InsertValues struct {
+	// statement text up to VALUES keyword inclusive
+	Prefix string
+	// tuples of VALUES clause, with enclosing parentheses
+	Tuples []string
+	// rest of statement after VALUES clause up to terminating semicolon, e.g. RETURNING
+	Suffix string
+}
+
+// InsertShardQuery is part of multi-row INSERT statement, executed on single shard
+type InsertShardQuery struct {
+	Shard string
+	Query string
+}
+
+var ErrInsertTuplesMixedRoutes = spqrerror.New(spqrerror.SPQR_ROUTING_ERROR, "some tuples of multi-row INSERT have no distribution key value")
+var ErrInsertTuplesMultiShard = spqrerror.New(spqrerror.SPQR_CROSS_SHARD_QUERY, "tuples of multi-row INSERT belong to different shards, such statement can be split only with simple query protocol")
+var ErrInsertValuesSplit = spqrerror.New(spqrerror.SPQR_COMPLEX_QUERY, "failed to split VALUES clause into tuples")
+var ErrInsertMultiStatement = spqrerror.New(spqrerror.SPQR_COMPLEX_QUERY, "INSERT ... VALUES statement, which is split or rewritten by router, must be the only statement of query")
+
+// statementEnd returns end of statement, which goes on at query[pos:]: offset of
+// semicolon, which terminates statement, or length of query. Queries with more
+// statements after it are rejected.
+func statementEnd(query string, pos int) (int, error) {
+	tokenizer := lyx.NewStringTokenizer(query[pos:])
+	end := -1
+	for {
+		tok := tokenizer.NextToken()
+		switch tok.Type {
+		case 0:
+			if pos+tok.Start != len(query) {
+				return 0, ErrInsertValuesSplit
+			}
+			if end == -1 {
+				end = len(query)
+			}
+			return end, nil
+		case lyx.TSEMICOLON:
+			if end == -1 {
+				end = pos + tok.Start
+			}
+		default:
+			if end != -1 {
+				return 0, ErrInsertMultiStatement
+			}
+		}
+	}
+}
+
+// ParseInsertValues splits text of INSERT ... VALUES statement into tuples,
+// using their locations in parse tree of the statement.
+// It returns nil for statements without VALUES clause, e.g. INSERT ... SELECT.
+func ParseInsertValues(stmt *lyx.Insert, query string) (*InsertValues, error) {
+	vc, ok := stmt.SubSelect.(*lyx.ValueClause)
+	if !ok {
+		return nil, nil
+	}
+	if len(vc.Locations) != 1+len(vc.Rest) {
+		return nil, ErrInsertValuesSplit
+	}
+
+	first, last := vc.Locations[0], vc.Locations[len(vc.Locations)-1]
+	if last.End > len(query) {
+		return nil, ErrInsertValuesSplit
+	}
+	end, err := statementEnd(query, last.End)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := &InsertValues{
+		Prefix: strings.TrimRight(query[:first.Start], " \t\n\r\f"),
+		Suffix: query[last.End:end],
+	}
+	for _, loc := range vc.Locations {
+		iv.Tuples = append(iv.Tuples, query[loc.Start:loc.End])
+	}
+	return iv, nil
+}
+
+// routeInsertTuples routes every tuple of multi-row INSERT statement separately.
+// Shard of every tuple is returned, or nil, if statement should be routed as whole.
+func routeInsertTuples(ctx context.Context, qr QueryRouter, stmt *lyx.Insert, sph session.SessionParamsHolder) ([]string, error) {
+	vc, ok := stmt.SubSelect.(*lyx.ValueClause)
+	if !ok || len(vc.Rest) == 0 {
+		return nil, nil
+	}
+
+	switch sph.RouteHint().(type) {
+	case *routehint.TargetRouteHint, *routehint.ScatterRouteHint:
+		/* route hint forces us to route statement as whole */
+		return nil, nil
+	}
+
+	tuples := append([][]lyx.Node{vc.Values}, vc.Rest...)
+	shards := make([]string, 0, len(tuples))
+	multi := 0
+	for _, tuple := range tuples {
+		st, err := qr.Route(ctx, &lyx.Insert{
+			TableRef:  stmt.TableRef,
+			Columns:   stmt.Columns,
+			SubSelect: &lyx.ValueClause{Values: tuple},
+		}, sph)
+		if err != nil {
+			return nil, err
+		}
+		switch v := st.(type) {
+		case routingstate.ShardMatchState:
+			shards = append(shards, v.Route.Shkey.Name)
+		case routingstate.MultiMatchState:
+			multi++
+		default:
+			/* e.g. reference relation, statement is executed as is */
+			return nil, nil
+		}
+	}
+
+	switch multi {
+	case 0:
+		return shards, nil
+	case len(tuples):
+		/* no distribution key values at all, route statement as whole */
+		return nil, nil
+	default:
+		return nil, ErrInsertTuplesMixedRoutes
+	}
+}
+
+// SplitInsertValues routes every tuple of multi-row INSERT statement and groups
+// tuples by shard, they belong to. For every shard INSERT statement with its tuples
+// is returned, shards are listed in order of their first tuple.
+// Nil is returned if statement can not be split, so it should be routed as whole.
+func SplitInsertValues(ctx context.Context, qr QueryRouter, stmt *lyx.Insert, query string, sph session.SessionParamsHolder) ([]*InsertShardQuery, error) {
+	shards, err := routeInsertTuples(ctx, qr, stmt, sph)
+	if err != nil || shards == nil {
+		return nil, err
+	}
+
+	iv, err := ParseInsertValues(stmt, query)
+	if err != nil {
+		return nil, err
+	}
+	if iv == nil || len(iv.Tuples) != len(shards) {
+		return nil, ErrInsertValuesSplit
+	}
+
+	var res []*InsertShardQuery
+	tuples := map[string][]string{}
+	for i, tuple := range iv.Tuples {
+		if _, ok := tuples[shards[i]]; !ok {
+			res = append(res, &InsertShardQuery{Shard: shards[i]})
+		}
+		tuples[shards[i]] = append(tuples[shards[i]], tuple)
+	}
+
+	for _, q := range res {
+		q.Query = iv.Prefix + " " + strings.Join(tuples[q.Shard], ", ") + iv.Suffix
+	}
+	return res, nil
+}
+
+// CheckInsertTuplesSingleShard checks that all tuples of multi-row INSERT statement
+// belong to one shard. Statements of extended query protocol can not be split.
+func CheckInsertTuplesSingleShard(ctx context.Context, qr QueryRouter, stmt *lyx.Insert, sph session.SessionParamsHolder) error {
+	shards, err := routeInsertTuples(ctx, qr, stmt, sph)
+	if err != nil {
+		return err
+	}
+	for _, sh := range shards {
+		if sh != shards[0] {
+			return ErrInsertTuplesMultiShard
+		}
+	}
+	return nil
+}
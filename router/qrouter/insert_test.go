@@ -0,0 +1,242 @@
+package qrouter_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/coord/local"
+	"github.com/pg-sharding/spqr/pkg/session"
+	"github.com/pg-sharding/spqr/qdb"
+	"github.com/pg-sharding/spqr/router/qrouter"
+
+	"github.com/pg-sharding/lyx/lyx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInsertValues(t *testing.T) {
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   *qrouter.InsertValues
+		err   error
+	}
+
+	for _, tt := range []tcase{
+		{
+			query: "INSERT INTO xx (i, j) VALUES (1, 'a'), (2, 'b')",
+			exp: &qrouter.InsertValues{
+				Prefix: "INSERT INTO xx (i, j) VALUES",
+				Tuples: []string{"(1, 'a')", "(2, 'b')"},
+				Suffix: "",
+			},
+		},
+		{
+			query: "insert into xx (i, j) values (1, 'a), (x'), /* (0) */ (lower('B'), ')') RETURNING i; -- done",
+			exp: &qrouter.InsertValues{
+				Prefix: "insert into xx (i, j) values",
+				Tuples: []string{"(1, 'a), (x')", "(lower('B'), ')')"},
+				Suffix: " RETURNING i",
+			},
+		},
+		{
+			query: "INSERT INTO xx (i) SELECT i FROM yy",
+			exp:   nil,
+		},
+		{
+			/* lexer stops at dollar quote, parser takes it as end of statement */
+			query: "INSERT INTO xx (i) VALUES (1), (2) $$ x",
+			err:   qrouter.ErrInsertValuesSplit,
+		},
+	} {
+		stmt, err := lyx.Parse(tt.query)
+		assert.NoError(err, "query %s", tt.query)
+
+		iv, err := qrouter.ParseInsertValues(stmt.(*lyx.Insert), tt.query)
+		if tt.err != nil {
+			assert.ErrorIs(err, tt.err, "query %s", tt.query)
+			continue
+		}
+		assert.NoError(err, "query %s", tt.query)
+		assert.Equal(tt.exp, iv, "query %s", tt.query)
+	}
+
+	/* statements after INSERT are never sent to every shard */
+	query := "INSERT INTO xx (i) VALUES (1), (2)"
+	stmt, err := lyx.Parse(query)
+	assert.NoError(err)
+
+	_, err = qrouter.ParseInsertValues(stmt.(*lyx.Insert), query+"; SELECT 1")
+	assert.ErrorIs(err, qrouter.ErrInsertMultiStatement)
+}
+
+func prepareInsertRouter(t *testing.T) *qrouter.ProxyQrouter {
+	assert := assert.New(t)
+
+	db, _ := qdb.NewMemQDB(MemQDBPath)
+	distribution := "dd"
+
+	_ = db.CreateDistribution(context.TODO(), &qdb.Distribution{
+		ID:       distribution,
+		ColTypes: []string{qdb.ColumnTypeInteger},
+		Relations: map[string]*qdb.DistributedRelation{
+			"xx": {
+				Name: "xx",
+				DistributionKey: []qdb.DistributionKeyEntry{
+					{
+						Column: "i",
+					},
+				},
+			},
+		},
+	})
+
+	err := db.CreateKeyRange(context.TODO(), &qdb.KeyRange{
+		ShardID:        "sh1",
+		DistributionId: distribution,
+		KeyRangeID:     "id1",
+		LowerBound:     []byte("1"),
+	})
+	assert.NoError(err)
+
+	err = db.CreateKeyRange(context.TODO(), &qdb.KeyRange{
+		ShardID:        "sh2",
+		DistributionId: distribution,
+		KeyRangeID:     "id2",
+		LowerBound:     []byte("11"),
+	})
+	assert.NoError(err)
+
+	lc := local.NewLocalCoordinator(db)
+
+	pr, err := qrouter.NewProxyRouter(map[string]*config.Shard{
+		"sh1": {
+			Hosts: nil,
+		},
+		"sh2": {
+			Hosts: nil,
+		},
+	}, lc, &config.QRouter{})
+	assert.NoError(err)
+
+	return pr
+}
+
+func TestSplitInsertValues(t *testing.T) {
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   []*qrouter.InsertShardQuery
+		err   error
+	}
+
+	pr := prepareInsertRouter(t)
+
+	for _, tt := range []tcase{
+		{
+			query: "INSERT INTO xx (i, j) VALUES (1, 'a'), (12, 'b'), (2, 'c') RETURNING *",
+			exp: []*qrouter.InsertShardQuery{
+				{
+					Shard: "sh1",
+					Query: "INSERT INTO xx (i, j) VALUES (1, 'a'), (2, 'c') RETURNING *",
+				},
+				{
+					Shard: "sh2",
+					Query: "INSERT INTO xx (i, j) VALUES (12, 'b') RETURNING *",
+				},
+			},
+		},
+		{
+			query: "INSERT INTO xx (i, j) VALUES (1, 'a'), (12, 'b');",
+			exp: []*qrouter.InsertShardQuery{
+				{
+					Shard: "sh1",
+					Query: "INSERT INTO xx (i, j) VALUES (1, 'a')",
+				},
+				{
+					Shard: "sh2",
+					Query: "INSERT INTO xx (i, j) VALUES (12, 'b')",
+				},
+			},
+		},
+		{
+			query: "INSERT INTO xx (i, j) VALUES (1, 'a'), (2, 'b')",
+			exp: []*qrouter.InsertShardQuery{
+				{
+					Shard: "sh1",
+					Query: "INSERT INTO xx (i, j) VALUES (1, 'a'), (2, 'b')",
+				},
+			},
+		},
+		{
+			/* single row statements are routed as usual */
+			query: "INSERT INTO xx (i, j) VALUES (12, 'a')",
+			exp:   nil,
+		},
+		{
+			query: "INSERT INTO xx (j) VALUES ('a'), ('b')",
+			exp:   nil,
+		},
+		{
+			query: "INSERT INTO xx (i, j) VALUES (1, 'a'), (i + 1, 'b')",
+			err:   qrouter.ErrInsertTuplesMixedRoutes,
+		},
+	} {
+		stmt, err := lyx.Parse(tt.query)
+		assert.NoError(err, "query %s", tt.query)
+
+		queries, err := qrouter.SplitInsertValues(context.TODO(), pr, stmt.(*lyx.Insert), tt.query, session.NewDummyHandler("dd"))
+		if tt.err != nil {
+			assert.ErrorIs(err, tt.err, "query %s", tt.query)
+			continue
+		}
+		assert.NoError(err, "query %s", tt.query)
+		assert.Equal(tt.exp, queries, "query %s", tt.query)
+	}
+}
+
+func TestCheckInsertTuplesSingleShard(t *testing.T) {
+	assert := assert.New(t)
+
+	pr := prepareInsertRouter(t)
+
+	type tcase struct {
+		query  string
+		params [][]byte
+		err    error
+	}
+
+	for _, tt := range []tcase{
+		{
+			query:  "INSERT INTO xx (i, j) VALUES ($1, 'a'), ($2, 'b')",
+			params: [][]byte{[]byte("1"), []byte("2")},
+			err:    nil,
+		},
+		{
+			query:  "INSERT INTO xx (i, j) VALUES ($1, 'a'), ($2, 'b')",
+			params: [][]byte{[]byte("1"), []byte("12")},
+			err:    qrouter.ErrInsertTuplesMultiShard,
+		},
+		{
+			query:  "INSERT INTO xx (i, j) VALUES ($1, 'a')",
+			params: [][]byte{[]byte("12")},
+			err:    nil,
+		},
+	} {
+		stmt, err := lyx.Parse(tt.query)
+		assert.NoError(err, "query %s", tt.query)
+
+		sph := session.NewDummyHandler("dd")
+		sph.SetBindParams(tt.params)
+		sph.SetParamFormatCodes([]int16{0})
+
+		err = qrouter.CheckInsertTuplesSingleShard(context.TODO(), pr, stmt.(*lyx.Insert), sph)
+		if tt.err != nil {
+			assert.ErrorIs(err, tt.err, "query %s", tt.query)
+			continue
+		}
+		assert.NoError(err, "query %s", tt.query)
+	}
+}
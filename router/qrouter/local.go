@@ -76,3 +76,18 @@ func (l *LocalQrouter) Route(_ context.Context, _ lyx.Node, _ session.SessionPar
 func (l *LocalQrouter) ListKeyRanges(ctx context.Context) ([]*kr.KeyRange, error) {
 	return nil, nil
 }
+
+// RewriteSequences keeps query as is, sequences of the only shard are used
+func (l *LocalQrouter) RewriteSequences(_ context.Context, query string) (string, error) {
+	return query, nil
+}
+
+// CheckPreparedSequences accepts any statement, sequences of the only shard are used
+func (l *LocalQrouter) CheckPreparedSequences(_ context.Context, _ string) error {
+	return nil
+}
+
+// RecordSchemaChange does nothing, the only shard has no schema to keep in sync with
+func (l *LocalQrouter) RecordSchemaChange(_ context.Context, _ string) error {
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"go.uber.org/atomic"
+	"google.golang.org/grpc"
 
 	"github.com/pg-sharding/spqr/pkg/config"
 	"github.com/pg-sharding/spqr/pkg/meta"
@@ -28,6 +29,15 @@ type ProxyQrouter struct {
 
 	mgr meta.EntityMgr
 
+	// blocks of distributed sequences values, reserved by the router
+	seqMu     sync.Mutex
+	seqBlocks map[string]*sequenceBlock
+
+	// connection to coordinator, shared by requests of the router
+	coordMu   sync.Mutex
+	coordAddr string
+	coordConn *grpc.ClientConn
+
 	initialized *atomic.Bool
 }
 
@@ -45,6 +55,31 @@ func (qr *ProxyQrouter) Mgr() meta.EntityMgr {
 	return qr.mgr
 }
 
+// coordinatorConn returns connection to coordinator. Connection is opened once
+// and reused, it is opened again only if coordinator moves to another address.
+func (qr *ProxyQrouter) coordinatorConn(ctx context.Context) (*grpc.ClientConn, error) {
+	coordAddr, err := qr.mgr.GetCoordinator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	qr.coordMu.Lock()
+	defer qr.coordMu.Unlock()
+
+	if qr.coordConn != nil && qr.coordAddr == coordAddr {
+		return qr.coordConn, nil
+	}
+	conn, err := grpc.NewClient(coordAddr, grpc.WithInsecure()) //nolint:all
+	if err != nil {
+		return nil, err
+	}
+	if qr.coordConn != nil {
+		_ = qr.coordConn.Close()
+	}
+	qr.coordAddr, qr.coordConn = coordAddr, conn
+	return conn, nil
+}
+
 // TODO : unit tests
 func (qr *ProxyQrouter) DataShardsRoutes() []*routingstate.DataShardRoute {
 	rc, _ := qr.mgr.ListShards(context.TODO())
@@ -88,6 +123,7 @@ func NewProxyRouter(shardMapping map[string]*config.Shard, mgr meta.EntityMgr, q
 		initialized:    atomic.NewBool(false),
 		cfg:            qcfg,
 		mgr:            mgr,
+		seqBlocks:      map[string]*sequenceBlock{},
 	}
 
 	for name, shardCfg := range shardMapping {
@@ -2,12 +2,16 @@ package qrouter
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 
 	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/models/distributions"
 	"github.com/pg-sharding/spqr/pkg/models/hashfunction"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
 	"github.com/pg-sharding/spqr/pkg/session"
@@ -15,9 +19,11 @@ import (
 	"github.com/pg-sharding/spqr/qdb"
 	"github.com/pg-sharding/spqr/router/routehint"
 	"github.com/pg-sharding/spqr/router/routingstate"
+	"github.com/pg-sharding/spqr/router/statistics"
 	"github.com/pg-sharding/spqr/router/xproto"
 
 	"github.com/pg-sharding/lyx/lyx"
+	"golang.org/x/exp/slices"
 )
 
 type RelationFQN struct {
@@ -55,11 +61,25 @@ type RoutingMetadataContext struct {
 	// rarg:{range_var:{relname:"t2" inh:true relpersistence:"p" alias:{aliasname:"b"}
 	tableAliases map[string]RelationFQN
 
+	// column equalities from WHERE clause, e.g. a.id = b.order_id,
+	// used to propagate distribution key values between co-located relations
+	colEqualities []columnEquality
+
 	params            [][]byte
 	paramsFormatCodes []int16
 	// TODO: include client ops and metadata here
 }
 
+type relationColumn struct {
+	rfqn    RelationFQN
+	colname string
+}
+
+type columnEquality struct {
+	left  relationColumn
+	right relationColumn
+}
+
 func NewRoutingMetadataContext(params [][]byte, paramsFormatCodes []int16) *RoutingMetadataContext {
 	meta := &RoutingMetadataContext{
 		rels:             map[RelationFQN]struct{}{},
@@ -114,6 +134,25 @@ func (meta *RoutingMetadataContext) RecordConstExpr(resolvedRelation RelationFQN
 	return nil
 }
 
+// RecordColumnEquality remembers equality of two relation columns
+func (meta *RoutingMetadataContext) RecordColumnEquality(lft, rght *lyx.ColumnRef) {
+	lrel, err := meta.ResolveRelationByAlias(lft.TableAlias)
+	if err != nil {
+		return
+	}
+	rrel, err := meta.ResolveRelationByAlias(rght.TableAlias)
+	if err != nil {
+		return
+	}
+	if meta.RFQNIsCTE(lrel) || meta.RFQNIsCTE(rrel) {
+		return
+	}
+	meta.colEqualities = append(meta.colEqualities, columnEquality{
+		left:  relationColumn{rfqn: lrel, colname: lft.ColName},
+		right: relationColumn{rfqn: rrel, colname: rght.ColName},
+	})
+}
+
 // TODO : unit tests
 func (meta *RoutingMetadataContext) ResolveRelationByAlias(alias string) (RelationFQN, error) {
 	if _, ok := meta.rels[RelationFQN{RelationName: alias}]; ok {
@@ -142,6 +181,8 @@ var FailedToMatch = fmt.Errorf("failed to match query to any sharding rule")
 var SkipColumn = fmt.Errorf("skip column for routing")
 var ShardingKeysMissing = fmt.Errorf("sharding keys are missing in query")
 var CrossShardQueryUnsupported = fmt.Errorf("cross shard query unsupported")
+var ReferenceRelationSourceUnsupported = spqrerror.New(spqrerror.SPQR_CROSS_SHARD_QUERY, "reference relation can be filled only from reference relations")
+var CrossDistributionQuery = spqrerror.New(spqrerror.SPQR_CROSS_SHARD_QUERY, "query on relations from different distributions is not supported")
 
 // DeparseExprShardingEntries deparses sharding column entries(column names or aliased column names)
 // e.g {fields:{string:{str:"a"}} fields:{string:{str:"i"}} for `WHERE a.i = 1`
@@ -166,14 +207,7 @@ func (qr *ProxyQrouter) DeparseKeyWithRangesInternal(_ context.Context, key stri
 		Int("key-ranges-count", len(krs)).
 		Msg("checking key with key ranges")
 
-	var matched_krkey *kr.KeyRange = nil
-
-	for _, krkey := range krs {
-		if kr.CmpRangesLessEqual(krkey.LowerBound, []byte(key)) &&
-			(matched_krkey == nil || kr.CmpRangesLessEqual(matched_krkey.LowerBound, krkey.LowerBound)) {
-			matched_krkey = krkey
-		}
-	}
+	matched_krkey := kr.MatchKeyRange([]byte(key), krs)
 
 	if matched_krkey != nil {
 		if err := qr.mgr.ShareKeyRange(matched_krkey.ID); err != nil {
@@ -189,54 +223,138 @@ func (qr *ProxyQrouter) DeparseKeyWithRangesInternal(_ context.Context, key stri
 	return nil, FailedToFindKeyRange
 }
 
+// distributionKeyEntry looks up distribution key entry of relation column, along with column type
+func (qr *ProxyQrouter) distributionKeyEntry(resolvedRelation RelationFQN, colname string) (*distributions.DistributionKeyEntry, string, bool) {
+	ds, err := qr.Mgr().GetRelationDistribution(context.TODO(), resolvedRelation.RelationName)
+	if err != nil {
+		return nil, "", false
+	}
+	// TODO: optimize
+	for i, c := range ds.Relations[resolvedRelation.RelationName].DistributionKey {
+		if c.Column == colname {
+			colType := ""
+			if i < len(ds.ColTypes) {
+				colType = ds.ColTypes[i]
+			}
+			return &ds.Relations[resolvedRelation.RelationName].DistributionKey[i], colType, true
+		}
+	}
+	return nil, "", false
+}
+
 func (qr *ProxyQrouter) RecordDistributionKeyColumnValueOnRFQN(meta *RoutingMetadataContext, resolvedRelation RelationFQN, colname, value string) error {
 
 	/* do not process non-distributed relations or columns not from relation distribution key */
-	if ds, err := qr.Mgr().GetRelationDistribution(context.TODO(), resolvedRelation.RelationName); err != nil {
+	entry, _, ok := qr.distributionKeyEntry(resolvedRelation, colname)
+	if !ok {
+		// some junk column
+		return nil
+	}
+
+	/* expression-based key, e.g. lower(email), is computed on column value */
+	ke, err := distributions.KeyExpressionByName(entry.Expression)
+	if err != nil {
+		return nil
+	}
+	value, err = distributions.ApplyKeyExpression(value, ke)
+	if err != nil {
 		return nil
-	} else {
-		// TODO: optimize
-		ok := false
-		for _, c := range ds.Relations[resolvedRelation.RelationName].DistributionKey {
-			if c.Column == colname {
-				ok = true
-				break
-			}
-		}
-		if !ok {
-			// some junk column
-			return nil
-		}
 	}
 
 	// will not work not ints
 	return meta.RecordConstExpr(resolvedRelation, colname, value)
 }
 
-// TODO : unit tests
-func (qr *ProxyQrouter) RecordDistributionKeyExprOnRFQN(meta *RoutingMetadataContext, resolvedRelation RelationFQN, colname string, expr lyx.Node) error {
+// RecordDistributionKeyExpressionValue records value of expression-based distribution key,
+// e.g. 'x' for `lower(email) = 'x'`. Value is recorded only if relation is distributed by the same expression.
+func (qr *ProxyQrouter) RecordDistributionKeyExpressionValue(meta *RoutingMetadataContext, alias, fname, colname string, expr lyx.Node) error {
+	resolvedRelation, err := meta.ResolveRelationByAlias(alias)
+	if err != nil {
+		// failed to resolve relation, skip column
+		meta.unparsed_columns[colname] = struct{}{}
+		return nil
+	}
+
+	entry, colType, ok := qr.distributionKeyEntry(resolvedRelation, colname)
+	if !ok || entry.Expression == "" || !strings.EqualFold(entry.Expression, fname) {
+		return nil
+	}
+
+	value, err := meta.deparseConstValue(expr, colType)
+	if err != nil {
+		return nil
+	}
+	return meta.RecordConstExpr(resolvedRelation, colname, value)
+}
+
+// deparseConstValue returns text representation of constant or bind parameter, used as routing value
+func (meta *RoutingMetadataContext) deparseConstValue(expr lyx.Node, colType string) (string, error) {
 	switch e := expr.(type) {
 	case *lyx.ParamRef:
-		if e.Number > len(meta.params) {
-			return ComplexQuery
-		}
+		return meta.paramRoutingValue(e.Number, colType)
+	case *lyx.AExprSConst:
+		return e.Value, nil
+	case *lyx.AExprIConst:
+		return fmt.Sprintf("%d", e.Value), nil
+	default:
+		return "", ComplexQuery
+	}
+}
 
-		// switch parameter format code and convert into proper representation
+// paramRoutingValue returns text representation of bind parameter.
+// Binary integer parameters, which drivers send for e.g. $1::bigint, are decoded.
+func (meta *RoutingMetadataContext) paramRoutingValue(number int, colType string) (string, error) {
+	if number < 1 || number > len(meta.params) {
+		return "", ComplexQuery
+	}
 
-		var routeParam []byte
-		fc := meta.paramsFormatCodes[e.Number-1]
+	param := meta.params[number-1]
 
-		switch fc {
-		case xproto.FormatCodeBinary:
-			// TODO: here we need to invoke out function for convertion
-			// actually, we need to convert everything to binary format
-		case xproto.FormatCodeText:
-			routeParam = meta.params[e.Number-1]
-		default:
-			// ??? protoc violation
+	// switch parameter format code and convert into proper representation
+	switch meta.paramsFormatCodes[number-1] {
+	case xproto.FormatCodeText:
+		return string(param), nil
+	case xproto.FormatCodeBinary:
+		return binaryKeyValue(param, colType)
+	default:
+		// ??? protoc violation
+		return "", ComplexQuery
+	}
+}
+
+// binaryKeyValue converts binary representation of distribution key value into text one
+func binaryKeyValue(param []byte, colType string) (string, error) {
+	switch colType {
+	case qdb.ColumnTypeInteger:
+		switch len(param) {
+		case 2:
+			return strconv.FormatInt(int64(int16(binary.BigEndian.Uint16(param))), 10), nil
+		case 4:
+			return strconv.FormatInt(int64(int32(binary.BigEndian.Uint32(param))), 10), nil
+		case 8:
+			return strconv.FormatInt(int64(binary.BigEndian.Uint64(param)), 10), nil
+		}
+	case qdb.ColumnTypeVarchar:
+		/* binary representation of text types is the string itself */
+		return string(param), nil
+	}
+	return "", ComplexQuery
+}
+
+// TODO : unit tests
+func (qr *ProxyQrouter) RecordDistributionKeyExprOnRFQN(meta *RoutingMetadataContext, resolvedRelation RelationFQN, colname string, expr lyx.Node) error {
+	switch e := expr.(type) {
+	case *lyx.ParamRef:
+		_, colType, ok := qr.distributionKeyEntry(resolvedRelation, colname)
+		if !ok {
+			return nil
+		}
+		routeParam, err := meta.paramRoutingValue(e.Number, colType)
+		if err != nil {
+			return err
 		}
 
-		return qr.RecordDistributionKeyColumnValueOnRFQN(meta, resolvedRelation, colname, string(routeParam))
+		return qr.RecordDistributionKeyColumnValueOnRFQN(meta, resolvedRelation, colname, routeParam)
 	case *lyx.AExprSConst:
 		return qr.RecordDistributionKeyColumnValueOnRFQN(meta, resolvedRelation, colname, string(e.Value))
 	case *lyx.AExprIConst:
@@ -247,7 +365,8 @@ func (qr *ProxyQrouter) RecordDistributionKeyExprOnRFQN(meta *RoutingMetadataCon
 	}
 }
 
-func (qr *ProxyQrouter) RecordDistributionKeyColumnValue(meta *RoutingMetadataContext, alias, colname, value string) error {
+// RecordDistributionKeyExpr records distribution key column value, given by constant or bind parameter
+func (qr *ProxyQrouter) RecordDistributionKeyExpr(meta *RoutingMetadataContext, alias, colname string, expr lyx.Node) error {
 
 	resolvedRelation, err := meta.ResolveRelationByAlias(alias)
 	if err != nil {
@@ -256,7 +375,9 @@ func (qr *ProxyQrouter) RecordDistributionKeyColumnValue(meta *RoutingMetadataCo
 		return nil
 	}
 
-	return qr.RecordDistributionKeyColumnValueOnRFQN(meta, resolvedRelation, colname, value)
+	/* value may be unusable for routing, e.g. binary parameter of unknown type, skip it then */
+	_ = qr.RecordDistributionKeyExprOnRFQN(meta, resolvedRelation, colname, expr)
+	return nil
 }
 
 // routeByClause de-parses sharding column-value pair from Where clause of the query
@@ -280,39 +401,22 @@ func (qr *ProxyQrouter) routeByClause(ctx context.Context, expr lyx.Node, meta *
 
 				/* simple key-value pair */
 				switch rght := texpr.Right.(type) {
-				case *lyx.ParamRef:
-					if rght.Number <= len(meta.params) {
-						if err := qr.RecordDistributionKeyColumnValue(meta, alias, colname, string(meta.params[rght.Number-1])); err != nil {
-							return err
-						}
-					}
-					// else  error out?
-				case *lyx.AExprSConst:
-					// TBD: postpone routing from here to root of parsing tree
-					if err := qr.RecordDistributionKeyColumnValue(meta, alias, colname, rght.Value); err != nil {
-						return err
-					}
-				case *lyx.AExprIConst:
+				case *lyx.ParamRef, *lyx.AExprSConst, *lyx.AExprIConst:
 					// TBD: postpone routing from here to root of parsing tree
 					// maybe expimely inefficient. Will be fixed in SPQR-2.0
-					if err := qr.RecordDistributionKeyColumnValue(meta, alias, colname, fmt.Sprintf("%d", rght.Value)); err != nil {
+					if err := qr.RecordDistributionKeyExpr(meta, alias, colname, rght); err != nil {
 						return err
 					}
+				case *lyx.ColumnRef:
+					/* join condition, e.g. a.id = b.order_id */
+					if texpr.Op == "=" {
+						meta.RecordColumnEquality(lft, rght)
+					}
 				case *lyx.AExprList:
 					if len(rght.List) != 0 {
-						expr := rght.List[0]
-						switch bexpr := expr.(type) {
-						case *lyx.AExprSConst:
-							// TBD: postpone routing from here to root of parsing tree
-							if err := qr.RecordDistributionKeyColumnValue(meta, alias, colname, bexpr.Value); err != nil {
-								return err
-							}
-						case *lyx.AExprIConst:
-							// TBD: postpone routing from here to root of parsing tree
-							// maybe expimely inefficient. Will be fixed in SPQR-2.0
-							if err := qr.RecordDistributionKeyColumnValue(meta, alias, colname, fmt.Sprintf("%d", bexpr.Value)); err != nil {
-								return err
-							}
+						// TBD: postpone routing from here to root of parsing tree
+						if err := qr.RecordDistributionKeyExpr(meta, alias, colname, rght.List[0]); err != nil {
+							return err
 						}
 					}
 				case *lyx.FuncApplication:
@@ -337,6 +441,30 @@ func (qr *ProxyQrouter) routeByClause(ctx context.Context, expr lyx.Node, meta *
 				default:
 					queue = append(queue, texpr.Left, texpr.Right)
 				}
+			case *lyx.FuncApplication:
+				/* expression-based distribution key, e.g. lower(email) = 'x' */
+				if len(lft.Args) == 1 {
+					if colref, ok := lft.Args[0].(*lyx.ColumnRef); ok {
+						if err := qr.RecordDistributionKeyExpressionValue(meta, colref.TableAlias, lft.Name, colref.ColName, texpr.Right); err != nil {
+							return err
+						}
+					}
+				}
+			case *lyx.AExprList:
+				/* row comparison, e.g. (tenant_id, id) = ($1, $2), is split into pairs of its elements */
+				rght, ok := texpr.Right.(*lyx.AExprList)
+				if !ok || texpr.Op != "=" || len(lft.List) != len(rght.List) {
+					/* other row comparisons do not restrict routing */
+					queue = append(queue, texpr.Left, texpr.Right)
+					break
+				}
+				for i := range lft.List {
+					queue = append(queue, &lyx.AExprOp{
+						Left:  lft.List[i],
+						Right: rght.List[i],
+						Op:    texpr.Op,
+					})
+				}
 			case *lyx.Select:
 				if err := qr.DeparseSelectStmt(ctx, lft, meta); err != nil {
 					return err
@@ -351,6 +479,9 @@ func (qr *ProxyQrouter) routeByClause(ctx context.Context, expr lyx.Node, meta *
 			}
 		case *lyx.ColumnRef:
 			/* colref = colref case, skip */
+		case *lyx.AExprList:
+			/* row constructor, e.g. (a, b) < (1, 2) */
+			queue = append(queue, texpr.List...)
 		case *lyx.AExprIConst, *lyx.AExprSConst, *lyx.AExprBConst, *lyx.AExprNConst:
 			/* should not happend */
 		case *lyx.AExprEmpty:
@@ -432,6 +563,12 @@ func (qr *ProxyQrouter) deparseFromNode(node lyx.FromClauseNode, meta *RoutingMe
 		if err := qr.deparseFromNode(q.Larg, meta); err != nil {
 			return err
 		}
+		if q.Quals != nil {
+			qr.deparseJoinQuals(q.Quals, meta)
+		}
+		if len(q.Using) != 0 {
+			qr.deparseJoinUsing(q, meta)
+		}
 	default:
 		// other cases to consider
 		// lateral join, natual, etc
@@ -441,6 +578,61 @@ func (qr *ProxyQrouter) deparseFromNode(node lyx.FromClauseNode, meta *RoutingMe
 	return nil
 }
 
+// deparseJoinQuals records column equalities of join ON clause, e.g. `o.id = i.order_id`.
+// Constant conditions of ON clause are skipped: for outer joins they do not filter rows of
+// preserved side, so they can not restrict routing.
+func (qr *ProxyQrouter) deparseJoinQuals(expr lyx.Node, meta *RoutingMetadataContext) {
+	q, ok := expr.(*lyx.AExprOp)
+	if !ok {
+		return
+	}
+	switch strings.ToLower(q.Op) {
+	case "and":
+		qr.deparseJoinQuals(q.Left, meta)
+		qr.deparseJoinQuals(q.Right, meta)
+	case "=":
+		lft, lok := q.Left.(*lyx.ColumnRef)
+		rght, rok := q.Right.(*lyx.ColumnRef)
+		if lok && rok {
+			meta.RecordColumnEquality(lft, rght)
+		}
+	}
+}
+
+// deparseJoinUsing records column equalities of join USING clause.
+// Column of USING clause is unambiguous within each side of join,
+// so it is equal for every pair of left and right side relations.
+func (qr *ProxyQrouter) deparseJoinUsing(q *lyx.JoinExpr, meta *RoutingMetadataContext) {
+	lrels := joinRelations(q.Larg, meta)
+	rrels := joinRelations(q.Rarg, meta)
+	for _, colname := range q.Using {
+		for _, lrel := range lrels {
+			for _, rrel := range rrels {
+				meta.colEqualities = append(meta.colEqualities, columnEquality{
+					left:  relationColumn{rfqn: lrel, colname: colname},
+					right: relationColumn{rfqn: rrel, colname: colname},
+				})
+			}
+		}
+	}
+}
+
+// joinRelations returns relations of one side of join, CTEs are skipped.
+func joinRelations(node lyx.FromClauseNode, meta *RoutingMetadataContext) []RelationFQN {
+	switch q := node.(type) {
+	case *lyx.RangeVar:
+		rqdn := RelationFQNFromRangeRangeVar(q)
+		if meta.RFQNIsCTE(rqdn) {
+			return nil
+		}
+		return []RelationFQN{rqdn}
+	case *lyx.JoinExpr:
+		return append(joinRelations(q.Larg, meta), joinRelations(q.Rarg, meta)...)
+	default:
+		return nil
+	}
+}
+
 // TODO : unit tests
 func (qr *ProxyQrouter) deparseFromClauseList(
 	clause []lyx.FromClauseNode, meta *RoutingMetadataContext) error {
@@ -644,7 +836,11 @@ func (qr *ProxyQrouter) deparseShardingMapping(
 		return qr.routeByClause(ctx, clause, meta)
 	case *lyx.Copy:
 		if !stmt.IsFrom {
-			return fmt.Errorf("copy from stdin is not implemented")
+			/* COPY (SELECT ...) TO is routed by its query, COPY relation TO - to all shards */
+			if stmt.SubStmt != nil {
+				return qr.deparseShardingMapping(ctx, stmt.SubStmt, meta)
+			}
+			return qr.deparseFromNode(stmt.TableRef, meta)
 		}
 
 		_ = qr.deparseFromNode(stmt.TableRef, meta)
@@ -758,7 +954,7 @@ func (qr *ProxyQrouter) routeWithRules(ctx context.Context, stmt lyx.Node, sph s
 		if err := qr.CheckTableIsRoutable(ctx, node, meta); err != nil {
 			return nil, err
 		}
-		return routingstate.MultiMatchState{}, nil
+		return routingstate.DDLState{}, nil
 	case *lyx.Vacuum:
 		/* Send vacuum to each shard */
 		return routingstate.MultiMatchState{}, nil
@@ -773,12 +969,11 @@ func (qr *ProxyQrouter) routeWithRules(ctx context.Context, stmt lyx.Node, sph s
 		 * Disallow to index on table which does not contain any sharding column
 		 */
 		// XXX: do it
-		return routingstate.MultiMatchState{}, nil
+		return routingstate.DDLState{}, nil
 
 	case *lyx.Alter, *lyx.Drop, *lyx.Truncate:
 		// support simple ddl commands, route them to every chard
-		// this is not fully ACID (not atomic at least)
-		return routingstate.MultiMatchState{}, nil
+		return routingstate.DDLState{}, nil
 		/*
 			 case *pgquery.Node_DropdbStmt, *pgquery.Node_DropRoleStmt:
 				 // forbid under separate setting
@@ -788,6 +983,14 @@ func (qr *ProxyQrouter) routeWithRules(ctx context.Context, stmt lyx.Node, sph s
 		// forbid under separate setting
 		return routingstate.MultiMatchState{}, nil
 	case *lyx.Insert:
+		if qr.isReferenceRelation(ctx, node.TableRef) {
+			if node.SubSelect != nil {
+				if err := qr.checkReferenceRelationSource(ctx, node.SubSelect); err != nil {
+					return nil, err
+				}
+			}
+			return routingstate.ReferenceRelationState{}, nil
+		}
 		err := qr.deparseShardingMapping(ctx, stmt, meta)
 		if err != nil {
 			if qr.cfg.MulticastUnroutableInsertStatement {
@@ -878,6 +1081,38 @@ func (qr *ProxyQrouter) routeWithRules(ctx context.Context, stmt lyx.Node, sph s
 		}
 
 	case *lyx.Delete, *lyx.Update, *lyx.Copy:
+		switch q := node.(type) {
+		case *lyx.Delete:
+			if qr.isReferenceRelation(ctx, q.TableRef) {
+				return routingstate.ReferenceRelationState{}, nil
+			}
+		case *lyx.Update:
+			if qr.isReferenceRelation(ctx, q.TableRef) {
+				return routingstate.ReferenceRelationState{}, nil
+			}
+		case *lyx.Copy:
+			if qr.isReferenceRelation(ctx, q.TableRef) {
+				if q.IsFrom {
+					return routingstate.ReferenceRelationState{}, nil
+				}
+				/* every shard has full copy of relation */
+				return routingstate.RandomMatchState{}, nil
+			}
+			if rv, ok := q.TableRef.(*lyx.RangeVar); ok && q.IsFrom {
+				switch q.Where.(type) {
+				case nil, *lyx.AExprEmpty:
+					/* no WHERE clause, route rows of data stream one by one */
+					rfqn := RelationFQNFromRangeRangeVar(rv)
+					if _, err := qr.mgr.GetRelationDistribution(ctx, rfqn.RelationName); err == nil {
+						return routingstate.CopyFromState{
+							RelationName: rfqn.RelationName,
+						}, nil
+					}
+					/* relation is not distributed, route as usual */
+				}
+			}
+		}
+
 		// UPDATE and/or DELETE, COPY stmts, which
 		// would be routed with their WHERE clause
 		err := qr.deparseShardingMapping(ctx, stmt, meta)
@@ -892,15 +1127,25 @@ func (qr *ProxyQrouter) routeWithRules(ctx context.Context, stmt lyx.Node, sph s
 	 * Step 2: traverse all aggregated relation distribution tuples and route on them.
 	 */
 
+	if err := qr.propagateKeyEqualities(ctx, meta); err != nil {
+		return nil, err
+	}
+
 	var route routingstate.RoutingState
 	route = nil
 	var route_err error
+	onlyReference := len(meta.rels) != 0
 	for rfqn := range meta.rels {
 		// TODO: check by whole RFQN
 		ds, err := qr.mgr.GetRelationDistribution(ctx, rfqn.RelationName)
 		if err != nil {
 			return nil, err
 		}
+		if ds.Id == distributions.REPLICATED {
+			/* reference relation is present on every shard, any route fits */
+			continue
+		}
+		onlyReference = false
 
 		krs, err := qr.mgr.ListKeyRanges(ctx, ds.Id)
 		if err != nil {
@@ -970,6 +1215,10 @@ func (qr *ProxyQrouter) routeWithRules(ctx context.Context, stmt lyx.Node, sph s
 		return nil, route_err
 	}
 
+	if route == nil && onlyReference {
+		return routingstate.RandomMatchState{}, nil
+	}
+
 	// set up this variable if not yet
 	if route == nil {
 		route = routingstate.MultiMatchState{}
@@ -978,6 +1227,110 @@ func (qr *ProxyQrouter) routeWithRules(ctx context.Context, stmt lyx.Node, sph s
 	return route, nil
 }
 
+// propagateKeyEqualities copies distribution key values between relations, joined by
+// distribution key columns, e.g. for `o.id = i.order_id AND o.id = 5` value 5 is recorded
+// for both relations. This makes joins of co-located relations routable to single shard.
+// Joins of relations from different distributions are not co-located, they are rejected.
+// Reference relations are present on every shard, so they can be joined with any relation.
+func (qr *ProxyQrouter) propagateKeyEqualities(ctx context.Context, meta *RoutingMetadataContext) error {
+	dss := map[RelationFQN]*distributions.Distribution{}
+	for rfqn := range meta.rels {
+		ds, err := qr.mgr.GetRelationDistribution(ctx, rfqn.RelationName)
+		if err != nil {
+			return err
+		}
+		if ds.Id == distributions.REPLICATED {
+			/* reference relations can be joined with any distribution */
+			continue
+		}
+		dss[rfqn] = ds
+	}
+
+	for _, eq := range meta.colEqualities {
+		lds, lok := dss[eq.left.rfqn]
+		rds, rok := dss[eq.right.rfqn]
+		if lok && rok && lds.Id != rds.Id {
+			return CrossDistributionQuery
+		}
+	}
+
+	/* position of column in distribution key, or -1 */
+	keyPos := func(rc relationColumn) (int, string) {
+		ds, ok := dss[rc.rfqn]
+		if !ok {
+			return -1, ""
+		}
+		for i, e := range ds.Relations[rc.rfqn.RelationName].DistributionKey {
+			if e.Column == rc.colname {
+				return i, e.Expression
+			}
+		}
+		return -1, ""
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, eq := range meta.colEqualities {
+			lpos, lexpr := keyPos(eq.left)
+			rpos, rexpr := keyPos(eq.right)
+			/* only columns on same position of distribution key are co-located */
+			if lpos == -1 || lpos != rpos || lexpr != rexpr {
+				continue
+			}
+
+			for _, pair := range [][2]relationColumn{{eq.left, eq.right}, {eq.right, eq.left}} {
+				from, to := pair[0], pair[1]
+				for _, val := range meta.exprs[from.rfqn][from.colname] {
+					if slices.Contains(meta.exprs[to.rfqn][to.colname], val) {
+						continue
+					}
+					if err := meta.RecordConstExpr(to.rfqn, to.colname, val); err != nil {
+						return err
+					}
+					changed = true
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// isReferenceRelation checks if relation, modified by statement, is a reference relation
+func (qr *ProxyQrouter) isReferenceRelation(ctx context.Context, node lyx.FromClauseNode) bool {
+	rv, ok := node.(*lyx.RangeVar)
+	if !ok {
+		return false
+	}
+	ds, err := qr.mgr.GetRelationDistribution(ctx, RelationFQNFromRangeRangeVar(rv).RelationName)
+	if err != nil {
+		return false
+	}
+	return ds.Id == distributions.REPLICATED
+}
+
+// checkReferenceRelationSource checks that INSERT ... SELECT into reference relation
+// reads reference relations only. Otherwise every shard would insert its own part of data,
+// and copies of reference relation would diverge.
+func (qr *ProxyQrouter) checkReferenceRelationSource(ctx context.Context, sel lyx.Node) error {
+	meta := NewRoutingMetadataContext(nil, nil)
+	if s, ok := sel.(*lyx.Select); ok {
+		for _, cte := range s.WithClause {
+			meta.cteNames[cte.Name] = struct{}{}
+			_ = qr.DeparseSelectStmt(ctx, cte.SubQuery, meta)
+		}
+	}
+	_ = qr.DeparseSelectStmt(ctx, sel, meta)
+
+	for rfqn := range meta.rels {
+		ds, err := qr.mgr.GetRelationDistribution(ctx, rfqn.RelationName)
+		if err != nil || ds.Id != distributions.REPLICATED {
+			return ReferenceRelationSourceUnsupported
+		}
+	}
+	return nil
+}
+
 // TODO : unit tests
 func (qr *ProxyQrouter) Route(ctx context.Context, stmt lyx.Node, sph session.SessionParamsHolder) (routingstate.RoutingState, error) {
 	route, err := qr.routeWithRules(ctx, stmt, sph)
@@ -987,15 +1340,22 @@ func (qr *ProxyQrouter) Route(ctx context.Context, stmt lyx.Node, sph session.Se
 
 	switch v := route.(type) {
 	case routingstate.ShardMatchState:
+		if v.Route != nil && v.Route.Matchedkr != nil {
+			statistics.RecordKeyRangeQuery(v.Route.Matchedkr.ID, time.Now())
+		}
 		return v, nil
 	case routingstate.RandomMatchState:
 		return v, nil
-	case routingstate.MultiMatchState:
+	case routingstate.ReferenceRelationState:
+		return v, nil
+	case routingstate.CopyFromState:
+		return v, nil
+	case routingstate.MultiMatchState, routingstate.DDLState:
 		switch sph.DefaultRouteBehaviour() {
 		case "BLOCK":
 			return routingstate.SkipRoutingState{}, FailedToMatch
 		default:
-			return routingstate.MultiMatchState{}, nil
+			return v, nil
 		}
 	}
 	return routingstate.SkipRoutingState{}, nil
@@ -6,7 +6,9 @@ import (
 
 	"github.com/pg-sharding/spqr/pkg/config"
 	"github.com/pg-sharding/spqr/pkg/coord/local"
+	"github.com/pg-sharding/spqr/pkg/models/distributions"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
 	"github.com/pg-sharding/spqr/pkg/session"
 	"github.com/pg-sharding/spqr/qdb"
 	"github.com/pg-sharding/spqr/router/qrouter"
@@ -61,12 +63,12 @@ func TestMultiShardRouting(t *testing.T) {
 	for _, tt := range []tcase{
 		{
 			query: "create table xx (i int);",
-			exp:   routingstate.MultiMatchState{},
+			exp:   routingstate.DDLState{},
 			err:   nil,
 		},
 		{
 			query: " DROP TABLE copy_test;",
-			exp:   routingstate.MultiMatchState{},
+			exp:   routingstate.DDLState{},
 			err:   nil,
 		},
 		{
@@ -86,7 +88,7 @@ func TestMultiShardRouting(t *testing.T) {
 		},
 		{
 			query: "alter table xx  add column i int;",
-			exp:   routingstate.MultiMatchState{},
+			exp:   routingstate.DDLState{},
 			err:   nil,
 		},
 		{
@@ -104,6 +106,11 @@ func TestMultiShardRouting(t *testing.T) {
 			exp:   routingstate.MultiMatchState{},
 			err:   nil,
 		},
+		{
+			query: "COPY xx TO STDOUT;",
+			exp:   routingstate.MultiMatchState{},
+			err:   nil,
+		},
 	} {
 		parserRes, err := lyx.Parse(tt.query)
 
@@ -936,6 +943,37 @@ func TestJoins(t *testing.T) {
 					},
 				},
 			},
+			"orders": {
+				Name: "orders",
+				DistributionKey: []qdb.DistributionKeyEntry{
+					{
+						Column: "id",
+					},
+				},
+			},
+			"order_items": {
+				Name: "order_items",
+				DistributionKey: []qdb.DistributionKeyEntry{
+					{
+						Column: "order_id",
+					},
+				},
+			},
+		},
+	})
+
+	_ = db.CreateDistribution(context.TODO(), &qdb.Distribution{
+		ID:       "dd2",
+		ColTypes: []string{qdb.ColumnTypeVarchar},
+		Relations: map[string]*qdb.DistributedRelation{
+			"zjoin": {
+				Name: "zjoin",
+				DistributionKey: []qdb.DistributionKeyEntry{
+					{
+						Column: "i",
+					},
+				},
+			},
 		},
 	})
 
@@ -1021,6 +1059,174 @@ func TestJoins(t *testing.T) {
 			exp:   routingstate.MultiMatchState{},
 			err:   nil,
 		},
+
+		// distribution key value is propagated by join condition
+		{
+			query: "SELECT * FROM xjoin x JOIN yjoin y ON x.i = y.i WHERE y.i = 12;",
+			exp: routingstate.ShardMatchState{
+				Route: &routingstate.DataShardRoute{
+					Shkey: kr.ShardKey{
+						Name: "sh2",
+					},
+					Matchedkr: &kr.KeyRange{
+						ShardID:      "sh2",
+						ID:           "id2",
+						Distribution: distribution,
+						LowerBound:   []byte("11"),
+					},
+				},
+				TargetSessionAttrs: "any",
+			},
+			err: nil,
+		},
+
+		// distribution key value is propagated by USING clause
+		{
+			query: "SELECT * FROM xjoin x JOIN yjoin y USING (i) WHERE y.i = 12;",
+			exp: routingstate.ShardMatchState{
+				Route: &routingstate.DataShardRoute{
+					Shkey: kr.ShardKey{
+						Name: "sh2",
+					},
+					Matchedkr: &kr.KeyRange{
+						ShardID:      "sh2",
+						ID:           "id2",
+						Distribution: distribution,
+						LowerBound:   []byte("11"),
+					},
+				},
+				TargetSessionAttrs: "any",
+			},
+			err: nil,
+		},
+		{
+			query: "SELECT * FROM xjoin x JOIN zjoin z USING (i) WHERE x.i = 12;",
+			exp:   nil,
+			err:   qrouter.CrossDistributionQuery,
+		},
+
+		{
+			query: "SELECT * FROM orders o JOIN order_items i ON o.id = i.order_id WHERE o.id = 12",
+			exp: routingstate.ShardMatchState{
+				Route: &routingstate.DataShardRoute{
+					Shkey: kr.ShardKey{
+						Name: "sh2",
+					},
+					Matchedkr: &kr.KeyRange{
+						ShardID:      "sh2",
+						ID:           "id2",
+						Distribution: distribution,
+						LowerBound:   []byte("11"),
+					},
+				},
+				TargetSessionAttrs: "any",
+			},
+			err: nil,
+		},
+
+		{
+			query: "SELECT * FROM orders o LEFT JOIN order_items i ON i.order_id = o.id AND i.qty > 1 WHERE i.order_id = 12",
+			exp: routingstate.ShardMatchState{
+				Route: &routingstate.DataShardRoute{
+					Shkey: kr.ShardKey{
+						Name: "sh2",
+					},
+					Matchedkr: &kr.KeyRange{
+						ShardID:      "sh2",
+						ID:           "id2",
+						Distribution: distribution,
+						LowerBound:   []byte("11"),
+					},
+				},
+				TargetSessionAttrs: "any",
+			},
+			err: nil,
+		},
+
+		// tenant_id is not a distribution key, nothing to propagate
+		{
+			query: "SELECT * FROM orders o JOIN order_items i ON o.id = i.order_id WHERE o.tenant_id = 5",
+			exp:   routingstate.MultiMatchState{},
+			err:   nil,
+		},
+
+		// constant of ON clause does not restrict outer join
+		{
+			query: "SELECT * FROM orders o LEFT JOIN order_items i ON o.id = i.order_id AND i.order_id = 12",
+			exp:   routingstate.MultiMatchState{},
+			err:   nil,
+		},
+
+		// join on non-key columns is not co-located
+		{
+			query: "SELECT * FROM xjoin x JOIN yjoin y ON x.j = y.j WHERE y.i = 12;",
+			exp: routingstate.ShardMatchState{
+				Route: &routingstate.DataShardRoute{
+					Shkey: kr.ShardKey{
+						Name: "sh2",
+					},
+					Matchedkr: &kr.KeyRange{
+						ShardID:      "sh2",
+						ID:           "id2",
+						Distribution: distribution,
+						LowerBound:   []byte("11"),
+					},
+				},
+				TargetSessionAttrs: "any",
+			},
+			err: nil,
+		},
+
+		{
+			query: "SELECT * FROM xjoin x, yjoin y, sshjt1 z WHERE z.i = 12 AND y.i = x.i AND x.i = z.i;",
+			exp: routingstate.ShardMatchState{
+				Route: &routingstate.DataShardRoute{
+					Shkey: kr.ShardKey{
+						Name: "sh2",
+					},
+					Matchedkr: &kr.KeyRange{
+						ShardID:      "sh2",
+						ID:           "id2",
+						Distribution: distribution,
+						LowerBound:   []byte("11"),
+					},
+				},
+				TargetSessionAttrs: "any",
+			},
+			err: nil,
+		},
+
+		// relations are not co-located
+		{
+			query: "SELECT * FROM xjoin x JOIN zjoin z ON x.i = z.i WHERE x.i = 12;",
+			exp:   nil,
+			err:   qrouter.CrossDistributionQuery,
+		},
+		{
+			query: "SELECT * FROM xjoin x, zjoin z WHERE x.i = z.i AND x.i = 12;",
+			exp:   nil,
+			err:   qrouter.CrossDistributionQuery,
+		},
+
+		// relations of different distributions without join condition are routed independently
+		{
+			query: "SELECT * FROM xjoin x, zjoin z WHERE x.i = 12 AND z.i = 13;",
+			exp: routingstate.ShardMatchState{
+				Route: &routingstate.DataShardRoute{
+					Shkey: kr.ShardKey{
+						Name: "sh2",
+					},
+					Matchedkr: &kr.KeyRange{
+						ShardID:      "sh2",
+						ID:           "id2",
+						Distribution: distribution,
+						LowerBound:   []byte("11"),
+					},
+				},
+				TargetSessionAttrs: "any",
+			},
+			err: nil,
+		},
 	} {
 		parserRes, err := lyx.Parse(tt.query)
 
@@ -1227,6 +1433,37 @@ func TestCopySingleShard(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			query: "COPY xx (i, j) FROM STDIN;",
+			exp: routingstate.CopyFromState{
+				RelationName: "xx",
+			},
+			err: nil,
+		},
+		// relation is not distributed, rows are not routed one by one
+		{
+			query: "COPY zz (i, j) FROM STDIN;",
+			exp:   nil,
+			err:   spqrerror.Newf(spqrerror.SPQR_NO_DISTRIBUTION, "distribution for relation \"%s\" not found", "zz"),
+		},
+		{
+			query: "COPY (SELECT * FROM xx WHERE i = 12) TO STDOUT;",
+			exp: routingstate.ShardMatchState{
+				Route: &routingstate.DataShardRoute{
+					Shkey: kr.ShardKey{
+						Name: "sh2",
+					},
+					Matchedkr: &kr.KeyRange{
+						ShardID:      "sh2",
+						ID:           "id2",
+						Distribution: distribution,
+						LowerBound:   []byte("11"),
+					},
+				},
+				TargetSessionAttrs: "any",
+			},
+			err: nil,
+		},
 	} {
 		parserRes, err := lyx.Parse(tt.query)
 
@@ -1234,6 +1471,11 @@ func TestCopySingleShard(t *testing.T) {
 
 		tmp, err := pr.Route(context.TODO(), parserRes, session.NewDummyHandler(distribution))
 
+		if tt.err != nil {
+			assert.Equal(tt.err, err, "query %s", tt.query)
+			continue
+		}
+
 		assert.NoError(err, "query %s", tt.query)
 
 		assert.Equal(tt.exp, tmp)
@@ -1413,3 +1655,308 @@ func TestMiscRouting(t *testing.T) {
 		}
 	}
 }
+
+func TestRoutingExpressions(t *testing.T) {
+	assert := assert.New(t)
+
+	type tcase struct {
+		query  string
+		params [][]byte
+		fcodes []int16
+		exp    routingstate.RoutingState
+	}
+	db, _ := qdb.NewMemQDB(MemQDBPath)
+	distribution := "ds1"
+	distribution2 := "ds2"
+
+	assert.NoError(db.CreateDistribution(context.TODO(), &qdb.Distribution{
+		ID:       distribution,
+		ColTypes: []string{qdb.ColumnTypeInteger},
+		Relations: map[string]*qdb.DistributedRelation{
+			"xx": {
+				Name: "xx",
+				DistributionKey: []qdb.DistributionKeyEntry{
+					{
+						Column: "i",
+					},
+				},
+			},
+		},
+	}))
+	assert.NoError(db.CreateDistribution(context.TODO(), &qdb.Distribution{
+		ID:       distribution2,
+		ColTypes: []string{qdb.ColumnTypeVarchar},
+		Relations: map[string]*qdb.DistributedRelation{
+			"users": {
+				Name: "users",
+				DistributionKey: []qdb.DistributionKeyEntry{
+					{
+						Column:     "email",
+						Expression: "lower",
+					},
+				},
+			},
+		},
+	}))
+
+	for _, krg := range []*qdb.KeyRange{
+		{ShardID: "sh1", DistributionId: distribution, KeyRangeID: "id1", LowerBound: []byte("1")},
+		{ShardID: "sh2", DistributionId: distribution, KeyRangeID: "id2", LowerBound: []byte("11")},
+		{ShardID: "sh1", DistributionId: distribution2, KeyRangeID: "id3", LowerBound: []byte("aaa@example.com")},
+		{ShardID: "sh2", DistributionId: distribution2, KeyRangeID: "id4", LowerBound: []byte("nnn@example.com")},
+	} {
+		assert.NoError(db.CreateKeyRange(context.TODO(), krg))
+	}
+
+	lc := local.NewLocalCoordinator(db)
+
+	pr, err := qrouter.NewProxyRouter(map[string]*config.Shard{
+		"sh1": {
+			Hosts: nil,
+		},
+		"sh2": {
+			Hosts: nil,
+		},
+	}, lc, &config.QRouter{})
+
+	assert.NoError(err)
+
+	route := func(sh, krid, ds, bound string) routingstate.RoutingState {
+		return routingstate.ShardMatchState{
+			Route: &routingstate.DataShardRoute{
+				Shkey: kr.ShardKey{
+					Name: sh,
+				},
+				Matchedkr: &kr.KeyRange{
+					ShardID:      sh,
+					ID:           krid,
+					Distribution: ds,
+					LowerBound:   []byte(bound),
+				},
+			},
+			TargetSessionAttrs: "any",
+		}
+	}
+
+	for _, tt := range []tcase{
+		{
+			query:  "SELECT * FROM xx WHERE i = $1::bigint;",
+			params: [][]byte{{0, 0, 0, 0, 0, 0, 0, 12}},
+			fcodes: []int16{1},
+			exp:    route("sh2", "id2", distribution, "11"),
+		},
+		{
+			query:  "SELECT * FROM xx WHERE i = $1::int;",
+			params: [][]byte{[]byte("5")},
+			exp:    route("sh1", "id1", distribution, "1"),
+		},
+		{
+			query: "SELECT * FROM users WHERE lower(email) = 'xyz@example.com';",
+			exp:   route("sh2", "id4", distribution2, "nnn@example.com"),
+		},
+		{
+			query: "SELECT * FROM users WHERE email = 'ABC@example.com';",
+			exp:   route("sh1", "id3", distribution2, "aaa@example.com"),
+		},
+		{
+			/* function differs from distribution key expression */
+			query: "SELECT * FROM users WHERE upper(email) = 'XYZ@EXAMPLE.COM';",
+			exp:   routingstate.MultiMatchState{},
+		},
+		{
+			query: "INSERT INTO users (email) VALUES ('XYZ@example.com');",
+			exp:   route("sh2", "id4", distribution2, "nnn@example.com"),
+		},
+		{
+			query: "SELECT * FROM xx WHERE (i, j) = (12, 1);",
+			exp:   route("sh2", "id2", distribution, "11"),
+		},
+		{
+			query: "SELECT * FROM xx WHERE ROW(j, i) = ROW(1, 5);",
+			exp:   route("sh1", "id1", distribution, "1"),
+		},
+		{
+			query:  "SELECT * FROM xx WHERE (i, j) = ($1, $2);",
+			params: [][]byte{[]byte("12"), []byte("1")},
+			exp:    route("sh2", "id2", distribution, "11"),
+		},
+		{
+			/* row comparison other than equality does not restrict routing */
+			query: "SELECT * FROM xx WHERE (i, j) < (12, 1);",
+			exp:   routingstate.MultiMatchState{},
+		},
+		{
+			query: "SELECT * FROM xx WHERE (i, j) < (12, 1) AND i = 5;",
+			exp:   route("sh1", "id1", distribution, "1"),
+		},
+	} {
+		stmt, err := lyx.Parse(tt.query)
+		assert.NoError(err, "query %s", tt.query)
+
+		sph := session.NewDummyHandler(distribution)
+		sph.SetBindParams(tt.params)
+		sph.SetParamFormatCodes(tt.fcodes)
+
+		tmp, err := pr.Route(context.TODO(), stmt, sph)
+
+		assert.NoError(err, "query %s", tt.query)
+
+		assert.Equal(tt.exp, tmp, "query %s", tt.query)
+	}
+}
+
+func TestReferenceRelationRouting(t *testing.T) {
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   routingstate.RoutingState
+		err   error
+	}
+	/* TODO: fix by adding configurable setting */
+	db, _ := qdb.NewMemQDB(MemQDBPath)
+	distribution := "dd"
+
+	_ = db.CreateDistribution(context.TODO(), &qdb.Distribution{
+		ID:       distribution,
+		ColTypes: []string{qdb.ColumnTypeVarchar},
+		Relations: map[string]*qdb.DistributedRelation{
+			"orders": {
+				Name: "orders",
+				DistributionKey: []qdb.DistributionKeyEntry{
+					{
+						Column: "i",
+					},
+				},
+			},
+		},
+	})
+
+	_ = db.CreateDistribution(context.TODO(), &qdb.Distribution{
+		ID: distributions.REPLICATED,
+		Relations: map[string]*qdb.DistributedRelation{
+			"currencies": {
+				Name: "currencies",
+			},
+		},
+	})
+
+	err := db.CreateKeyRange(context.TODO(), &qdb.KeyRange{
+		ShardID:        "sh1",
+		KeyRangeID:     "id1",
+		DistributionId: distribution,
+		LowerBound:     []byte("1"),
+	})
+
+	assert.NoError(err)
+
+	err = db.CreateKeyRange(context.TODO(), &qdb.KeyRange{
+		ShardID:        "sh2",
+		KeyRangeID:     "id2",
+		DistributionId: distribution,
+		LowerBound:     []byte("11"),
+	})
+
+	assert.NoError(err)
+
+	lc := local.NewLocalCoordinator(db)
+
+	pr, err := qrouter.NewProxyRouter(map[string]*config.Shard{
+		"sh1": {
+			Hosts: nil,
+		},
+		"sh2": {
+			Hosts: nil,
+		},
+	}, lc, &config.QRouter{})
+
+	assert.NoError(err)
+
+	for _, tt := range []tcase{
+		{
+			query: "SELECT * FROM currencies WHERE code = 'EUR';",
+			exp:   routingstate.RandomMatchState{},
+			err:   nil,
+		},
+		{
+			query: "COPY currencies TO STDOUT;",
+			exp:   routingstate.RandomMatchState{},
+			err:   nil,
+		},
+		{
+			query: "INSERT INTO currencies (code) VALUES ('EUR');",
+			exp:   routingstate.ReferenceRelationState{},
+			err:   nil,
+		},
+		{
+			query: "INSERT INTO currencies (code) SELECT code FROM currencies WHERE rate > 1;",
+			exp:   routingstate.ReferenceRelationState{},
+			err:   nil,
+		},
+		{
+			query: "INSERT INTO currencies (code) WITH c AS (SELECT code FROM currencies) SELECT code FROM c;",
+			exp:   routingstate.ReferenceRelationState{},
+			err:   nil,
+		},
+		// every shard would insert its own rows of sharded relation
+		{
+			query: "INSERT INTO currencies (code) SELECT code FROM orders;",
+			exp:   nil,
+			err:   qrouter.ReferenceRelationSourceUnsupported,
+		},
+		{
+			query: "INSERT INTO currencies (code) SELECT c.code FROM currencies c JOIN orders o ON o.code = c.code;",
+			exp:   nil,
+			err:   qrouter.ReferenceRelationSourceUnsupported,
+		},
+		{
+			query: "UPDATE currencies SET rate = 1 WHERE code = 'EUR';",
+			exp:   routingstate.ReferenceRelationState{},
+			err:   nil,
+		},
+		{
+			query: "DELETE FROM currencies;",
+			exp:   routingstate.ReferenceRelationState{},
+			err:   nil,
+		},
+		{
+			query: "COPY currencies FROM STDIN;",
+			exp:   routingstate.ReferenceRelationState{},
+			err:   nil,
+		},
+
+		// reference relation is joined locally on the shard of sharded data
+		{
+			query: "SELECT * FROM orders o JOIN currencies c ON c.code = o.code WHERE o.i = 12;",
+			exp: routingstate.ShardMatchState{
+				Route: &routingstate.DataShardRoute{
+					Shkey: kr.ShardKey{
+						Name: "sh2",
+					},
+					Matchedkr: &kr.KeyRange{
+						ShardID:      "sh2",
+						ID:           "id2",
+						Distribution: distribution,
+						LowerBound:   []byte("11"),
+					},
+				},
+				TargetSessionAttrs: "any",
+			},
+			err: nil,
+		},
+	} {
+		parserRes, err := lyx.Parse(tt.query)
+
+		assert.NoError(err, "query %s", tt.query)
+
+		tmp, err := pr.Route(context.TODO(), parserRes, session.NewDummyHandler(distribution))
+
+		if tt.err != nil {
+			assert.Equal(tt.err, err, "query %s", tt.query)
+		} else {
+			assert.NoError(err, "query %s", tt.query)
+
+			assert.Equal(tt.exp, tmp, tt.query)
+		}
+	}
+}
@@ -27,6 +27,13 @@ type QueryRouter interface {
 	Initialized() bool
 	Initialize() bool
 
+	// RewriteSequences replaces usages of distributed sequences in query with their values
+	RewriteSequences(ctx context.Context, query string) (string, error)
+	// CheckPreparedSequences rejects prepared statements, which use distributed sequences
+	CheckPreparedSequences(ctx context.Context, query string) error
+	// RecordSchemaChange adds DDL statement, applied on all shards, to schema history
+	RecordSchemaChange(ctx context.Context, query string) error
+
 	Mgr() meta.EntityMgr
 }
 
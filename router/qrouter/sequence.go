@@ -0,0 +1,391 @@
+package qrouter
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pg-sharding/lyx/lyx"
+	"golang.org/x/exp/slices"
+
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/coord"
+	"github.com/pg-sharding/spqr/pkg/models/sequences"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+)
+
+const defaultSequenceCacheSize = 100
+
+// sequenceBlock is a range of sequence values, reserved by the router
+type sequenceBlock struct {
+	mu   sync.Mutex
+	next int64
+	last int64
+}
+
+// sequenceBlock returns block of sequence values, empty block is created
+// for sequence, which was not used by the router yet
+func (qr *ProxyQrouter) sequenceBlock(name string) *sequenceBlock {
+	qr.seqMu.Lock()
+	defer qr.seqMu.Unlock()
+
+	b, ok := qr.seqBlocks[name]
+	if !ok {
+		b = &sequenceBlock{
+			next: 1,
+			last: 0,
+		}
+		qr.seqBlocks[name] = b
+	}
+	return b
+}
+
+// dropStaleSequenceBlocks forgets blocks of dropped sequences. Coordinator
+// keeps values of dropped sequence, so the sequence, created again with the
+// same name, never hands out values of the forgotten block.
+func (qr *ProxyQrouter) dropStaleSequenceBlocks(seqs []*sequences.Sequence) {
+	known := map[string]struct{}{}
+	for _, seq := range seqs {
+		known[seq.Name] = struct{}{}
+	}
+
+	qr.seqMu.Lock()
+	defer qr.seqMu.Unlock()
+
+	for name := range qr.seqBlocks {
+		if _, ok := known[name]; !ok {
+			delete(qr.seqBlocks, name)
+		}
+	}
+}
+
+// NextVal returns next value of distributed sequence. Values are taken from
+// the block, reserved by the router, new block is requested when it runs out.
+// Only users of the same sequence wait for the request.
+func (qr *ProxyQrouter) NextVal(ctx context.Context, name string) (int64, error) {
+	b := qr.sequenceBlock(name)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.next > b.last {
+		size := qr.cfg.SequenceCacheSize
+		if size <= 0 {
+			size = defaultSequenceCacheSize
+		}
+		first, err := qr.nextSequenceRange(ctx, name, size)
+		if err != nil {
+			return 0, err
+		}
+
+		spqrlog.Zero.Debug().
+			Str("sequence", name).
+			Int64("first", first).
+			Int64("count", size).
+			Msg("reserved sequence values")
+
+		b.next = first
+		b.last = first + size - 1
+	}
+
+	v := b.next
+	b.next++
+	return v, nil
+}
+
+// nextSequenceRange reserves values in coordinator, which is the only
+// source of sequence values, when the router works with coordinator.
+func (qr *ProxyQrouter) nextSequenceRange(ctx context.Context, name string, count int64) (int64, error) {
+	if !config.RouterConfig().WithCoordinator {
+		return qr.mgr.NextSequenceRange(ctx, name, count)
+	}
+
+	conn, err := qr.coordinatorConn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return coord.NewAdapter(conn).NextSequenceRange(ctx, name, count)
+}
+
+// mayUseSequences tells queries, which can not use distributed sequences, without
+// parsing them: such query neither calls nextval() nor inserts rows
+func mayUseSequences(query string) bool {
+	query = strings.ToLower(query)
+	return strings.Contains(query, "nextval") || strings.Contains(query, "insert")
+}
+
+// RewriteSequences replaces usages of distributed sequences in query with their values
+func (qr *ProxyQrouter) RewriteSequences(ctx context.Context, query string) (string, error) {
+	if !mayUseSequences(query) {
+		return query, nil
+	}
+	seqs, err := qr.mgr.ListSequences(ctx)
+	if err != nil {
+		return "", err
+	}
+	qr.dropStaleSequenceBlocks(seqs)
+	if len(seqs) == 0 {
+		return query, nil
+	}
+	return RewriteSequences(query, seqs, func(name string) (int64, error) {
+		return qr.NextVal(ctx, name)
+	})
+}
+
+// CheckPreparedSequences rejects prepared statement, which uses distributed
+// sequences: value of the sequence can not be substituted into the statement,
+// which is parsed once and executed many times.
+func (qr *ProxyQrouter) CheckPreparedSequences(ctx context.Context, query string) error {
+	if !mayUseSequences(query) {
+		return nil
+	}
+	seqs, err := qr.mgr.ListSequences(ctx)
+	if err != nil || len(seqs) == 0 {
+		return err
+	}
+	_, err = RewriteSequences(query, seqs, func(name string) (int64, error) {
+		return 0, spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "distributed sequence %s can not be used in prepared statement, use simple query protocol", name)
+	})
+	return err
+}
+
+// sqlIdent normalizes identifier as PostgreSQL does: quoted identifiers
+// are taken as is, unquoted are folded to lower case
+func sqlIdent(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return strings.ReplaceAll(s[1:len(s)-1], `""`, `"`)
+	}
+	return strings.ToLower(s)
+}
+
+func quoteSQLIdent(s string) string {
+	for i := 0; i < len(s); i++ {
+		if !(s[i] == '_' || (s[i] >= 'a' && s[i] <= 'z') || (i > 0 && s[i] >= '0' && s[i] <= '9')) {
+			return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+		}
+	}
+	return s
+}
+
+// sqlLexemes splits query into lexemes, skipping whitespaces and comments.
+// Every lexeme is returned as its start and end offsets. Text, which lexer
+// can not split, is left out, such query fails to parse when it is routed.
+func sqlLexemes(query string) [][2]int {
+	tokenizer := lyx.NewStringTokenizer(query)
+	var res [][2]int
+	for tok := tokenizer.NextToken(); tok.Type != 0; tok = tokenizer.NextToken() {
+		res = append(res, [2]int{tok.Start, tok.End})
+	}
+	return res
+}
+
+// rewriteNextval replaces nextval('seq') calls of distributed sequences with values
+func rewriteNextval(query string, known map[string]struct{}, nextval func(name string) (int64, error)) (string, error) {
+	lexemes := sqlLexemes(query)
+	lex := func(i int) string {
+		if i >= len(lexemes) {
+			return ""
+		}
+		return query[lexemes[i][0]:lexemes[i][1]]
+	}
+
+	var sb strings.Builder
+	pos := 0
+	for i := 0; i < len(lexemes); i++ {
+		if !strings.EqualFold(lex(i), "nextval") || lex(i+1) != "(" {
+			continue
+		}
+		arg := lex(i + 2)
+		if len(arg) < 2 || arg[0] != '\'' {
+			continue
+		}
+		/* nextval('seq'::regclass) is used in column defaults */
+		j := i + 3
+		if lex(j) == "::" && strings.EqualFold(lex(j+1), "regclass") {
+			j += 2
+		}
+		if lex(j) != ")" {
+			continue
+		}
+
+		name := sqlIdent(arg[1 : len(arg)-1])
+		if _, ok := known[name]; !ok {
+			/* shard-local sequence */
+			continue
+		}
+		v, err := nextval(name)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(query[pos:lexemes[i][0]])
+		sb.WriteString(strconv.FormatInt(v, 10))
+		pos = lexemes[j][1]
+		i = j
+	}
+	sb.WriteString(query[pos:])
+	return sb.String(), nil
+}
+
+// splitTuple splits tuple of VALUES clause into its items, keeping surrounding whitespaces
+func splitTuple(tuple string) []string {
+	inner := tuple[1 : len(tuple)-1]
+	tokenizer := lyx.NewStringTokenizer(inner)
+	var items []string
+	start, depth := 0, 0
+	for tok := tokenizer.NextToken(); tok.Type != 0; tok = tokenizer.NextToken() {
+		switch tok.Type {
+		case lyx.TOPENBR, lyx.TSQOPENBR:
+			depth++
+		case lyx.TCLOSEBR, lyx.TSQCLOSEBR:
+			depth--
+		case lyx.TCOMMA:
+			if depth == 0 {
+				items = append(items, inner[start:tok.Start])
+				start = tok.End
+			}
+		}
+	}
+	return append(items, inner[start:])
+}
+
+// rejectNextval fails nextval() call of distributed sequence, which would give
+// the same value to many rows, e.g. in INSERT ... SELECT or UPDATE
+func rejectNextval(name string) (int64, error) {
+	return 0, spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "distributed sequence %s can be used only in VALUES of INSERT or in SELECT without FROM, its value is taken once for every call", name)
+}
+
+// rewriteInsert fills columns of INSERT ... VALUES statement, which are filled
+// by distributed sequences, when column is omitted or its value is DEFAULT, and
+// replaces nextval() calls in tuples of VALUES clause. Positions of columns are
+// unknown without column list, so such statements are rejected, as well as
+// INSERT ... SELECT, which omits sequence columns or calls nextval().
+func rewriteInsert(stmt *lyx.Insert, query string, seqs []*sequences.Sequence, known map[string]struct{}, nextval func(name string) (int64, error)) (string, error) {
+	/* the router knows relations by their names only */
+	relName := ""
+	if rv, ok := stmt.TableRef.(*lyx.RangeVar); ok {
+		relName = rv.RelationName
+	}
+
+	var relSeqs []*sequences.Sequence
+	for _, seq := range seqs {
+		if seq.RelationName == relName && seq.ColumnName != "" {
+			relSeqs = append(relSeqs, seq)
+		}
+	}
+	if len(relSeqs) != 0 && len(stmt.Columns) == 0 {
+		return "", spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "relation %s is filled by distributed sequence %s, INSERT into it must have column list", relName, relSeqs[0].Name)
+	}
+
+	iv, err := ParseInsertValues(stmt, query)
+	if err != nil {
+		return "", err
+	}
+	if iv == nil {
+		/* INSERT ... SELECT */
+		for _, seq := range relSeqs {
+			if !slices.Contains(stmt.Columns, seq.ColumnName) {
+				return "", spqrerror.Newf(spqrerror.SPQR_SEQUENCE_ERROR, "column %s is filled by distributed sequence %s, its value must be listed in INSERT", seq.ColumnName, seq.Name)
+			}
+		}
+		return rewriteNextval(query, known, rejectNextval)
+	}
+
+	/* e.g. RETURNING nextval('seq') */
+	for _, part := range []string{iv.Prefix, iv.Suffix} {
+		if _, err := rewriteNextval(part, known, rejectNextval); err != nil {
+			return "", err
+		}
+	}
+
+	changed := false
+	tuples := make([][]string, len(iv.Tuples))
+	for k, t := range iv.Tuples {
+		rewritten, err := rewriteNextval(t, known, nextval)
+		if err != nil {
+			return "", err
+		}
+		changed = changed || rewritten != t
+		tuples[k] = splitTuple(rewritten)
+	}
+
+	prefix := iv.Prefix
+	if len(relSeqs) != 0 {
+		/* column list is the last parenthesized part of text before VALUES */
+		closing := -1
+		for _, lex := range sqlLexemes(prefix) {
+			if prefix[lex[0]:lex[1]] == ")" {
+				closing = lex[0]
+			}
+		}
+		for _, t := range tuples {
+			if closing == -1 || len(t) != len(stmt.Columns) {
+				/* statement is invalid, let shard report it */
+				return query, nil
+			}
+		}
+
+		for _, seq := range relSeqs {
+			pos := slices.Index(stmt.Columns, seq.ColumnName)
+			if pos == -1 {
+				prefix = prefix[:closing] + ", " + quoteSQLIdent(seq.ColumnName) + prefix[closing:]
+				closing += len(", " + quoteSQLIdent(seq.ColumnName))
+			}
+
+			for k := range tuples {
+				if pos != -1 && !strings.EqualFold(strings.TrimSpace(tuples[k][pos]), "default") {
+					continue
+				}
+				v, err := nextval(seq.Name)
+				if err != nil {
+					return "", err
+				}
+				changed = true
+				if pos == -1 {
+					tuples[k] = append(tuples[k], " "+strconv.FormatInt(v, 10))
+				} else {
+					item := tuples[k][pos]
+					tuples[k][pos] = strings.Replace(item, strings.TrimSpace(item), strconv.FormatInt(v, 10), 1)
+				}
+			}
+		}
+	}
+
+	if !changed {
+		return query, nil
+	}
+
+	res := make([]string, len(tuples))
+	for k, t := range tuples {
+		res[k] = "(" + strings.Join(t, ",") + ")"
+	}
+	return prefix + " " + strings.Join(res, ", ") + iv.Suffix, nil
+}
+
+// RewriteSequences replaces nextval() calls of distributed sequences and
+// omitted or DEFAULT values of columns, filled by them, with values of the sequences.
+// Every call takes one value, so calls are allowed only where they are evaluated
+// once for every row: in VALUES of INSERT and in SELECT without FROM.
+func RewriteSequences(query string, seqs []*sequences.Sequence, nextval func(name string) (int64, error)) (string, error) {
+	known := map[string]struct{}{}
+	for _, seq := range seqs {
+		known[seq.Name] = struct{}{}
+	}
+
+	stmt, err := lyx.Parse(query)
+	if err != nil {
+		/* statement is invalid, router reports it */
+		return query, nil
+	}
+
+	switch q := stmt.(type) {
+	case *lyx.Insert:
+		return rewriteInsert(q, query, seqs, known, nextval)
+	case *lyx.Select:
+		/* single row */
+		if len(q.FromClause) == 0 && len(q.WithClause) == 0 && q.Op == "" {
+			return rewriteNextval(query, known, nextval)
+		}
+	}
+	return rewriteNextval(query, known, rejectNextval)
+}
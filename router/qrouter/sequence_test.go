@@ -0,0 +1,295 @@
+package qrouter_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/coord/local"
+	"github.com/pg-sharding/spqr/pkg/models/sequences"
+	protos "github.com/pg-sharding/spqr/pkg/protos"
+	"github.com/pg-sharding/spqr/qdb"
+	"github.com/pg-sharding/spqr/router/qrouter"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewriteSequences(t *testing.T) {
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   string
+		err   bool
+	}
+
+	seqs := []*sequences.Sequence{
+		{
+			Name:         "orders_id",
+			RelationName: "orders",
+			ColumnName:   "id",
+		},
+		{
+			Name: "tickets",
+		},
+	}
+
+	for _, tt := range []tcase{
+		{
+			query: "SELECT nextval('tickets'), NEXTVAL ( 'Tickets'::regclass ), nextval('local_seq')",
+			exp:   "SELECT 1, 2, nextval('local_seq')",
+		},
+		{
+			query: "SELECT 'nextval(''tickets'')', nextval('\"Tickets\"') /* nextval('tickets') */",
+			exp:   "SELECT 'nextval(''tickets'')', nextval('\"Tickets\"') /* nextval('tickets') */",
+		},
+		{
+			query: "INSERT INTO orders (customer, amount) VALUES (1, 10), ('x, y', lower('A')) RETURNING id",
+			exp:   "INSERT INTO orders (customer, amount, id) VALUES (1, 10, 1), ('x, y', lower('A'), 2) RETURNING id",
+		},
+		{
+			query: "insert into public.orders (id, customer) values (DEFAULT, 1), (42, 2), (default, nextval('tickets'));",
+			exp:   "insert into public.orders (id, customer) values (1, 1), (42, 2), (2, 1)",
+		},
+		{
+			query: "INSERT INTO orders (id, customer) VALUES (42, 1)",
+			exp:   "INSERT INTO orders (id, customer) VALUES (42, 1)",
+		},
+		{
+			/* column positions are unknown without column list */
+			query: "INSERT INTO orders VALUES (DEFAULT, 1)",
+			err:   true,
+		},
+		{
+			/* router can not parse statement, it is rejected when routed */
+			query: "INSERT INTO orders DEFAULT VALUES",
+			exp:   "INSERT INTO orders DEFAULT VALUES",
+		},
+		{
+			query: "INSERT INTO orders SELECT 1, 2",
+			err:   true,
+		},
+		{
+			query: "INSERT INTO orders (customer) SELECT 1",
+			err:   true,
+		},
+		{
+			query: "INSERT INTO orders (id, customer) SELECT id, 1 FROM old_orders",
+			exp:   "INSERT INTO orders (id, customer) SELECT id, 1 FROM old_orders",
+		},
+		{
+			query: "INSERT INTO items VALUES (1, 2)",
+			exp:   "INSERT INTO items VALUES (1, 2)",
+		},
+		{
+			query: "INSERT INTO items (order_id) VALUES (nextval('tickets')), (nextval('tickets'))",
+			exp:   "INSERT INTO items (order_id) VALUES (1), (2)",
+		},
+		{
+			/* every row would get the same value */
+			query: "INSERT INTO items (order_id) SELECT nextval('tickets') FROM old_items",
+			err:   true,
+		},
+		{
+			query: "UPDATE items SET order_id = nextval('tickets')",
+			err:   true,
+		},
+		{
+			query: "SELECT nextval('tickets') FROM items",
+			err:   true,
+		},
+		{
+			query: "INSERT INTO items (order_id) VALUES (1) RETURNING nextval('tickets')",
+			err:   true,
+		},
+		{
+			query: "SELECT nextval('local_seq') FROM items",
+			exp:   "SELECT nextval('local_seq') FROM items",
+		},
+	} {
+		vals := map[string]int64{}
+		query, err := qrouter.RewriteSequences(tt.query, seqs, func(name string) (int64, error) {
+			vals[name]++
+			return vals[name], nil
+		})
+		if tt.err {
+			assert.Error(err, "query %s", tt.query)
+			continue
+		}
+		assert.NoError(err, "query %s", tt.query)
+		assert.Equal(tt.exp, query, "query %s", tt.query)
+	}
+}
+
+func TestProxyRouterNextVal(t *testing.T) {
+	assert := assert.New(t)
+
+	db, _ := qdb.NewMemQDB(MemQDBPath)
+	lc := local.NewLocalCoordinator(db)
+
+	assert.NoError(lc.CreateSequence(context.TODO(), &sequences.Sequence{Name: "seq"}))
+
+	newRouter := func() *qrouter.ProxyQrouter {
+		pr, err := qrouter.NewProxyRouter(map[string]*config.Shard{
+			"sh1": {
+				Hosts: nil,
+			},
+		}, lc, &config.QRouter{
+			SequenceCacheSize: 3,
+		})
+		assert.NoError(err)
+		return pr
+	}
+
+	pr1 := newRouter()
+	pr2 := newRouter()
+
+	/* routers take values from their own blocks */
+	for _, exp := range []struct {
+		pr  *qrouter.ProxyQrouter
+		val int64
+	}{
+		{pr1, 1},
+		{pr2, 4},
+		{pr1, 2},
+		{pr1, 3},
+		{pr1, 7},
+		{pr2, 5},
+	} {
+		v, err := exp.pr.NextVal(context.TODO(), "seq")
+		assert.NoError(err)
+		assert.Equal(exp.val, v)
+	}
+
+	_, err := pr1.NextVal(context.TODO(), "unknown")
+	assert.Error(err)
+}
+
+// sequenceServer is coordinator, which hands out values of sequences
+type sequenceServer struct {
+	protos.UnimplementedSequenceServiceServer
+
+	mu    sync.Mutex
+	vals  map[string]int64
+	calls int
+
+	/* requests for this sequence wait until the channel is closed */
+	slow    string
+	release chan struct{}
+}
+
+func (s *sequenceServer) NextSequenceRange(_ context.Context, request *protos.NextSequenceRangeRequest) (*protos.NextSequenceRangeReply, error) {
+	if request.Name == s.slow {
+		<-s.release
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+	first := s.vals[request.Name] + 1
+	s.vals[request.Name] += request.Count
+	return &protos.NextSequenceRangeReply{First: first}, nil
+}
+
+func TestProxyRouterSequencesWithCoordinator(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := &sequenceServer{
+		vals: map[string]int64{
+			"seq": 100,
+		},
+		slow:    "slow_seq",
+		release: make(chan struct{}),
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+	gs := grpc.NewServer()
+	protos.RegisterSequenceServiceServer(gs, srv)
+	go func() {
+		_ = gs.Serve(listener)
+	}()
+	defer gs.Stop()
+
+	config.RouterConfig().WithCoordinator = true
+	defer func() {
+		config.RouterConfig().WithCoordinator = false
+	}()
+
+	db, _ := qdb.NewMemQDB(MemQDBPath)
+	lc := local.NewLocalCoordinator(db)
+	assert.NoError(lc.UpdateCoordinator(context.TODO(), listener.Addr().String()))
+
+	for _, seq := range []*sequences.Sequence{
+		{
+			Name:         "seq",
+			RelationName: "orders",
+			ColumnName:   "id",
+		},
+		{
+			Name: "slow_seq",
+		},
+	} {
+		assert.NoError(lc.CreateSequence(context.TODO(), seq))
+	}
+
+	pr, err := qrouter.NewProxyRouter(map[string]*config.Shard{
+		"sh1": {
+			Hosts: nil,
+		},
+	}, lc, &config.QRouter{
+		SequenceCacheSize: 3,
+	})
+	assert.NoError(err)
+
+	/* values are reserved in coordinator, not in local QDB */
+	query, err := pr.RewriteSequences(context.TODO(), "INSERT INTO orders (customer) VALUES (1), (2), (3)")
+	assert.NoError(err)
+	assert.Equal("INSERT INTO orders (customer, id) VALUES (1, 101), (2, 102), (3, 103)", query)
+	srv.mu.Lock()
+	assert.Equal(1, srv.calls)
+	srv.mu.Unlock()
+
+	/* waiting for values of one sequence does not block others */
+	done := make(chan int64)
+	go func() {
+		v, err := pr.NextVal(context.TODO(), "slow_seq")
+		assert.NoError(err)
+		done <- v
+	}()
+
+	v, err := pr.NextVal(context.TODO(), "seq")
+	assert.NoError(err)
+	assert.Equal(int64(104), v)
+
+	close(srv.release)
+	assert.Equal(int64(1), <-done)
+
+	/* prepared statements can not use distributed sequences */
+	assert.Error(pr.CheckPreparedSequences(context.TODO(), "INSERT INTO orders (customer) VALUES ($1)"))
+	assert.Error(pr.CheckPreparedSequences(context.TODO(), "SELECT nextval('slow_seq')"))
+	assert.NoError(pr.CheckPreparedSequences(context.TODO(), "INSERT INTO orders (id, customer) VALUES ($1, $2)"))
+	assert.NoError(pr.CheckPreparedSequences(context.TODO(), "SELECT nextval('local_seq')"))
+
+	/* block of dropped sequence is forgotten, though it still has values */
+	assert.NoError(lc.DropSequence(context.TODO(), "seq"))
+	query, err = pr.RewriteSequences(context.TODO(), "INSERT INTO orders (customer) VALUES (1)")
+	assert.NoError(err)
+	assert.Equal("INSERT INTO orders (customer) VALUES (1)", query)
+
+	assert.NoError(lc.CreateSequence(context.TODO(), &sequences.Sequence{
+		Name:         "seq",
+		RelationName: "orders",
+		ColumnName:   "id",
+	}))
+	srv.mu.Lock()
+	srv.vals["seq"] = 200
+	srv.mu.Unlock()
+	query, err = pr.RewriteSequences(context.TODO(), "SELECT nextval('seq')")
+	assert.NoError(err)
+	assert.Equal("SELECT 201", query)
+}
@@ -20,7 +20,27 @@ import (
 )
 
 func AdvancedPoolModeNeeded(rst RelayStateMgr) bool {
-	return rst.Client().Rule().PoolMode == config.PoolModeTransaction && rst.Client().Rule().PoolPreparedStatement || rst.RouterMode() == config.ProxyMode
+	switch rst.Client().Rule().PoolMode {
+	case config.PoolModeTransaction, config.PoolModeStatement:
+		return rst.Client().Rule().PoolPreparedStatement || rst.RouterMode() == config.ProxyMode
+	default:
+		return rst.RouterMode() == config.ProxyMode
+	}
+}
+
+// ErrStatementPoolTx is returned to clients, which start transaction block in statement pool mode
+var ErrStatementPoolTx = spqrerror.New(spqrerror.SPQR_INVALID_REQUEST, "transaction blocks are not allowed in statement pool mode")
+
+// parseBoolSetting parses boolean value of setting the way postgres does
+func parseBoolSetting(name, value string) (bool, error) {
+	switch strings.ToLower(strings.Trim(value, "'")) {
+	case "on", "true", "yes", "1", "t":
+		return true, nil
+	case "off", "false", "no", "0", "f":
+		return false, nil
+	default:
+		return false, spqrerror.Newf(spqrerror.SPQR_INVALID_REQUEST, "parameter \"%s\" requires a Boolean value", name)
+	}
 }
 
 func deparseRouteHint(rst RelayStateMgr, params map[string]string) (routehint.RouteHint, error) {
@@ -93,6 +113,14 @@ func ProcQueryAdvanced(rst RelayStateMgr, query string, ph ProtoStateHandler, bi
 
 	switch st := state.(type) {
 	case parser.ParseStateTXBegin:
+		if rst.Client().Rule().PoolMode == config.PoolModeStatement {
+			/* ready for query is sent on relay completion */
+			return rst.Client().Send(&pgproto3.ErrorResponse{
+				Severity: "ERROR",
+				Code:     ErrStatementPoolTx.ErrorCode,
+				Message:  ErrStatementPoolTx.Error(),
+			})
+		}
 		if rst.TxStatus() != txstatus.TXIDLE {
 			// ignore this
 			_ = rst.Client().ReplyWarningf("there is already transaction in progress")
@@ -114,6 +142,10 @@ func ProcQueryAdvanced(rst RelayStateMgr, query string, ph ProtoStateHandler, bi
 				rst.Client().SetTsa(config.TargetSessionAttrsPS)
 			case lyx.TransactionReadWrite:
 				rst.Client().SetTsa(config.TargetSessionAttrsRW)
+			case lyx.TransactionIsolation:
+				if isRepeatableRead(st) && rst.Client().ConsistentSnapshot() {
+					rst.BeginConsistentSnapshot(query)
+				}
 			}
 		}
 		return rst.Client().ReplyCommandComplete("BEGIN")
@@ -150,6 +182,18 @@ func ProcQueryAdvanced(rst RelayStateMgr, query string, ph ProtoStateHandler, bi
 				rst.Client().SetDefaultRouteBehaviour(st.Value)
 			case session.SPQR_SHARDING_KEY:
 				rst.Client().SetShardingKey(st.Value)
+			case session.SPQR_READ_YOUR_WRITES:
+				ryw, err := parseBoolSetting(st.Name, st.Value)
+				if err != nil {
+					return err
+				}
+				rst.Client().SetReadYourWrites(ryw)
+			case session.SPQR_CONSISTENT_SNAPSHOT:
+				snapshot, err := parseBoolSetting(st.Name, st.Value)
+				if err != nil {
+					return err
+				}
+				rst.Client().SetConsistentSnapshot(snapshot)
 			default:
 				rst.Client().SetParam(st.Name, st.Value)
 			}
@@ -212,6 +256,58 @@ func ProcQueryAdvanced(rst RelayStateMgr, query string, ph ProtoStateHandler, bi
 					},
 				},
 			)
+		case session.SPQR_READ_YOUR_WRITES:
+
+			_ = rst.Client().Send(
+				&pgproto3.RowDescription{
+					Fields: []pgproto3.FieldDescription{
+						{
+							Name:         []byte("read your writes"),
+							DataTypeOID:  25,
+							DataTypeSize: -1,
+							TypeModifier: -1,
+						},
+					},
+				},
+			)
+
+			val := "off"
+			if rst.Client().ReadYourWrites() {
+				val = "on"
+			}
+			_ = rst.Client().Send(
+				&pgproto3.DataRow{
+					Values: [][]byte{
+						[]byte(val),
+					},
+				},
+			)
+		case session.SPQR_CONSISTENT_SNAPSHOT:
+
+			_ = rst.Client().Send(
+				&pgproto3.RowDescription{
+					Fields: []pgproto3.FieldDescription{
+						{
+							Name:         []byte("consistent snapshot"),
+							DataTypeOID:  25,
+							DataTypeSize: -1,
+							TypeModifier: -1,
+						},
+					},
+				},
+			)
+
+			val := "off"
+			if rst.Client().ConsistentSnapshot() {
+				val = "on"
+			}
+			_ = rst.Client().Send(
+				&pgproto3.DataRow{
+					Values: [][]byte{
+						[]byte(val),
+					},
+				},
+			)
 		case session.SPQR_SCATTER_QUERY:
 
 			_ = rst.Client().Send(
@@ -305,6 +401,19 @@ func ProcQueryAdvanced(rst RelayStateMgr, query string, ph ProtoStateHandler, bi
 			// process like regular query
 			return binder()
 		}
+	case parser.ParseStateDeallocate:
+		if AdvancedPoolModeNeeded(rst) {
+			/* statements on server connections are shared between clients and evicted by cache */
+			if st.All {
+				rst.Client().DeallocateAllPreparedStatements()
+				return rst.Client().ReplyCommandComplete("DEALLOCATE ALL")
+			}
+			rst.Client().DeallocatePreparedStatement(st.Name)
+			return rst.Client().ReplyCommandComplete("DEALLOCATE")
+		} else {
+			// process like regular query
+			return binder()
+		}
 	case parser.ParseStateExplain:
 		_ = rst.Client().ReplyErrMsgByCode(spqrerror.SPQR_UNEXPECTED)
 		return nil
@@ -0,0 +1,116 @@
+package relay
+
+import (
+	"github.com/pg-sharding/lyx/lyx"
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/pkg/tsa"
+	"github.com/pg-sharding/spqr/router/qrouter"
+)
+
+// isWriteStmt reports if statement modifies data or schema.
+// Data modifying functions called from SELECT are not detected.
+func isWriteStmt(stmt lyx.Node) bool {
+	switch v := stmt.(type) {
+	case *lyx.Insert, *lyx.Update, *lyx.Delete, *lyx.Truncate:
+		return true
+	case *lyx.Copy:
+		return v.IsFrom
+	default:
+		return qrouter.IsSchemaChange(stmt)
+	}
+}
+
+// trackWriteLSN remembers WAL position of shards primaries after
+// transaction, which modified data, in read-your-writes session mode.
+// Must be called before server connection is released.
+func (rst *RelayStateImpl) trackWriteLSN() {
+	if !rst.txWrote {
+		return
+	}
+	rst.txWrote = false
+
+	if !rst.Client().ReadYourWrites() {
+		return
+	}
+
+	rst.Client().RLock()
+	defer rst.Client().RUnlock()
+
+	serv := rst.Client().Server()
+	if serv == nil {
+		return
+	}
+
+	for _, sh := range serv.Datashards() {
+		lsn, err := tsa.CurrentWalLSN(sh)
+		if err != nil {
+			spqrlog.Zero.Warn().
+				Uint("client", rst.Client().ID()).
+				Str("shard", sh.Name()).
+				Err(err).
+				Msg("failed to get wal position after write transaction")
+			continue
+		}
+		if lsn > rst.writeLSN[sh.Name()] {
+			rst.writeLSN[sh.Name()] = lsn
+		}
+	}
+}
+
+// replicasCaughtUp reports if hosts, client is connected to for reads,
+// have replayed writes of its previous transactions
+func (rst *RelayStateImpl) replicasCaughtUp() bool {
+	if len(rst.writeLSN) == 0 || !rst.Client().ReadYourWrites() {
+		return true
+	}
+	if rst.Client().GetTsa() == config.TargetSessionAttrsRW {
+		return true
+	}
+
+	rst.Client().RLock()
+	defer rst.Client().RUnlock()
+
+	serv := rst.Client().Server()
+	if serv == nil {
+		return true
+	}
+
+	for _, sh := range serv.Datashards() {
+		lsn, ok := rst.writeLSN[sh.Name()]
+		if !ok {
+			continue
+		}
+		host := sh.Instance().Hostname()
+		// replay position of replica only grows
+		if rst.replayLSN[host] >= lsn {
+			continue
+		}
+
+		replay, inRecovery, err := tsa.ReplayLSN(sh)
+		if err != nil {
+			spqrlog.Zero.Warn().
+				Uint("client", rst.Client().ID()).
+				Str("host", host).
+				Err(err).
+				Msg("failed to get replay position of host")
+			return false
+		}
+		if !inRecovery {
+			// primary has all writes
+			continue
+		}
+		rst.replayLSN[host] = replay
+		if replay < lsn {
+			spqrlog.Zero.Debug().
+				Uint("client", rst.Client().ID()).
+				Str("host", host).
+				Str("replay lsn", replay.String()).
+				Str("write lsn", lsn.String()).
+				Msg("replica has not replayed writes of client yet")
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,247 @@
+package relay
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/pg-sharding/spqr/pkg/config"
+	mockinst "github.com/pg-sharding/spqr/pkg/mock/conn"
+	mocksh "github.com/pg-sharding/spqr/pkg/mock/shard"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/pg-sharding/spqr/pkg/tsa"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+	mockcl "github.com/pg-sharding/spqr/router/mock/client"
+	mockcmgr "github.com/pg-sharding/spqr/router/mock/poolmgr"
+	mockqr "github.com/pg-sharding/spqr/router/mock/qrouter"
+	mocksrv "github.com/pg-sharding/spqr/router/mock/server"
+	"github.com/pg-sharding/spqr/router/route"
+	"github.com/pg-sharding/spqr/router/routingstate"
+	"github.com/stretchr/testify/assert"
+)
+
+// expectLSNQuery makes shard answer single pg_lsn query, NULL for empty lsn
+func expectLSNQuery(sh *mocksh.MockShard, query string, lsn string) {
+	var values [][]byte
+	if lsn == "" {
+		values = [][]byte{nil}
+	} else {
+		values = [][]byte{[]byte(lsn)}
+	}
+
+	sh.EXPECT().Send(&pgproto3.Query{String: query}).Return(nil).Times(1)
+	gomock.InOrder(
+		sh.EXPECT().Receive().Return(&pgproto3.DataRow{Values: values}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("SELECT 1")}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: byte(txstatus.TXIDLE)}, nil),
+	)
+}
+
+func newLSNShard(ctrl *gomock.Controller, name string, host string) *mocksh.MockShard {
+	sh := mocksh.NewMockShard(ctrl)
+	ins := mockinst.NewMockDBInstance(ctrl)
+	ins.EXPECT().Hostname().Return(host).AnyTimes()
+	sh.EXPECT().Name().Return(name).AnyTimes()
+	sh.EXPECT().Instance().Return(ins).AnyTimes()
+	return sh
+}
+
+func newReadYourWritesState(ctrl *gomock.Controller, cl *mockcl.MockRouterClient) *RelayStateImpl {
+	cl.EXPECT().ID().Return(uint(1)).AnyTimes()
+	cl.EXPECT().ReadYourWrites().Return(true).AnyTimes()
+	cl.EXPECT().RLock().AnyTimes()
+	cl.EXPECT().RUnlock().AnyTimes()
+
+	return NewRelayState(mockqr.NewMockQueryRouter(ctrl), cl, mockcmgr.NewMockPoolMgr(ctrl), &config.Router{})
+}
+
+func TestTrackWriteLSN(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	cl := mockcl.NewMockRouterClient(ctrl)
+	srv := mocksrv.NewMockServer(ctrl)
+
+	sh1 := newLSNShard(ctrl, "sh1", "h1")
+	sh2 := newLSNShard(ctrl, "sh2", "h2")
+
+	rst := newReadYourWritesState(ctrl, cl)
+	cl.EXPECT().Server().Return(srv).AnyTimes()
+	srv.EXPECT().Datashards().Return([]shard.Shard{sh1, sh2}).AnyTimes()
+
+	rst.writeLSN["sh1"] = 0x2_00000000
+
+	/* transaction did not write */
+	rst.trackWriteLSN()
+	assert.Equal(map[string]tsa.LSN{"sh1": 0x2_00000000}, rst.writeLSN)
+
+	expectLSNQuery(sh1, "SELECT pg_current_wal_lsn()", "1/10")
+	expectLSNQuery(sh2, "SELECT pg_current_wal_lsn()", "1/10")
+
+	rst.txWrote = true
+	rst.trackWriteLSN()
+
+	assert.False(rst.txWrote)
+	/* lsn of shard never goes back */
+	assert.Equal(map[string]tsa.LSN{
+		"sh1": 0x2_00000000,
+		"sh2": 0x1_00000010,
+	}, rst.writeLSN)
+}
+
+func TestReplicasCaughtUp(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tt := range []struct {
+		name     string
+		writeLSN map[string]tsa.LSN
+		replay   string
+		tsa      string
+		query    bool
+		exp      bool
+	}{
+		{
+			name:   "no writes",
+			replay: "1/0",
+			tsa:    config.TargetSessionAttrsRO,
+			exp:    true,
+		},
+		{
+			name:     "read-write session",
+			writeLSN: map[string]tsa.LSN{"sh1": 0x1_00000010},
+			tsa:      config.TargetSessionAttrsRW,
+			exp:      true,
+		},
+		{
+			name:     "replica is behind",
+			writeLSN: map[string]tsa.LSN{"sh1": 0x1_00000010},
+			replay:   "1/F",
+			tsa:      config.TargetSessionAttrsRO,
+			query:    true,
+			exp:      false,
+		},
+		{
+			name:     "replica replayed writes",
+			writeLSN: map[string]tsa.LSN{"sh1": 0x1_00000010},
+			replay:   "1/10",
+			tsa:      config.TargetSessionAttrsRO,
+			query:    true,
+			exp:      true,
+		},
+		{
+			name:     "host is primary",
+			writeLSN: map[string]tsa.LSN{"sh1": 0x1_00000010},
+			replay:   "",
+			tsa:      config.TargetSessionAttrsAny,
+			query:    true,
+			exp:      true,
+		},
+		{
+			name:     "no writes to shard",
+			writeLSN: map[string]tsa.LSN{"sh2": 0x1_00000010},
+			tsa:      config.TargetSessionAttrsRO,
+			exp:      true,
+		},
+	} {
+		ctrl := gomock.NewController(t)
+		cl := mockcl.NewMockRouterClient(ctrl)
+		srv := mocksrv.NewMockServer(ctrl)
+		sh := newLSNShard(ctrl, "sh1", "h1")
+
+		rst := newReadYourWritesState(ctrl, cl)
+		for k, v := range tt.writeLSN {
+			rst.writeLSN[k] = v
+		}
+		cl.EXPECT().GetTsa().Return(tt.tsa).AnyTimes()
+		cl.EXPECT().Server().Return(srv).AnyTimes()
+		srv.EXPECT().Datashards().Return([]shard.Shard{sh}).AnyTimes()
+
+		if tt.query {
+			expectLSNQuery(sh, "SELECT pg_last_wal_replay_lsn()", tt.replay)
+		}
+
+		assert.Equal(tt.exp, rst.replicasCaughtUp(), tt.name)
+		ctrl.Finish()
+	}
+}
+
+func TestReplicasCaughtUpCachesReplayLSN(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	cl := mockcl.NewMockRouterClient(ctrl)
+	srv := mocksrv.NewMockServer(ctrl)
+	sh := newLSNShard(ctrl, "sh1", "h1")
+
+	rst := newReadYourWritesState(ctrl, cl)
+	rst.writeLSN["sh1"] = 0x1_00000010
+
+	cl.EXPECT().GetTsa().Return(config.TargetSessionAttrsRO).AnyTimes()
+	cl.EXPECT().Server().Return(srv).AnyTimes()
+	srv.EXPECT().Datashards().Return([]shard.Shard{sh}).AnyTimes()
+
+	/* host is asked only once, replay position of replica only grows */
+	expectLSNQuery(sh, "SELECT pg_last_wal_replay_lsn()", "1/20")
+
+	assert.True(rst.replicasCaughtUp())
+	assert.True(rst.replicasCaughtUp())
+	assert.Equal(tsa.LSN(0x1_00000020), rst.replayLSN["h1"])
+}
+
+func TestConnectFallsBackToPrimary(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	cl := mockcl.NewMockRouterClient(ctrl)
+	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
+	srv := mocksrv.NewMockServer(ctrl)
+	sh := newLSNShard(ctrl, "sh1", "replica")
+
+	cl.EXPECT().ID().Return(uint(1)).AnyTimes()
+	cl.EXPECT().ReadYourWrites().Return(true).AnyTimes()
+	cl.EXPECT().RLock().AnyTimes()
+	cl.EXPECT().RUnlock().AnyTimes()
+	cl.EXPECT().Usr().Return("user1").AnyTimes()
+	cl.EXPECT().DB().Return("db1").AnyTimes()
+	cl.EXPECT().ReplyDebugNotice(gomock.Any()).Return(nil).AnyTimes()
+	cl.EXPECT().Server().Return(srv).AnyTimes()
+	srv.EXPECT().Datashards().Return([]shard.Shard{sh}).AnyTimes()
+
+	rt := route.NewRoute(&config.BackendRule{}, &config.FrontendRule{}, map[string]*config.Shard{
+		"sh1": {},
+	}, nil, "")
+	cl.EXPECT().Route().Return(rt).AnyTimes()
+
+	rst := NewRelayState(mockqr.NewMockQueryRouter(ctrl), cl, cmngr, &config.Router{})
+	rst.writeLSN["sh1"] = 0x1_00000010
+	rst.activeShards = []kr.ShardKey{{Name: "sh1"}}
+
+	expectLSNQuery(sh, "SELECT pg_last_wal_replay_lsn()", "1/F")
+
+	gomock.InOrder(
+		cl.EXPECT().AssignServerConn(gomock.Any()).Return(nil),
+		cmngr.EXPECT().RouteCB(cl, rst.activeShards).Return(nil),
+		cl.EXPECT().GetTsa().Return(config.TargetSessionAttrsRO),
+		cmngr.EXPECT().UnRouteCB(cl, rst.activeShards).Return(nil),
+		cl.EXPECT().Unroute().Return(nil),
+		cl.EXPECT().GetTsa().Return(config.TargetSessionAttrsRO),
+		cl.EXPECT().SetTsa(config.TargetSessionAttrsRW),
+
+		/* reconnect to primary */
+		cl.EXPECT().AssignServerConn(gomock.Any()).Return(nil),
+		cmngr.EXPECT().RouteCB(cl, rst.activeShards).Return(nil),
+		cl.EXPECT().GetTsa().Return(config.TargetSessionAttrsRW),
+
+		cl.EXPECT().SetTsa(config.TargetSessionAttrsRO),
+	)
+
+	err := rst.Connect([]*routingstate.DataShardRoute{
+		{
+			Shkey: kr.ShardKey{Name: "sh1"},
+		},
+	})
+
+	assert.NoError(err)
+	assert.Equal(tsa.LSN(0x1_0000000F), rst.replayLSN["replica"])
+}
@@ -2,6 +2,7 @@ package relay
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"time"
@@ -10,10 +11,12 @@ import (
 
 	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/opentracing/opentracing-go"
+	"github.com/pg-sharding/lyx/lyx"
 	"github.com/pg-sharding/spqr/pkg/config"
 	"github.com/pg-sharding/spqr/pkg/models/kr"
 	"github.com/pg-sharding/spqr/pkg/shard"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/pkg/tsa"
 	"github.com/pg-sharding/spqr/pkg/txstatus"
 	"github.com/pg-sharding/spqr/router/client"
 	"github.com/pg-sharding/spqr/router/parser"
@@ -24,6 +27,7 @@ import (
 	"github.com/pg-sharding/spqr/router/server"
 	"github.com/pg-sharding/spqr/router/statistics"
 	"github.com/spaolacci/murmur3"
+	"go.uber.org/atomic"
 	"golang.org/x/exp/slices"
 )
 
@@ -44,6 +48,8 @@ type RelayStateMgr interface {
 	AddSilentQuery(q pgproto3.FrontendMessage)
 	TxActive() bool
 
+	BeginConsistentSnapshot(query string)
+
 	PgprotoDebug() bool
 
 	RelayStep(msg pgproto3.FrontendMessage, waitForResp bool, replyCl bool) (txstatus.TXStatus, []pgproto3.BackendMessage, error)
@@ -141,6 +147,34 @@ type RelayStateImpl struct {
 
 	// buffer of messages to process on Sync request
 	xBuf []pgproto3.FrontendMessage
+
+	// retryOnFailover allows ProcQuery to hide read-only error of demoted primary
+	retryOnFailover bool
+
+	// copyRouter routes rows of COPY FROM STDIN data stream to their shards
+	copyRouter *qrouter.CopyRowRouter
+	// copyErr is error of routing COPY data stream
+	copyErr error
+
+	// splitInsert holds per-shard parts of multi-row INSERT statement,
+	// which are sent instead of client query
+	splitInsert map[string]*pgproto3.Query
+
+	// twoPhase is implicit transaction of DDL statement, committed with two-phase commit
+	twoPhase *twoPhaseTx
+
+	// txWrote is set, if current transaction has data modifying statements
+	txWrote bool
+	// writeLSN is WAL position of shard primary after last write transaction of client
+	writeLSN map[string]tsa.LSN
+	// replayLSN is last known WAL replay position of replica hosts
+	replayLSN map[string]tsa.LSN
+
+	// snapshotBegin is BEGIN statement of transaction, which acquires
+	// consistent snapshot of all shards on its first statement
+	snapshotBegin string
+	// snapshotTx is set, while transaction with consistent snapshot is active
+	snapshotTx bool
 }
 
 func NewRelayState(qr qrouter.QueryRouter, client client.RouterClient, manager poolmgr.PoolMgr, rcfg *config.Router) *RelayStateImpl {
@@ -154,6 +188,8 @@ func NewRelayState(qr qrouter.QueryRouter, client client.RouterClient, manager p
 		manager:            manager,
 		WorldShardFallback: rcfg.WorldShardFallback,
 		routerMode:         config.RouterMode(rcfg.RouterMode),
+		writeLSN:           map[string]tsa.LSN{},
+		replayLSN:          map[string]tsa.LSN{},
 		maintain_params:    rcfg.MaintainParams,
 		pgprotoDebug:       rcfg.PgprotoDebug,
 		execute:            nil,
@@ -184,6 +220,11 @@ func (rst *RelayStateImpl) TxStatus() txstatus.TXStatus {
 func (rst *RelayStateImpl) PrepareStatement(hash uint64, d server.PrepStmtDesc) (*shard.PreparedStatementDescriptor, pgproto3.BackendMessage, error) {
 	rst.Cl.ServerAcquireUse()
 
+	if shs := rst.Cl.Server().Datashards(); len(shs) > 1 {
+		rst.Cl.ServerReleaseUse()
+		return prepareStatementOnShards(shs, hash, d)
+	}
+
 	if ok, rd := rst.Cl.Server().HasPrepareStatement(hash); ok {
 		rst.Cl.ServerReleaseUse()
 		return rd, &pgproto3.ParseComplete{}, nil
@@ -215,7 +256,85 @@ func (rst *RelayStateImpl) PrepareStatement(hash uint64, d server.PrepStmtDesc)
 		return nil, nil, err
 	}
 
+	for _, msg := range unreplied {
+		spqrlog.Zero.Debug().Uint("client", rst.Client().ID()).Interface("type", msg).Msg("unreplied msg in prepare")
+	}
+
+	rd, retMsg, deployed := prepStmtDescFromReplies(d.Name, unreplied)
+	if deployed {
+		// dont need to complete relay because tx state didt changed
+		rst.Cl.Server().PrepareStatement(hash, rd)
+	}
+	return rd, retMsg, nil
+}
+
+// prepareStatementOnShards deploys statement on each server connection of multishard server,
+// which does not have it yet. Shards are synced one by one, so multishard server state is unaffected.
+//
+// TODO : unit tests
+func prepareStatementOnShards(shs []shard.Shard, hash uint64, d server.PrepStmtDesc) (*shard.PreparedStatementDescriptor, pgproto3.BackendMessage, error) {
+	var rd *shard.PreparedStatementDescriptor
+	var retMsg pgproto3.BackendMessage = &pgproto3.ParseComplete{}
+
+	for _, sh := range shs {
+		if ok, shRd := sh.HasPrepareStatement(hash); ok {
+			if rd == nil {
+				rd = shRd
+			}
+			continue
+		}
+
+		spqrlog.Zero.Debug().
+			Uint("shard", sh.ID()).
+			Str("name", d.Name).
+			Msg("deploy prepared statement on shard of multishard server")
+
+		for _, msg := range []pgproto3.FrontendMessage{
+			&pgproto3.Parse{
+				Name:  d.Name,
+				Query: d.Query,
+			},
+			&pgproto3.Describe{
+				ObjectType: 'S',
+				Name:       d.Name,
+			},
+			&pgproto3.Sync{},
+		} {
+			if err := sh.Send(msg); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		var replies []pgproto3.BackendMessage
+		for {
+			msg, err := sh.Receive()
+			if err != nil {
+				return nil, nil, err
+			}
+			if _, ok := msg.(*pgproto3.ReadyForQuery); ok {
+				break
+			}
+			replies = append(replies, msg)
+		}
+
+		shRd, shRetMsg, deployed := prepStmtDescFromReplies(d.Name, replies)
+		if !deployed {
+			return shRd, shRetMsg, nil
+		}
+		sh.PrepareStatement(hash, shRd)
+		if rd == nil {
+			rd = shRd
+		}
+	}
+
+	return rd, retMsg, nil
+}
+
+// prepStmtDescFromReplies builds statement descriptor from replies on Parse and Describe messages.
+// Reply for the client is ParseComplete, if statement was deployed, or ErrorResponse otherwise.
+func prepStmtDescFromReplies(name string, replies []pgproto3.BackendMessage) (*shard.PreparedStatementDescriptor, pgproto3.BackendMessage, bool) {
 	rd := &shard.PreparedStatementDescriptor{
+		Name:      name,
 		NoData:    false,
 		RowDesc:   nil,
 		ParamDesc: nil,
@@ -225,8 +344,7 @@ func (rst *RelayStateImpl) PrepareStatement(hash uint64, d server.PrepStmtDesc)
 
 	deployed := false
 
-	for _, msg := range unreplied {
-		spqrlog.Zero.Debug().Uint("client", rst.Client().ID()).Interface("type", msg).Msg("unreplied msg in prepare")
+	for _, msg := range replies {
 		switch q := msg.(type) {
 		case *pgproto3.ParseComplete:
 			// skip
@@ -257,11 +375,7 @@ func (rst *RelayStateImpl) PrepareStatement(hash uint64, d server.PrepStmtDesc)
 		}
 	}
 
-	if deployed {
-		// dont need to complete relay because tx state didt changed
-		rst.Cl.Server().PrepareStatement(hash, rd)
-	}
-	return rd, retMsg, nil
+	return rd, retMsg, deployed
 }
 
 func (rst *RelayStateImpl) RouterMode() config.RouterMode {
@@ -309,6 +423,68 @@ func (rst *RelayStateImpl) Flush() {
 
 var ErrSkipQuery = fmt.Errorf("wait for a next query")
 
+// sqlstate of "cannot execute ... in a read-only transaction"
+const readOnlySQLTransactionCode = "25006"
+
+// sqlstate of "canceling statement due to user request"
+const queryCanceledCode = "57014"
+
+// queryTimeout returns time, statement may run on shards,
+// before router cancels it. Zero means no limit.
+func (rst *RelayStateImpl) queryTimeout() time.Duration {
+	rule := rst.Client().Rule()
+	if rule == nil {
+		return 0
+	}
+	return time.Duration(rule.QueryTimeoutSec) * time.Second
+}
+
+// PrimaryChangedError is returned, when read-write statement was rejected
+// by a host, which is not primary anymore
+type PrimaryChangedError struct {
+	Shard string
+	Host  string
+}
+
+func (e *PrimaryChangedError) Error() string {
+	return fmt.Sprintf("host %s of shard %s is not primary anymore", e.Host, e.Shard)
+}
+
+// primaryChangedError returns error describing the host, which rejected read-write statement,
+// or nil if the statement can not be retried on another host
+func (rst *RelayStateImpl) primaryChangedError(serv server.Server) *PrimaryChangedError {
+	if rst.Client().GetTsa() != config.TargetSessionAttrsRW {
+		return nil
+	}
+	shs := serv.Datashards()
+	if len(shs) != 1 || shs[0] == nil {
+		return nil
+	}
+	return &PrimaryChangedError{
+		Shard: shs[0].Name(),
+		Host:  shs[0].Instance().Hostname(),
+	}
+}
+
+// handlePrimaryChange invalidates connections to the demoted primary
+// and reconnects client to the current routes
+func (rst *RelayStateImpl) handlePrimaryChange(pcErr *PrimaryChangedError) error {
+	spqrlog.Zero.Warn().
+		Uint("client", rst.Client().ID()).
+		Str("shard", pcErr.Shard).
+		Str("host", pcErr.Host).
+		Msg("primary failover detected, retrying statement on new primary")
+	_ = rst.Cl.ReplyNotice(fmt.Sprintf("primary of shard %s has changed, retrying statement on new primary", pcErr.Shard))
+
+	rst.Cl.Route().ServPool().InvalidateHost(pcErr.Host)
+
+	routes := rst.CurrentRoutes()
+	if len(routes) == 0 {
+		return pcErr
+	}
+	return rst.procRoutes(routes)
+}
+
 // TODO : unit tests
 func (rst *RelayStateImpl) procRoutes(routes []*routingstate.DataShardRoute) error {
 	// if there is no routes configurted, there is nowhere to route to
@@ -363,6 +539,27 @@ func (rst *RelayStateImpl) Reroute() error {
 		Interface("params", rst.Client().BindParams()).
 		Msg("rerouting the client connection, resolving shard")
 
+	if _, ok := rst.qp.Stmt().(*lyx.TransactionStmt); !ok && rst.snapshotBegin != "" {
+		return rst.rerouteSnapshot()
+	}
+
+	rst.splitInsert = nil
+	rst.twoPhase = nil
+	if ins, ok := rst.qp.Stmt().(*lyx.Insert); ok {
+		if rst.plainQueryBuffered() {
+			queries, err := qrouter.SplitInsertValues(context.TODO(), rst.Qr, ins, rst.plainQ, rst.Cl)
+			if err != nil {
+				return fmt.Errorf("error processing query '%v': %v", rst.plainQ, err)
+			}
+			if len(queries) > 1 {
+				return rst.rerouteSplitInsert(queries)
+			}
+		} else if err := qrouter.CheckInsertTuplesSingleShard(context.TODO(), rst.Qr, ins, rst.Cl); err != nil {
+			/* prepared statement is executed as whole */
+			return fmt.Errorf("error processing query '%v': %v", rst.plainQ, err)
+		}
+	}
+
 	routingState, err := rst.Qr.Route(context.TODO(), rst.qp.Stmt(), rst.Cl)
 
 	if err != nil {
@@ -378,10 +575,52 @@ func (rst *RelayStateImpl) Reroute() error {
 			Uint("client", rst.Client().ID()).
 			Err(err).
 			Msgf("parsed multi-shard routing state")
-		return rst.procRoutes(rst.Qr.DataShardsRoutes())
+		if err := rst.procRoutes(rst.Qr.DataShardsRoutes()); err != nil {
+			return err
+		}
+		rst.prepareCopyOut()
+		return nil
 	case routingstate.ShardMatchState:
 		// TBD: do it better
 		return rst.procRoutes([]*routingstate.DataShardRoute{v.Route})
+	case routingstate.DDLState:
+		if rst.TxActive() {
+			return fmt.Errorf("ddl is forbidden inside multi-shard transition")
+		}
+		if err := rst.procRoutes(rst.Qr.DataShardsRoutes()); err != nil {
+			return err
+		}
+		/* statements like CREATE INDEX CONCURRENTLY or DROP DATABASE are applied shard by shard */
+		if qrouter.DDLAllowsTransaction(rst.qp.Stmt()) {
+			rst.wrapImplicitDDLTx()
+		}
+		return nil
+	case routingstate.ReferenceRelationState:
+		/* writes to reference relation are applied on every shard */
+		if err := rst.procRoutes(rst.Qr.DataShardsRoutes()); err != nil {
+			return err
+		}
+		rst.wrapImplicitTx()
+		return nil
+	case routingstate.CopyFromState:
+		cp, ok := rst.qp.Stmt().(*lyx.Copy)
+		if !ok {
+			return spqrerror.New(spqrerror.SPQR_ROUTING_ERROR, "COPY FROM STDIN is routed without COPY statement")
+		}
+		opts, err := qrouter.ParseCopyOptions(cp)
+		if err != nil {
+			return err
+		}
+		copyRouter, err := qrouter.NewCopyRowRouter(context.TODO(), rst.Qr, v.RelationName, opts)
+		if err != nil {
+			return err
+		}
+		if err := rst.procRoutes(rst.Qr.DataShardsRoutes()); err != nil {
+			return err
+		}
+		rst.copyRouter = copyRouter
+		rst.wrapImplicitTx()
+		return nil
 	case routingstate.SkipRoutingState:
 		return ErrSkipQuery
 	case routingstate.RandomMatchState:
@@ -403,6 +642,88 @@ func (rst *RelayStateImpl) Reroute() error {
 	}
 }
 
+// plainQueryBuffered reports if client query was sent with simple query protocol
+// and is not yet executed
+func (rst *RelayStateImpl) plainQueryBuffered() bool {
+	for _, msg := range rst.msgBuf {
+		if q, ok := msg.msg.(*pgproto3.Query); ok && msg.tp == BufferedMessageRegular && q.String == rst.plainQ {
+			return true
+		}
+	}
+	return false
+}
+
+// rerouteSplitInsert routes multi-row INSERT statement to all shards its tuples
+// belong to. Every shard receives INSERT statement with its own tuples only.
+func (rst *RelayStateImpl) rerouteSplitInsert(queries []*qrouter.InsertShardQuery) error {
+	routes := make([]*routingstate.DataShardRoute, 0, len(queries))
+	split := make(map[string]*pgproto3.Query, len(queries))
+	for _, q := range queries {
+		routes = append(routes, &routingstate.DataShardRoute{
+			Shkey: kr.ShardKey{Name: q.Shard},
+		})
+		split[q.Shard] = &pgproto3.Query{String: q.Query}
+	}
+
+	spqrlog.Zero.Debug().
+		Uint("client", rst.Client().ID()).
+		Int("shards", len(routes)).
+		Msg("split multi-row insert statement between shards")
+
+	rst.routingState = routingstate.MultiMatchState{}
+	if err := rst.procRoutes(routes); err != nil {
+		return err
+	}
+	rst.splitInsert = split
+	rst.wrapImplicitTx()
+	return nil
+}
+
+// sendSplitInsert sends parts of multi-row INSERT statement to their shards
+func (rst *RelayStateImpl) sendSplitInsert() error {
+	split := rst.splitInsert
+	rst.splitInsert = nil
+	for _, sh := range rst.Client().Server().Datashards() {
+		q, ok := split[sh.SHKey().Name]
+		if !ok {
+			return fmt.Errorf("multi-row insert has no tuples for shard %s", sh.SHKey().Name)
+		}
+		if err := sh.Send(q); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepareCopyOut makes multishard server pass only one header line
+// of COPY TO STDOUT with HEADER option
+func (rst *RelayStateImpl) prepareCopyOut() {
+	cp, ok := rst.qp.Stmt().(*lyx.Copy)
+	if !ok || cp.IsFrom {
+		return
+	}
+	opts, err := qrouter.ParseCopyOptions(cp)
+	if err != nil || !opts.Header {
+		return
+	}
+	if ms, ok := rst.Client().Server().(*server.MultiShardServer); ok {
+		ms.SkipCopyOutHeaders()
+	}
+}
+
+// wrapImplicitTx wraps buffered autocommit statement, executed on multiple shards,
+// into transaction, so that failed statement is rolled back on all of them.
+// Only simple protocol queries are buffered here, extended protocol statements are not wrapped.
+// Shards commit independently, COMMIT failed on one shard does not roll back the others.
+func (rst *RelayStateImpl) wrapImplicitTx() {
+	if rst.TxActive() || len(rst.msgBuf) == 0 {
+		return
+	}
+	buf := []BufferedMessage{InternalBufferedMessage(&pgproto3.Query{String: "BEGIN"})}
+	buf = append(buf, rst.msgBuf...)
+	rst.msgBuf = append(buf, InternalBufferedMessage(&pgproto3.Query{String: "COMMIT"}))
+}
+
 // TODO : unit tests
 func (rst *RelayStateImpl) RerouteToRandomRoute() error {
 	_ = rst.Cl.ReplyDebugNotice("rerouting the client connection")
@@ -514,6 +835,22 @@ func (rst *RelayStateImpl) Connect(shardRoutes []*routingstate.DataShardRoute) e
 	if err := rst.manager.RouteCB(rst.Cl, rst.activeShards); err != nil {
 		return err
 	}
+	if !rst.replicasCaughtUp() {
+		/* read-your-writes: fall back to primary */
+		spqrlog.Zero.Debug().
+			Uint("client", rst.Client().ID()).
+			Msg("replicas are behind writes of client, reconnecting to primary")
+		if err := rst.manager.UnRouteCB(rst.Cl, rst.activeShards); err != nil {
+			return err
+		}
+		_ = rst.Cl.Unroute()
+
+		savedTsa := rst.Cl.GetTsa()
+		rst.Cl.SetTsa(config.TargetSessionAttrsRW)
+		defer rst.Cl.SetTsa(savedTsa)
+
+		return rst.Connect(shardRoutes)
+	}
 	if rst.maintain_params {
 		query := rst.Cl.ConstructClientParams()
 		spqrlog.Zero.Debug().
@@ -614,9 +951,40 @@ func (rst *RelayStateImpl) ProcCopy(query pgproto3.FrontendMessage) error {
 	_ = rst.Client().ReplyDebugNotice(fmt.Sprintf("executing your query %v", query)) // TODO perfomance issue
 	rst.Client().RLock()
 	defer rst.Client().RUnlock()
+
+	if cpData, ok := query.(*pgproto3.CopyData); ok && rst.copyRouter != nil {
+		if rst.copyErr != nil {
+			/* copy is aborted on completion, skip rest of data */
+			return nil
+		}
+		rows, err := rst.copyRouter.Route(context.TODO(), cpData.Data)
+		if err != nil {
+			rst.copyErr = err
+			return nil
+		}
+		return rst.sendCopyRows(rows)
+	}
 	return rst.Client().Server().Send(query)
 }
 
+// sendCopyRows sends rows of COPY data stream to the shards they belong to
+func (rst *RelayStateImpl) sendCopyRows(rows map[string][]byte) error {
+	for _, sh := range rst.Client().Server().Datashards() {
+		data, ok := rows[sh.SHKey().Name]
+		if !ok {
+			continue
+		}
+		delete(rows, sh.SHKey().Name)
+		if err := sh.Send(&pgproto3.CopyData{Data: data}); err != nil {
+			return err
+		}
+	}
+	for name := range rows {
+		return fmt.Errorf("COPY row is routed to shard %s, which is not connected", name)
+	}
+	return nil
+}
+
 // TODO : unit tests
 func (rst *RelayStateImpl) ProcCopyComplete(query *pgproto3.FrontendMessage) error {
 	spqrlog.Zero.Debug().
@@ -625,7 +993,28 @@ func (rst *RelayStateImpl) ProcCopyComplete(query *pgproto3.FrontendMessage) err
 		Msg("client process copy end")
 	rst.Client().RLock()
 	defer rst.Client().RUnlock()
-	if err := rst.Client().Server().Send(*query); err != nil {
+
+	msg := *query
+	if rst.copyRouter != nil {
+		copyRouter, copyErr := rst.copyRouter, rst.copyErr
+		rst.copyRouter, rst.copyErr = nil, nil
+		if _, ok := msg.(*pgproto3.CopyDone); ok {
+			if copyErr == nil {
+				var rows map[string][]byte
+				if rows, copyErr = copyRouter.Done(context.TODO()); copyErr == nil {
+					if err := rst.sendCopyRows(rows); err != nil {
+						return err
+					}
+				}
+			}
+			/* abort copy on all shards, they reply with error */
+			if copyErr != nil {
+				msg = &pgproto3.CopyFail{Message: copyErr.Error()}
+			}
+		}
+	}
+
+	if err := rst.Client().Server().Send(msg); err != nil {
 		return err
 	}
 
@@ -661,7 +1050,16 @@ func (rst *RelayStateImpl) ProcQuery(query pgproto3.FrontendMessage, waitForResp
 		Type("query-type", query).
 		Msg("relay process query")
 
-	if err := server.Send(query); err != nil {
+	// statement out of transaction may be retried on new primary,
+	// if nothing was replied to client yet
+	_, isQuery := query.(*pgproto3.Query)
+	retriable := isQuery && rst.retryOnFailover
+
+	if q, ok := query.(*pgproto3.Query); ok && rst.splitInsert != nil && q.String == rst.plainQ {
+		if err := rst.sendSplitInsert(); err != nil {
+			return txstatus.TXERR, nil, false, err
+		}
+	} else if err := server.Send(query); err != nil {
 		return txstatus.TXERR, nil, false, err
 	}
 
@@ -680,16 +1078,45 @@ func (rst *RelayStateImpl) ProcQuery(query pgproto3.FrontendMessage, waitForResp
 		return txstatus.TXCONT, nil, true, nil
 	}
 
+	timedOut := atomic.NewBool(false)
+	if timeout := rst.queryTimeout(); timeout > 0 {
+		timer := time.AfterFunc(timeout, func() {
+			timedOut.Store(true)
+			spqrlog.Zero.Warn().
+				Uint("client", rst.Client().ID()).
+				Dur("timeout", timeout).
+				Msg("query timeout exceeded, canceling statement on shards")
+			if err := server.Cancel(); err != nil {
+				spqrlog.Zero.Error().Err(err).Msg("failed to cancel statement on query timeout")
+			}
+		})
+		defer timer.Stop()
+	}
+
 	ok := true
 
 	unreplied := make([]pgproto3.BackendMessage, 0)
 
-	for {
+	for first := true; ; first = false {
 		msg, err := server.Receive()
 		if err != nil {
 			return txstatus.TXERR, nil, false, err
 		}
 
+		if errMsg, isErr := msg.(*pgproto3.ErrorResponse); isErr && first && retriable && errMsg.Code == readOnlySQLTransactionCode {
+			if pcErr := rst.primaryChangedError(server); pcErr != nil {
+				for {
+					msg, err := server.Receive()
+					if err != nil {
+						return txstatus.TXERR, nil, false, err
+					}
+					if v, ok := msg.(*pgproto3.ReadyForQuery); ok {
+						return txstatus.TXStatus(v.TxStatus), nil, false, pcErr
+					}
+				}
+			}
+		}
+
 		switch v := msg.(type) {
 		case *pgproto3.CopyInResponse:
 			// handle replyCl somehow
@@ -724,6 +1151,13 @@ func (rst *RelayStateImpl) ProcQuery(query pgproto3.FrontendMessage, waitForResp
 		case *pgproto3.ReadyForQuery:
 			return txstatus.TXStatus(v.TxStatus), unreplied, ok, nil
 		case *pgproto3.ErrorResponse:
+			if timedOut.Load() && v.Code == queryCanceledCode {
+				msg = &pgproto3.ErrorResponse{
+					Severity: "ERROR",
+					Code:     spqrerror.SPQR_QUERY_TIMEOUT,
+					Message:  "canceling statement due to query timeout",
+				}
+			}
 			if replyCl {
 				err = rst.Client().Send(msg)
 				if err != nil {
@@ -788,9 +1222,36 @@ func (rst *RelayStateImpl) RelayFlush(waitForResp bool, replyCl bool) (txstatus.
 			switch v.tp {
 			case BufferedMessageInternal:
 				resolvedReplyCl = false
+				/* implicit transaction is rolled back on every shard, if statement failed on some of them */
+				if q, isQuery := v.msg.(*pgproto3.Query); isQuery && q.String == "COMMIT" && !ok {
+					v.msg = &pgproto3.Query{String: "ROLLBACK"}
+				} else if isQuery && rst.twoPhase != nil {
+					msg, skip := rst.twoPhase.step(q, ok)
+					if skip {
+						continue
+					}
+					v.msg = msg
+				}
+			}
+
+			rst.retryOnFailover = v.tp == BufferedMessageRegular && !rst.TxActive()
+			release := rst.lockMultishardCommit(v)
+			txst, _, txok, err = rst.ProcQuery(v.msg, waitForResp, resolvedReplyCl)
+			var pcErr *PrimaryChangedError
+			if errors.As(err, &pcErr) {
+				// statement is retried only once
+				rst.retryOnFailover = false
+				if err = rst.handlePrimaryChange(pcErr); err == nil {
+					txst, _, txok, err = rst.ProcQuery(v.msg, waitForResp, resolvedReplyCl)
+				}
+			}
+			release()
+			rst.retryOnFailover = false
+			if err == nil && !txok && rst.twoPhase != nil && rst.twoPhase.isCommit(v.msg) {
+				rst.reportTwoPhaseCommitFailure()
 			}
 
-			if txst, _, txok, err = rst.ProcQuery(v.msg, waitForResp, resolvedReplyCl); err != nil {
+			if err != nil {
 				ok = false
 				return txstatus.TXERR, err
 			} else {
@@ -848,11 +1309,21 @@ func (rst *RelayStateImpl) CompleteRelay(replyCl bool) error {
 		Str("txstatus", rst.txStatus.String()).
 		Msg("complete relay iter")
 
+	if rst.txStatus == txstatus.TXIDLE {
+		rst.snapshotBegin = ""
+		rst.snapshotTx = false
+	}
+
 	switch rst.routingState.(type) {
-	case routingstate.MultiMatchState:
+	case routingstate.MultiMatchState, routingstate.DDLState:
+		if rst.snapshotTx {
+			// connections are kept up to the end of transaction with consistent snapshot
+			break
+		}
 		// TODO: explicitly forbid transaction, or hadnle it properly
 		spqrlog.Zero.Debug().Msg("unroute multishard route")
 
+		rst.trackWriteLSN()
 		if err := rst.manager.TXEndCB(rst); err != nil {
 			return nil
 		}
@@ -868,6 +1339,20 @@ func (rst *RelayStateImpl) CompleteRelay(replyCl bool) error {
 		return nil
 	}
 
+	if rst.Client().Rule().PoolMode == config.PoolModeStatement && rst.txStatus != txstatus.TXIDLE && rst.txStatus != txstatus.TXCONT {
+		// statement left transaction open, e.g. multi-statement query with BEGIN.
+		// Non-idle server connection is discarded by pool and is not shared.
+		spqrlog.Zero.Warn().
+			Uint("client", rst.Client().ID()).
+			Str("txstatus", rst.txStatus.String()).
+			Msg("transaction block in statement pool mode, resetting server connection")
+		_ = rst.Reset()
+		if replyCl {
+			return rst.Cl.ReplyErr(ErrStatementPoolTx)
+		}
+		return nil
+	}
+
 	switch rst.txStatus {
 	case txstatus.TXIDLE:
 		if replyCl {
@@ -878,6 +1363,7 @@ func (rst *RelayStateImpl) CompleteRelay(replyCl bool) error {
 			}
 		}
 
+		rst.trackWriteLSN()
 		if err := rst.manager.TXEndCB(rst); err != nil {
 			return err
 		}
@@ -965,17 +1451,11 @@ func (rst *RelayStateImpl) AddExtendedProtocMessage(q pgproto3.FrontendMessage)
 	rst.xBuf = append(rst.xBuf, q)
 }
 
-var MultiShardPrepStmtDeployError = fmt.Errorf("multishard prepared statement deploy is not supported")
-
 // TODO : unit tests
 func (rst *RelayStateImpl) DeployPrepStmt(qname string) (*shard.PreparedStatementDescriptor, pgproto3.BackendMessage, error) {
 	query := rst.Client().PreparedStatementQueryByName(qname)
 	hash := murmur3.Sum64([]byte(query))
 
-	if len(rst.Client().Server().Datashards()) != 1 {
-		return nil, nil, MultiShardPrepStmtDeployError
-	}
-
 	spqrlog.Zero.Debug().
 		Str("name", qname).
 		Str("query", query).
@@ -984,13 +1464,15 @@ func (rst *RelayStateImpl) DeployPrepStmt(qname string) (*shard.PreparedStatemen
 		Uints("shards", shard.ShardIDs(rst.Client().Server().Datashards())).
 		Msg("deploy prepared statement")
 
-	// TODO: multi-shard statements
 	if rst.bindRoute == nil {
 		routes := rst.CurrentRoutes()
-		if len(routes) == 1 {
-			rst.bindRoute = routes[0]
-		} else {
+		switch len(routes) {
+		case 0:
 			return nil, nil, fmt.Errorf("failed to deploy prepared statement %s", query)
+		case 1:
+			rst.bindRoute = routes[0]
+		default:
+			/* multishard statement is executed on current connection, see prepareRelayStepOnBindRoute */
 		}
 	}
 
@@ -1126,7 +1608,7 @@ func (rst *RelayStateImpl) ProcessExtendedBuffer(cmngr poolmgr.PoolMgr) error {
 				}
 
 				rst.execute = func() error {
-					fin, err := rst.PrepareRelayStepOnHintRoute(cmngr, rst.bindRoute)
+					fin, err := rst.prepareRelayStepOnBindRoute(cmngr)
 					if err != nil {
 						_ = fin()
 						return err
@@ -1159,7 +1641,7 @@ func (rst *RelayStateImpl) ProcessExtendedBuffer(cmngr poolmgr.PoolMgr) error {
 					Str("last-bind-name", rst.lastBindName).
 					Msg("Describe portal")
 
-				fin, err := rst.PrepareRelayStepOnHintRoute(cmngr, rst.bindRoute)
+				fin, err := rst.prepareRelayStepOnBindRoute(cmngr)
 				if err != nil {
 					return err
 				}
@@ -1270,7 +1752,31 @@ func (rst *RelayStateImpl) ProcessExtendedBuffer(cmngr poolmgr.PoolMgr) error {
 				return err
 			}
 		case *pgproto3.Close:
-			//
+			spqrlog.Zero.Debug().
+				Uint("client", rst.Client().ID()).
+				Str("name", q.Name).
+				Msg("Close prepared statement or portal")
+
+			/* server-side statements are shared between clients and evicted by connection cache */
+			if q.ObjectType == 'S' && rst.Client().PreparedStatementQueryByName(q.Name) != "" {
+				rst.Client().DeallocatePreparedStatement(q.Name)
+				if err := rst.Client().Send(&pgproto3.CloseComplete{}); err != nil {
+					return err
+				}
+				break
+			}
+
+			/* portals and unknown statements live on server connection only, if any */
+			if rst.Client().Server() == nil {
+				if err := rst.Client().Send(&pgproto3.CloseComplete{}); err != nil {
+					return err
+				}
+				break
+			}
+			/* close complete is replied along with the results of preceding execute */
+			if _, _, err := rst.RelayStep(q, false, false); err != nil {
+				return err
+			}
 		default:
 			panic(fmt.Sprintf("unexpected query type %v", msg))
 		}
@@ -1301,6 +1807,9 @@ func (rst *RelayStateImpl) ProcessExtendedBuffer(cmngr poolmgr.PoolMgr) error {
 func (rst *RelayStateImpl) Parse(query string) (parser.ParseState, string, error) {
 	state, comm, err := rst.qp.Parse(query)
 	rst.plainQ = query
+	if err == nil && isWriteStmt(rst.qp.Stmt()) {
+		rst.txWrote = true
+	}
 	return state, comm, err
 }
 
@@ -1315,6 +1824,9 @@ func (rst *RelayStateImpl) PrepareRelayStep(cmngr poolmgr.PoolMgr) error {
 		Msg("preparing relay step for client")
 	// txactive == 0 || activeSh == nil
 	if !cmngr.ValidateReRoute(rst) {
+		if rst.snapshotTx {
+			rst.prepareSnapshotStmt()
+		}
 		return nil
 	}
 
@@ -1342,6 +1854,16 @@ var noopCloseRouteFunc = func() error {
 	return nil
 }
 
+// prepareRelayStepOnBindRoute routes client to the shard of bound statement.
+// Multishard statements have no single bind route, they are executed
+// on the multishard connection, which was used to bind them.
+func (rst *RelayStateImpl) prepareRelayStepOnBindRoute(cmngr poolmgr.PoolMgr) (func() error, error) {
+	if rst.bindRoute == nil && rst.Client().Server() != nil && len(rst.Client().Server().Datashards()) > 1 {
+		return noopCloseRouteFunc, nil
+	}
+	return rst.PrepareRelayStepOnHintRoute(cmngr, rst.bindRoute)
+}
+
 // TODO : unit tests
 func (rst *RelayStateImpl) PrepareRelayStepOnHintRoute(cmngr poolmgr.PoolMgr, route *routingstate.DataShardRoute) (func() error, error) {
 	spqrlog.Zero.Debug().
@@ -1433,6 +1955,9 @@ func (rst *RelayStateImpl) ProcessMessageBuf(waitForResp, replyCl, completeRelay
 	if _, ok, err := rst.RelayFlush(waitForResp, replyCl); err != nil {
 		return false, err
 	} else {
+		if ok {
+			rst.recordSchemaChange()
+		}
 		if completeRelay {
 			if err := rst.CompleteRelay(replyCl); err != nil {
 				return false, err
@@ -1442,6 +1967,22 @@ func (rst *RelayStateImpl) ProcessMessageBuf(waitForResp, replyCl, completeRelay
 	}
 }
 
+// recordSchemaChange adds DDL statement, which was applied on all shards,
+// to schema history. Failure to record is reported to client as warning,
+// because the statement is already applied.
+func (rst *RelayStateImpl) recordSchemaChange() {
+	if _, ok := rst.routingState.(routingstate.DDLState); !ok || !qrouter.IsSchemaChange(rst.qp.Stmt()) {
+		return
+	}
+	if err := rst.Qr.RecordSchemaChange(context.TODO(), rst.plainQ); err != nil {
+		spqrlog.Zero.Error().
+			Uint("client", rst.Client().ID()).
+			Err(err).
+			Msg("failed to record schema change")
+		_ = rst.Cl.ReplyWarningf("schema change is not recorded in schema history: %s", err)
+	}
+}
+
 // TODO : unit tests
 func (rst *RelayStateImpl) Sync(waitForResp, replyCl bool, cmngr poolmgr.PoolMgr) error {
 	spqrlog.Zero.Debug().
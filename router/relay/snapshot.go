@@ -0,0 +1,213 @@
+package relay
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/pg-sharding/lyx/lyx"
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/models/spqrerror"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+	"github.com/pg-sharding/spqr/router/parser"
+	"github.com/pg-sharding/spqr/router/routingstate"
+	"github.com/pg-sharding/spqr/router/server"
+)
+
+// commitBarriers keeps barrier of every shard. Barrier is held shared by commits of
+// transactions spanning several shards and exclusively while consistent snapshot
+// is started on the shard, so that snapshot never sees commit applied on part of shards only.
+// Snapshots and commits on disjoint sets of shards do not wait for each other.
+var (
+	commitBarriersMu sync.Mutex
+	commitBarriers   = map[string]*sync.RWMutex{}
+)
+
+// shardBarriers returns commit barriers of shards, ordered by shard name,
+// so that barriers are always taken in the same order
+func shardBarriers(shards []shard.Shard) []*sync.RWMutex {
+	names := make([]string, 0, len(shards))
+	for _, sh := range shards {
+		names = append(names, sh.SHKey().Name)
+	}
+	sort.Strings(names)
+	names = slices.Compact(names)
+
+	commitBarriersMu.Lock()
+	defer commitBarriersMu.Unlock()
+
+	barriers := make([]*sync.RWMutex, 0, len(names))
+	for _, name := range names {
+		b, ok := commitBarriers[name]
+		if !ok {
+			b = &sync.RWMutex{}
+			commitBarriers[name] = b
+		}
+		barriers = append(barriers, b)
+	}
+	return barriers
+}
+
+// isRepeatableRead reports if BEGIN statement starts repeatable read transaction
+func isRepeatableRead(st parser.ParseStateTXBegin) bool {
+	return st.IsolationLevel == lyx.IsolationRepeatableRead
+}
+
+// BeginConsistentSnapshot makes the transaction, started by query,
+// acquire consistent snapshot of all shards on its first statement
+func (rst *RelayStateImpl) BeginConsistentSnapshot(query string) {
+	rst.snapshotBegin = query
+}
+
+// isCommit reports if buffered message commits transaction: either COMMIT
+// of implicit transaction made by router or COMMIT statement of client
+func (rst *RelayStateImpl) isCommit(msg BufferedMessage) bool {
+	q, ok := msg.msg.(*pgproto3.Query)
+	if !ok {
+		return false
+	}
+	if msg.tp == BufferedMessageInternal {
+		return q.String == "COMMIT" || rst.twoPhase != nil && rst.twoPhase.isCommit(q)
+	}
+	if q.String != rst.plainQ {
+		return false
+	}
+	st, ok := rst.qp.Stmt().(*lyx.TransactionStmt)
+	return ok && st.Kind == lyx.TRANS_STMT_COMMIT
+}
+
+// lockMultishardCommit holds commit barriers of shards while commit is sent to several shards.
+// Returned function releases the barriers.
+func (rst *RelayStateImpl) lockMultishardCommit(msg BufferedMessage) func() {
+	if !rst.isCommit(msg) {
+		return func() {}
+	}
+	serv, ok := rst.Client().Server().(*server.MultiShardServer)
+	if !ok {
+		return func() {}
+	}
+
+	barriers := shardBarriers(serv.Datashards())
+	for _, b := range barriers {
+		b.RLock()
+	}
+	return func() {
+		for i := len(barriers) - 1; i >= 0; i-- {
+			barriers[i].RUnlock()
+		}
+	}
+}
+
+// rerouteSnapshot routes transaction to primaries of all shards
+// and starts consistent snapshot on them
+func (rst *RelayStateImpl) rerouteSnapshot() error {
+	savedTsa := rst.Cl.GetTsa()
+	rst.Cl.SetTsa(config.TargetSessionAttrsRW)
+	defer rst.Cl.SetTsa(savedTsa)
+
+	if err := rst.procRoutes(rst.Qr.DataShardsRoutes()); err != nil {
+		return err
+	}
+	rst.routingState = routingstate.MultiMatchState{}
+
+	if err := rst.acquireSnapshot(); err != nil {
+		return err
+	}
+
+	/* transaction is already started on shards */
+	buf := make([]BufferedMessage, 0, len(rst.msgBuf))
+	for _, msg := range rst.msgBuf {
+		if q, ok := msg.msg.(*pgproto3.Query); ok && msg.tp == BufferedMessageInternal && q.String == rst.snapshotBegin {
+			continue
+		}
+		buf = append(buf, msg)
+	}
+	rst.msgBuf = buf
+
+	rst.snapshotBegin = ""
+	rst.snapshotTx = true
+
+	rst.prepareSnapshotStmt()
+	return nil
+}
+
+// prepareSnapshotStmt prepares multishard server for statement of transaction
+// with consistent snapshot. Every statement of such transaction is executed on all shards,
+// so output of the shards is merged as for multishard statement.
+func (rst *RelayStateImpl) prepareSnapshotStmt() {
+	rst.prepareCopyOut()
+}
+
+// acquireSnapshot starts read-only repeatable read transaction on every shard
+// of client, while commits of multishard transactions on these shards are blocked
+func (rst *RelayStateImpl) acquireSnapshot() error {
+	shards := rst.Client().Server().Datashards()
+	query := fmt.Sprintf("%s; SET TRANSACTION READ ONLY; SELECT txid_current_snapshot()",
+		strings.TrimRight(strings.TrimSpace(rst.snapshotBegin), ";"))
+
+	errs := make([]error, len(shards))
+
+	barriers := shardBarriers(shards)
+	for _, b := range barriers {
+		b.Lock()
+	}
+	var wg sync.WaitGroup
+	for i, sh := range shards {
+		wg.Add(1)
+		go func(i int, sh shard.Shard) {
+			defer wg.Done()
+			errs[i] = execShardQuery(sh, query, txstatus.TXACT)
+		}(i, sh)
+	}
+	wg.Wait()
+	for i := len(barriers) - 1; i >= 0; i-- {
+		barriers[i].Unlock()
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		/* do not leave transaction opened on part of shards */
+		for _, sh := range shards {
+			_ = execShardQuery(sh, "ROLLBACK", txstatus.TXIDLE)
+		}
+		return spqrerror.Newf(spqrerror.SPQR_ROUTER_ERROR, "failed to acquire consistent snapshot: %v", err)
+	}
+
+	spqrlog.Zero.Debug().
+		Uint("client", rst.Client().ID()).
+		Uints("shards", shard.ShardIDs(shards)).
+		Msg("acquired consistent snapshot on shards")
+	return nil
+}
+
+// execShardQuery runs query on shard connection, expecting transaction status after it
+func execShardQuery(sh shard.Shard, query string, expect txstatus.TXStatus) error {
+	if err := sh.Send(&pgproto3.Query{
+		String: query,
+	}); err != nil {
+		return err
+	}
+
+	var qErr error
+	for {
+		msg, err := sh.Receive()
+		if err != nil {
+			return err
+		}
+
+		switch v := msg.(type) {
+		case *pgproto3.ErrorResponse:
+			qErr = fmt.Errorf("shard %s: %s", sh.Name(), v.Message)
+		case *pgproto3.ReadyForQuery:
+			if qErr == nil && txstatus.TXStatus(v.TxStatus) != expect {
+				qErr = fmt.Errorf("shard %s: unexpected transaction status %s", sh.Name(), txstatus.TXStatus(v.TxStatus).String())
+			}
+			return qErr
+		}
+	}
+}
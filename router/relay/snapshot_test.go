@@ -0,0 +1,291 @@
+package relay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/pg-sharding/spqr/pkg/config"
+	mocksh "github.com/pg-sharding/spqr/pkg/mock/shard"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+	mockcl "github.com/pg-sharding/spqr/router/mock/client"
+	mockcmgr "github.com/pg-sharding/spqr/router/mock/poolmgr"
+	mockqr "github.com/pg-sharding/spqr/router/mock/qrouter"
+	mocksrv "github.com/pg-sharding/spqr/router/mock/server"
+	"github.com/pg-sharding/spqr/router/parser"
+	"github.com/pg-sharding/spqr/router/route"
+	"github.com/pg-sharding/spqr/router/routingstate"
+	"github.com/stretchr/testify/assert"
+)
+
+const snapshotQuery = "BEGIN ISOLATION LEVEL REPEATABLE READ; SET TRANSACTION READ ONLY; SELECT txid_current_snapshot()"
+
+func newSnapshotShard(ctrl *gomock.Controller, id uint, name string) *mocksh.MockShard {
+	sh := mocksh.NewMockShard(ctrl)
+	sh.EXPECT().ID().Return(id).AnyTimes()
+	sh.EXPECT().Name().Return(name).AnyTimes()
+	sh.EXPECT().SHKey().Return(kr.ShardKey{Name: name}).AnyTimes()
+	return sh
+}
+
+// expectSnapshot makes shard start snapshot transaction
+func expectSnapshot(sh *mocksh.MockShard) *gomock.Call {
+	call := sh.EXPECT().Send(&pgproto3.Query{String: snapshotQuery}).Return(nil)
+	gomock.InOrder(
+		call,
+		sh.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("BEGIN")}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("SET")}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.RowDescription{}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.DataRow{Values: [][]byte{[]byte("10:10:")}}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("SELECT 1")}, nil),
+		sh.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: byte(txstatus.TXACT)}, nil),
+	)
+	return call
+}
+
+func newSnapshotState(ctrl *gomock.Controller, cl *mockcl.MockRouterClient, shards ...shard.Shard) *RelayStateImpl {
+	srv := mocksrv.NewMockServer(ctrl)
+	srv.EXPECT().Datashards().Return(shards).AnyTimes()
+	cl.EXPECT().Server().Return(srv).AnyTimes()
+	cl.EXPECT().ID().Return(uint(1)).AnyTimes()
+
+	rst := NewRelayState(mockqr.NewMockQueryRouter(ctrl), cl, mockcmgr.NewMockPoolMgr(ctrl), &config.Router{})
+	rst.snapshotBegin = "BEGIN ISOLATION LEVEL REPEATABLE READ;"
+	return rst
+}
+
+func TestIsRepeatableRead(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tt := range []struct {
+		query string
+		exp   bool
+	}{
+		{query: "BEGIN ISOLATION LEVEL REPEATABLE READ", exp: true},
+		{query: "begin transaction read only, isolation level repeatable read;", exp: true},
+		{query: "BEGIN ISOLATION LEVEL SERIALIZABLE", exp: false},
+		{query: "BEGIN ISOLATION LEVEL READ COMMITTED", exp: false},
+		{query: "BEGIN READ ONLY", exp: false},
+	} {
+		qp := parser.QParser{}
+		st, _, err := qp.Parse(tt.query)
+		assert.NoError(err, tt.query)
+
+		begin, ok := st.(parser.ParseStateTXBegin)
+		assert.True(ok, tt.query)
+		assert.Equal(tt.exp, isRepeatableRead(begin), tt.query)
+	}
+}
+
+func TestIsCommit(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tt := range []struct {
+		name   string
+		parsed string
+		msg    BufferedMessage
+		exp    bool
+	}{
+		{
+			name: "commit of implicit transaction",
+			msg:  InternalBufferedMessage(&pgproto3.Query{String: "COMMIT"}),
+			exp:  true,
+		},
+		{
+			name: "rollback of implicit transaction",
+			msg:  InternalBufferedMessage(&pgproto3.Query{String: "ROLLBACK"}),
+			exp:  false,
+		},
+		{
+			name:   "commit of client",
+			parsed: "commit;",
+			msg:    RegularBufferedMessage(&pgproto3.Query{String: "commit;"}),
+			exp:    true,
+		},
+		{
+			name:   "end of client",
+			parsed: "END TRANSACTION",
+			msg:    RegularBufferedMessage(&pgproto3.Query{String: "END TRANSACTION"}),
+			exp:    true,
+		},
+		{
+			name:   "rollback of client",
+			parsed: "ROLLBACK",
+			msg:    RegularBufferedMessage(&pgproto3.Query{String: "ROLLBACK"}),
+			exp:    false,
+		},
+		{
+			name:   "query mentioning commit",
+			parsed: "SELECT 'COMMIT'",
+			msg:    RegularBufferedMessage(&pgproto3.Query{String: "SELECT 'COMMIT'"}),
+			exp:    false,
+		},
+		{
+			name:   "message is not parsed statement",
+			parsed: "COMMIT",
+			msg:    RegularBufferedMessage(&pgproto3.Query{String: "SELECT 1"}),
+			exp:    false,
+		},
+		{
+			name:   "extended protocol message",
+			parsed: "COMMIT",
+			msg:    RegularBufferedMessage(&pgproto3.Parse{Query: "COMMIT"}),
+			exp:    false,
+		},
+	} {
+		rst := &RelayStateImpl{}
+		if tt.parsed != "" {
+			_, _, err := rst.Parse(tt.parsed)
+			assert.NoError(err, tt.name)
+		}
+		assert.Equal(tt.exp, rst.isCommit(tt.msg), tt.name)
+	}
+}
+
+func TestAcquireSnapshot(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	cl := mockcl.NewMockRouterClient(ctrl)
+	sh1 := newSnapshotShard(ctrl, 1, "snap_sh1")
+	sh2 := newSnapshotShard(ctrl, 2, "snap_sh2")
+
+	rst := newSnapshotState(ctrl, cl, sh1, sh2)
+
+	expectSnapshot(sh1)
+	expectSnapshot(sh2)
+
+	assert.NoError(rst.acquireSnapshot())
+}
+
+func TestAcquireSnapshotFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	cl := mockcl.NewMockRouterClient(ctrl)
+	sh1 := newSnapshotShard(ctrl, 1, "snap_sh1")
+	sh2 := newSnapshotShard(ctrl, 2, "snap_sh2")
+
+	rst := newSnapshotState(ctrl, cl, sh1, sh2)
+
+	first := expectSnapshot(sh1)
+	gomock.InOrder(
+		sh2.EXPECT().Send(&pgproto3.Query{String: snapshotQuery}).Return(nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.ErrorResponse{Message: "could not serialize access"}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: byte(txstatus.TXIDLE)}, nil),
+	)
+
+	/* transaction is not left opened on any shard */
+	for _, sh := range []*mocksh.MockShard{sh1, sh2} {
+		gomock.InOrder(
+			sh.EXPECT().Send(&pgproto3.Query{String: "ROLLBACK"}).Return(nil).After(first),
+			sh.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("ROLLBACK")}, nil),
+			sh.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: byte(txstatus.TXIDLE)}, nil),
+		)
+	}
+
+	err := rst.acquireSnapshot()
+	assert.ErrorContains(err, "failed to acquire consistent snapshot")
+	assert.ErrorContains(err, "shard snap_sh2: could not serialize access")
+}
+
+func TestAcquireSnapshotWaitsForCommit(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	sh1 := newSnapshotShard(ctrl, 1, "barrier_sh1")
+	sh2 := newSnapshotShard(ctrl, 2, "barrier_sh2")
+	sh3 := newSnapshotShard(ctrl, 3, "barrier_sh3")
+
+	/* multishard commit on first and third shards is in progress */
+	commit := shardBarriers([]shard.Shard{sh3, sh1})
+	for _, b := range commit {
+		b.RLock()
+	}
+
+	/* snapshot of other shards is not blocked */
+	other := newSnapshotState(ctrl, mockcl.NewMockRouterClient(ctrl), sh2)
+	expectSnapshot(sh2)
+	assert.NoError(other.acquireSnapshot())
+
+	rst := newSnapshotState(ctrl, mockcl.NewMockRouterClient(ctrl), sh1, sh2)
+	expectSnapshot(sh1)
+	expectSnapshot(sh2)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- rst.acquireSnapshot()
+	}()
+
+	select {
+	case <-done:
+		assert.Fail("snapshot is taken while commit is in progress")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	for _, b := range commit {
+		b.RUnlock()
+	}
+	assert.NoError(<-done)
+}
+
+func TestRerouteSnapshot(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	cl := mockcl.NewMockRouterClient(ctrl)
+	qr := mockqr.NewMockQueryRouter(ctrl)
+	cmngr := mockcmgr.NewMockPoolMgr(ctrl)
+	srv := mocksrv.NewMockServer(ctrl)
+	sh1 := newSnapshotShard(ctrl, 1, "sh1")
+	sh2 := newSnapshotShard(ctrl, 2, "sh2")
+
+	rt := route.NewRoute(&config.BackendRule{}, &config.FrontendRule{}, map[string]*config.Shard{
+		"sh1": {},
+		"sh2": {},
+	}, nil, "")
+
+	cl.EXPECT().ID().Return(uint(1)).AnyTimes()
+	cl.EXPECT().Usr().Return("user1").AnyTimes()
+	cl.EXPECT().DB().Return("db1").AnyTimes()
+	cl.EXPECT().Route().Return(rt).AnyTimes()
+	cl.EXPECT().Server().Return(srv).AnyTimes()
+	srv.EXPECT().Datashards().Return([]shard.Shard{sh1, sh2}).AnyTimes()
+
+	routes := []*routingstate.DataShardRoute{
+		{Shkey: kr.ShardKey{Name: "sh1"}},
+		{Shkey: kr.ShardKey{Name: "sh2"}},
+	}
+	qr.EXPECT().DataShardsRoutes().Return(routes)
+
+	rst := NewRelayState(qr, cl, cmngr, &config.Router{})
+
+	begin := &pgproto3.Query{String: "BEGIN ISOLATION LEVEL REPEATABLE READ;"}
+	query := &pgproto3.Query{String: "SELECT * FROM t"}
+	rst.BeginConsistentSnapshot(begin.String)
+	rst.AddSilentQuery(begin)
+	rst.AddQuery(query)
+
+	gomock.InOrder(
+		cl.EXPECT().GetTsa().Return(config.TargetSessionAttrsPS),
+		cl.EXPECT().SetTsa(config.TargetSessionAttrsRW),
+		cmngr.EXPECT().UnRouteCB(cl, nil).Return(nil),
+		cl.EXPECT().AssignServerConn(gomock.Any()).Return(nil),
+		cmngr.EXPECT().RouteCB(cl, []kr.ShardKey{{Name: "sh1"}, {Name: "sh2"}}).Return(nil),
+		expectSnapshot(sh1),
+		/* session attributes of client are restored */
+		cl.EXPECT().SetTsa(config.TargetSessionAttrsPS),
+	)
+	expectSnapshot(sh2)
+
+	assert.NoError(rst.rerouteSnapshot())
+
+	/* snapshot transaction is already started, BEGIN is not sent again */
+	assert.Equal([]BufferedMessage{RegularBufferedMessage(query)}, rst.msgBuf)
+	assert.Equal(routingstate.MultiMatchState{}, rst.routingState)
+	assert.True(rst.snapshotTx)
+	assert.Equal("", rst.snapshotBegin)
+}
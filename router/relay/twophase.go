@@ -0,0 +1,95 @@
+package relay
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/pg-sharding/spqr/pkg/spqrlog"
+)
+
+// twoPhaseTx is implicit transaction of DDL statement, which is committed
+// on all shards with two-phase commit: statement is prepared on every shard
+// first, and is committed only if it was prepared on all of them.
+type twoPhaseTx struct {
+	gid      string
+	prepared bool
+}
+
+func newTwoPhaseTx() *twoPhaseTx {
+	return &twoPhaseTx{
+		gid: "spqr_ddl_" + uuid.NewString(),
+	}
+}
+
+func (tx *twoPhaseTx) prepareQuery() string {
+	return fmt.Sprintf("PREPARE TRANSACTION '%s'", tx.gid)
+}
+
+func (tx *twoPhaseTx) commitQuery() string {
+	return fmt.Sprintf("COMMIT PREPARED '%s'", tx.gid)
+}
+
+func (tx *twoPhaseTx) rollbackQuery() string {
+	return fmt.Sprintf("ROLLBACK PREPARED '%s'", tx.gid)
+}
+
+// isCommit reports if query commits prepared transaction
+func (tx *twoPhaseTx) isCommit(msg pgproto3.FrontendMessage) bool {
+	q, ok := msg.(*pgproto3.Query)
+	return ok && q.String == tx.commitQuery()
+}
+
+// step returns statement of two-phase commit to send instead of q, depending
+// on outcome of previous statements. If statement failed on some shard,
+// transaction is rolled back instead of PREPARE, and transaction, prepared
+// on part of shards, is rolled back instead of COMMIT PREPARED.
+// skip is set, if nothing is to be sent.
+func (tx *twoPhaseTx) step(q *pgproto3.Query, ok bool) (msg *pgproto3.Query, skip bool) {
+	switch q.String {
+	case tx.prepareQuery():
+		if !ok {
+			return &pgproto3.Query{String: "ROLLBACK"}, false
+		}
+		tx.prepared = true
+		return q, false
+	case tx.commitQuery():
+		if !tx.prepared {
+			return nil, true
+		}
+		if !ok {
+			return &pgproto3.Query{String: tx.rollbackQuery()}, false
+		}
+		return q, false
+	default:
+		return q, false
+	}
+}
+
+// wrapImplicitDDLTx wraps buffered autocommit DDL statement into transaction,
+// like wrapImplicitTx, but commits it with two-phase commit, so that
+// statement is not committed on part of shards, if some shard fails to commit.
+func (rst *RelayStateImpl) wrapImplicitDDLTx() {
+	if rst.TxActive() || len(rst.msgBuf) == 0 {
+		return
+	}
+	tx := newTwoPhaseTx()
+	rst.twoPhase = tx
+
+	buf := []BufferedMessage{InternalBufferedMessage(&pgproto3.Query{String: "BEGIN"})}
+	buf = append(buf, rst.msgBuf...)
+	rst.msgBuf = append(buf,
+		InternalBufferedMessage(&pgproto3.Query{String: tx.prepareQuery()}),
+		InternalBufferedMessage(&pgproto3.Query{String: tx.commitQuery()}))
+}
+
+// reportTwoPhaseCommitFailure warns client, that statement, prepared on all shards,
+// was not committed on some of them. Prepared transaction is kept by shard
+// and should be committed manually.
+func (rst *RelayStateImpl) reportTwoPhaseCommitFailure() {
+	spqrlog.Zero.Error().
+		Uint("client", rst.Client().ID()).
+		Str("gid", rst.twoPhase.gid).
+		Msg("failed to commit prepared transaction on some shards")
+	_ = rst.Cl.ReplyWarningf("statement is not committed on some shards, run COMMIT PREPARED '%s' on them", rst.twoPhase.gid)
+}
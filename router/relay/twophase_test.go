@@ -0,0 +1,65 @@
+package relay
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapImplicitDDLTx(t *testing.T) {
+	assert := assert.New(t)
+
+	rst := &RelayStateImpl{}
+	rst.msgBuf = []BufferedMessage{RegularBufferedMessage(&pgproto3.Query{String: "CREATE TABLE xx (i int)"})}
+	rst.wrapImplicitDDLTx()
+
+	assert.NotNil(rst.twoPhase)
+	var queries []string
+	for _, msg := range rst.msgBuf {
+		queries = append(queries, msg.msg.(*pgproto3.Query).String)
+	}
+	assert.Equal([]string{
+		"BEGIN",
+		"CREATE TABLE xx (i int)",
+		"PREPARE TRANSACTION '" + rst.twoPhase.gid + "'",
+		"COMMIT PREPARED '" + rst.twoPhase.gid + "'",
+	}, queries)
+	assert.True(rst.isCommit(rst.msgBuf[3]))
+	assert.False(rst.isCommit(rst.msgBuf[2]))
+}
+
+func TestTwoPhaseTxStep(t *testing.T) {
+	assert := assert.New(t)
+
+	prepare := func(tx *twoPhaseTx) *pgproto3.Query {
+		return &pgproto3.Query{String: tx.prepareQuery()}
+	}
+	commit := func(tx *twoPhaseTx) *pgproto3.Query {
+		return &pgproto3.Query{String: tx.commitQuery()}
+	}
+
+	/* statement succeeded on all shards */
+	tx := newTwoPhaseTx()
+	msg, skip := tx.step(prepare(tx), true)
+	assert.False(skip)
+	assert.Equal(tx.prepareQuery(), msg.String)
+	msg, skip = tx.step(commit(tx), true)
+	assert.False(skip)
+	assert.Equal(tx.commitQuery(), msg.String)
+
+	/* statement failed on some shard, nothing is prepared */
+	tx = newTwoPhaseTx()
+	msg, skip = tx.step(prepare(tx), false)
+	assert.False(skip)
+	assert.Equal("ROLLBACK", msg.String)
+	_, skip = tx.step(commit(tx), false)
+	assert.True(skip)
+
+	/* PREPARE failed on some shard, prepared transaction is rolled back */
+	tx = newTwoPhaseTx()
+	_, _ = tx.step(prepare(tx), true)
+	msg, skip = tx.step(commit(tx), false)
+	assert.False(skip)
+	assert.Equal(tx.rollbackQuery(), msg.String)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/pg-sharding/spqr/pkg/pool"
 	"github.com/pg-sharding/spqr/pkg/shard"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/pkg/tsa"
 )
 
 type Key struct {
@@ -50,15 +51,16 @@ type Route struct {
 	params       shard.ParameterSet
 }
 
-func NewRoute(beRule *config.BackendRule, frRule *config.FrontendRule, mapping map[string]*config.Shard) *Route {
+func NewRoute(beRule *config.BackendRule, frRule *config.FrontendRule, mapping map[string]*config.Shard, health tsa.HealthChecker, localZone string) *Route {
 	route := &Route{
 		beRule:   beRule,
 		frRule:   frRule,
-		servPool: pool.NewDBPool(mapping),
+		servPool: pool.NewDBPool(mapping, health, localZone),
 		clPool:   client.NewClientPool(),
 		params:   shard.ParameterSet{},
 	}
 	_ = route.servPool.InitRule(beRule)
+	route.servPool.InitReserve(frRule)
 	return route
 }
 
@@ -107,13 +109,35 @@ func (r *Route) ServPool() pool.DBPool {
 }
 
 func (r *Route) BeRule() *config.BackendRule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	return r.beRule
 }
 
+// SetBeRule replaces backend rule of the route, e.g. after config reload.
+// Existing server connections are kept.
+func (r *Route) SetBeRule(beRule *config.BackendRule) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.beRule = beRule
+	_ = r.servPool.InitRule(beRule)
+}
+
 func (r *Route) FrRule() *config.FrontendRule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	return r.frRule
 }
 
+// SetFrRule replaces frontend rule of the route, e.g. after config reload.
+// Reserved capacity of pools is resized to the new rule.
+func (r *Route) SetFrRule(frRule *config.FrontendRule) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.frRule = frRule
+	r.servPool.InitReserve(frRule)
+}
+
 func (r *Route) NofityClients(cb func(cl client.ClientInfo) error) error {
 	return r.clPool.ClientPoolForeach(cb)
 }
@@ -69,6 +69,26 @@ type RandomMatchState struct {
 	RoutingState
 }
 
+// ReferenceRelationState is a write to reference relation,
+// which should be applied on every shard in one transaction
+type ReferenceRelationState struct {
+	RoutingState
+}
+
+// DDLState is schema change, which should be applied on every shard
+// in one transaction, if statement allows it
+type DDLState struct {
+	RoutingState
+}
+
+// CopyFromState is COPY FROM STDIN to distributed relation,
+// which rows are routed to their shards one by one
+type CopyFromState struct {
+	RoutingState
+
+	RelationName string
+}
+
 type WorldRouteState struct {
 	RoutingState
 }
@@ -45,11 +45,15 @@ func (F *RulesMgrImpl) Reload(frmp map[route.Key]*config.FrontendRule, bemp map[
 				Str("user", dfr.Usr).
 				Msg("generating new dynamic rule")
 			return &config.FrontendRule{
-				Usr:                   key.Usr(),
-				DB:                    key.DB(),
-				AuthRule:              dfr.AuthRule,
-				PoolMode:              dfr.PoolMode,
-				PoolPreparedStatement: dfr.PoolPreparedStatement,
+				Usr:                       key.Usr(),
+				DB:                        key.DB(),
+				AuthRule:                  dfr.AuthRule,
+				PoolMode:                  dfr.PoolMode,
+				PoolPreparedStatement:     dfr.PoolPreparedStatement,
+				ReservePoolSize:           dfr.ReservePoolSize,
+				ReservePoolTimeoutSec:     dfr.ReservePoolTimeoutSec,
+				QueryTimeoutSec:           dfr.QueryTimeoutSec,
+				IdleTransactionTimeoutSec: dfr.IdleTransactionTimeoutSec,
 			}
 		},
 	}
@@ -61,11 +65,18 @@ func (F *RulesMgrImpl) Reload(frmp map[route.Key]*config.FrontendRule, bemp map[
 				return nil
 			}
 			return &config.BackendRule{
-				Usr:             key.Usr(),
-				DB:              key.DB(),
-				AuthRules:       dbe.AuthRules,
-				DefaultAuthRule: dbe.DefaultAuthRule,
-				ConnectionLimit: dbe.ConnectionLimit,
+				Usr:                         key.Usr(),
+				DB:                          key.DB(),
+				AuthRules:                   dbe.AuthRules,
+				DefaultAuthRule:             dbe.DefaultAuthRule,
+				ConnectionLimit:             dbe.ConnectionLimit,
+				QueryWaitTimeoutSec:         dbe.QueryWaitTimeoutSec,
+				HostSelectionPolicy:         dbe.HostSelectionPolicy,
+				ServerLifetimeSec:           dbe.ServerLifetimeSec,
+				ServerIdleTimeoutSec:        dbe.ServerIdleTimeoutSec,
+				MinPoolSize:                 dbe.MinPoolSize,
+				ServerCheckQuery:            dbe.ServerCheckQuery,
+				PreparedStatementsCacheSize: dbe.PreparedStatementsCacheSize,
 			}
 		},
 	}
@@ -119,11 +130,15 @@ func NewMgr(frmp map[route.Key]*config.FrontendRule,
 				return nil
 			}
 			return &config.FrontendRule{
-				Usr:                   key.Usr(),
-				DB:                    key.DB(),
-				AuthRule:              dfr.AuthRule,
-				PoolMode:              dfr.PoolMode,
-				PoolPreparedStatement: dfr.PoolPreparedStatement,
+				Usr:                       key.Usr(),
+				DB:                        key.DB(),
+				AuthRule:                  dfr.AuthRule,
+				PoolMode:                  dfr.PoolMode,
+				PoolPreparedStatement:     dfr.PoolPreparedStatement,
+				ReservePoolSize:           dfr.ReservePoolSize,
+				ReservePoolTimeoutSec:     dfr.ReservePoolTimeoutSec,
+				QueryTimeoutSec:           dfr.QueryTimeoutSec,
+				IdleTransactionTimeoutSec: dfr.IdleTransactionTimeoutSec,
 			}
 		},
 	}
@@ -135,11 +150,18 @@ func NewMgr(frmp map[route.Key]*config.FrontendRule,
 				return nil
 			}
 			return &config.BackendRule{
-				Usr:             key.Usr(),
-				DB:              key.DB(),
-				AuthRules:       dbe.AuthRules,
-				DefaultAuthRule: dbe.DefaultAuthRule,
-				ConnectionLimit: dbe.ConnectionLimit,
+				Usr:                         key.Usr(),
+				DB:                          key.DB(),
+				AuthRules:                   dbe.AuthRules,
+				DefaultAuthRule:             dbe.DefaultAuthRule,
+				ConnectionLimit:             dbe.ConnectionLimit,
+				QueryWaitTimeoutSec:         dbe.QueryWaitTimeoutSec,
+				HostSelectionPolicy:         dbe.HostSelectionPolicy,
+				ServerLifetimeSec:           dbe.ServerLifetimeSec,
+				ServerIdleTimeoutSec:        dbe.ServerIdleTimeoutSec,
+				MinPoolSize:                 dbe.MinPoolSize,
+				ServerCheckQuery:            dbe.ServerCheckQuery,
+				PreparedStatementsCacheSize: dbe.PreparedStatementsCacheSize,
 			}
 		},
 	}
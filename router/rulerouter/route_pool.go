@@ -8,6 +8,7 @@ import (
 	"github.com/pg-sharding/spqr/pkg/pool"
 	"github.com/pg-sharding/spqr/pkg/shard"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/pkg/tsa"
 	"github.com/pg-sharding/spqr/router/route"
 )
 
@@ -23,19 +24,24 @@ type RoutePool interface {
 	Obsolete(key route.Key) *route.Route
 	Shutdown() error
 	NotifyRoutes(func(route *route.Route) error) error
+	ForEachRoute(cb func(key route.Key, route *route.Route) error) error
 }
 
 type RoutePoolImpl struct {
 	mu           sync.Mutex
 	pool         map[route.Key]*route.Route
 	shardMapping map[string]*config.Shard
+	health       tsa.HealthChecker
+	localZone    string
 }
 
 var _ RoutePool = &RoutePoolImpl{}
 
-func NewRouterPoolImpl(shardMapping map[string]*config.Shard) *RoutePoolImpl {
+func NewRouterPoolImpl(shardMapping map[string]*config.Shard, health tsa.HealthChecker, localZone string) *RoutePoolImpl {
 	return &RoutePoolImpl{
 		shardMapping: shardMapping,
+		health:       health,
+		localZone:    localZone,
 		pool:         map[route.Key]*route.Route{},
 	}
 }
@@ -64,6 +70,19 @@ func (r *RoutePoolImpl) NotifyRoutes(cb func(route *route.Route) error) error {
 	return nil
 }
 
+func (r *RoutePoolImpl) ForEachRoute(cb func(key route.Key, route *route.Route) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, rt := range r.pool {
+		if err := cb(key, rt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // TODO : unit tests
 func (r *RoutePoolImpl) Obsolete(key route.Key) *route.Route {
 	r.mu.Lock()
@@ -111,7 +130,7 @@ func (r *RoutePoolImpl) MatchRoute(key route.Key,
 		Str("user", key.Usr()).
 		Str("db", key.DB()).
 		Msg("allocate route")
-	nroute := route.NewRoute(beRule, frRule, r.shardMapping)
+	nroute := route.NewRoute(beRule, frRule, r.shardMapping, r.health, r.localZone)
 
 	r.pool[key] = nroute
 	return nroute, nil
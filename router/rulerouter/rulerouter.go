@@ -1,6 +1,7 @@
 package rulerouter
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net"
@@ -13,9 +14,11 @@ import (
 	"github.com/pg-sharding/spqr/pkg/client"
 	"github.com/pg-sharding/spqr/pkg/config"
 	"github.com/pg-sharding/spqr/pkg/connectiterator"
+	"github.com/pg-sharding/spqr/pkg/models/kr"
 	"github.com/pg-sharding/spqr/pkg/pool"
 	"github.com/pg-sharding/spqr/pkg/shard"
 	"github.com/pg-sharding/spqr/pkg/spqrlog"
+	"github.com/pg-sharding/spqr/pkg/tsa"
 	"github.com/pg-sharding/spqr/qdb"
 	rclient "github.com/pg-sharding/spqr/router/client"
 	"github.com/pg-sharding/spqr/router/port"
@@ -59,6 +62,7 @@ type RuleRouterImpl struct {
 	clmp map[uint32]rclient.RouterClient
 
 	notifier *notifier.Notifier
+	health   tsa.HealthChecker
 }
 
 func (r *RuleRouterImpl) AddWorldShard(key qdb.ShardKey) error {
@@ -147,6 +151,32 @@ func (r *RuleRouterImpl) Reload(configPath string) error {
 	frontendRules, backendRules, defaultFrontendRule, defaultBackendRule := ParseRules(rcfg)
 	r.rmgr.Reload(frontendRules, backendRules, defaultFrontendRule, defaultBackendRule)
 
+	/* existing pools pick up new lifetime, idle timeout, min pool size and reserve pool settings */
+	if err := r.routePool.ForEachRoute(func(key route.Key, rt *route.Route) error {
+		if frRule, err := r.rmgr.MatchKeyFrontend(key); err == nil {
+			rt.SetFrRule(frRule)
+		}
+		beRule, err := r.rmgr.MatchKeyBackend(key)
+		if err != nil {
+			spqrlog.Zero.Warn().
+				Str("user", key.Usr()).
+				Str("db", key.DB()).
+				Err(err).
+				Msg("no backend rule for route after reload")
+			return nil
+		}
+		rt.SetBeRule(beRule)
+		return nil
+	}); err != nil {
+		return err
+	}
+	go r.WarmupPools(rcfg)
+
+	/* health checker probes hosts of new shard mapping */
+	if hc, ok := r.health.(*tsa.BackgroundHealthChecker); ok {
+		hc.SetMapping(rcfg.ShardMapping)
+	}
+
 	if r.notifier != nil {
 		if err = r.notifier.Ready(); err != nil {
 			return err
@@ -156,15 +186,65 @@ func (r *RuleRouterImpl) Reload(configPath string) error {
 	return nil
 }
 
-func NewRouter(tlsconfig *tls.Config, rcfg *config.Router, notifier *notifier.Notifier) *RuleRouterImpl {
+// WarmupPools opens connections to shard hosts for backend rules with min pool size.
+// Routes of such rules are created before first client connects.
+func (r *RuleRouterImpl) WarmupPools(rcfg *config.Router) {
+	_, backendRules, _, _ := ParseRules(rcfg)
+
+	for key, beRule := range backendRules {
+		if beRule.MinPoolSize <= 0 {
+			continue
+		}
+		frRule, err := r.rmgr.MatchKeyFrontend(key)
+		if err != nil {
+			spqrlog.Zero.Warn().
+				Str("user", key.Usr()).
+				Str("db", key.DB()).
+				Err(err).
+				Msg("skip pool warmup, no frontend rule for backend rule")
+			continue
+		}
+		rt, err := r.routePool.MatchRoute(key, beRule, frRule)
+		if err != nil {
+			continue
+		}
+		spqrlog.Zero.Info().
+			Str("user", key.Usr()).
+			Str("db", key.DB()).
+			Int("min pool size", beRule.MinPoolSize).
+			Msg("warming up connection pools")
+		rt.ServPool().Warmup()
+	}
+}
+
+// NewHealthChecker creates checker of shard hosts, connecting with backend rule
+// selected by host health check config
+// TODO : unit tests
+func NewHealthChecker(rcfg *config.Router) (*tsa.BackgroundHealthChecker, error) {
+	frontendRules, backendRules, defaultFrontendRule, defaultBackendRule := ParseRules(rcfg)
+	rmgr := rule.NewMgr(frontendRules, backendRules, defaultFrontendRule, defaultBackendRule)
+
+	beRule, err := rmgr.MatchKeyBackend(*route.NewRouteKey(rcfg.HostHealthCheck.Usr, rcfg.HostHealthCheck.DB))
+	if err != nil {
+		return nil, err
+	}
+
+	return tsa.NewHealthChecker(&rcfg.HostHealthCheck, rcfg.ShardMapping, func(ctx context.Context, shardName string, shardCfg *config.Shard, host string) (shard.Shard, error) {
+		return pool.ConnectShard(ctx, kr.ShardKey{Name: shardName}, shardCfg, host, beRule)
+	}), nil
+}
+
+// NewRouter creates rule router, health may be nil
+func NewRouter(tlsconfig *tls.Config, rcfg *config.Router, notifier *notifier.Notifier, health tsa.HealthChecker) *RuleRouterImpl {
 	frontendRules, backendRules, defaultFrontendRule, defaultBackendRule := ParseRules(rcfg)
 	return &RuleRouterImpl{
-		routePool: NewRouterPoolImpl(rcfg.ShardMapping),
+		routePool: NewRouterPoolImpl(rcfg.ShardMapping, health, rcfg.AvailabilityZone),
 		rcfg:      rcfg,
 		rmgr:      rule.NewMgr(frontendRules, backendRules, defaultFrontendRule, defaultBackendRule),
 		tlsconfig: tlsconfig,
 		clmp:      map[uint32]rclient.RouterClient{},
 		notifier:  notifier,
+		health:    health,
 	}
 }
 
@@ -285,9 +365,13 @@ func (r *RuleRouterImpl) ListShards() []string {
 	return ret
 }
 
-// TODO : unit tests
+// ObsoleteRoute drops route and stops its pools
 func (r *RuleRouterImpl) ObsoleteRoute(key route.Key) error {
 	rt := r.routePool.Obsolete(key)
+	if rt == nil {
+		return nil
+	}
+	rt.ServPool().Stop()
 
 	if err := rt.NofityClients(func(cl client.ClientInfo) error {
 		return nil
@@ -0,0 +1,99 @@
+package rulerouter
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/pg-sharding/spqr/pkg/config"
+	"github.com/pg-sharding/spqr/pkg/pool"
+	"github.com/pg-sharding/spqr/router/route"
+	"github.com/stretchr/testify/assert"
+)
+
+func routeKeys(t *testing.T, rp RoutePool) []string {
+	var keys []string
+	assert.NoError(t, rp.ForEachRoute(func(key route.Key, rt *route.Route) error {
+		keys = append(keys, key.String())
+		return nil
+	}))
+	sort.Strings(keys)
+	return keys
+}
+
+func TestForEachRoute(t *testing.T) {
+	assert := assert.New(t)
+
+	rp := NewRouterPoolImpl(map[string]*config.Shard{}, nil, "")
+	assert.Empty(routeKeys(t, rp))
+
+	for _, key := range []route.Key{*route.NewRouteKey("u1", "db1"), *route.NewRouteKey("u2", "db2")} {
+		_, err := rp.MatchRoute(key, &config.BackendRule{}, &config.FrontendRule{})
+		assert.NoError(err)
+	}
+	assert.Equal([]string{
+		route.NewRouteKey("u1", "db1").String(),
+		route.NewRouteKey("u2", "db2").String(),
+	}, routeKeys(t, rp))
+
+	// iteration stops on error
+	visited := 0
+	err := rp.ForEachRoute(func(key route.Key, rt *route.Route) error {
+		visited++
+		return errors.New("stop")
+	})
+	assert.Error(err)
+	assert.Equal(1, visited)
+}
+
+func TestWarmupPools(t *testing.T) {
+	assert := assert.New(t)
+
+	rcfg := &config.Router{
+		ShardMapping: map[string]*config.Shard{
+			"sh1": {Hosts: []string{"localhost:1"}},
+		},
+		FrontendRules: []*config.FrontendRule{
+			{Usr: "warm", DB: "db1"},
+			{Usr: "cold", DB: "db1"},
+		},
+		BackendRules: []*config.BackendRule{
+			{Usr: "warm", DB: "db1", MinPoolSize: 1},
+			{Usr: "cold", DB: "db1"},
+			// no frontend rule
+			{Usr: "orphan", DB: "db1", MinPoolSize: 1},
+		},
+	}
+	r := NewRouter(nil, rcfg, nil, nil)
+
+	r.WarmupPools(rcfg)
+
+	// only route of rule with min pool size and frontend rule is created,
+	// host is unreachable, so no connections are opened
+	assert.Equal([]string{route.NewRouteKey("warm", "db1").String()}, routeKeys(t, r.routePool))
+
+	hosts := 0
+	assert.NoError(r.routePool.ForEachPool(func(p pool.Pool) error {
+		assert.Equal("localhost:1", p.Hostname())
+		hosts++
+		return nil
+	}))
+	assert.Equal(1, hosts)
+}
+
+func TestObsoleteRoute(t *testing.T) {
+	assert := assert.New(t)
+
+	rcfg := &config.Router{}
+	r := NewRouter(nil, rcfg, nil, nil)
+
+	key := *route.NewRouteKey("u1", "db1")
+	_, err := r.routePool.MatchRoute(key, &config.BackendRule{}, &config.FrontendRule{})
+	assert.NoError(err)
+
+	assert.NoError(r.ObsoleteRoute(key))
+	assert.Empty(routeKeys(t, r.routePool))
+
+	// unknown route
+	assert.NoError(r.ObsoleteRoute(key))
+}
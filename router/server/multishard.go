@@ -1,8 +1,12 @@
 package server
 
 import (
+	"bytes"
 	"crypto/tls"
+	"errors"
 	"fmt"
+	"strconv"
+	"sync"
 
 	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/pg-sharding/spqr/pkg/config"
@@ -31,6 +35,8 @@ const (
 	ServerErrorState
 	CommandCompleteState
 	CopyState
+	CopyInState
+	CopyDoneState
 )
 
 type MultiShardServer struct {
@@ -44,14 +50,50 @@ type MultiShardServer struct {
 
 	status txstatus.TXStatus
 
-	copyBuf []*pgproto3.CopyOutResponse
+	copyOutResp *pgproto3.CopyOutResponse
+	// shard, which data is currently streamed, has sent some data
+	copyOutStarted bool
+	// every shard sends header line of COPY TO, only first one is passed to client
+	copyOutSkipHeader bool
+
+	copyInResp *pgproto3.CopyInResponse
+
+	// command tags of shards, which completed current statement
+	ccTags [][]byte
+
+	// replies of shards, which are read concurrently in running state,
+	// so that slow shard does not delay data rows of others
+	replies chan shardReply
+	// number of shards, which have not completed current statement yet
+	running int
+}
+
+type shardReply struct {
+	i   int
+	msg pgproto3.BackendMessage
+	err error
 }
 
+// HasPrepareStatement reports if statement is prepared on all shards
 func (m *MultiShardServer) HasPrepareStatement(hash uint64) (bool, *shard.PreparedStatementDescriptor) {
-	panic("implement me")
+	var rd *shard.PreparedStatementDescriptor
+	for _, sh := range m.activeShards {
+		ok, shRd := sh.HasPrepareStatement(hash)
+		if !ok {
+			return false, nil
+		}
+		if rd == nil {
+			rd = shRd
+		}
+	}
+	return rd != nil, rd
 }
 
-func (m *MultiShardServer) PrepareStatement(hash uint64, rd *shard.PreparedStatementDescriptor) {}
+func (m *MultiShardServer) PrepareStatement(hash uint64, rd *shard.PreparedStatementDescriptor) {
+	for _, sh := range m.activeShards {
+		sh.PrepareStatement(hash, rd)
+	}
+}
 
 func (m *MultiShardServer) Reset() error {
 	return nil
@@ -71,6 +113,13 @@ func (m *MultiShardServer) AddDataShard(clid uint, shkey kr.ShardKey, tsa string
 }
 
 func (m *MultiShardServer) UnRouteShard(sh kr.ShardKey, rule *config.FrontendRule) error {
+	if m.replies != nil {
+		// statement is still running, do not leave readers of connection,
+		// which is returned to pool
+		_ = m.Cancel()
+		m.stopReaders()
+	}
+
 	// map?
 	for _, activeShard := range m.activeShards {
 		if activeShard.Name() == sh.Name {
@@ -118,8 +167,72 @@ var (
 	MultiShardSyncBroken = fmt.Errorf("multishard state is out of sync")
 )
 
+var (
+	// signature, flags and empty header extension of binary COPY format
+	copyBinaryHeader  = []byte("PGCOPY\n\377\r\n\000\000\000\000\000\000\000\000\000")
+	copyBinaryTrailer = []byte{0xff, 0xff}
+)
+
+// SkipCopyOutHeaders makes server pass header line of COPY TO data
+// only from first shard
+func (m *MultiShardServer) SkipCopyOutHeaders() {
+	m.copyOutSkipHeader = true
+}
+
+// startReaders starts reading replies of every shard, which is still
+// executing current statement, up to its completion
+func (m *MultiShardServer) startReaders() {
+	m.replies = make(chan shardReply, len(m.activeShards))
+	m.running = 0
+	for i := range m.activeShards {
+		if m.states[i] != DatarowState {
+			continue
+		}
+		m.running++
+		go func(i int) {
+			for {
+				msg, err := m.activeShards[i].Receive()
+				m.replies <- shardReply{i: i, msg: msg, err: err}
+				if err != nil {
+					return
+				}
+				switch msg.(type) {
+				case *pgproto3.CommandComplete, *pgproto3.ErrorResponse, *pgproto3.ReadyForQuery:
+					return
+				}
+			}
+		}(i)
+	}
+}
+
+// stopReaders waits for running readers to finish, discarding their replies
+func (m *MultiShardServer) stopReaders() {
+	for m.running > 0 {
+		r := <-m.replies
+		if r.err != nil {
+			m.states[r.i] = ErrorState
+			m.running--
+			continue
+		}
+		switch r.msg.(type) {
+		case *pgproto3.CommandComplete:
+			m.states[r.i] = ShardCCState
+			m.running--
+		case *pgproto3.ErrorResponse, *pgproto3.ReadyForQuery:
+			m.states[r.i] = ErrorState
+			m.running--
+		}
+	}
+	m.replies = nil
+}
+
 func (m *MultiShardServer) Receive() (pgproto3.BackendMessage, error) {
+	// rollback drains replies of every shard up to ReadyForQuery, so that
+	// next statement, e.g. ROLLBACK of implicit transaction, is not mixed
+	// with replies to the failed one
 	rollback := func() {
+		var wg sync.WaitGroup
+		defer wg.Wait()
 		for i := range m.activeShards {
 			spqrlog.Zero.Debug().
 				Uint("shard", m.activeShards[i].ID()).
@@ -130,7 +243,9 @@ func (m *MultiShardServer) Receive() (pgproto3.BackendMessage, error) {
 			// error state or something else
 			m.states[i] = ShardRFQState
 
+			wg.Add(1)
 			go func(i int) {
+				defer wg.Done()
 				for {
 					msg, err := m.activeShards[i].Receive()
 					if err != nil {
@@ -156,13 +271,19 @@ func (m *MultiShardServer) Receive() (pgproto3.BackendMessage, error) {
 	case ServerErrorState:
 		m.multistate = InitialState
 		return &pgproto3.ReadyForQuery{
-			TxStatus: byte(txstatus.TXIDLE), // XXX : fix this
+			TxStatus: byte(m.shardsTxStatus()),
 		}, nil
 	case InitialState:
-		m.copyBuf = nil
+		m.copyOutResp = nil
+		m.copyOutStarted = false
 		var saveRd *pgproto3.RowDescription = nil
 		var saveCC *pgproto3.CommandComplete = nil
 		var saveRFQ *pgproto3.ReadyForQuery = nil
+		rfqStatus := txstatus.TXIDLE
+		// extended protocol replies, which every shard sends once
+		var saveX pgproto3.BackendMessage = nil
+		m.copyInResp = nil
+		m.ccTags = nil
 		/* Step one: ensure all shard backend are stared */
 		for i := range m.activeShards {
 			for {
@@ -190,10 +311,18 @@ func (m *MultiShardServer) Receive() (pgproto3.BackendMessage, error) {
 					}
 					m.states[i] = ShardCopyState
 					m.multistate = CopyState
-					m.copyBuf = append(m.copyBuf, retMsg)
+					m.copyOutResp = retMsg // all should be same
+				case *pgproto3.CopyInResponse:
+					if m.multistate != InitialState && m.multistate != CopyInState {
+						return nil, MultiShardSyncBroken
+					}
+					m.states[i] = ShardCopyState
+					m.multistate = CopyInState
+					m.copyInResp = retMsg // all should be same
 				case *pgproto3.CommandComplete:
 					m.states[i] = ShardCCState
 					saveCC = retMsg //
+					m.ccTags = append(m.ccTags, retMsg.CommandTag)
 				case *pgproto3.RowDescription:
 					m.states[i] = DatarowState
 					saveRd = retMsg // all should be same
@@ -203,6 +332,10 @@ func (m *MultiShardServer) Receive() (pgproto3.BackendMessage, error) {
 					}
 					m.states[i] = ShardRFQState
 					saveRFQ = retMsg
+					rfqStatus = combineTxStatus(rfqStatus, txstatus.TXStatus(retMsg.TxStatus))
+				case *pgproto3.ParseComplete, *pgproto3.BindComplete, *pgproto3.CloseComplete,
+					*pgproto3.NoData, *pgproto3.ParameterDescription:
+					saveX = retMsg
 				case *pgproto3.ParameterStatus:
 					m.states[i] = DatarowState
 					// ignore
@@ -236,34 +369,34 @@ func (m *MultiShardServer) Receive() (pgproto3.BackendMessage, error) {
 
 		if saveCC != nil {
 			m.multistate = InitialState
-			return saveCC, nil
+			return &pgproto3.CommandComplete{
+				CommandTag: combineCommandTags(m.ccTags),
+			}, nil
 		}
 		if saveRFQ != nil {
 			m.multistate = InitialState
-			return saveRFQ, nil
+			m.status = rfqStatus
+			return &pgproto3.ReadyForQuery{
+				TxStatus: byte(rfqStatus),
+			}, nil
+		}
+		if saveRd == nil && saveX != nil {
+			m.multistate = InitialState
+			return saveX, nil
+		}
+		if m.multistate == CopyInState {
+			return m.copyInResp, nil
 		}
 		if m.multistate == CopyState {
-			n := len(m.copyBuf)
-			var currMsg *pgproto3.CopyOutResponse
-			m.copyBuf, currMsg = m.copyBuf[n-2:], m.copyBuf[n-1]
-
-			spqrlog.Zero.Debug().
-				Int("new-buff-len", len(m.copyBuf)).
-				Msg("miltishard server: flush copy buff")
-			return currMsg, nil
+			return m.copyOutResp, nil
 		}
 
 		m.multistate = RunningState
+		m.startReaders()
 		return saveRd, nil
 	case CopyState:
-		if len(m.copyBuf) > 0 {
-			spqrlog.Zero.Debug().Msg("miltishard server: flush copy buff")
-			n := len(m.copyBuf)
-			var currMsg *pgproto3.CopyOutResponse
-			m.copyBuf, currMsg = m.copyBuf[n-2:], m.copyBuf[n-1]
-			return currMsg, nil
-		}
-		/* Step two: fetch all copy out resp */
+		/* Step two: stream copy data of shards one after another */
+		binaryFormat := m.copyOutResp != nil && m.copyOutResp.OverallFormat == 1
 		for i := range m.activeShards {
 			// some shards may be in cc state, some in copy state
 
@@ -274,81 +407,185 @@ func (m *MultiShardServer) Receive() (pgproto3.BackendMessage, error) {
 				return nil, MultiShardSyncBroken
 			}
 
-			msg, err := m.activeShards[i].Receive()
-			if err != nil {
-				spqrlog.Zero.Info().
+			for m.states[i] == ShardCopyState {
+				msg, err := m.activeShards[i].Receive()
+				if err != nil {
+					spqrlog.Zero.Info().
+						Uint("shard", m.activeShards[i].ID()).
+						Err(err).
+						Msg("multishard server: encountered error while reading from shard")
+					m.states[i] = ErrorState
+					rollback()
+					return nil, err
+				}
+				spqrlog.Zero.Debug().
 					Uint("shard", m.activeShards[i].ID()).
-					Err(err).
-					Msg("multishard server: encountered error while reading from shard")
-				m.states[i] = ErrorState
-				rollback()
-				return nil, err
-			}
-			spqrlog.Zero.Info().
-				Uint("shard", m.activeShards[i].ID()).
-				Type("message-type", msg).
-				Msg("multishard server: recived message from shard")
+					Type("message-type", msg).
+					Msg("multishard server: recived message from shard")
 
-			switch msg.(type) {
-			case *pgproto3.CommandComplete:
-				//
-				m.states[i] = ShardCCState
-			case *pgproto3.ReadyForQuery:
-				m.states[i] = ErrorState
-				rollback()
-				// sync is broken
-				return nil, MultiShardSyncBroken
-			default:
-				return msg, nil
+				switch retMsg := msg.(type) {
+				case *pgproto3.CopyData:
+					first := !m.copyOutStarted
+					m.copyOutStarted = true
+					data := retMsg.Data
+					if first && i != 0 {
+						if binaryFormat {
+							/* binary header is sent along with first row, strip it */
+							if !bytes.HasPrefix(data, copyBinaryHeader) {
+								return nil, MultiShardSyncBroken
+							}
+							data = data[len(copyBinaryHeader):]
+						} else if m.copyOutSkipHeader {
+							continue
+						}
+					}
+					if binaryFormat && i != len(m.activeShards)-1 {
+						/* only last shard ends binary data stream. Trailer is sent
+						* separately, or along with header, if there is no rows */
+						if bytes.Equal(data, copyBinaryTrailer) {
+							continue
+						}
+						if first && len(data) == len(copyBinaryHeader)+len(copyBinaryTrailer) &&
+							bytes.HasPrefix(data, copyBinaryHeader) && bytes.HasSuffix(data, copyBinaryTrailer) {
+							data = copyBinaryHeader
+						}
+					}
+					if len(data) == 0 {
+						continue
+					}
+					return &pgproto3.CopyData{Data: data}, nil
+				case *pgproto3.CopyDone:
+					// single copy done is sent after data of all shards
+				case *pgproto3.CommandComplete:
+					m.states[i] = ShardCCState
+					m.ccTags = append(m.ccTags, retMsg.CommandTag)
+					m.copyOutStarted = false
+				case *pgproto3.NoticeResponse:
+					// thats ok
+				case *pgproto3.ErrorResponse:
+					spqrlog.Zero.Error().
+						Uint("client", spqrlog.GetPointer(m)).
+						Str("message", retMsg.Message).
+						Msg("multishard server received error on copy")
+					m.states[i] = ErrorState
+					m.multistate = ServerErrorState
+					rollback()
+					return msg, nil
+				default:
+					m.states[i] = ErrorState
+					rollback()
+					// sync is broken
+					return nil, MultiShardSyncBroken
+				}
 			}
 		}
-		// all shard are in RFQ state
+		m.multistate = CopyDoneState
+		m.copyOutSkipHeader = false
+		return &pgproto3.CopyDone{}, nil
+	case CopyDoneState:
 		m.multistate = CommandCompleteState
 		return &pgproto3.CommandComplete{
-			CommandTag: []byte{}, // XXX : fix this
+			CommandTag: combineCommandTags(m.ccTags),
 		}, nil
-	case RunningState:
-		/* Step two: fetch all datarow ms	gs */
+	case CopyInState:
+		/* Step two: copy data is sent, collect completion of copy on all shards */
 		for i := range m.activeShards {
-			// some shards may be in cc state
-
-			if m.states[i] == ShardCCState {
-				continue
+			if m.states[i] != ShardCopyState {
+				return nil, MultiShardSyncBroken
 			}
+			for m.states[i] == ShardCopyState {
+				msg, err := m.activeShards[i].Receive()
+				if err != nil {
+					spqrlog.Zero.Info().
+						Uint("shard", m.activeShards[i].ID()).
+						Err(err).
+						Msg("multishard server: encountered error while reading from shard")
+					m.states[i] = ErrorState
+					rollback()
+					return nil, err
+				}
 
-			msg, err := m.activeShards[i].Receive()
-			if err != nil {
+				switch retMsg := msg.(type) {
+				case *pgproto3.CommandComplete:
+					m.states[i] = ShardCCState
+					m.ccTags = append(m.ccTags, retMsg.CommandTag)
+				case *pgproto3.NoticeResponse:
+					// thats ok
+				case *pgproto3.ErrorResponse:
+					spqrlog.Zero.Error().
+						Uint("client", spqrlog.GetPointer(m)).
+						Str("message", retMsg.Message).
+						Msg("multishard server received error on copy")
+					m.states[i] = ErrorState
+					m.multistate = ServerErrorState
+					rollback()
+					return msg, nil
+				default:
+					m.states[i] = ErrorState
+					rollback()
+					// sync is broken
+					return nil, MultiShardSyncBroken
+				}
+			}
+		}
+		m.multistate = CommandCompleteState
+		return &pgproto3.CommandComplete{
+			CommandTag: combineCommandTags(m.ccTags),
+		}, nil
+	case RunningState:
+		/* Step two: fetch all datarow msgs, in order they arrive from shards */
+		for m.running > 0 {
+			r := <-m.replies
+			if r.err != nil {
 				spqrlog.Zero.Info().
-					Uint("shard", m.activeShards[i].ID()).
-					Err(err).
+					Uint("shard", m.activeShards[r.i].ID()).
+					Err(r.err).
 					Msg("multishard server: encountered error while reading from shard")
-				m.states[i] = ErrorState
+				m.states[r.i] = ErrorState
+				m.running--
+				m.stopReaders()
 				rollback()
-				return nil, err
+				return nil, r.err
 			}
 
-			switch msg.(type) {
+			switch retMsg := r.msg.(type) {
 			case *pgproto3.CommandComplete:
-				//
-				m.states[i] = ShardCCState
+				m.states[r.i] = ShardCCState
+				m.ccTags = append(m.ccTags, retMsg.CommandTag)
+				m.running--
 			case *pgproto3.ReadyForQuery:
-				m.states[i] = ErrorState
+				m.states[r.i] = ErrorState
+				m.running--
+				m.stopReaders()
 				rollback()
 				// sync is broken
 				return nil, MultiShardSyncBroken
+			case *pgproto3.ErrorResponse:
+				spqrlog.Zero.Error().
+					Uint("client", spqrlog.GetPointer(m)).
+					Str("message", retMsg.Message).
+					Msg("multishard server received error")
+				m.states[r.i] = ErrorState
+				m.multistate = ServerErrorState
+				m.running--
+				m.stopReaders()
+				rollback()
+				return retMsg, nil
 			default:
-				return msg, nil
+				return retMsg, nil
 			}
 		}
-		// all shard are in RFQ state
+		m.replies = nil
+		// all shard are in CC state
 		m.multistate = CommandCompleteState
 		return &pgproto3.CommandComplete{
-			CommandTag: []byte{}, // XXX : fix this
+			CommandTag: combineCommandTags(m.ccTags),
 		}, nil
 	case CommandCompleteState:
 		spqrlog.Zero.Info().Msg("multishard server: enter rfq await mode")
 
 		/* Step tree: fetch all datarow msgs */
+		status := txstatus.TXIDLE
 		for i := range m.activeShards {
 			// all shards shall be in cc state
 			spqrlog.Zero.Info().Uint("shard", m.activeShards[i].ID()).Msg("multishard server: await server")
@@ -364,9 +601,10 @@ func (m *MultiShardServer) Receive() (pgproto3.BackendMessage, error) {
 						return err
 					}
 
-					switch msg.(type) {
+					switch v := msg.(type) {
 					case *pgproto3.ReadyForQuery:
 						m.states[i] = ShardRFQState
+						status = combineTxStatus(status, txstatus.TXStatus(v.TxStatus))
 						return nil
 					default:
 						// sync is broken
@@ -385,15 +623,63 @@ func (m *MultiShardServer) Receive() (pgproto3.BackendMessage, error) {
 		}
 
 		m.multistate = InitialState
-		m.status = txstatus.TXIDLE
+		m.status = status
 		return &pgproto3.ReadyForQuery{
-			TxStatus: byte(txstatus.TXIDLE), // XXX : fix this
+			TxStatus: byte(status),
 		}, nil
 	}
 
 	return nil, nil
 }
 
+// combineTxStatus returns transaction status of multishard server,
+// which shards are in cur and next statuses. Failure on any shard fails
+// the whole transaction.
+func combineTxStatus(cur, next txstatus.TXStatus) txstatus.TXStatus {
+	switch {
+	case cur == txstatus.TXERR || next == txstatus.TXERR:
+		return txstatus.TXERR
+	case cur == txstatus.TXACT || next == txstatus.TXACT:
+		return txstatus.TXACT
+	default:
+		return txstatus.TXIDLE
+	}
+}
+
+// shardsTxStatus returns combined transaction status of all shards
+func (m *MultiShardServer) shardsTxStatus() txstatus.TXStatus {
+	status := txstatus.TXIDLE
+	for _, sh := range m.activeShards {
+		status = combineTxStatus(status, sh.TxStatus())
+	}
+	return status
+}
+
+// combineCommandTags sums up row counts of shards command tags,
+// e.g. INSERT 0 2 and INSERT 0 3 are combined into INSERT 0 5.
+// Tags, which are different or have no row count, are not combined.
+func combineCommandTags(tags [][]byte) []byte {
+	if len(tags) == 0 {
+		return []byte{}
+	}
+
+	var prefix string
+	var total uint64
+	for i, tag := range tags {
+		pos := bytes.LastIndexByte(tag, ' ')
+		if pos == -1 {
+			return tags[len(tags)-1]
+		}
+		n, err := strconv.ParseUint(string(tag[pos+1:]), 10, 64)
+		if err != nil || (i != 0 && prefix != string(tag[:pos])) {
+			return tags[len(tags)-1]
+		}
+		prefix = string(tag[:pos])
+		total += n
+	}
+	return []byte(fmt.Sprintf("%s %d", prefix, total))
+}
+
 func (m *MultiShardServer) Cleanup(rule config.FrontendRule) error {
 	if rule.PoolRollback {
 		if err := m.Send(&pgproto3.Query{
@@ -419,12 +705,28 @@ func (m *MultiShardServer) Sync() int64 {
 	panic("implement me")
 }
 
+// Cancel sends cancel request to every shard concurrently. Shards, which
+// statement is canceled, reply with error, and multishard state machine
+// drains all of them up to ReadyForQuery.
 func (m *MultiShardServer) Cancel() error {
-	var err error
-	for _, sh := range m.activeShards {
-		err = sh.Cancel()
+	errs := make([]error, len(m.activeShards))
+
+	var wg sync.WaitGroup
+	for i, sh := range m.activeShards {
+		wg.Add(1)
+		go func(i int, sh shard.Shard) {
+			defer wg.Done()
+			if errs[i] = sh.Cancel(); errs[i] != nil {
+				spqrlog.Zero.Error().
+					Uint("shard", sh.ID()).
+					Err(errs[i]).
+					Msg("multishard server: failed to cancel statement on shard")
+			}
+		}(i, sh)
 	}
-	return err
+	wg.Wait()
+
+	return errors.Join(errs...)
 }
 
 func (m *MultiShardServer) SetTxStatus(tx txstatus.TXStatus) {
@@ -432,7 +734,7 @@ func (m *MultiShardServer) SetTxStatus(tx txstatus.TXStatus) {
 }
 
 func (m *MultiShardServer) TxStatus() txstatus.TXStatus {
-	return txstatus.TXIDLE
+	return m.shardsTxStatus()
 }
 
 func (m *MultiShardServer) Datashards() []shard.Shard {
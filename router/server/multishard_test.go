@@ -0,0 +1,409 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgproto3"
+	mocksh "github.com/pg-sharding/spqr/pkg/mock/shard"
+	"github.com/pg-sharding/spqr/pkg/shard"
+	"github.com/pg-sharding/spqr/pkg/txstatus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombineCommandTags(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tt := range []struct {
+		tags [][]byte
+		exp  string
+	}{
+		{
+			tags: [][]byte{[]byte("INSERT 0 2"), []byte("INSERT 0 3")},
+			exp:  "INSERT 0 5",
+		},
+		{
+			tags: [][]byte{[]byte("COPY 10"), []byte("COPY 0"), []byte("COPY 7")},
+			exp:  "COPY 17",
+		},
+		{
+			tags: [][]byte{[]byte("CREATE TABLE"), []byte("CREATE TABLE")},
+			exp:  "CREATE TABLE",
+		},
+		{
+			tags: [][]byte{[]byte("UPDATE 1"), []byte("DELETE 1")},
+			exp:  "DELETE 1",
+		},
+		{
+			tags: nil,
+			exp:  "",
+		},
+	} {
+		assert.Equal(tt.exp, string(combineCommandTags(tt.tags)))
+	}
+}
+
+func TestMultiShardCopyOut(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	header := []byte("PGCOPY\n\377\r\n\000\000\000\000\000\000\000\000\000")
+	row := func(val byte) []byte {
+		return []byte{0, 1, 0, 0, 0, 1, val}
+	}
+	trailer := []byte{0xff, 0xff}
+
+	sh1 := mocksh.NewMockShard(ctrl)
+	sh2 := mocksh.NewMockShard(ctrl)
+	sh1.EXPECT().ID().AnyTimes().Return(uint(1))
+	sh2.EXPECT().ID().AnyTimes().Return(uint(2))
+
+	gomock.InOrder(
+		sh1.EXPECT().Receive().Return(&pgproto3.CopyOutResponse{OverallFormat: 1}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CopyOutResponse{OverallFormat: 1}, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.CopyData{Data: append(append([]byte{}, header...), row('a')...)}, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.CopyData{Data: trailer}, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.CopyDone{}, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("COPY 1")}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CopyData{Data: append(append([]byte{}, header...), row('b')...)}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CopyData{Data: row('c')}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CopyData{Data: trailer}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CopyDone{}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("COPY 2")}, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: 'I'}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: 'I'}, nil),
+	)
+
+	m := &MultiShardServer{
+		activeShards: []shard.Shard{sh1, sh2},
+		states:       []ShardState{ShardRFQState, ShardRFQState},
+	}
+
+	for _, exp := range []pgproto3.BackendMessage{
+		&pgproto3.CopyOutResponse{OverallFormat: 1},
+		&pgproto3.CopyData{Data: append(append([]byte{}, header...), row('a')...)},
+		&pgproto3.CopyData{Data: row('b')},
+		&pgproto3.CopyData{Data: row('c')},
+		&pgproto3.CopyData{Data: trailer},
+		&pgproto3.CopyDone{},
+		&pgproto3.CommandComplete{CommandTag: []byte("COPY 3")},
+		&pgproto3.ReadyForQuery{TxStatus: 'I'},
+	} {
+		msg, err := m.Receive()
+		assert.NoError(err)
+		assert.Equal(exp, msg)
+	}
+}
+
+func TestMultiShardCopyOutHeader(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	sh1 := mocksh.NewMockShard(ctrl)
+	sh2 := mocksh.NewMockShard(ctrl)
+	sh1.EXPECT().ID().AnyTimes().Return(uint(1))
+	sh2.EXPECT().ID().AnyTimes().Return(uint(2))
+
+	gomock.InOrder(
+		sh1.EXPECT().Receive().Return(&pgproto3.CopyOutResponse{}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CopyOutResponse{}, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.CopyData{Data: []byte("i,j\n")}, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.CopyData{Data: []byte("1,a\n")}, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.CopyDone{}, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("COPY 1")}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CopyData{Data: []byte("i,j\n")}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CopyDone{}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("COPY 0")}, nil),
+	)
+
+	m := &MultiShardServer{
+		activeShards: []shard.Shard{sh1, sh2},
+		states:       []ShardState{ShardRFQState, ShardRFQState},
+	}
+	m.SkipCopyOutHeaders()
+
+	for _, exp := range []pgproto3.BackendMessage{
+		&pgproto3.CopyOutResponse{},
+		&pgproto3.CopyData{Data: []byte("i,j\n")},
+		&pgproto3.CopyData{Data: []byte("1,a\n")},
+		&pgproto3.CopyDone{},
+		&pgproto3.CommandComplete{CommandTag: []byte("COPY 1")},
+	} {
+		msg, err := m.Receive()
+		assert.NoError(err)
+		assert.Equal(exp, msg)
+	}
+}
+
+func TestMultiShardRunningNotSerialized(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	sh1 := mocksh.NewMockShard(ctrl)
+	sh2 := mocksh.NewMockShard(ctrl)
+	sh1.EXPECT().ID().AnyTimes().Return(uint(1))
+	sh2.EXPECT().ID().AnyTimes().Return(uint(2))
+
+	release := make(chan struct{})
+
+	sh1.EXPECT().Receive().Return(&pgproto3.RowDescription{}, nil)
+	sh2.EXPECT().Receive().Return(&pgproto3.RowDescription{}, nil)
+
+	gomock.InOrder(
+		sh1.EXPECT().Receive().DoAndReturn(func() (pgproto3.BackendMessage, error) {
+			<-release
+			return &pgproto3.DataRow{Values: [][]byte{[]byte("a")}}, nil
+		}),
+		sh1.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("SELECT 1")}, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: 'I'}, nil),
+	)
+	gomock.InOrder(
+		sh2.EXPECT().Receive().Return(&pgproto3.DataRow{Values: [][]byte{[]byte("b")}}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("SELECT 1")}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: 'I'}, nil),
+	)
+
+	m := &MultiShardServer{
+		activeShards: []shard.Shard{sh1, sh2},
+		states:       []ShardState{ShardRFQState, ShardRFQState},
+	}
+
+	msg, err := m.Receive()
+	assert.NoError(err)
+	assert.Equal(&pgproto3.RowDescription{}, msg)
+
+	/* first shard is stuck, but rows of second one are passed */
+	msg, err = m.Receive()
+	assert.NoError(err)
+	assert.Equal(&pgproto3.DataRow{Values: [][]byte{[]byte("b")}}, msg)
+
+	close(release)
+
+	for _, exp := range []pgproto3.BackendMessage{
+		&pgproto3.DataRow{Values: [][]byte{[]byte("a")}},
+		&pgproto3.CommandComplete{CommandTag: []byte("SELECT 2")},
+		&pgproto3.ReadyForQuery{TxStatus: 'I'},
+	} {
+		msg, err := m.Receive()
+		assert.NoError(err)
+		assert.Equal(exp, msg)
+	}
+}
+
+func TestMultiShardCancel(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	sh1 := mocksh.NewMockShard(ctrl)
+	sh2 := mocksh.NewMockShard(ctrl)
+	sh3 := mocksh.NewMockShard(ctrl)
+	sh1.EXPECT().ID().AnyTimes().Return(uint(1))
+	sh2.EXPECT().ID().AnyTimes().Return(uint(2))
+	sh3.EXPECT().ID().AnyTimes().Return(uint(3))
+
+	cancelErr := errors.New("connection refused")
+	sh1.EXPECT().Cancel().Times(1).Return(nil)
+	sh2.EXPECT().Cancel().Times(1).Return(cancelErr)
+	sh3.EXPECT().Cancel().Times(1).Return(nil)
+
+	m := &MultiShardServer{
+		activeShards: []shard.Shard{sh1, sh2, sh3},
+		states:       []ShardState{ShardRFQState, ShardRFQState, ShardRFQState},
+	}
+
+	err := m.Cancel()
+	assert.ErrorIs(err, cancelErr)
+}
+
+func TestMultiShardCancelDrain(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	sh1 := mocksh.NewMockShard(ctrl)
+	sh2 := mocksh.NewMockShard(ctrl)
+	sh1.EXPECT().ID().AnyTimes().Return(uint(1))
+	sh2.EXPECT().ID().AnyTimes().Return(uint(2))
+	sh1.EXPECT().Sync().AnyTimes().Return(int64(1))
+	sh2.EXPECT().Sync().AnyTimes().Return(int64(1))
+	sh1.EXPECT().TxStatus().AnyTimes().Return(txstatus.TXIDLE)
+	sh2.EXPECT().TxStatus().AnyTimes().Return(txstatus.TXIDLE)
+
+	canceled := make(chan struct{})
+	sh1.EXPECT().Cancel().Times(1).Return(nil)
+	sh2.EXPECT().Cancel().Times(1).DoAndReturn(func() error {
+		close(canceled)
+		return nil
+	})
+
+	canceledErr := &pgproto3.ErrorResponse{
+		Severity: "ERROR",
+		Code:     "57014",
+		Message:  "canceling statement due to user request",
+	}
+
+	gomock.InOrder(
+		sh1.EXPECT().Receive().Return(&pgproto3.RowDescription{}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.RowDescription{}, nil),
+	)
+	gomock.InOrder(
+		sh1.EXPECT().Receive().Return(&pgproto3.DataRow{Values: [][]byte{[]byte("a")}}, nil),
+		sh1.EXPECT().Receive().Return(canceledErr, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: 'I'}, nil),
+	)
+	gomock.InOrder(
+		sh2.EXPECT().Receive().DoAndReturn(func() (pgproto3.BackendMessage, error) {
+			<-canceled
+			return canceledErr, nil
+		}),
+		sh2.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: 'I'}, nil),
+	)
+
+	m := &MultiShardServer{
+		activeShards: []shard.Shard{sh1, sh2},
+		states:       []ShardState{ShardRFQState, ShardRFQState},
+	}
+
+	msg, err := m.Receive()
+	assert.NoError(err)
+	assert.Equal(&pgproto3.RowDescription{}, msg)
+
+	msg, err = m.Receive()
+	assert.NoError(err)
+	assert.Equal(&pgproto3.DataRow{Values: [][]byte{[]byte("a")}}, msg)
+
+	assert.NoError(m.Cancel())
+
+	for _, exp := range []pgproto3.BackendMessage{
+		canceledErr,
+		&pgproto3.ReadyForQuery{TxStatus: 'I'},
+	} {
+		msg, err := m.Receive()
+		assert.NoError(err)
+		assert.Equal(exp, msg)
+	}
+
+	/* every shard is drained up to ReadyForQuery */
+	assert.Equal([]ShardState{ShardRFQState, ShardRFQState}, m.states)
+	assert.Equal(InitialState, m.multistate)
+}
+
+func TestCombineTxStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tt := range []struct {
+		cur  txstatus.TXStatus
+		next txstatus.TXStatus
+		exp  txstatus.TXStatus
+	}{
+		{cur: txstatus.TXIDLE, next: txstatus.TXIDLE, exp: txstatus.TXIDLE},
+		{cur: txstatus.TXIDLE, next: txstatus.TXACT, exp: txstatus.TXACT},
+		{cur: txstatus.TXACT, next: txstatus.TXIDLE, exp: txstatus.TXACT},
+		{cur: txstatus.TXACT, next: txstatus.TXERR, exp: txstatus.TXERR},
+		{cur: txstatus.TXERR, next: txstatus.TXIDLE, exp: txstatus.TXERR},
+	} {
+		assert.Equal(tt.exp, combineTxStatus(tt.cur, tt.next))
+	}
+}
+
+func TestMultiShardReceiveTxStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tt := range []struct {
+		statuses []txstatus.TXStatus
+		exp      txstatus.TXStatus
+	}{
+		{
+			statuses: []txstatus.TXStatus{txstatus.TXIDLE, txstatus.TXIDLE},
+			exp:      txstatus.TXIDLE,
+		},
+		{
+			statuses: []txstatus.TXStatus{txstatus.TXIDLE, txstatus.TXACT},
+			exp:      txstatus.TXACT,
+		},
+		{
+			statuses: []txstatus.TXStatus{txstatus.TXACT, txstatus.TXACT, txstatus.TXACT},
+			exp:      txstatus.TXACT,
+		},
+		{
+			statuses: []txstatus.TXStatus{txstatus.TXERR, txstatus.TXACT},
+			exp:      txstatus.TXERR,
+		},
+		{
+			statuses: []txstatus.TXStatus{txstatus.TXACT, txstatus.TXIDLE, txstatus.TXERR},
+			exp:      txstatus.TXERR,
+		},
+	} {
+		ctrl := gomock.NewController(t)
+
+		m := &MultiShardServer{}
+		for i, st := range tt.statuses {
+			sh := mocksh.NewMockShard(ctrl)
+			sh.EXPECT().ID().AnyTimes().Return(uint(i))
+			sh.EXPECT().TxStatus().AnyTimes().Return(st)
+			gomock.InOrder(
+				sh.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("INSERT 0 1")}, nil),
+				sh.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: byte(st)}, nil),
+			)
+			m.activeShards = append(m.activeShards, sh)
+			m.states = append(m.states, ShardRFQState)
+		}
+
+		msg, err := m.Receive()
+		assert.NoError(err)
+		assert.Equal(&pgproto3.CommandComplete{CommandTag: []byte(fmt.Sprintf("INSERT 0 %d", len(tt.statuses)))}, msg)
+
+		msg, err = m.Receive()
+		assert.NoError(err)
+		assert.Equal(&pgproto3.ReadyForQuery{TxStatus: byte(tt.exp)}, msg, "statuses %v", tt.statuses)
+		assert.Equal(tt.exp, m.TxStatus())
+
+		ctrl.Finish()
+	}
+}
+
+func TestMultiShardReceiveErrorTxStatus(t *testing.T) {
+	assert := assert.New(t)
+	ctrl := gomock.NewController(t)
+
+	sh1 := mocksh.NewMockShard(ctrl)
+	sh2 := mocksh.NewMockShard(ctrl)
+	sh1.EXPECT().ID().AnyTimes().Return(uint(1))
+	sh2.EXPECT().ID().AnyTimes().Return(uint(2))
+	sh1.EXPECT().Sync().AnyTimes().Return(int64(1))
+	sh2.EXPECT().Sync().AnyTimes().Return(int64(1))
+	/* statement failed on first shard inside transaction */
+	sh1.EXPECT().TxStatus().AnyTimes().Return(txstatus.TXERR)
+	sh2.EXPECT().TxStatus().AnyTimes().Return(txstatus.TXACT)
+
+	failed := &pgproto3.ErrorResponse{
+		Severity: "ERROR",
+		Code:     "23505",
+		Message:  "duplicate key value violates unique constraint",
+	}
+
+	gomock.InOrder(
+		sh1.EXPECT().Receive().Return(failed, nil),
+		sh1.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: byte(txstatus.TXERR)}, nil),
+	)
+	gomock.InOrder(
+		sh2.EXPECT().Receive().Return(&pgproto3.CommandComplete{CommandTag: []byte("INSERT 0 1")}, nil),
+		sh2.EXPECT().Receive().Return(&pgproto3.ReadyForQuery{TxStatus: byte(txstatus.TXACT)}, nil),
+	)
+
+	m := &MultiShardServer{
+		activeShards: []shard.Shard{sh1, sh2},
+		states:       []ShardState{ShardRFQState, ShardRFQState},
+	}
+
+	msg, err := m.Receive()
+	assert.NoError(err)
+	assert.Equal(failed, msg)
+
+	/* transaction is failed, though second shard succeeded */
+	msg, err = m.Receive()
+	assert.NoError(err)
+	assert.Equal(&pgproto3.ReadyForQuery{TxStatus: byte(txstatus.TXERR)}, msg)
+	assert.Equal(InitialState, m.multistate)
+}
@@ -0,0 +1,48 @@
+package statistics
+
+import (
+	"sync"
+	"time"
+)
+
+// HostLatencyAlpha is the weight of the latest observation in host latency EWMA
+const HostLatencyAlpha = 0.2
+
+type hostLatencies struct {
+	lock sync.RWMutex
+	ewma map[string]float64
+}
+
+var hostStatistics = hostLatencies{
+	ewma: map[string]float64{},
+}
+
+// RecordHostLatency updates exponentially weighted moving average
+// of time, spent by the host on queries
+func RecordHostLatency(host string, d time.Duration) {
+	hostStatistics.lock.Lock()
+	defer hostStatistics.lock.Unlock()
+
+	v, ok := hostStatistics.ewma[host]
+	if !ok {
+		hostStatistics.ewma[host] = float64(d)
+		return
+	}
+	hostStatistics.ewma[host] = HostLatencyAlpha*float64(d) + (1-HostLatencyAlpha)*v
+}
+
+// GetHostLatency returns average latency of the host, ok is false if no queries were observed
+func GetHostLatency(host string) (time.Duration, bool) {
+	hostStatistics.lock.RLock()
+	defer hostStatistics.lock.RUnlock()
+
+	v, ok := hostStatistics.ewma[host]
+	return time.Duration(v), ok
+}
+
+func DropHostStatistics() {
+	hostStatistics.lock.Lock()
+	defer hostStatistics.lock.Unlock()
+
+	hostStatistics.ewma = map[string]float64{}
+}
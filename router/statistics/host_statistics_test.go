@@ -0,0 +1,25 @@
+package statistics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pg-sharding/spqr/router/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostLatency(t *testing.T) {
+	assert := assert.New(t)
+
+	statistics.DropHostStatistics()
+
+	_, ok := statistics.GetHostLatency("h1")
+	assert.False(ok)
+
+	statistics.RecordHostLatency("h1", 10*time.Millisecond)
+	statistics.RecordHostLatency("h1", 20*time.Millisecond)
+
+	d, ok := statistics.GetHostLatency("h1")
+	assert.True(ok)
+	assert.Equal(12*time.Millisecond, d)
+}
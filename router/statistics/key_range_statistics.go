@@ -0,0 +1,114 @@
+package statistics
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// KeyRangeStatBucket is the granularity of per-key-range counters
+	KeyRangeStatBucket = 10 * time.Second
+	// KeyRangeStatWindow is the longest interval per-key-range counters are kept for
+	KeyRangeStatWindow = time.Hour
+)
+
+type keyRangeBucket struct {
+	Start time.Time
+	Count uint64
+}
+
+type keyRangeStatistics struct {
+	Buckets map[string][]*keyRangeBucket
+	// LastPrune is the time counters of inactive key ranges were dropped last
+	LastPrune time.Time
+	lock      sync.Mutex
+}
+
+var krStatistics = keyRangeStatistics{
+	Buckets: make(map[string][]*keyRangeBucket),
+	lock:    sync.Mutex{},
+}
+
+// RecordKeyRangeQuery accounts query routed to the key range at time t.
+// Counters are used by balancer as a metrics source.
+func RecordKeyRangeQuery(krId string, t time.Time) {
+	krStatistics.lock.Lock()
+	defer krStatistics.lock.Unlock()
+
+	start := t.Truncate(KeyRangeStatBucket)
+	buckets := krStatistics.Buckets[krId]
+	if len(buckets) > 0 && !buckets[len(buckets)-1].Start.Before(start) {
+		buckets[len(buckets)-1].Count++
+		return
+	}
+
+	// drop buckets, which are out of the window
+	i := 0
+	for i < len(buckets) && buckets[i].Start.Before(t.Add(-KeyRangeStatWindow)) {
+		i++
+	}
+	krStatistics.Buckets[krId] = append(buckets[i:], &keyRangeBucket{Start: start, Count: 1})
+
+	if t.Sub(krStatistics.LastPrune) > KeyRangeStatWindow {
+		pruneKeyRangeStatistics(t)
+	}
+}
+
+// pruneKeyRangeStatistics drops counters of key ranges, which got no queries
+// during the window, e.g. of deleted ones. Must be called with lock held.
+func pruneKeyRangeStatistics(now time.Time) {
+	for krId, buckets := range krStatistics.Buckets {
+		if len(buckets) == 0 || buckets[len(buckets)-1].Start.Before(now.Add(-KeyRangeStatWindow)) {
+			delete(krStatistics.Buckets, krId)
+		}
+	}
+	krStatistics.LastPrune = now
+}
+
+// GetKeyRangeQueryCounts returns number of queries routed to each key range
+// during interval before now. Interval is rounded up to KeyRangeStatBucket
+// and limited by KeyRangeStatWindow.
+func GetKeyRangeQueryCounts(now time.Time, interval time.Duration) map[string]uint64 {
+	krStatistics.lock.Lock()
+	defer krStatistics.lock.Unlock()
+
+	from := now.Add(-min(interval, KeyRangeStatWindow)).Truncate(KeyRangeStatBucket)
+	res := make(map[string]uint64, len(krStatistics.Buckets))
+	for krId, buckets := range krStatistics.Buckets {
+		var count uint64
+		for _, b := range buckets {
+			if !b.Start.Before(from) && !b.Start.After(now) {
+				count += b.Count
+			}
+		}
+		if count > 0 {
+			res[krId] = count
+		}
+	}
+	return res
+}
+
+// DropKeyRangeStatistics forgets counters of all key ranges
+func DropKeyRangeStatistics() {
+	krStatistics.lock.Lock()
+	defer krStatistics.lock.Unlock()
+
+	krStatistics.Buckets = make(map[string][]*keyRangeBucket)
+	krStatistics.LastPrune = time.Time{}
+}
+
+// DropKeyRangeStatistic forgets counters of the key range
+func DropKeyRangeStatistic(krId string) {
+	krStatistics.lock.Lock()
+	defer krStatistics.lock.Unlock()
+
+	delete(krStatistics.Buckets, krId)
+}
+
+// KeyRangeStatisticsSize returns number of key ranges, which have counters
+func KeyRangeStatisticsSize() int {
+	krStatistics.lock.Lock()
+	defer krStatistics.lock.Unlock()
+
+	return len(krStatistics.Buckets)
+}
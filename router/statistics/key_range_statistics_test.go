@@ -0,0 +1,68 @@
+package statistics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pg-sharding/spqr/router/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyRangeQueryCounts(t *testing.T) {
+	assert := assert.New(t)
+
+	statistics.DropKeyRangeStatistics()
+	now := time.Now().Truncate(statistics.KeyRangeStatBucket)
+
+	statistics.RecordKeyRangeQuery("kr1", now.Add(-5*time.Minute))
+	statistics.RecordKeyRangeQuery("kr1", now.Add(-30*time.Second))
+	statistics.RecordKeyRangeQuery("kr1", now.Add(-30*time.Second))
+	statistics.RecordKeyRangeQuery("kr1", now)
+	statistics.RecordKeyRangeQuery("kr2", now)
+
+	assert.Equal(map[string]uint64{"kr1": 3, "kr2": 1}, statistics.GetKeyRangeQueryCounts(now, time.Minute))
+	assert.Equal(map[string]uint64{"kr1": 4, "kr2": 1}, statistics.GetKeyRangeQueryCounts(now, 10*time.Minute))
+	assert.Equal(map[string]uint64{"kr1": 1, "kr2": 1}, statistics.GetKeyRangeQueryCounts(now, 0))
+}
+
+func TestKeyRangeQueryCountsWindow(t *testing.T) {
+	assert := assert.New(t)
+
+	statistics.DropKeyRangeStatistics()
+	now := time.Now().Truncate(statistics.KeyRangeStatBucket)
+
+	statistics.RecordKeyRangeQuery("kr1", now.Add(-2*statistics.KeyRangeStatWindow))
+	statistics.RecordKeyRangeQuery("kr1", now)
+
+	assert.Equal(map[string]uint64{"kr1": 1}, statistics.GetKeyRangeQueryCounts(now, 3*statistics.KeyRangeStatWindow))
+}
+
+func TestKeyRangeStatisticsPrune(t *testing.T) {
+	assert := assert.New(t)
+
+	statistics.DropKeyRangeStatistics()
+	now := time.Now().Truncate(statistics.KeyRangeStatBucket)
+
+	statistics.RecordKeyRangeQuery("kr1", now)
+	statistics.RecordKeyRangeQuery("kr2", now)
+	assert.Equal(2, statistics.KeyRangeStatisticsSize())
+
+	// kr1 is dropped
+	statistics.RecordKeyRangeQuery("kr2", now.Add(statistics.KeyRangeStatWindow/2))
+	statistics.RecordKeyRangeQuery("kr2", now.Add(2*statistics.KeyRangeStatWindow))
+	assert.Equal(1, statistics.KeyRangeStatisticsSize())
+	assert.Equal(map[string]uint64{"kr2": 1}, statistics.GetKeyRangeQueryCounts(now.Add(2*statistics.KeyRangeStatWindow), statistics.KeyRangeStatWindow))
+}
+
+func TestDropKeyRangeStatistic(t *testing.T) {
+	assert := assert.New(t)
+
+	statistics.DropKeyRangeStatistics()
+	now := time.Now().Truncate(statistics.KeyRangeStatBucket)
+
+	statistics.RecordKeyRangeQuery("kr1", now)
+	statistics.RecordKeyRangeQuery("kr2", now)
+	statistics.DropKeyRangeStatistic("kr1")
+
+	assert.Equal(map[string]uint64{"kr2": 1}, statistics.GetKeyRangeQueryCounts(now, time.Minute))
+}
@@ -476,6 +476,16 @@ Feature: Coordinator test
     """
     DROP SHARD sh1;
     """
+    Then command return code should be "1"
+    And SQL error on host "coordinator" should match regexp
+    """
+    shard sh1 still owns key range krid1, drain it first
+    """
+    When I run SQL on host "coordinator"
+    """
+    DROP KEY RANGE krid1;
+    DROP SHARD sh1;
+    """
     Then command return code should be "0"
     When I run SQL on host "coordinator"
     """
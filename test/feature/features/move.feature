@@ -387,4 +387,51 @@ Feature: Move test
     And SQL error on host "coordinator" should match regexp
     """
     relation xMove does not exist on receiving shard
-    """
\ No newline at end of file
+    """
+  Scenario: DRAIN SHARD moves all key ranges from shard
+    When I run SQL on host "coordinator"
+    """
+    ADD SHARD sh1 WITH HOSTS spqr_shard_1::6432;
+    ADD SHARD sh2 WITH HOSTS spqr_shard_2::6432;
+    """
+    Then command return code should be "0"
+    When I run SQL on host "shard1"
+    """
+    CREATE TABLE xMove(w_id INT, s TEXT);
+    insert into xMove(w_id, s) values(1, '001');
+    """
+    Then command return code should be "0"
+    When I run SQL on host "shard2"
+    """
+    CREATE TABLE xMove(w_id INT, s TEXT);
+    insert into xMove(w_id, s) values(11, '002');
+    """
+    Then command return code should be "0"
+    When I execute SQL on host "coordinator"
+    """
+    DRAIN SHARD sh1
+    """
+    Then command return code should be "0"
+    When I run SQL on host "shard2"
+    """
+    SELECT * FROM xMove
+    """
+    Then command return code should be "0"
+    And SQL result should match regexp
+    """
+    .*002(.|\n)*001
+    """
+    When I run SQL on host "coordinator"
+    """
+    CREATE KEY RANGE krid3 FROM 21 ROUTE TO sh1 FOR DISTRIBUTION ds1
+    """
+    Then command return code should be "1"
+    And SQL error on host "coordinator" should match regexp
+    """
+    shard sh1 is draining
+    """
+    When I run SQL on host "coordinator"
+    """
+    DROP SHARD sh1
+    """
+    Then command return code should be "0"
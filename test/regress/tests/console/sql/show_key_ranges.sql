@@ -4,5 +4,7 @@ CREATE KEY RANGE krid2 FROM 11 ROUTE TO sh1 FOR DISTRIBUTION ds1;
 
 SHOW key_ranges;
 
+SHOW key_range FOR DISTRIBUTION ds1 KEY 5, 42;
+
 DROP DISTRIBUTION ALL CASCADE;
 DROP KEY RANGE ALL;
\ No newline at end of file
@@ -0,0 +1,14761 @@
+// Code generated by goyacc -o lyx/gram.go -p yy lyx/gram.y. DO NOT EDIT.
+
+//line lyx/gram.y:3
+package lyx
+
+import __yyfmt__ "fmt"
+
+//line lyx/gram.y:3
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+func randomHex(n int) (string, error) {
+	bytes := make([]byte, n)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// transactionMode is single transaction mode item with isolation level, if it is set
+type transactionMode struct {
+	item      TransactionModeItem
+	isolation string
+}
+
+// transactionModes is list of transaction mode items, isolation level is the last one set
+type transactionModes struct {
+	items     []TransactionModeItem
+	isolation string
+}
+
+func (m transactionModes) add(mode transactionMode) transactionModes {
+	m.items = append(m.items, mode.item)
+	if mode.isolation != "" {
+		m.isolation = mode.isolation
+	}
+	return m
+}
+
+// objectType returns kind of object of DDL statement, e.g. TABLE or DATABASE
+func objectType(toks []string) string {
+	if len(toks) == 0 {
+		return ""
+	}
+	return strings.ToUpper(toks[0])
+}
+
+// hasConcurrently reports if statement tokens contain CONCURRENTLY at position pos
+func hasConcurrently(toks []string, pos int) bool {
+	return len(toks) > pos && strings.EqualFold(toks[pos], "concurrently")
+}
+
+type LyxParser yyParser
+
+func NewLyxParser() LyxParser {
+	return yyNewParser()
+}
+
+//line lyx/gram.y:64
+type yySymType struct {
+	yys int
+	/* offset of token in SQL text */
+	loc     int
+	str     string
+	strlist []string
+	byte    byte
+	bytes   []byte
+	int     int
+	bool    bool
+	empty   struct{}
+
+	node      Node
+	from_list []FromClauseNode
+	from      FromClauseNode
+	tableelt  []TableElt
+	tableref  FromClauseNode
+
+	nodeList []Node
+
+	txMode     transactionMode
+	txModeList transactionModes
+
+	cte     *CommonTableExpr
+	cteList []*CommonTableExpr
+
+	copyOpt     *CopyOption
+	copyOptList []*CopyOption
+
+	joinExpr *JoinExpr
+}
+
+const SCONST = 57346
+const IDENT = 57347
+const ICONST = 57348
+const CREATE = 57349
+const ALTER = 57350
+const ROLE = 57351
+const DATABASE = 57352
+const NATURAL = 57353
+const TABLE = 57354
+const INDEX = 57355
+const SELECT = 57356
+const UPDATE = 57357
+const INSERT = 57358
+const DELETE = 57359
+const FROM = 57360
+const WHERE = 57361
+const AND = 57362
+const VALUES = 57363
+const OR = 57364
+const CALLED = 57365
+const ABORT_P = 57366
+const COMMENTS = 57367
+const EXTRACT = 57368
+const THEN = 57369
+const CONTENT_P = 57370
+const RULE = 57371
+const ELSE = 57372
+const NFKC = 57373
+const FUNCTION = 57374
+const UNKNOWN = 57375
+const XMLELEMENT = 57376
+const DECLARE = 57377
+const SUBSCRIPTION = 57378
+const INCLUDING = 57379
+const ADD_P = 57380
+const CHAIN = 57381
+const EXPRESSION = 57382
+const INSENSITIVE = 57383
+const SAVEPOINT = 57384
+const CONCURRENTLY = 57385
+const INPUT_P = 57386
+const REINDEX = 57387
+const CONSTRAINT = 57388
+const DEFERRED = 57389
+const CONNECTION = 57390
+const REPLACE = 57391
+const ASSIGNMENT = 57392
+const FINALIZE = 57393
+const RECHECK = 57394
+const XMLFOREST = 57395
+const CURRENT_TIMESTAMP = 57396
+const INITIALLY = 57397
+const COMPRESSION = 57398
+const READ = 57399
+const CAST = 57400
+const SCHEMAS = 57401
+const POSITION = 57402
+const LEAKPROOF = 57403
+const RESTRICT = 57404
+const UNLISTEN = 57405
+const USER = 57406
+const CHARACTERISTICS = 57407
+const IMPORT_P = 57408
+const SCROLL = 57409
+const SHARE = 57410
+const CURRENT_DATE = 57411
+const REASSIGN = 57412
+const ROUTINES = 57413
+const XML_P = 57414
+const EXTERNAL = 57415
+const ROLLUP = 57416
+const IDENTITY_P = 57417
+const INCREMENT = 57418
+const LARGE_P = 57419
+const MINVALUE = 57420
+const NAME_P = 57421
+const YES_P = 57422
+const GENERATED = 57423
+const GROUPS = 57424
+const PLANS = 57425
+const SYSTEM_P = 57426
+const AUTHORIZATION = 57427
+const LOCATION = 57428
+const SYSID = 57429
+const JSON = 57430
+const XMLTABLE = 57431
+const CURRENT_ROLE = 57432
+const STORAGE = 57433
+const OPERATOR = 57434
+const OWNED = 57435
+const OPTION = 57436
+const PASSWORD = 57437
+const SIMPLE = 57438
+const SNAPSHOT = 57439
+const TEXT_P = 57440
+const INOUT = 57441
+const WRITE = 57442
+const FAMILY = 57443
+const RESTART = 57444
+const TYPE_P = 57445
+const GRANT = 57446
+const UNION = 57447
+const DETACH = 57448
+const ACTION = 57449
+const FOLLOWING = 57450
+const UNIQUE = 57451
+const GRANTED = 57452
+const SEQUENCE = 57453
+const TIES = 57454
+const GROUPING = 57455
+const INDENT = 57456
+const CONVERSION_P = 57457
+const ENCRYPTED = 57458
+const TABLES = 57459
+const STABLE = 57460
+const JSON_ARRAY = 57461
+const LEAST = 57462
+const ATOMIC = 57463
+const NFD = 57464
+const STRICT_P = 57465
+const ALWAYS = 57466
+const MATERIALIZED = 57467
+const PREPARED = 57468
+const TRANSFORM = 57469
+const CURRENT_P = 57470
+const LOGGED = 57471
+const VALIDATOR = 57472
+const CASCADE = 57473
+const DISABLE_P = 57474
+const REFERENCING = 57475
+const SESSION = 57476
+const MAPPING = 57477
+const NOTIFY = 57478
+const PARTITION = 57479
+const STATEMENT = 57480
+const JSON_ARRAYAGG = 57481
+const CURRENT_SCHEMA = 57482
+const CUBE = 57483
+const FILTER = 57484
+const SQL_P = 57485
+const OVERLAPS = 57486
+const NAMES = 57487
+const ROWS = 57488
+const NORMALIZE = 57489
+const EVENT = 57490
+const OTHERS = 57491
+const BETWEEN = 57492
+const RETURN = 57493
+const SERVER = 57494
+const TEMPLATE = 57495
+const GROUP_P = 57496
+const AFTER = 57497
+const POLICY = 57498
+const UNBOUNDED = 57499
+const UNTIL = 57500
+const EXCEPT = 57501
+const ILIKE = 57502
+const IN_P = 57503
+const IMMEDIATE = 57504
+const NEXT = 57505
+const CONFIGURATION = 57506
+const INCLUDE = 57507
+const NEW = 57508
+const SHOW = 57509
+const EXTENSION = 57510
+const LABEL = 57511
+const JSON_OBJECTAGG = 57512
+const DICTIONARY = 57513
+const LISTEN = 57514
+const NULLS_P = 57515
+const AT = 57516
+const DOMAIN_P = 57517
+const OF = 57518
+const REPLICA = 57519
+const VALIDATE = 57520
+const TABLESAMPLE = 57521
+const END_P = 57522
+const COLUMNS = 57523
+const INHERIT = 57524
+const SERIALIZABLE = 57525
+const BREADTH = 57526
+const OWNER = 57527
+const SETS = 57528
+const VIEWS = 57529
+const MATCH = 57530
+const ACCESS = 57531
+const LOCK_P = 57532
+const OPTIONS = 57533
+const CURSOR = 57534
+const UESCAPE = 57535
+const SOME = 57536
+const CATALOG_P = 57537
+const COST = 57538
+const LOCKED = 57539
+const RECURSIVE = 57540
+const CURRENT_CATALOG = 57541
+const PARAMETER = 57542
+const PRIOR = 57543
+const PLACING = 57544
+const AGGREGATE = 57545
+const EXCLUSIVE = 57546
+const CONTINUE_P = 57547
+const DEFINER = 57548
+const MODE = 57549
+const REPEATABLE = 57550
+const REFRESH = 57551
+const SCALAR = 57552
+const VALUE_P = 57553
+const XMLSERIALIZE = 57554
+const DEPTH = 57555
+const NOTHING = 57556
+const OVER = 57557
+const START = 57558
+const WHEN = 57559
+const FORMAT = 57560
+const NONE = 57561
+const OFF = 57562
+const STANDALONE_P = 57563
+const HOLD = 57564
+const SCHEMA = 57565
+const WORK = 57566
+const LOCALTIME = 57567
+const SKIP = 57568
+const HANDLER = 57569
+const KEYS = 57570
+const LEADING = 57571
+const LEVEL = 57572
+const CHECK = 57573
+const INLINE_P = 57574
+const LIKE = 57575
+const OLD = 57576
+const SESSION_USER = 57577
+const CACHE = 57578
+const ISOLATION = 57579
+const WHITESPACE_P = 57580
+const SUBSTRING = 57581
+const DISCARD = 57582
+const PROCEDURE = 57583
+const LANGUAGE = 57584
+const OVERRIDING = 57585
+const EXCLUDE = 57586
+const TRAILING = 57587
+const ALSO = 57588
+const ASENSITIVE = 57589
+const INDEXES = 57590
+const REF_P = 57591
+const SECURITY = 57592
+const VARIADIC = 57593
+const COMMITTED = 57594
+const FORWARD = 57595
+const MERGE = 57596
+const TRANSACTION = 57597
+const FUNCTIONS = 57598
+const INVOKER = 57599
+const XMLATTRIBUTES = 57600
+const SYSTEM_USER = 57601
+const PARSER = 57602
+const PUBLICATION = 57603
+const MAXVALUE = 57604
+const ROUTINE = 57605
+const SUPPORT = 57606
+const BOTH = 57607
+const BACKWARD = 57608
+const RELATIVE_P = 57609
+const GREATEST = 57610
+const XMLNAMESPACES = 57611
+const CLASS = 57612
+const NOWAIT = 57613
+const REVOKE = 57614
+const XMLEXISTS = 57615
+const IMMUTABLE = 57616
+const SEARCH = 57617
+const TRUSTED = 57618
+const DEFERRABLE = 57619
+const ASSERTION = 57620
+const CHECKPOINT = 57621
+const EACH = 57622
+const ATTRIBUTE = 57623
+const METHOD = 57624
+const NFKD = 57625
+const VERSION_P = 57626
+const WRAPPER = 57627
+const ENABLE_P = 57628
+const SEQUENCES = 57629
+const XMLCONCAT = 57630
+const LOCALTIMESTAMP = 57631
+const RETURNS = 57632
+const CONSTRAINTS = 57633
+const MATCHED = 57634
+const JSON_OBJECT = 57635
+const ATTACH = 57636
+const BEFORE = 57637
+const BEGIN_P = 57638
+const PRIVILEGES = 57639
+const VOLATILE = 57640
+const OBJECT_P = 57641
+const TREAT = 57642
+const HAVING = 57643
+const COMMENT = 57644
+const CYCLE = 57645
+const LOAD = 57646
+const COALESCE = 57647
+const NFC = 57648
+const RELEASE = 57649
+const ANY = 57650
+const CLOSE = 57651
+const OUT_P = 57652
+const PASSING = 57653
+const STATISTICS = 57654
+const CURRENT_TIME = 57655
+const CONFLICT = 57656
+const PROCEDURES = 57657
+const RENAME = 57658
+const UNENCRYPTED = 57659
+const ABSOLUTE_P = 57660
+const PRECEDING = 57661
+const JSON_SERIALIZE = 57662
+const XMLPARSE = 57663
+const INHERITS = 57664
+const VALID = 57665
+const CURRENT_USER = 57666
+const ABSENT = 57667
+const CALL = 57668
+const COLLATION = 57669
+const CASE = 57670
+const PARALLEL = 57671
+const UNCOMMITTED = 57672
+const IMPLICIT_P = 57673
+const MOVE = 57674
+const OVERLAY = 57675
+const SIMILAR = 57676
+const SYMMETRIC = 57677
+const TRIM = 57678
+const ANALYSE = 57679
+const NULLIF = 57680
+const XMLROOT = 57681
+const VERBOSE = 57682
+const ADMIN = 57683
+const PARTIAL = 57684
+const VIEW = 57685
+const XMLPI = 57686
+const CASCADED = 57687
+const INSTEAD = 57688
+const STORED = 57689
+const TRIGGER = 57690
+const TYPES_P = 57691
+const DEFAULTS = 57692
+const DEPENDS = 57693
+const RANGE = 57694
+const DO = 57695
+const INTERSECT = 57696
+const COLUMN = 57697
+const ENUM_P = 57698
+const EXCLUDING = 57699
+const STRIP_P = 57700
+const WITHIN = 57701
+const JSON_SCALAR = 57702
+const PROCEDURAL = 57703
+const EXPLAIN = 57704
+const EXECUTE = 57705
+const PREPARE = 57706
+const BEGIN = 57707
+const COMMIT = 57708
+const ROLLBACK = 57709
+const VACUUM = 57710
+const CLUSTER = 57711
+const ANALYZE = 57712
+const INTO = 57713
+const OUTER_P = 57714
+const FULL = 57715
+const RIGHT = 57716
+const LEFT = 57717
+const INNER_P = 57718
+const DROP = 57719
+const TRUNCATE = 57720
+const FETCH = 57721
+const FOR = 57722
+const ORDER = 57723
+const GROUP = 57724
+const BY = 57725
+const OFFSET = 57726
+const LIMIT = 57727
+const WINDOW = 57728
+const CROSS = 57729
+const SET = 57730
+const RESET = 57731
+const LOCAL = 57732
+const ALL = 57733
+const PRIMARY = 57734
+const KEY = 57735
+const FOREIGN = 57736
+const REFERENCES = 57737
+const USING = 57738
+const TCOLON = 57739
+const TCOMMA = 57740
+const TOPENBR = 57741
+const TCLOSEBR = 57742
+const TSQOPENBR = 57743
+const TSQCLOSEBR = 57744
+const TSEMICOLON = 57745
+const TNOT_EQUALS = 57746
+const TDOT = 57747
+const NULL_P = 57748
+const ISNULL = 57749
+const ON = 57750
+const JOIN = 57751
+const TPLUS = 57752
+const TMINUS = 57753
+const TMUL = 57754
+const TDIV = 57755
+const TGREATER = 57756
+const TGREATER_EQUALS = 57757
+const TLESS = 57758
+const TLESS_EQUALS = 57759
+const TMOD = 57760
+const TPOW = 57761
+const DELIMITERS = 57762
+const PROGRAM = 57763
+const STDIN = 57764
+const FALSE_P = 57765
+const TRUE_P = 57766
+const BINARY = 57767
+const FREEZE = 57768
+const DELIMITER = 57769
+const CSV = 57770
+const HEADER_P = 57771
+const QUOTE = 57772
+const ESCAPE = 57773
+const FORCE = 57774
+const ENCODING = 57775
+const TEQ = 57776
+const OP = 57777
+const ONLY = 57778
+const RETURNING = 57779
+const COPY = 57780
+const ARRAY = 57781
+const ROW = 57782
+const SETOF = 57783
+const INT_P = 57784
+const INTEGER = 57785
+const SMALLINT = 57786
+const BIGINT = 57787
+const REAL = 57788
+const FLOAT_P = 57789
+const DOUBLE_P = 57790
+const DECIMAL_P = 57791
+const DEC = 57792
+const NUMERIC = 57793
+const BOOLEAN_P = 57794
+const BIT = 57795
+const YEAR_P = 57796
+const MONTH_P = 57797
+const DAY_P = 57798
+const HOUR_P = 57799
+const MINUTE_P = 57800
+const SECOND_P = 57801
+const CHARACTER = 57802
+const CHAR_P = 57803
+const VARCHAR = 57804
+const NATIONAL = 57805
+const NCHAR = 57806
+const PRECISION = 57807
+const VARYING = 57808
+const TIMESTAMP = 57809
+const TIME = 57810
+const INTERVAL = 57811
+const WITHOUT = 57812
+const ZONE = 57813
+const IF_P = 57814
+const DEALLOCATE = 57815
+const EXISTS = 57816
+const DELETE_P = 57817
+const OIDS = 57818
+const PRESERVE = 57819
+const TABLESPACE = 57820
+const PARAM = 57821
+const IS = 57822
+const NOT = 57823
+const NULL = 57824
+const DISTINCT = 57825
+const DEFAULT = 57826
+const NORMALIZED = 57827
+const NOTNULL = 57828
+const DOCUMENT_P = 57829
+const ASYMMETRIC = 57830
+const ASC = 57831
+const DESC = 57832
+const NULLS_LA = 57833
+const FIRST_P = 57834
+const LAST_P = 57835
+const TO = 57836
+const STDOUT = 57837
+const TEMPORARY = 57838
+const GLOBAL = 57839
+const TEMP = 57840
+const UNLOGGED = 57841
+const LATERAL_P = 57842
+const ORDINALITY = 57843
+const WITH_LA = 57844
+const WITH = 57845
+const COLLATE = 57846
+const AS = 57847
+const DATA_P = 57848
+const NO = 57849
+const NOT_LA = 57850
+const Op = 57851
+const UMINUS = 57852
+const TYPECAST = 57853
+
+var yyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
+	"SCONST",
+	"IDENT",
+	"ICONST",
+	"CREATE",
+	"ALTER",
+	"ROLE",
+	"DATABASE",
+	"NATURAL",
+	"TABLE",
+	"INDEX",
+	"SELECT",
+	"UPDATE",
+	"INSERT",
+	"DELETE",
+	"FROM",
+	"WHERE",
+	"AND",
+	"VALUES",
+	"OR",
+	"CALLED",
+	"ABORT_P",
+	"COMMENTS",
+	"EXTRACT",
+	"THEN",
+	"CONTENT_P",
+	"RULE",
+	"ELSE",
+	"NFKC",
+	"FUNCTION",
+	"UNKNOWN",
+	"XMLELEMENT",
+	"DECLARE",
+	"SUBSCRIPTION",
+	"INCLUDING",
+	"ADD_P",
+	"CHAIN",
+	"EXPRESSION",
+	"INSENSITIVE",
+	"SAVEPOINT",
+	"CONCURRENTLY",
+	"INPUT_P",
+	"REINDEX",
+	"CONSTRAINT",
+	"DEFERRED",
+	"CONNECTION",
+	"REPLACE",
+	"ASSIGNMENT",
+	"FINALIZE",
+	"RECHECK",
+	"XMLFOREST",
+	"CURRENT_TIMESTAMP",
+	"INITIALLY",
+	"COMPRESSION",
+	"READ",
+	"CAST",
+	"SCHEMAS",
+	"POSITION",
+	"LEAKPROOF",
+	"RESTRICT",
+	"UNLISTEN",
+	"USER",
+	"CHARACTERISTICS",
+	"IMPORT_P",
+	"SCROLL",
+	"SHARE",
+	"CURRENT_DATE",
+	"REASSIGN",
+	"ROUTINES",
+	"XML_P",
+	"EXTERNAL",
+	"ROLLUP",
+	"IDENTITY_P",
+	"INCREMENT",
+	"LARGE_P",
+	"MINVALUE",
+	"NAME_P",
+	"YES_P",
+	"GENERATED",
+	"GROUPS",
+	"PLANS",
+	"SYSTEM_P",
+	"AUTHORIZATION",
+	"LOCATION",
+	"SYSID",
+	"JSON",
+	"XMLTABLE",
+	"CURRENT_ROLE",
+	"STORAGE",
+	"OPERATOR",
+	"OWNED",
+	"OPTION",
+	"PASSWORD",
+	"SIMPLE",
+	"SNAPSHOT",
+	"TEXT_P",
+	"INOUT",
+	"WRITE",
+	"FAMILY",
+	"RESTART",
+	"TYPE_P",
+	"GRANT",
+	"UNION",
+	"DETACH",
+	"ACTION",
+	"FOLLOWING",
+	"UNIQUE",
+	"GRANTED",
+	"SEQUENCE",
+	"TIES",
+	"GROUPING",
+	"INDENT",
+	"CONVERSION_P",
+	"ENCRYPTED",
+	"TABLES",
+	"STABLE",
+	"JSON_ARRAY",
+	"LEAST",
+	"ATOMIC",
+	"NFD",
+	"STRICT_P",
+	"ALWAYS",
+	"MATERIALIZED",
+	"PREPARED",
+	"TRANSFORM",
+	"CURRENT_P",
+	"LOGGED",
+	"VALIDATOR",
+	"CASCADE",
+	"DISABLE_P",
+	"REFERENCING",
+	"SESSION",
+	"MAPPING",
+	"NOTIFY",
+	"PARTITION",
+	"STATEMENT",
+	"JSON_ARRAYAGG",
+	"CURRENT_SCHEMA",
+	"CUBE",
+	"FILTER",
+	"SQL_P",
+	"OVERLAPS",
+	"NAMES",
+	"ROWS",
+	"NORMALIZE",
+	"EVENT",
+	"OTHERS",
+	"BETWEEN",
+	"RETURN",
+	"SERVER",
+	"TEMPLATE",
+	"GROUP_P",
+	"AFTER",
+	"POLICY",
+	"UNBOUNDED",
+	"UNTIL",
+	"EXCEPT",
+	"ILIKE",
+	"IN_P",
+	"IMMEDIATE",
+	"NEXT",
+	"CONFIGURATION",
+	"INCLUDE",
+	"NEW",
+	"SHOW",
+	"EXTENSION",
+	"LABEL",
+	"JSON_OBJECTAGG",
+	"DICTIONARY",
+	"LISTEN",
+	"NULLS_P",
+	"AT",
+	"DOMAIN_P",
+	"OF",
+	"REPLICA",
+	"VALIDATE",
+	"TABLESAMPLE",
+	"END_P",
+	"COLUMNS",
+	"INHERIT",
+	"SERIALIZABLE",
+	"BREADTH",
+	"OWNER",
+	"SETS",
+	"VIEWS",
+	"MATCH",
+	"ACCESS",
+	"LOCK_P",
+	"OPTIONS",
+	"CURSOR",
+	"UESCAPE",
+	"SOME",
+	"CATALOG_P",
+	"COST",
+	"LOCKED",
+	"RECURSIVE",
+	"CURRENT_CATALOG",
+	"PARAMETER",
+	"PRIOR",
+	"PLACING",
+	"AGGREGATE",
+	"EXCLUSIVE",
+	"CONTINUE_P",
+	"DEFINER",
+	"MODE",
+	"REPEATABLE",
+	"REFRESH",
+	"SCALAR",
+	"VALUE_P",
+	"XMLSERIALIZE",
+	"DEPTH",
+	"NOTHING",
+	"OVER",
+	"START",
+	"WHEN",
+	"FORMAT",
+	"NONE",
+	"OFF",
+	"STANDALONE_P",
+	"HOLD",
+	"SCHEMA",
+	"WORK",
+	"LOCALTIME",
+	"SKIP",
+	"HANDLER",
+	"KEYS",
+	"LEADING",
+	"LEVEL",
+	"CHECK",
+	"INLINE_P",
+	"LIKE",
+	"OLD",
+	"SESSION_USER",
+	"CACHE",
+	"ISOLATION",
+	"WHITESPACE_P",
+	"SUBSTRING",
+	"DISCARD",
+	"PROCEDURE",
+	"LANGUAGE",
+	"OVERRIDING",
+	"EXCLUDE",
+	"TRAILING",
+	"ALSO",
+	"ASENSITIVE",
+	"INDEXES",
+	"REF_P",
+	"SECURITY",
+	"VARIADIC",
+	"COMMITTED",
+	"FORWARD",
+	"MERGE",
+	"TRANSACTION",
+	"FUNCTIONS",
+	"INVOKER",
+	"XMLATTRIBUTES",
+	"SYSTEM_USER",
+	"PARSER",
+	"PUBLICATION",
+	"MAXVALUE",
+	"ROUTINE",
+	"SUPPORT",
+	"BOTH",
+	"BACKWARD",
+	"RELATIVE_P",
+	"GREATEST",
+	"XMLNAMESPACES",
+	"CLASS",
+	"NOWAIT",
+	"REVOKE",
+	"XMLEXISTS",
+	"IMMUTABLE",
+	"SEARCH",
+	"TRUSTED",
+	"DEFERRABLE",
+	"ASSERTION",
+	"CHECKPOINT",
+	"EACH",
+	"ATTRIBUTE",
+	"METHOD",
+	"NFKD",
+	"VERSION_P",
+	"WRAPPER",
+	"ENABLE_P",
+	"SEQUENCES",
+	"XMLCONCAT",
+	"LOCALTIMESTAMP",
+	"RETURNS",
+	"CONSTRAINTS",
+	"MATCHED",
+	"JSON_OBJECT",
+	"ATTACH",
+	"BEFORE",
+	"BEGIN_P",
+	"PRIVILEGES",
+	"VOLATILE",
+	"OBJECT_P",
+	"TREAT",
+	"HAVING",
+	"COMMENT",
+	"CYCLE",
+	"LOAD",
+	"COALESCE",
+	"NFC",
+	"RELEASE",
+	"ANY",
+	"CLOSE",
+	"OUT_P",
+	"PASSING",
+	"STATISTICS",
+	"CURRENT_TIME",
+	"CONFLICT",
+	"PROCEDURES",
+	"RENAME",
+	"UNENCRYPTED",
+	"ABSOLUTE_P",
+	"PRECEDING",
+	"JSON_SERIALIZE",
+	"XMLPARSE",
+	"INHERITS",
+	"VALID",
+	"CURRENT_USER",
+	"ABSENT",
+	"CALL",
+	"COLLATION",
+	"CASE",
+	"PARALLEL",
+	"UNCOMMITTED",
+	"IMPLICIT_P",
+	"MOVE",
+	"OVERLAY",
+	"SIMILAR",
+	"SYMMETRIC",
+	"TRIM",
+	"ANALYSE",
+	"NULLIF",
+	"XMLROOT",
+	"VERBOSE",
+	"ADMIN",
+	"PARTIAL",
+	"VIEW",
+	"XMLPI",
+	"CASCADED",
+	"INSTEAD",
+	"STORED",
+	"TRIGGER",
+	"TYPES_P",
+	"DEFAULTS",
+	"DEPENDS",
+	"RANGE",
+	"DO",
+	"INTERSECT",
+	"COLUMN",
+	"ENUM_P",
+	"EXCLUDING",
+	"STRIP_P",
+	"WITHIN",
+	"JSON_SCALAR",
+	"PROCEDURAL",
+	"EXPLAIN",
+	"EXECUTE",
+	"PREPARE",
+	"BEGIN",
+	"COMMIT",
+	"ROLLBACK",
+	"VACUUM",
+	"CLUSTER",
+	"ANALYZE",
+	"INTO",
+	"OUTER_P",
+	"FULL",
+	"RIGHT",
+	"LEFT",
+	"INNER_P",
+	"DROP",
+	"TRUNCATE",
+	"FETCH",
+	"FOR",
+	"ORDER",
+	"GROUP",
+	"BY",
+	"OFFSET",
+	"LIMIT",
+	"WINDOW",
+	"CROSS",
+	"SET",
+	"RESET",
+	"LOCAL",
+	"ALL",
+	"PRIMARY",
+	"KEY",
+	"FOREIGN",
+	"REFERENCES",
+	"USING",
+	"TCOLON",
+	"TCOMMA",
+	"TOPENBR",
+	"TCLOSEBR",
+	"TSQOPENBR",
+	"TSQCLOSEBR",
+	"TSEMICOLON",
+	"TNOT_EQUALS",
+	"TDOT",
+	"NULL_P",
+	"ISNULL",
+	"ON",
+	"JOIN",
+	"TPLUS",
+	"TMINUS",
+	"TMUL",
+	"TDIV",
+	"TGREATER",
+	"TGREATER_EQUALS",
+	"TLESS",
+	"TLESS_EQUALS",
+	"TMOD",
+	"TPOW",
+	"DELIMITERS",
+	"PROGRAM",
+	"STDIN",
+	"FALSE_P",
+	"TRUE_P",
+	"BINARY",
+	"FREEZE",
+	"DELIMITER",
+	"CSV",
+	"HEADER_P",
+	"QUOTE",
+	"ESCAPE",
+	"FORCE",
+	"ENCODING",
+	"TEQ",
+	"OP",
+	"ONLY",
+	"RETURNING",
+	"COPY",
+	"ARRAY",
+	"ROW",
+	"SETOF",
+	"INT_P",
+	"INTEGER",
+	"SMALLINT",
+	"BIGINT",
+	"REAL",
+	"FLOAT_P",
+	"DOUBLE_P",
+	"DECIMAL_P",
+	"DEC",
+	"NUMERIC",
+	"BOOLEAN_P",
+	"BIT",
+	"YEAR_P",
+	"MONTH_P",
+	"DAY_P",
+	"HOUR_P",
+	"MINUTE_P",
+	"SECOND_P",
+	"CHARACTER",
+	"CHAR_P",
+	"VARCHAR",
+	"NATIONAL",
+	"NCHAR",
+	"PRECISION",
+	"VARYING",
+	"TIMESTAMP",
+	"TIME",
+	"INTERVAL",
+	"WITHOUT",
+	"ZONE",
+	"IF_P",
+	"DEALLOCATE",
+	"EXISTS",
+	"DELETE_P",
+	"OIDS",
+	"PRESERVE",
+	"TABLESPACE",
+	"PARAM",
+	"IS",
+	"NOT",
+	"NULL",
+	"DISTINCT",
+	"DEFAULT",
+	"NORMALIZED",
+	"NOTNULL",
+	"DOCUMENT_P",
+	"ASYMMETRIC",
+	"ASC",
+	"DESC",
+	"NULLS_LA",
+	"FIRST_P",
+	"LAST_P",
+	"TO",
+	"STDOUT",
+	"TEMPORARY",
+	"GLOBAL",
+	"TEMP",
+	"UNLOGGED",
+	"LATERAL_P",
+	"ORDINALITY",
+	"WITH_LA",
+	"WITH",
+	"COLLATE",
+	"AS",
+	"DATA_P",
+	"NO",
+	"NOT_LA",
+	"Op",
+	"UMINUS",
+	"TYPECAST",
+	"','",
+}
+
+var yyStatenames = [...]string{}
+
+const yyEofCode = 1
+const yyErrCode = 2
+const yyInitialStackSize = 16
+
+//line lyx/gram.y:5428
+
+//line yacctab:1
+var yyExca = [...]int16{
+	-1, 1,
+	1, -1,
+	-2, 0,
+	-1, 47,
+	1, 1605,
+	400, 1605,
+	403, 1605,
+	408, 1605,
+	437, 1605,
+	-2, 1634,
+	-1, 52,
+	1, 1608,
+	400, 1608,
+	403, 1608,
+	408, 1608,
+	437, 1608,
+	-2, 1633,
+	-1, 482,
+	1, 1273,
+	403, 1273,
+	-2, 163,
+	-1, 993,
+	379, 1426,
+	380, 1426,
+	384, 1426,
+	385, 1426,
+	-2, 1609,
+	-1, 996,
+	379, 1427,
+	380, 1427,
+	384, 1427,
+	385, 1427,
+	-2, 1612,
+	-1, 1130,
+	379, 1426,
+	380, 1426,
+	384, 1426,
+	385, 1426,
+	-2, 1613,
+	-1, 1163,
+	4, 1124,
+	399, 1124,
+	-2, 1241,
+	-1, 1323,
+	146, 1550,
+	440, 1550,
+	-2, 1199,
+	-1, 1367,
+	105, 1634,
+	159, 1634,
+	354, 1634,
+	379, 1634,
+	380, 1634,
+	381, 1634,
+	384, 1634,
+	385, 1634,
+	-2, 1238,
+	-1, 1562,
+	436, 1554,
+	503, 1554,
+	-2, 355,
+	-1, 1563,
+	436, 1555,
+	503, 1555,
+	-2, 237,
+	-1, 1569,
+	146, 1553,
+	440, 1553,
+	-2, 1143,
+	-1, 1585,
+	1, 279,
+	18, 279,
+	19, 279,
+	105, 279,
+	159, 279,
+	301, 279,
+	354, 279,
+	379, 279,
+	380, 279,
+	381, 279,
+	382, 279,
+	384, 279,
+	385, 279,
+	386, 279,
+	400, 279,
+	403, 279,
+	405, 279,
+	408, 279,
+	437, 279,
+	-2, 1516,
+	-1, 1586,
+	1, 277,
+	18, 277,
+	19, 277,
+	105, 277,
+	159, 277,
+	301, 277,
+	354, 277,
+	379, 277,
+	380, 277,
+	381, 277,
+	382, 277,
+	384, 277,
+	385, 277,
+	386, 277,
+	400, 277,
+	403, 277,
+	405, 277,
+	408, 277,
+	437, 277,
+	-2, 1516,
+	-1, 1589,
+	1, 295,
+	18, 295,
+	19, 295,
+	105, 295,
+	159, 295,
+	301, 295,
+	354, 295,
+	379, 295,
+	380, 295,
+	381, 295,
+	382, 295,
+	384, 295,
+	385, 295,
+	386, 295,
+	400, 295,
+	403, 295,
+	405, 295,
+	408, 295,
+	437, 295,
+	-2, 1516,
+	-1, 1600,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1209,
+	-1, 1601,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1210,
+	-1, 1602,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1211,
+	-1, 1603,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1212,
+	-1, 1604,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1213,
+	-1, 1605,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1214,
+	-1, 1738,
+	399, 1124,
+	-2, 1459,
+	-1, 1867,
+	399, 1442,
+	-2, 1002,
+	-1, 1872,
+	11, 1470,
+	373, 1470,
+	374, 1470,
+	375, 1470,
+	376, 1470,
+	387, 1470,
+	409, 1470,
+	-2, 1634,
+	-1, 1886,
+	407, 0,
+	480, 0,
+	486, 0,
+	-2, 1227,
+	-1, 1975,
+	399, 1125,
+	-2, 1460,
+	-1, 1981,
+	407, 0,
+	480, 0,
+	486, 0,
+	-2, 1228,
+	-1, 1982,
+	150, 0,
+	161, 0,
+	508, 0,
+	-2, 1229,
+	-1, 1989,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1254,
+	-1, 1990,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1255,
+	-1, 1991,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1256,
+	-1, 1992,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1257,
+	-1, 1993,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1258,
+	-1, 1994,
+	404, 0,
+	414, 0,
+	415, 0,
+	416, 0,
+	417, 0,
+	434, 0,
+	-2, 1259,
+	-1, 2027,
+	399, 1124,
+	-2, 1661,
+}
+
+const yyPrivate = 57344
+
+const yyLast = 28786
+
+var yyAct = [...]int16{
+	1163, 2293, 1272, 994, 2070, 1549, 1145, 2248, 2131, 1012,
+	1862, 81, 1208, 1726, 1157, 1635, 1707, 1577, 2172, 2162,
+	2132, 2127, 1819, 1365, 2148, 2107, 2013, 1540, 2212, 2049,
+	78, 455, 458, 2190, 1737, 458, 1412, 484, 484, 484,
+	458, 457, 502, 2026, 461, 1144, 47, 2175, 80, 497,
+	2048, 1035, 1408, 46, 1167, 2043, 2034, 1768, 1139, 1008,
+	458, 458, 1939, 2037, 1951, 1860, 1407, 1538, 1852, 500,
+	47, 1863, 1113, 1709, 1832, 1135, 1685, 1955, 1108, 1691,
+	1735, 1732, 1848, 1741, 1870, 1730, 1836, 1833, 1550, 505,
+	505, 505, 505, 505, 505, 1646, 1150, 1541, 1019, 1329,
+	998, 1000, 1169, 1712, 1261, 1221, 1618, 1320, 1964, 999,
+	1159, 977, 978, 979, 1582, 1186, 1640, 1271, 1568, 1187,
+	1391, 1364, 1136, 1188, 1514, 1524, 1558, 1517, 1018, 1303,
+	1295, 1301, 1321, 1109, 19, 1396, 1011, 1304, 1302, 1386,
+	1122, 1222, 1333, 1123, 52, 1189, 1124, 1269, 1172, 1277,
+	1773, 1349, 1731, 1009, 984, 1342, 1556, 53, 19, 2064,
+	2065, 1355, 501, 980, 1308, 1337, 1338, 1339, 1340, 1339,
+	1340, 1349, 1356, 1341, 1342, 1341, 1342, 1308, 2296, 1209,
+	1355, 1711, 2178, 1711, 2191, 1378, 1934, 1265, 1530, 1394,
+	1349, 1356, 1349, 1390, 1499, 1219, 2109, 2213, 1693, 997,
+	1953, 1952, 1842, 1720, 993, 1393, 1307, 981, 981, 982,
+	2262, 1006, 996, 2014, 1013, 1875, 1974, 1310, 1746, 58,
+	1747, 56, 49, 48, 50, 1704, 1683, 1336, 61, 1319,
+	1310, 1692, 58, 1837, 56, 49, 48, 50, 1392, 2288,
+	1744, 61, 1745, 58, 1045, 56, 1046, 1336, 1846, 1847,
+	464, 465, 61, 462, 463, 1043, 1016, 1044, 1682, 1681,
+	1393, 1727, 1680, 1552, 1393, 1547, 1336, 2218, 1336, 1843,
+	1721, 1609, 1617, 2217, 1750, 1578, 1579, 2167, 1062, 2308,
+	1531, 1114, 1003, 2197, 2109, 1705, 1260, 2303, 1612, 1611,
+	2017, 1752, 1751, 1392, 1666, 1056, 1936, 1392, 1821, 1822,
+	1690, 1021, 1529, 1038, 1788, 1787, 1097, 2195, 1094, 1058,
+	1656, 1021, 2073, 1655, 2072, 527, 2085, 2091, 2092, 472,
+	1446, 2090, 1486, 2074, 2075, 511, 512, 513, 1444, 2076,
+	1445, 1525, 1397, 1802, 1385, 1491, 1518, 2287, 1475, 1400,
+	1401, 1803, 1804, 1525, 1021, 1017, 1610, 1567, 1613, 1678,
+	1615, 1753, 1614, 1755, 1463, 1754, 1283, 1284, 2196, 1806,
+	1525, 1515, 1468, 1478, 1825, 1553, 1459, 985, 2023, 2161,
+	2306, 1713, 1494, 1115, 1004, 1820, 1015, 1465, 2170, 988,
+	2180, 2033, 1950, 1215, 1053, 1350, 1893, 1351, 2018, 1818,
+	2019, 1519, 1520, 1521, 1522, 1523, 1525, 2022, 1466, 1519,
+	1520, 1521, 1522, 1523, 1525, 989, 1949, 2046, 1855, 1857,
+	1856, 1858, 597, 618, 1960, 1348, 1959, 1493, 1360, 2221,
+	1699, 1337, 1338, 1339, 1340, 1344, 1347, 1343, 1346, 1341,
+	1342, 1200, 2220, 1350, 2244, 1351, 1378, 1876, 2166, 1382,
+	1337, 1338, 1339, 1340, 1963, 1345, 1349, 1944, 1341, 1342,
+	1629, 1024, 2165, 1026, 1628, 1029, 1945, 1890, 1891, 1202,
+	1057, 63, 598, 1892, 1893, 1349, 1350, 596, 1351, 1477,
+	1798, 2055, 2005, 1757, 484, 484, 1854, 1888, 1889, 1890,
+	1891, 1020, 1923, 1502, 1922, 1892, 1893, 1770, 1476, 1800,
+	55, 1020, 1371, 1675, 1676, 1069, 58, 2318, 56, 458,
+	2297, 2305, 1488, 2304, 2205, 61, 2286, 1464, 1100, 1502,
+	1485, 2273, 505, 505, 505, 1355, 1350, 2155, 1351, 1357,
+	1070, 1022, 1336, 2154, 1020, 1497, 1356, 2260, 2062, 2004,
+	2168, 1022, 1068, 1481, 1040, 1041, 2001, 1480, 1357, 1460,
+	2281, 1336, 1311, 1487, 1887, 1502, 2000, 2272, 2220, 2271,
+	505, 2270, 1999, 1492, 2167, 1311, 1973, 1925, 1318, 1496,
+	1374, 1906, 2265, 1355, 1022, 1823, 1715, 1490, 2254, 2253,
+	2228, 1102, 2227, 1457, 1356, 2146, 1133, 2215, 2199, 2146,
+	2200, 2153, 24, 1801, 2146, 1471, 2147, 2106, 1700, 2105,
+	2063, 2021, 1502, 2020, 1919, 1791, 1355, 1482, 1789, 1786,
+	2169, 1502, 1784, 1902, 1052, 1778, 55, 1356, 1810, 599,
+	1811, 1502, 1502, 1793, 1785, 1502, 1454, 1783, 1777, 55,
+	1715, 1467, 1714, 1700, 1133, 1701, 981, 1039, 1535, 1376,
+	55, 469, 1469, 1064, 1854, 1668, 1458, 1669, 1502, 1297,
+	1664, 1566, 1211, 1489, 1374, 1442, 1355, 1210, 1132, 2290,
+	1667, 1838, 1821, 1502, 1708, 1527, 1309, 1356, 51, 1715,
+	1583, 1474, 602, 1462, 1512, 1502, 1513, 1503, 2223, 1309,
+	2077, 2078, 2079, 521, 2080, 2081, 2086, 2087, 1447, 540,
+	2208, 1861, 1057, 1861, 1007, 2088, 2089, 544, 543, 537,
+	538, 2093, 542, 541, 624, 2096, 2082, 2083, 2084, 1443,
+	1450, 2094, 1449, 1451, 1495, 548, 547, 545, 546, 60,
+	59, 1055, 2207, 1036, 1484, 2166, 566, 2095, 557, 1214,
+	556, 560, 60, 59, 1839, 1023, 558, 559, 2143, 2165,
+	1027, 1453, 1452, 60, 59, 1023, 995, 467, 470, 468,
+	471, 1548, 1287, 567, 1448, 551, 2036, 573, 2061, 2289,
+	2098, 2101, 2256, 1911, 1715, 2099, 2100, 995, 1063, 1715,
+	1904, 2051, 1715, 2222, 2176, 1971, 1932, 1931, 1023, 1348,
+	1877, 1054, 1353, 1794, 1772, 1337, 1338, 1339, 1340, 1344,
+	1347, 1343, 1346, 1341, 1342, 55, 1620, 1381, 2097, 1483,
+	1684, 1473, 1470, 1047, 1049, 1658, 1456, 1455, 1472, 1345,
+	1349, 1014, 616, 1296, 2164, 1033, 1528, 2168, 1479, 1387,
+	626, 625, 1461, 550, 1384, 1405, 1334, 1348, 1403, 1292,
+	1353, 1247, 1206, 1337, 1338, 1339, 1340, 1344, 1347, 1343,
+	1346, 1341, 1342, 1201, 1032, 2205, 1286, 1034, 1855, 1857,
+	1856, 1858, 1335, 1335, 2315, 1352, 2282, 1345, 1349, 1810,
+	1348, 1354, 1851, 1353, 1578, 1579, 1337, 1338, 1339, 1340,
+	1344, 1347, 1343, 1346, 1341, 1342, 1850, 1835, 1797, 1724,
+	1630, 1576, 1534, 1357, 1853, 1203, 1336, 1005, 2279, 1545,
+	1345, 1349, 1814, 55, 1813, 1554, 2181, 1293, 1624, 1126,
+	1121, 2038, 1126, 1352, 1127, 1125, 2188, 1127, 1125, 1354,
+	1348, 1127, 1849, 1353, 2258, 2257, 1337, 1338, 1339, 1340,
+	1344, 1347, 1343, 1346, 1341, 1342, 1126, 990, 1359, 1957,
+	995, 1357, 1125, 1129, 1336, 2119, 1352, 1121, 1899, 1965,
+	1345, 1349, 1354, 1306, 1888, 1889, 1890, 1891, 1895, 1898,
+	1894, 1897, 1892, 1893, 2116, 2117, 2118, 2120, 2121, 2122,
+	2123, 2124, 2125, 1899, 1357, 2309, 1334, 1336, 1896, 1888,
+	1889, 1890, 1891, 1895, 1898, 1894, 1897, 1892, 1893, 983,
+	1937, 992, 2015, 1689, 991, 2024, 1352, 1672, 1826, 2157,
+	1956, 1216, 1354, 1896, 1008, 1008, 2059, 71, 1008, 77,
+	1573, 1533, 75, 73, 1098, 1095, 1855, 1857, 1856, 1858,
+	1131, 1289, 1623, 1288, 1357, 1213, 1647, 1336, 1781, 2103,
+	1851, 458, 2159, 1723, 1137, 1137, 2008, 2209, 72, 2044,
+	1507, 2307, 1914, 1536, 1377, 1327, 1621, 2068, 1218, 2067,
+	505, 2045, 1853, 1702, 47, 1572, 1280, 1795, 1207, 1373,
+	1702, 2158, 2225, 1008, 1954, 1844, 1099, 1096, 1264, 1555,
+	1673, 2030, 1930, 1504, 3, 1671, 478, 1016, 1281, 1708,
+	1257, 1112, 22, 1069, 1116, 1117, 1069, 1069, 1051, 1250,
+	1885, 1111, 21, 1110, 20, 1756, 1583, 70, 1199, 69,
+	2291, 1249, 1350, 986, 1351, 1625, 22, 71, 1070, 1916,
+	71, 1070, 1070, 1717, 490, 492, 21, 1742, 20, 1042,
+	1068, 1722, 62, 1068, 1068, 74, 76, 1008, 1661, 1711,
+	459, 460, 1626, 1259, 1997, 2241, 2240, 1212, 72, 2239,
+	2238, 72, 64, 65, 66, 67, 68, 2230, 2198, 2114,
+	2032, 1941, 1118, 1119, 1924, 1225, 1104, 1105, 1315, 1913,
+	1322, 1106, 1330, 1229, 981, 1103, 1509, 1130, 1241, 1809,
+	1799, 1212, 1242, 1361, 1362, 1363, 1243, 2226, 1697, 998,
+	1679, 1366, 998, 998, 1204, 1657, 991, 1654, 1652, 1285,
+	1643, 1508, 1375, 1268, 1060, 1059, 1030, 1028, 1244, 1380,
+	1025, 1228, 2184, 2108, 2025, 1665, 1323, 1328, 1929, 2278,
+	1645, 1358, 1622, 995, 1367, 1647, 1370, 1369, 1616, 1935,
+	1372, 1266, 999, 1278, 458, 1817, 2185, 458, 2104, 1,
+	1627, 1031, 1355, 1220, 1411, 1230, 1501, 1158, 1510, 1224,
+	1383, 1226, 1170, 1356, 1227, 1406, 1171, 1198, 1506, 1544,
+	1574, 466, 987, 1948, 2035, 2126, 2115, 2201, 2247, 1290,
+	1291, 1938, 1205, 1500, 1065, 1413, 1542, 1165, 1164, 1146,
+	1134, 1410, 23, 1571, 5, 4, 1584, 1061, 997, 1294,
+	1250, 997, 997, 9, 1313, 47, 1312, 1305, 8, 1331,
+	1332, 1298, 1249, 486, 1299, 1300, 485, 476, 477, 1282,
+	2129, 1002, 1001, 1314, 1317, 2134, 2136, 2135, 2137, 1120,
+	1128, 1326, 57, 1539, 1543, 16, 15, 1551, 1511, 14,
+	13, 12, 18, 17, 7, 10, 506, 1037, 1153, 1152,
+	1505, 1379, 1395, 1779, 1149, 1546, 1874, 6, 11, 54,
+	2156, 2058, 1729, 1388, 1389, 2229, 1225, 2173, 1008, 1398,
+	1399, 2295, 1402, 2280, 1229, 1008, 47, 1361, 1362, 1241,
+	1498, 2292, 1404, 1242, 2261, 2171, 2163, 1243, 1137, 2160,
+	1594, 1595, 1596, 1597, 1598, 1599, 1600, 1601, 1602, 1603,
+	1604, 1605, 1606, 1607, 1608, 1516, 2007, 1395, 1928, 1244,
+	1526, 2060, 1228, 1250, 1943, 1698, 1559, 1258, 1388, 1389,
+	2, 0, 0, 1650, 0, 1249, 1641, 0, 1399, 1398,
+	1411, 0, 1402, 0, 1532, 1632, 0, 0, 0, 0,
+	0, 0, 1619, 0, 0, 1648, 0, 0, 0, 0,
+	0, 1653, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1413, 0, 0, 1537, 0, 0, 1410, 0, 1663,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1225,
+	0, 0, 1593, 0, 1570, 1644, 0, 1229, 1565, 0,
+	1557, 0, 1241, 0, 0, 0, 1242, 0, 1592, 0,
+	1243, 0, 1581, 1580, 0, 0, 1348, 0, 0, 1353,
+	0, 0, 1337, 1338, 1339, 1340, 1344, 1347, 1343, 1346,
+	1341, 1342, 1244, 0, 0, 1228, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1345, 1349, 0, 0,
+	0, 0, 0, 0, 1642, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1670, 0, 0, 0, 1662,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1686,
+	0, 0, 0, 0, 0, 0, 1659, 1660, 0, 0,
+	0, 0, 1352, 0, 0, 0, 1069, 0, 1354, 1250,
+	0, 0, 0, 0, 0, 0, 1710, 0, 0, 0,
+	0, 1249, 0, 0, 0, 0, 0, 0, 0, 1322,
+	1357, 1070, 0, 1336, 0, 0, 0, 1366, 0, 0,
+	0, 0, 0, 1068, 1738, 0, 0, 0, 0, 1330,
+	0, 1008, 0, 0, 0, 0, 0, 0, 1740, 1728,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1225, 0, 0, 1674, 0,
+	0, 0, 0, 1229, 0, 0, 0, 0, 1241, 1734,
+	0, 0, 1242, 0, 0, 0, 1243, 0, 1739, 1771,
+	1411, 1677, 1767, 1769, 1762, 0, 0, 0, 0, 1641,
+	1641, 1641, 1695, 1775, 1776, 1703, 1716, 0, 1244, 0,
+	1782, 1228, 1694, 1696, 1718, 1706, 1367, 1619, 1764, 458,
+	1743, 1413, 1107, 1748, 999, 1758, 1725, 1410, 1796, 1792,
+	1570, 0, 1790, 0, 1719, 0, 0, 0, 0, 0,
+	458, 1008, 0, 0, 0, 1765, 0, 0, 0, 1815,
+	0, 1827, 0, 1543, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1761, 0, 0, 1551, 1830, 1831, 0,
+	1829, 1834, 0, 1250, 0, 1008, 0, 1263, 1763, 0,
+	0, 0, 0, 1866, 1869, 1249, 0, 1738, 0, 0,
+	0, 1780, 1008, 0, 1008, 0, 0, 0, 1740, 1008,
+	0, 1740, 0, 1774, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1886,
+	0, 0, 0, 0, 0, 0, 0, 0, 1909, 1910,
+	1912, 0, 1872, 0, 0, 1641, 1824, 0, 1739, 1225,
+	999, 1739, 1812, 1212, 1915, 1816, 0, 1229, 1864, 0,
+	1828, 0, 1241, 1841, 1840, 0, 1242, 1805, 1807, 1808,
+	1243, 1686, 0, 0, 0, 1868, 1859, 1871, 1758, 1758,
+	0, 0, 1873, 0, 0, 0, 0, 0, 1880, 1881,
+	1882, 1883, 1244, 1411, 0, 1228, 0, 0, 1137, 0,
+	47, 0, 0, 0, 0, 0, 1710, 0, 0, 0,
+	0, 1738, 0, 0, 1738, 0, 1761, 1761, 0, 0,
+	0, 0, 0, 0, 1413, 1740, 1970, 1933, 1740, 1866,
+	1410, 0, 1968, 1921, 1940, 1920, 1968, 0, 0, 0,
+	0, 1008, 1008, 1008, 1008, 0, 0, 1926, 1411, 1908,
+	1641, 1927, 0, 1976, 0, 0, 1734, 0, 1981, 1734,
+	1982, 1942, 0, 0, 1946, 1739, 0, 1917, 1739, 1947,
+	1918, 0, 0, 0, 0, 0, 0, 1996, 0, 1413,
+	1641, 0, 0, 1962, 1641, 1410, 0, 0, 0, 2002,
+	0, 2009, 0, 1539, 1864, 0, 1958, 458, 0, 1961,
+	1972, 0, 2011, 1975, 0, 2027, 2016, 1758, 1758, 1758,
+	1758, 1758, 1758, 1758, 1758, 1758, 1758, 1758, 1758, 1740,
+	1738, 1738, 1995, 0, 1738, 1966, 1967, 0, 0, 2039,
+	2050, 0, 2050, 0, 1740, 1740, 505, 0, 1740, 2028,
+	2054, 0, 0, 0, 0, 1761, 1761, 1761, 1761, 1761,
+	1761, 1761, 1761, 1761, 1761, 1761, 1761, 2006, 2003, 1739,
+	2012, 0, 2056, 0, 0, 1734, 1734, 0, 0, 1734,
+	0, 0, 1575, 2031, 1739, 1739, 458, 0, 1739, 1591,
+	0, 0, 2052, 0, 1998, 2066, 0, 0, 1908, 0,
+	2040, 458, 0, 1411, 0, 1411, 0, 0, 0, 2053,
+	2112, 0, 2111, 0, 0, 2041, 2042, 1738, 1411, 2047,
+	0, 0, 2050, 2133, 2110, 0, 0, 0, 2144, 0,
+	0, 1740, 0, 0, 1413, 2071, 1413, 2057, 0, 1350,
+	1410, 1351, 1410, 1411, 1940, 0, 2102, 0, 0, 1413,
+	0, 0, 0, 0, 2069, 1410, 0, 505, 2113, 2128,
+	0, 0, 1734, 2150, 0, 0, 0, 0, 2142, 0,
+	0, 1739, 2152, 0, 1413, 0, 0, 2027, 2130, 2186,
+	1410, 2177, 0, 0, 2174, 0, 0, 0, 2179, 0,
+	0, 1740, 2182, 0, 0, 0, 0, 0, 0, 0,
+	2189, 2202, 2145, 0, 2187, 0, 0, 0, 0, 0,
+	1069, 2028, 0, 0, 1551, 0, 0, 2050, 0, 2210,
+	2183, 1366, 2150, 2192, 0, 0, 2193, 2194, 1551, 0,
+	0, 1739, 458, 2216, 0, 1070, 0, 0, 0, 0,
+	0, 2219, 0, 0, 0, 0, 2211, 1068, 2214, 0,
+	0, 0, 1543, 0, 1367, 2233, 0, 2231, 0, 0,
+	0, 0, 999, 0, 0, 0, 1551, 0, 1551, 1355,
+	0, 2242, 2235, 2245, 0, 0, 2232, 2249, 2236, 2234,
+	1356, 1411, 0, 0, 2237, 0, 1069, 0, 2133, 0,
+	0, 2246, 0, 2071, 0, 0, 2250, 1758, 0, 0,
+	0, 2251, 2252, 1900, 1901, 0, 2263, 0, 2255, 2259,
+	1411, 1070, 1413, 0, 0, 1551, 0, 2264, 1410, 2267,
+	2269, 2266, 2128, 1068, 2268, 0, 2150, 2275, 1551, 1551,
+	0, 0, 2274, 2276, 2277, 1761, 2133, 0, 0, 0,
+	0, 1413, 0, 0, 2249, 1749, 2283, 1410, 0, 2285,
+	0, 2174, 2284, 1069, 0, 0, 0, 0, 1869, 2300,
+	2301, 2302, 0, 1411, 2298, 0, 0, 0, 0, 0,
+	0, 0, 2299, 0, 458, 0, 0, 2311, 1070, 1869,
+	0, 2312, 0, 2310, 0, 2313, 2317, 1411, 2316, 0,
+	1068, 0, 1641, 2299, 1413, 2314, 0, 0, 0, 0,
+	1410, 0, 0, 0, 2294, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1413, 2071,
+	0, 0, 0, 0, 1410, 0, 0, 0, 2294, 0,
+	0, 0, 1983, 1984, 1985, 1986, 1987, 1988, 1989, 1990,
+	1991, 1992, 1993, 1994, 0, 1263, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1845,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1878, 0, 1879, 0,
+	0, 0, 0, 1884, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1905,
+	0, 0, 0, 1348, 0, 0, 1353, 0, 0, 1337,
+	1338, 1339, 1340, 1344, 1347, 1343, 1346, 1341, 1342, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1345, 1349, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1352,
+	0, 0, 0, 0, 0, 1354, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1357, 0, 0,
+	1336, 0, 0, 0, 0, 1977, 1978, 1979, 1980, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1409, 2224, 527, 1414, 1429, 1418, 1086, 1446, 1423,
+	1486, 1433, 1424, 511, 512, 513, 1444, 1438, 1445, 108,
+	82, 120, 406, 1491, 128, 316, 1475, 246, 182, 371,
+	445, 142, 349, 199, 87, 112, 171, 208, 317, 1074,
+	207, 297, 1463, 144, 126, 302, 96, 176, 292, 447,
+	1468, 1478, 123, 290, 1459, 320, 430, 219, 306, 372,
+	1494, 113, 197, 321, 332, 1465, 291, 314, 392, 173,
+	312, 192, 200, 217, 236, 240, 394, 184, 187, 275,
+	352, 1071, 224, 351, 414, 453, 1466, 345, 259, 266,
+	260, 274, 334, 336, 358, 410, 391, 174, 305, 365,
+	597, 618, 151, 86, 178, 1493, 186, 325, 359, 409,
+	201, 130, 162, 353, 338, 415, 421, 98, 245, 347,
+	93, 231, 279, 361, 135, 227, 379, 109, 153, 295,
+	329, 228, 251, 272, 341, 416, 1076, 134, 175, 337,
+	1089, 241, 315, 425, 165, 263, 396, 307, 328, 356,
+	598, 89, 276, 368, 374, 596, 1079, 1477, 194, 243,
+	124, 198, 242, 333, 172, 215, 418, 152, 221, 253,
+	97, 156, 255, 303, 378, 1092, 1476, 118, 204, 327,
+	104, 267, 331, 384, 229, 85, 225, 261, 136, 367,
+	1488, 111, 132, 226, 293, 1464, 269, 281, 1485, 90,
+	168, 129, 145, 237, 301, 296, 318, 380, 452, 150,
+	250, 264, 340, 1497, 180, 424, 256, 339, 190, 319,
+	389, 1481, 335, 188, 214, 1480, 220, 1460, 206, 1085,
+	258, 1487, 106, 212, 386, 436, 154, 284, 216, 265,
+	166, 1492, 91, 94, 203, 294, 324, 1496, 122, 181,
+	233, 360, 183, 211, 443, 1490, 270, 287, 232, 313,
+	350, 1457, 101, 298, 408, 448, 115, 252, 309, 446,
+	195, 322, 364, 1471, 95, 114, 159, 100, 234, 247,
+	382, 390, 160, 326, 444, 1482, 308, 127, 230, 417,
+	99, 102, 103, 282, 385, 254, 439, 599, 119, 137,
+	222, 402, 244, 299, 1454, 116, 428, 273, 342, 1467,
+	125, 285, 300, 370, 84, 277, 420, 449, 205, 377,
+	1469, 83, 107, 1073, 1458, 268, 369, 196, 239, 429,
+	1091, 1489, 440, 1442, 426, 451, 1093, 88, 271, 383,
+	450, 110, 210, 346, 362, 366, 143, 149, 289, 1474,
+	602, 1462, 163, 167, 348, 387, 419, 283, 1422, 1421,
+	1427, 521, 1417, 1430, 1434, 1416, 1447, 540, 1088, 1078,
+	1090, 1084, 1080, 1420, 1432, 544, 543, 537, 538, 1415,
+	542, 541, 624, 1439, 1431, 1428, 1426, 1443, 1450, 1425,
+	1449, 1451, 1495, 548, 547, 545, 546, 0, 0, 0,
+	0, 0, 1484, 1082, 566, 1441, 557, 0, 556, 560,
+	0, 0, 0, 0, 558, 559, 148, 286, 343, 1453,
+	1452, 1072, 1077, 147, 133, 189, 288, 164, 179, 161,
+	0, 567, 1448, 551, 131, 573, 433, 434, 1436, 1437,
+	435, 397, 432, 1435, 1419, 404, 403, 427, 399, 398,
+	393, 238, 140, 191, 235, 323, 401, 400, 442, 422,
+	423, 431, 381, 438, 437, 413, 388, 395, 193, 141,
+	405, 146, 257, 280, 354, 0, 1440, 1483, 0, 1473,
+	1470, 249, 1087, 155, 1456, 1455, 1472, 0, 177, 218,
+	616, 344, 357, 185, 355, 373, 1479, 262, 626, 625,
+	1461, 550, 138, 248, 1409, 0, 527, 1414, 1429, 1418,
+	1086, 1446, 1423, 1486, 1433, 1424, 511, 512, 513, 1444,
+	1438, 1445, 108, 82, 120, 406, 1491, 128, 316, 1475,
+	246, 182, 371, 445, 142, 349, 199, 87, 112, 171,
+	208, 317, 1074, 207, 297, 1463, 144, 126, 302, 96,
+	176, 292, 447, 1468, 1478, 123, 290, 1459, 320, 430,
+	219, 306, 372, 1494, 113, 197, 321, 332, 1465, 291,
+	314, 392, 173, 312, 192, 200, 217, 236, 240, 394,
+	184, 187, 275, 352, 1071, 224, 351, 414, 453, 1466,
+	345, 259, 266, 260, 274, 334, 336, 358, 410, 391,
+	174, 305, 365, 597, 618, 151, 86, 178, 1493, 186,
+	325, 359, 409, 201, 130, 162, 353, 338, 415, 421,
+	98, 245, 347, 93, 231, 279, 361, 135, 227, 379,
+	109, 153, 295, 329, 228, 251, 272, 341, 416, 1076,
+	134, 175, 337, 1089, 241, 315, 425, 165, 263, 396,
+	307, 328, 356, 598, 89, 276, 368, 374, 596, 1079,
+	1477, 194, 243, 124, 198, 242, 333, 172, 215, 418,
+	152, 221, 253, 97, 156, 255, 303, 378, 1092, 1476,
+	118, 204, 327, 104, 267, 331, 384, 229, 85, 225,
+	261, 136, 367, 1488, 111, 132, 226, 293, 1464, 269,
+	281, 1485, 90, 168, 129, 145, 237, 301, 296, 318,
+	380, 452, 150, 250, 264, 340, 1497, 180, 424, 256,
+	339, 190, 319, 389, 1481, 335, 188, 214, 1480, 220,
+	1460, 206, 1085, 258, 1487, 106, 212, 386, 436, 154,
+	284, 216, 265, 166, 1492, 91, 94, 203, 294, 324,
+	1496, 122, 181, 233, 360, 183, 211, 443, 1490, 270,
+	287, 232, 313, 350, 1457, 101, 298, 408, 448, 115,
+	252, 309, 446, 195, 322, 364, 1471, 95, 114, 159,
+	100, 234, 247, 382, 390, 160, 326, 444, 1482, 308,
+	127, 230, 417, 99, 102, 103, 282, 385, 254, 439,
+	599, 119, 137, 222, 402, 244, 299, 1454, 116, 428,
+	273, 342, 1467, 125, 285, 300, 370, 84, 277, 420,
+	449, 205, 377, 1469, 83, 107, 1073, 1458, 268, 369,
+	196, 239, 429, 1091, 1489, 440, 1442, 426, 451, 1093,
+	88, 271, 383, 450, 110, 210, 346, 362, 366, 143,
+	149, 289, 1474, 602, 1462, 163, 167, 348, 387, 419,
+	283, 1422, 1421, 1427, 521, 1417, 1430, 1434, 1416, 1447,
+	540, 1088, 1078, 1090, 1084, 1080, 1420, 1432, 544, 543,
+	537, 538, 1415, 542, 541, 624, 1439, 1431, 1428, 1426,
+	1443, 1450, 1425, 1449, 1451, 1495, 548, 547, 545, 546,
+	0, 0, 0, 0, 0, 1484, 1082, 566, 1441, 557,
+	0, 1766, 560, 0, 0, 0, 0, 558, 559, 148,
+	286, 343, 1453, 1452, 1072, 1077, 147, 133, 189, 288,
+	164, 179, 161, 0, 567, 1448, 551, 131, 573, 433,
+	434, 1436, 1437, 435, 397, 432, 1435, 1419, 404, 403,
+	427, 399, 398, 393, 238, 140, 191, 235, 323, 401,
+	400, 442, 422, 423, 431, 381, 438, 437, 413, 388,
+	395, 193, 141, 405, 146, 257, 280, 354, 0, 1440,
+	1483, 0, 1473, 1470, 249, 1087, 155, 1456, 1455, 1472,
+	0, 177, 218, 616, 344, 357, 185, 355, 373, 1479,
+	262, 626, 625, 1461, 550, 138, 248, 503, 504, 0,
+	527, 528, 535, 536, 942, 517, 534, 610, 509, 510,
+	511, 512, 513, 514, 516, 515, 651, 627, 662, 922,
+	615, 669, 838, 594, 773, 716, 890, 968, 681, 869,
+	732, 632, 655, 706, 740, 839, 917, 739, 822, 582,
+	683, 667, 827, 640, 710, 817, 970, 587, 601, 664,
+	815, 578, 842, 951, 749, 830, 891, 620, 656, 730,
+	843, 852, 584, 816, 836, 906, 708, 834, 725, 733,
+	747, 764, 767, 907, 718, 721, 801, 872, 909, 753,
+	871, 931, 976, 585, 865, 786, 792, 787, 800, 854,
+	856, 878, 929, 905, 709, 829, 884, 597, 618, 690,
+	631, 712, 619, 720, 846, 879, 926, 734, 671, 699,
+	873, 858, 932, 938, 642, 772, 867, 637, 760, 804,
+	881, 676, 756, 896, 652, 692, 820, 850, 757, 778,
+	798, 861, 933, 918, 675, 175, 857, 0, 768, 837,
+	945, 702, 790, 910, 831, 849, 876, 598, 634, 802,
+	887, 893, 596, 927, 600, 727, 770, 665, 731, 769,
+	853, 707, 745, 935, 691, 751, 780, 641, 695, 782,
+	828, 895, 959, 595, 660, 736, 848, 648, 793, 851,
+	900, 758, 630, 754, 788, 677, 886, 612, 654, 673,
+	755, 818, 583, 795, 806, 609, 635, 705, 670, 684,
+	765, 826, 821, 840, 897, 975, 689, 777, 264, 860,
+	623, 714, 944, 783, 859, 724, 841, 903, 605, 855,
+	722, 744, 604, 750, 579, 738, 940, 785, 611, 649,
+	743, 902, 958, 693, 809, 746, 791, 703, 617, 636,
+	638, 735, 819, 845, 622, 663, 715, 762, 880, 717,
+	742, 966, 614, 796, 812, 761, 835, 870, 576, 645,
+	823, 925, 971, 658, 779, 833, 969, 728, 844, 883,
+	590, 639, 657, 696, 644, 763, 774, 898, 904, 697,
+	847, 967, 606, 832, 668, 759, 934, 643, 646, 647,
+	807, 901, 781, 962, 599, 661, 678, 752, 915, 771,
+	824, 572, 659, 948, 799, 862, 586, 666, 810, 825,
+	889, 629, 803, 937, 972, 737, 894, 588, 628, 650,
+	916, 577, 794, 888, 729, 766, 950, 956, 613, 963,
+	507, 946, 974, 965, 633, 797, 899, 973, 653, 741,
+	866, 882, 885, 682, 688, 814, 593, 602, 581, 700,
+	704, 868, 387, 936, 808, 518, 519, 520, 521, 522,
+	523, 529, 530, 531, 540, 949, 924, 953, 939, 928,
+	532, 533, 544, 543, 537, 538, 539, 542, 541, 624,
+	562, 524, 525, 526, 508, 554, 552, 553, 555, 621,
+	548, 547, 545, 546, 0, 0, 0, 0, 0, 608,
+	0, 566, 561, 557, 0, 556, 560, 0, 0, 0,
+	0, 558, 559, 687, 811, 863, 565, 564, 912, 923,
+	686, 674, 723, 813, 701, 713, 698, 0, 567, 549,
+	551, 672, 573, 954, 955, 568, 571, 957, 911, 952,
+	569, 570, 920, 919, 947, 914, 913, 393, 238, 140,
+	191, 235, 323, 0, 0, 964, 941, 943, 0, 381,
+	961, 960, 930, 388, 908, 726, 680, 921, 685, 784,
+	805, 874, 0, 563, 607, 0, 592, 589, 776, 0,
+	694, 575, 574, 591, 0, 711, 748, 616, 864, 877,
+	719, 875, 892, 603, 789, 626, 625, 580, 550, 679,
+	775, 1066, 1067, 0, 0, 92, 310, 139, 1086, 0,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	1074, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 1071, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 416, 1076, 134, 175,
+	337, 1089, 241, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 1079, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 1092, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	1085, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 360, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 1073, 0, 268, 369, 196, 239,
+	429, 1091, 0, 440, 0, 426, 451, 1093, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 1088,
+	1078, 1090, 1084, 1080, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 1075, 330, 304, 223, 0, 0,
+	213, 0, 0, 0, 0, 0, 2204, 0, 0, 0,
+	0, 0, 0, 0, 1082, 1276, 1083, 0, 0, 2203,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	1275, 1274, 1072, 1077, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 433, 434, 411,
+	412, 435, 397, 432, 407, 157, 404, 403, 427, 399,
+	398, 393, 238, 140, 191, 235, 323, 401, 400, 442,
+	422, 423, 431, 381, 438, 437, 413, 388, 395, 193,
+	141, 405, 146, 257, 280, 354, 0, 1081, 0, 0,
+	0, 0, 249, 1087, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 0, 262, 0,
+	0, 0, 0, 138, 248, 1066, 1067, 1273, 0, 92,
+	310, 139, 1086, 0, 202, 0, 375, 209, 0, 0,
+	0, 0, 441, 0, 108, 82, 120, 406, 0, 128,
+	316, 0, 246, 182, 371, 445, 142, 349, 199, 87,
+	112, 171, 208, 317, 1074, 207, 297, 0, 144, 126,
+	302, 96, 176, 292, 447, 0, 0, 123, 290, 0,
+	320, 430, 219, 306, 372, 0, 113, 197, 321, 332,
+	0, 291, 314, 392, 173, 312, 192, 200, 217, 236,
+	240, 394, 184, 187, 275, 352, 1071, 224, 351, 414,
+	453, 0, 345, 259, 266, 260, 274, 334, 336, 358,
+	410, 391, 174, 305, 365, 0, 0, 151, 86, 178,
+	0, 186, 325, 359, 409, 201, 130, 162, 353, 338,
+	415, 421, 98, 245, 347, 93, 231, 279, 361, 135,
+	227, 379, 109, 153, 295, 329, 228, 251, 272, 341,
+	416, 1076, 134, 175, 337, 1089, 241, 315, 425, 165,
+	263, 396, 307, 328, 356, 0, 89, 276, 368, 374,
+	0, 1079, 0, 194, 243, 124, 198, 242, 333, 172,
+	215, 418, 152, 221, 253, 97, 156, 255, 303, 378,
+	1092, 0, 118, 204, 327, 104, 267, 331, 384, 229,
+	85, 225, 261, 136, 367, 0, 111, 132, 226, 293,
+	0, 269, 281, 0, 90, 168, 129, 145, 237, 301,
+	296, 318, 380, 452, 150, 250, 264, 340, 0, 180,
+	424, 256, 339, 190, 319, 389, 0, 335, 188, 214,
+	0, 220, 0, 206, 1085, 258, 0, 106, 212, 386,
+	436, 154, 284, 216, 265, 166, 0, 91, 94, 203,
+	294, 324, 0, 122, 181, 233, 360, 183, 211, 443,
+	0, 270, 287, 232, 313, 350, 0, 101, 298, 408,
+	448, 115, 252, 309, 446, 195, 322, 364, 0, 95,
+	114, 159, 100, 234, 247, 382, 390, 160, 326, 444,
+	0, 308, 127, 230, 417, 99, 102, 103, 282, 385,
+	254, 439, 0, 119, 137, 222, 402, 244, 299, 0,
+	116, 428, 273, 342, 0, 125, 285, 300, 370, 84,
+	277, 420, 449, 205, 377, 0, 83, 107, 1073, 0,
+	268, 369, 196, 239, 429, 1091, 0, 440, 0, 426,
+	451, 1093, 88, 271, 383, 450, 110, 210, 346, 362,
+	366, 143, 149, 289, 0, 0, 0, 163, 167, 348,
+	387, 419, 283, 170, 169, 278, 0, 121, 311, 376,
+	117, 0, 0, 1088, 1078, 1090, 1084, 1080, 158, 363,
+	0, 0, 0, 0, 105, 0, 0, 0, 1075, 330,
+	304, 223, 0, 0, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1082, 1276,
+	1083, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 148, 286, 343, 1275, 1274, 1072, 1077, 147, 133,
+	189, 288, 164, 179, 161, 0, 0, 0, 0, 131,
+	0, 433, 434, 411, 412, 435, 397, 432, 407, 157,
+	404, 403, 427, 399, 398, 393, 238, 140, 191, 235,
+	323, 401, 400, 442, 422, 423, 431, 381, 438, 437,
+	413, 388, 395, 193, 141, 405, 146, 257, 280, 354,
+	0, 1081, 0, 0, 0, 1279, 249, 1087, 155, 0,
+	0, 0, 0, 177, 218, 0, 344, 357, 185, 355,
+	373, 0, 262, 0, 0, 0, 0, 138, 248, 1066,
+	1067, 1273, 0, 92, 310, 139, 1086, 0, 202, 0,
+	375, 209, 0, 0, 0, 0, 441, 0, 108, 82,
+	120, 406, 0, 128, 316, 0, 246, 182, 371, 445,
+	142, 349, 199, 87, 112, 171, 208, 317, 1074, 207,
+	297, 0, 144, 126, 302, 96, 176, 292, 447, 0,
+	0, 123, 290, 0, 320, 430, 219, 306, 372, 0,
+	113, 197, 321, 332, 0, 291, 314, 392, 173, 312,
+	192, 200, 217, 236, 240, 394, 184, 187, 275, 352,
+	1071, 224, 351, 414, 453, 0, 345, 259, 266, 260,
+	274, 334, 336, 358, 410, 391, 174, 305, 365, 0,
+	0, 151, 86, 178, 0, 186, 325, 359, 409, 201,
+	130, 162, 353, 338, 415, 421, 98, 245, 347, 93,
+	231, 279, 361, 135, 227, 379, 109, 153, 295, 329,
+	228, 251, 272, 341, 416, 1076, 134, 175, 337, 1089,
+	241, 315, 425, 165, 263, 396, 307, 328, 356, 0,
+	89, 276, 368, 374, 0, 1079, 0, 194, 243, 124,
+	198, 242, 333, 172, 215, 418, 152, 221, 253, 97,
+	156, 255, 303, 378, 1092, 0, 118, 204, 327, 104,
+	267, 331, 384, 229, 85, 225, 261, 136, 367, 0,
+	111, 132, 226, 293, 0, 269, 281, 0, 90, 168,
+	129, 145, 237, 301, 296, 318, 380, 452, 150, 250,
+	264, 340, 0, 180, 424, 256, 339, 190, 319, 389,
+	0, 335, 188, 214, 0, 220, 0, 206, 1085, 258,
+	0, 106, 212, 386, 436, 154, 284, 216, 265, 166,
+	0, 91, 94, 203, 294, 324, 0, 122, 181, 233,
+	360, 183, 211, 443, 0, 270, 287, 232, 313, 350,
+	0, 101, 298, 408, 448, 115, 252, 309, 446, 195,
+	322, 364, 0, 95, 114, 159, 100, 234, 247, 382,
+	390, 160, 326, 444, 0, 308, 127, 230, 417, 99,
+	102, 103, 282, 385, 254, 439, 0, 119, 137, 222,
+	402, 244, 299, 0, 116, 428, 273, 342, 0, 125,
+	285, 300, 370, 84, 277, 420, 449, 205, 377, 0,
+	83, 107, 1073, 0, 268, 369, 196, 239, 429, 1091,
+	0, 440, 0, 426, 451, 1093, 88, 271, 383, 450,
+	110, 210, 346, 362, 366, 143, 149, 289, 0, 0,
+	0, 163, 167, 348, 387, 419, 283, 170, 169, 278,
+	0, 121, 311, 376, 117, 0, 0, 1088, 1078, 1090,
+	1084, 1080, 158, 363, 0, 0, 0, 0, 105, 0,
+	0, 0, 1075, 330, 304, 223, 0, 0, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1082, 1276, 1083, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 148, 286, 343, 1275, 1274,
+	1072, 1077, 147, 133, 189, 288, 164, 179, 161, 0,
+	0, 0, 0, 131, 0, 433, 434, 411, 412, 435,
+	397, 432, 407, 157, 404, 403, 427, 399, 398, 393,
+	238, 140, 191, 235, 323, 401, 400, 442, 422, 423,
+	431, 381, 438, 437, 413, 388, 395, 193, 141, 405,
+	146, 257, 280, 354, 0, 1081, 0, 0, 0, 1270,
+	249, 1087, 155, 0, 0, 0, 0, 177, 218, 0,
+	344, 357, 185, 355, 373, 0, 262, 0, 0, 0,
+	0, 138, 248, 1066, 1067, 1273, 0, 92, 310, 139,
+	1086, 0, 202, 0, 375, 209, 0, 0, 0, 0,
+	441, 0, 108, 82, 120, 406, 0, 128, 316, 0,
+	246, 182, 371, 445, 142, 349, 199, 87, 112, 171,
+	208, 317, 1074, 207, 297, 0, 144, 126, 302, 96,
+	176, 292, 447, 0, 0, 123, 290, 0, 320, 430,
+	219, 306, 372, 0, 113, 197, 321, 332, 0, 291,
+	314, 392, 173, 312, 192, 200, 217, 236, 240, 394,
+	184, 187, 275, 352, 1071, 224, 351, 414, 453, 0,
+	345, 259, 266, 260, 274, 334, 336, 358, 410, 391,
+	174, 305, 365, 0, 0, 151, 86, 178, 0, 186,
+	325, 359, 409, 201, 130, 162, 353, 338, 415, 421,
+	98, 245, 347, 93, 231, 279, 361, 135, 227, 379,
+	109, 153, 295, 329, 228, 251, 272, 341, 416, 1076,
+	134, 175, 337, 1089, 241, 315, 425, 165, 263, 396,
+	307, 328, 356, 0, 89, 276, 368, 374, 0, 1079,
+	0, 194, 243, 124, 198, 242, 333, 172, 215, 418,
+	152, 221, 253, 97, 156, 255, 303, 378, 1092, 0,
+	118, 204, 327, 104, 267, 331, 384, 229, 85, 225,
+	261, 136, 367, 0, 111, 132, 226, 293, 0, 269,
+	281, 0, 90, 168, 129, 145, 237, 301, 296, 318,
+	380, 452, 150, 250, 264, 340, 0, 180, 424, 256,
+	339, 190, 319, 389, 0, 335, 188, 214, 0, 220,
+	0, 206, 1085, 258, 0, 106, 212, 386, 436, 154,
+	284, 216, 265, 166, 0, 91, 94, 203, 294, 324,
+	0, 122, 181, 233, 360, 183, 211, 443, 0, 270,
+	287, 232, 313, 350, 0, 101, 298, 408, 448, 115,
+	252, 309, 446, 195, 322, 364, 0, 95, 114, 159,
+	100, 234, 247, 382, 390, 160, 326, 444, 0, 308,
+	127, 230, 417, 99, 102, 103, 282, 385, 254, 439,
+	0, 119, 137, 222, 402, 244, 299, 0, 116, 428,
+	273, 342, 0, 125, 285, 300, 370, 84, 277, 420,
+	449, 205, 377, 0, 83, 107, 1073, 0, 268, 369,
+	196, 239, 429, 1091, 0, 440, 0, 426, 451, 1093,
+	88, 271, 383, 450, 110, 210, 346, 362, 366, 143,
+	149, 289, 0, 0, 0, 163, 167, 348, 387, 419,
+	283, 170, 169, 278, 0, 121, 311, 376, 117, 0,
+	0, 1088, 1078, 1090, 1084, 1080, 158, 363, 0, 0,
+	0, 0, 105, 0, 0, 0, 1075, 330, 304, 223,
+	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1082, 1276, 1083, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 148,
+	286, 343, 1275, 1274, 1072, 1077, 147, 133, 189, 288,
+	164, 179, 161, 0, 0, 0, 0, 131, 0, 433,
+	434, 411, 412, 435, 397, 432, 407, 157, 404, 403,
+	427, 399, 398, 393, 238, 140, 191, 235, 323, 401,
+	400, 442, 422, 423, 431, 381, 438, 437, 413, 388,
+	395, 193, 141, 405, 146, 257, 280, 354, 0, 1081,
+	0, 0, 0, 0, 249, 1087, 155, 0, 0, 0,
+	0, 177, 218, 0, 344, 357, 185, 355, 373, 0,
+	262, 0, 0, 1066, 1067, 138, 248, 92, 310, 139,
+	1086, 0, 202, 0, 375, 209, 0, 0, 0, 0,
+	441, 0, 108, 82, 120, 406, 0, 128, 316, 0,
+	246, 182, 371, 445, 142, 349, 199, 87, 112, 171,
+	208, 317, 1074, 207, 297, 0, 144, 126, 302, 96,
+	176, 292, 447, 0, 0, 123, 290, 0, 320, 430,
+	219, 306, 372, 0, 113, 197, 321, 332, 0, 291,
+	314, 392, 173, 312, 192, 200, 217, 236, 240, 394,
+	184, 187, 275, 352, 1071, 224, 351, 414, 453, 0,
+	345, 259, 266, 260, 274, 334, 336, 358, 410, 391,
+	174, 305, 365, 0, 0, 151, 86, 178, 0, 186,
+	325, 359, 409, 201, 130, 162, 353, 338, 415, 421,
+	98, 245, 347, 93, 231, 279, 361, 135, 227, 379,
+	109, 153, 295, 329, 228, 251, 272, 341, 416, 1076,
+	134, 175, 337, 1089, 241, 315, 425, 165, 263, 396,
+	307, 328, 356, 0, 89, 276, 368, 374, 0, 1079,
+	0, 194, 243, 124, 198, 242, 333, 172, 215, 418,
+	152, 221, 253, 97, 156, 255, 303, 378, 1092, 0,
+	118, 204, 327, 104, 267, 331, 384, 229, 85, 225,
+	261, 136, 367, 0, 111, 132, 226, 293, 0, 269,
+	281, 0, 90, 168, 129, 145, 237, 301, 296, 318,
+	380, 452, 150, 250, 264, 340, 0, 180, 424, 256,
+	339, 190, 319, 389, 0, 335, 188, 214, 0, 220,
+	0, 206, 1085, 258, 0, 106, 212, 386, 436, 154,
+	284, 216, 265, 166, 0, 91, 94, 203, 294, 324,
+	0, 122, 181, 233, 360, 183, 211, 443, 0, 270,
+	287, 232, 313, 350, 0, 101, 298, 408, 448, 115,
+	252, 309, 446, 195, 322, 364, 0, 95, 114, 159,
+	100, 234, 247, 382, 390, 160, 326, 444, 0, 308,
+	127, 230, 417, 99, 102, 103, 282, 385, 254, 439,
+	0, 119, 137, 222, 402, 244, 299, 0, 116, 428,
+	273, 342, 0, 125, 285, 300, 370, 84, 277, 420,
+	449, 205, 377, 0, 83, 107, 1073, 0, 268, 369,
+	196, 239, 429, 1091, 0, 440, 0, 426, 451, 1093,
+	88, 271, 383, 450, 110, 210, 346, 362, 366, 143,
+	149, 289, 0, 0, 0, 163, 167, 348, 387, 419,
+	283, 170, 169, 278, 0, 121, 311, 376, 117, 0,
+	0, 1088, 1078, 1090, 1084, 1080, 158, 363, 0, 0,
+	0, 0, 105, 0, 0, 0, 1075, 330, 304, 223,
+	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1082, 1276, 1083, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 148,
+	286, 343, 1275, 1274, 1072, 1077, 147, 133, 189, 288,
+	164, 179, 161, 0, 0, 0, 0, 131, 0, 433,
+	434, 411, 412, 435, 397, 432, 407, 157, 404, 403,
+	427, 399, 398, 393, 238, 140, 191, 235, 323, 401,
+	400, 442, 422, 423, 431, 381, 438, 437, 413, 388,
+	395, 193, 141, 405, 146, 257, 280, 354, 0, 1081,
+	0, 0, 0, 0, 249, 1087, 155, 0, 0, 0,
+	0, 177, 218, 0, 344, 357, 185, 355, 373, 0,
+	262, 0, 0, 1066, 1067, 138, 248, 92, 310, 139,
+	1086, 0, 202, 0, 375, 209, 0, 0, 0, 0,
+	441, 0, 108, 82, 120, 406, 0, 128, 316, 0,
+	246, 182, 371, 445, 142, 349, 199, 87, 112, 171,
+	208, 317, 1074, 207, 297, 0, 144, 126, 302, 96,
+	176, 292, 447, 0, 0, 123, 290, 0, 320, 430,
+	219, 306, 372, 0, 113, 197, 321, 332, 0, 291,
+	314, 392, 173, 312, 192, 200, 217, 236, 240, 394,
+	184, 187, 275, 352, 1071, 224, 351, 414, 453, 0,
+	345, 259, 266, 260, 274, 334, 336, 358, 410, 391,
+	174, 305, 365, 0, 0, 151, 86, 178, 0, 186,
+	325, 359, 409, 201, 130, 162, 353, 338, 415, 421,
+	98, 245, 347, 93, 231, 279, 361, 135, 227, 379,
+	109, 153, 295, 329, 228, 251, 272, 341, 416, 1076,
+	134, 175, 337, 1089, 241, 315, 425, 165, 263, 396,
+	307, 328, 356, 0, 89, 276, 368, 374, 0, 1079,
+	0, 194, 243, 124, 198, 242, 333, 172, 215, 418,
+	152, 221, 253, 97, 156, 255, 303, 378, 1092, 0,
+	118, 204, 327, 104, 267, 331, 384, 229, 85, 225,
+	261, 136, 367, 0, 111, 132, 226, 293, 0, 269,
+	281, 0, 90, 168, 129, 145, 237, 301, 296, 318,
+	380, 452, 150, 250, 264, 340, 0, 180, 424, 256,
+	339, 190, 319, 389, 0, 335, 188, 214, 0, 220,
+	0, 206, 1085, 258, 0, 106, 212, 386, 436, 154,
+	284, 216, 265, 166, 0, 91, 94, 203, 294, 324,
+	0, 122, 181, 233, 360, 183, 211, 443, 0, 270,
+	287, 232, 313, 350, 0, 101, 298, 408, 448, 115,
+	252, 309, 446, 195, 322, 364, 0, 95, 114, 159,
+	100, 234, 247, 382, 390, 160, 326, 444, 0, 308,
+	127, 230, 417, 99, 102, 103, 282, 385, 254, 439,
+	0, 119, 137, 222, 402, 244, 299, 0, 116, 428,
+	273, 342, 0, 125, 285, 300, 370, 84, 277, 420,
+	449, 205, 377, 0, 83, 107, 1073, 0, 268, 369,
+	196, 239, 429, 1091, 0, 440, 0, 426, 451, 1093,
+	88, 271, 383, 450, 110, 210, 346, 362, 366, 143,
+	149, 289, 0, 0, 0, 163, 167, 348, 387, 419,
+	283, 170, 169, 278, 0, 121, 311, 376, 117, 0,
+	0, 1088, 1078, 1090, 1084, 1080, 158, 363, 0, 0,
+	0, 0, 105, 0, 0, 0, 1075, 330, 304, 223,
+	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1082, 0, 1083, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 148,
+	286, 343, 0, 0, 1072, 1077, 147, 133, 189, 288,
+	164, 179, 161, 0, 0, 0, 0, 131, 0, 433,
+	434, 411, 412, 435, 397, 432, 407, 157, 404, 403,
+	427, 399, 398, 393, 238, 140, 191, 235, 323, 401,
+	400, 442, 422, 423, 431, 381, 438, 437, 413, 388,
+	395, 193, 141, 405, 146, 257, 280, 354, 0, 1081,
+	0, 0, 0, 1267, 249, 1087, 155, 0, 0, 0,
+	0, 177, 218, 0, 344, 357, 185, 355, 373, 0,
+	262, 0, 0, 1066, 1067, 138, 248, 92, 310, 139,
+	1086, 0, 202, 0, 375, 209, 0, 0, 0, 0,
+	441, 0, 108, 82, 120, 406, 0, 128, 316, 0,
+	246, 182, 371, 445, 142, 349, 199, 87, 112, 171,
+	208, 317, 1074, 207, 297, 0, 144, 126, 302, 96,
+	176, 292, 447, 0, 0, 123, 290, 0, 320, 430,
+	219, 306, 372, 0, 113, 197, 321, 332, 0, 291,
+	314, 392, 173, 312, 192, 200, 217, 236, 240, 394,
+	184, 187, 275, 352, 1071, 224, 351, 414, 453, 0,
+	345, 259, 266, 260, 274, 334, 336, 358, 410, 391,
+	174, 305, 365, 0, 0, 151, 86, 178, 0, 186,
+	325, 359, 409, 201, 130, 162, 353, 338, 415, 421,
+	98, 245, 347, 93, 231, 279, 361, 135, 227, 379,
+	109, 153, 295, 329, 228, 251, 272, 341, 416, 1076,
+	134, 175, 337, 1089, 241, 315, 425, 165, 263, 396,
+	307, 328, 356, 0, 89, 276, 368, 374, 0, 1079,
+	0, 194, 243, 124, 198, 242, 333, 172, 215, 418,
+	152, 221, 253, 97, 156, 255, 303, 378, 1092, 0,
+	118, 204, 327, 104, 267, 331, 384, 229, 85, 225,
+	261, 136, 367, 0, 111, 132, 226, 293, 0, 269,
+	281, 0, 90, 168, 129, 145, 237, 301, 296, 318,
+	380, 452, 150, 250, 264, 340, 0, 180, 424, 256,
+	339, 190, 319, 389, 0, 335, 188, 214, 0, 220,
+	0, 206, 1085, 258, 0, 106, 212, 386, 436, 154,
+	284, 216, 265, 166, 0, 91, 94, 203, 294, 324,
+	0, 122, 181, 233, 360, 183, 211, 443, 0, 270,
+	287, 232, 313, 350, 0, 101, 298, 408, 448, 115,
+	252, 309, 446, 195, 322, 364, 0, 95, 114, 159,
+	100, 234, 247, 382, 390, 160, 326, 444, 0, 308,
+	127, 230, 417, 99, 102, 103, 282, 385, 254, 439,
+	0, 119, 137, 222, 402, 244, 299, 0, 116, 428,
+	273, 342, 0, 125, 285, 300, 370, 84, 277, 420,
+	449, 205, 377, 0, 83, 107, 1073, 0, 268, 369,
+	196, 239, 429, 1091, 0, 440, 0, 426, 451, 1093,
+	88, 271, 383, 450, 110, 210, 346, 362, 366, 143,
+	149, 289, 0, 0, 0, 163, 167, 348, 387, 419,
+	283, 170, 169, 278, 0, 121, 311, 376, 117, 0,
+	0, 1088, 1078, 1090, 1084, 1080, 158, 363, 0, 0,
+	0, 0, 105, 0, 0, 0, 1075, 330, 304, 223,
+	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1082, 0, 1083, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 148,
+	286, 343, 0, 0, 1072, 1077, 147, 133, 189, 288,
+	164, 179, 161, 0, 0, 0, 0, 131, 0, 433,
+	434, 411, 412, 435, 397, 432, 407, 157, 404, 403,
+	427, 399, 398, 393, 238, 140, 191, 235, 323, 401,
+	400, 442, 422, 423, 431, 381, 438, 437, 413, 388,
+	395, 193, 141, 405, 146, 257, 280, 354, 0, 1081,
+	0, 0, 0, 0, 249, 1087, 155, 0, 0, 0,
+	0, 177, 218, 0, 344, 357, 185, 355, 373, 0,
+	262, 0, 0, 0, 0, 138, 248, 1155, 1174, 1156,
+	0, 92, 310, 139, 0, 58, 202, 56, 375, 209,
+	0, 0, 0, 0, 1368, 0, 108, 82, 120, 406,
+	0, 128, 316, 0, 246, 182, 371, 445, 142, 349,
+	199, 87, 112, 171, 208, 317, 0, 207, 297, 0,
+	144, 126, 302, 96, 176, 292, 447, 0, 0, 123,
+	290, 0, 320, 430, 219, 306, 372, 0, 113, 197,
+	321, 332, 0, 291, 314, 392, 173, 312, 192, 200,
+	217, 236, 240, 394, 184, 187, 275, 352, 0, 224,
+	351, 414, 453, 0, 345, 259, 266, 260, 274, 334,
+	336, 358, 410, 391, 174, 305, 365, 0, 0, 151,
+	86, 178, 0, 186, 325, 359, 409, 201, 130, 162,
+	353, 338, 415, 421, 98, 245, 347, 93, 231, 279,
+	361, 135, 227, 379, 109, 153, 295, 329, 228, 251,
+	272, 341, 416, 0, 134, 175, 337, 0, 241, 315,
+	425, 165, 263, 396, 307, 328, 356, 0, 89, 276,
+	368, 374, 0, 0, 0, 194, 243, 124, 198, 242,
+	333, 172, 215, 418, 152, 221, 253, 97, 156, 255,
+	303, 378, 0, 0, 118, 204, 327, 104, 267, 331,
+	384, 229, 85, 225, 261, 136, 367, 0, 111, 132,
+	226, 293, 0, 269, 281, 0, 90, 168, 129, 145,
+	237, 301, 296, 318, 380, 452, 150, 250, 264, 340,
+	0, 180, 424, 256, 339, 190, 319, 389, 0, 335,
+	188, 214, 0, 220, 0, 206, 0, 258, 0, 106,
+	212, 386, 436, 154, 284, 216, 265, 166, 0, 91,
+	94, 203, 294, 324, 0, 122, 181, 233, 360, 183,
+	211, 443, 0, 270, 287, 232, 313, 350, 0, 101,
+	298, 408, 448, 115, 252, 309, 446, 195, 322, 364,
+	0, 95, 114, 159, 100, 234, 247, 382, 390, 160,
+	326, 444, 0, 308, 127, 230, 417, 99, 102, 103,
+	282, 385, 254, 439, 0, 119, 137, 222, 402, 244,
+	299, 0, 116, 428, 273, 342, 0, 125, 285, 300,
+	370, 84, 277, 420, 449, 205, 377, 0, 83, 107,
+	0, 1166, 268, 369, 196, 239, 429, 0, 0, 440,
+	0, 426, 451, 0, 88, 271, 383, 450, 110, 210,
+	346, 362, 366, 143, 149, 289, 0, 0, 0, 163,
+	167, 348, 387, 419, 283, 170, 169, 278, 0, 121,
+	311, 376, 117, 0, 0, 0, 0, 0, 0, 0,
+	158, 363, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 330, 304, 223, 0, 0, 213, 0, 0, 0,
+	0, 0, 1148, 2206, 0, 0, 0, 0, 0, 1162,
+	0, 0, 0, 1140, 1141, 0, 0, 0, 0, 0,
+	0, 0, 0, 148, 286, 343, 1161, 1160, 0, 0,
+	147, 133, 189, 288, 164, 179, 161, 0, 0, 0,
+	0, 131, 1151, 1168, 434, 1175, 1176, 1177, 1178, 1179,
+	1180, 1181, 1182, 1183, 1184, 1185, 1192, 393, 238, 140,
+	191, 235, 323, 1194, 1193, 1197, 1195, 1196, 431, 381,
+	1190, 1191, 1173, 388, 395, 193, 141, 1154, 146, 257,
+	280, 354, 1147, 0, 1142, 0, 0, 1143, 249, 0,
+	155, 0, 0, 0, 0, 177, 218, 0, 344, 357,
+	185, 355, 373, 0, 262, 60, 59, 0, 0, 138,
+	248, 1155, 1174, 1156, 0, 92, 310, 139, 0, 0,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 241, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 1636, 122,
+	181, 233, 360, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 1166, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 223, 1637, 0,
+	213, 0, 0, 0, 0, 0, 1148, 1634, 0, 0,
+	0, 0, 0, 1162, 0, 0, 0, 1140, 1141, 1639,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	1161, 1160, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 1151, 1168, 434, 1175,
+	1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185,
+	1192, 393, 238, 140, 191, 235, 323, 1194, 1193, 1197,
+	1195, 1196, 431, 381, 1190, 1191, 1173, 388, 395, 193,
+	141, 1154, 146, 257, 280, 354, 1147, 0, 1142, 0,
+	1638, 1143, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 0, 262, 0,
+	0, 0, 0, 138, 248, 1155, 1174, 1156, 0, 92,
+	310, 139, 0, 58, 202, 56, 375, 209, 0, 0,
+	0, 0, 1368, 0, 108, 82, 120, 406, 0, 128,
+	316, 0, 246, 182, 371, 445, 142, 349, 199, 87,
+	112, 171, 208, 317, 0, 207, 297, 0, 144, 126,
+	302, 96, 176, 292, 447, 0, 0, 123, 290, 0,
+	320, 430, 219, 306, 372, 0, 113, 197, 321, 332,
+	0, 291, 314, 392, 173, 312, 192, 200, 217, 236,
+	240, 394, 184, 187, 275, 352, 0, 224, 351, 414,
+	453, 0, 345, 259, 266, 260, 274, 334, 336, 358,
+	410, 391, 174, 305, 365, 0, 0, 151, 86, 178,
+	0, 186, 325, 359, 409, 201, 130, 162, 353, 338,
+	415, 421, 98, 245, 347, 93, 231, 279, 361, 135,
+	227, 379, 109, 153, 295, 329, 228, 251, 272, 341,
+	416, 0, 134, 175, 337, 0, 241, 315, 425, 165,
+	263, 396, 307, 328, 356, 0, 89, 276, 368, 374,
+	0, 0, 0, 194, 243, 124, 198, 242, 333, 172,
+	215, 418, 152, 221, 253, 97, 156, 255, 303, 378,
+	0, 0, 118, 204, 327, 104, 267, 331, 384, 229,
+	85, 225, 261, 136, 367, 0, 111, 132, 226, 293,
+	0, 269, 281, 0, 90, 168, 129, 145, 237, 301,
+	296, 318, 380, 452, 150, 250, 264, 340, 0, 180,
+	424, 256, 339, 190, 319, 389, 0, 335, 188, 214,
+	0, 220, 0, 206, 0, 258, 0, 106, 212, 386,
+	436, 154, 284, 216, 265, 166, 0, 91, 94, 203,
+	294, 324, 0, 122, 181, 233, 360, 183, 211, 443,
+	0, 270, 287, 232, 313, 350, 0, 101, 298, 408,
+	448, 115, 252, 309, 446, 195, 322, 364, 0, 95,
+	114, 159, 100, 234, 247, 382, 390, 160, 326, 444,
+	0, 308, 127, 230, 417, 99, 102, 103, 282, 385,
+	254, 439, 0, 119, 137, 222, 402, 244, 299, 0,
+	116, 428, 273, 342, 0, 125, 285, 300, 370, 84,
+	277, 420, 449, 205, 377, 0, 83, 107, 0, 1166,
+	268, 369, 196, 239, 429, 0, 0, 440, 0, 426,
+	451, 0, 88, 271, 383, 450, 110, 210, 346, 362,
+	366, 143, 149, 289, 0, 0, 0, 163, 167, 348,
+	387, 419, 283, 170, 169, 278, 0, 121, 311, 376,
+	117, 0, 0, 0, 0, 0, 0, 0, 158, 363,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 330,
+	304, 223, 0, 0, 213, 0, 0, 0, 0, 0,
+	1148, 0, 0, 0, 0, 0, 0, 1162, 0, 0,
+	0, 1140, 1141, 0, 0, 0, 0, 0, 0, 0,
+	0, 148, 286, 343, 1161, 1160, 0, 0, 147, 133,
+	189, 288, 164, 179, 161, 0, 0, 0, 0, 131,
+	1151, 1168, 434, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
+	1182, 1183, 1184, 1185, 1192, 393, 238, 140, 191, 235,
+	323, 1194, 1193, 1197, 1195, 1196, 431, 381, 1190, 1191,
+	1173, 388, 395, 193, 141, 1154, 146, 257, 280, 354,
+	1147, 0, 1142, 0, 0, 1143, 249, 0, 155, 0,
+	0, 0, 0, 177, 218, 0, 344, 357, 185, 355,
+	373, 0, 262, 60, 59, 0, 0, 138, 248, 1155,
+	1174, 1156, 0, 92, 310, 139, 0, 0, 202, 0,
+	375, 209, 0, 0, 0, 0, 441, 0, 108, 82,
+	120, 406, 0, 128, 316, 0, 246, 182, 371, 445,
+	142, 349, 199, 87, 112, 171, 208, 317, 0, 207,
+	297, 0, 144, 126, 302, 96, 176, 292, 447, 0,
+	0, 123, 290, 0, 320, 430, 219, 306, 372, 0,
+	113, 197, 321, 332, 0, 291, 314, 392, 173, 312,
+	192, 200, 217, 236, 240, 394, 184, 187, 275, 352,
+	0, 224, 351, 414, 453, 0, 345, 259, 266, 260,
+	274, 334, 336, 358, 410, 391, 174, 305, 365, 0,
+	0, 151, 86, 178, 0, 186, 325, 359, 409, 201,
+	130, 162, 353, 338, 415, 421, 98, 245, 347, 93,
+	231, 279, 361, 135, 227, 379, 109, 153, 295, 329,
+	228, 251, 272, 341, 416, 0, 134, 175, 337, 0,
+	241, 315, 425, 165, 263, 396, 307, 328, 356, 0,
+	89, 276, 368, 374, 0, 0, 0, 194, 243, 124,
+	198, 242, 333, 172, 215, 418, 152, 221, 253, 97,
+	156, 255, 303, 378, 0, 0, 118, 204, 327, 104,
+	267, 331, 384, 229, 85, 225, 261, 136, 367, 0,
+	111, 132, 226, 293, 0, 269, 281, 0, 90, 168,
+	129, 145, 237, 301, 296, 318, 380, 452, 150, 250,
+	264, 340, 0, 180, 424, 256, 339, 190, 319, 389,
+	0, 335, 188, 214, 0, 220, 0, 206, 0, 258,
+	0, 106, 212, 386, 436, 154, 284, 216, 265, 166,
+	0, 91, 94, 203, 294, 324, 0, 122, 181, 233,
+	360, 183, 211, 443, 0, 270, 287, 232, 313, 350,
+	0, 101, 298, 408, 448, 115, 252, 309, 446, 195,
+	322, 364, 0, 95, 114, 159, 100, 234, 247, 382,
+	390, 160, 326, 444, 0, 308, 127, 230, 417, 99,
+	102, 103, 282, 385, 254, 439, 0, 119, 137, 222,
+	402, 244, 299, 0, 116, 428, 273, 342, 0, 125,
+	285, 300, 370, 84, 277, 420, 449, 205, 377, 0,
+	83, 107, 0, 1166, 268, 369, 196, 239, 429, 0,
+	0, 440, 0, 426, 451, 0, 88, 271, 383, 450,
+	110, 210, 346, 362, 366, 143, 149, 289, 0, 0,
+	0, 163, 167, 348, 387, 419, 283, 170, 169, 278,
+	0, 121, 311, 376, 117, 0, 0, 0, 0, 0,
+	0, 0, 158, 363, 0, 0, 0, 0, 105, 0,
+	0, 0, 0, 330, 304, 223, 0, 0, 213, 0,
+	0, 0, 0, 0, 1148, 0, 0, 0, 0, 0,
+	0, 1162, 0, 0, 0, 1140, 1141, 1138, 0, 0,
+	0, 0, 0, 0, 0, 148, 286, 343, 1161, 1160,
+	0, 0, 147, 133, 189, 288, 164, 179, 161, 0,
+	0, 0, 0, 131, 1151, 1168, 434, 1175, 1176, 1177,
+	1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1192, 393,
+	238, 140, 191, 235, 323, 1194, 1193, 1197, 1195, 1196,
+	431, 381, 1190, 1191, 1173, 388, 395, 193, 141, 1154,
+	146, 257, 280, 354, 1147, 0, 1142, 0, 0, 1143,
+	249, 0, 155, 0, 0, 0, 0, 177, 218, 0,
+	344, 357, 185, 355, 373, 0, 262, 0, 0, 0,
+	0, 138, 248, 1155, 1174, 1156, 0, 92, 310, 139,
+	0, 0, 202, 0, 375, 209, 0, 0, 0, 0,
+	441, 0, 108, 82, 120, 406, 0, 128, 316, 0,
+	246, 182, 371, 445, 142, 349, 199, 87, 112, 171,
+	208, 317, 0, 207, 297, 0, 144, 126, 302, 96,
+	176, 292, 447, 0, 0, 123, 290, 0, 320, 430,
+	219, 306, 372, 0, 113, 197, 321, 332, 0, 291,
+	314, 392, 173, 312, 192, 200, 217, 236, 240, 394,
+	184, 187, 275, 352, 0, 224, 351, 414, 453, 0,
+	345, 259, 266, 260, 274, 334, 336, 358, 410, 391,
+	174, 305, 365, 0, 0, 151, 86, 178, 0, 186,
+	325, 359, 409, 201, 130, 162, 353, 338, 415, 421,
+	98, 245, 347, 93, 231, 279, 361, 135, 227, 379,
+	109, 153, 295, 329, 228, 251, 272, 341, 416, 0,
+	134, 175, 337, 0, 241, 315, 425, 165, 263, 396,
+	307, 328, 356, 0, 89, 276, 368, 374, 0, 0,
+	0, 194, 243, 124, 198, 242, 333, 172, 215, 418,
+	152, 221, 253, 97, 156, 255, 303, 378, 0, 0,
+	118, 204, 327, 104, 267, 331, 384, 229, 85, 225,
+	261, 136, 367, 0, 111, 132, 226, 293, 0, 269,
+	281, 0, 90, 168, 129, 145, 237, 301, 296, 318,
+	380, 452, 150, 250, 264, 340, 0, 180, 424, 256,
+	339, 190, 319, 389, 0, 335, 188, 214, 0, 220,
+	0, 206, 0, 258, 0, 106, 212, 386, 436, 154,
+	284, 216, 265, 166, 0, 91, 94, 203, 294, 324,
+	1907, 122, 181, 233, 360, 183, 211, 443, 0, 270,
+	287, 232, 313, 350, 0, 101, 298, 408, 448, 115,
+	252, 309, 446, 195, 322, 364, 0, 95, 114, 159,
+	100, 234, 247, 382, 390, 160, 326, 444, 0, 308,
+	127, 230, 417, 99, 102, 103, 282, 385, 254, 439,
+	0, 119, 137, 222, 402, 244, 299, 0, 116, 428,
+	273, 342, 0, 125, 285, 300, 370, 84, 277, 420,
+	449, 205, 377, 0, 83, 107, 0, 1166, 268, 369,
+	196, 239, 429, 0, 0, 440, 0, 426, 451, 0,
+	88, 271, 383, 450, 110, 210, 346, 362, 366, 143,
+	149, 289, 0, 0, 0, 163, 167, 348, 387, 419,
+	283, 170, 169, 278, 0, 121, 311, 376, 117, 0,
+	0, 0, 0, 0, 0, 0, 158, 363, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 330, 304, 223,
+	0, 0, 213, 0, 0, 0, 0, 0, 1148, 0,
+	0, 0, 0, 0, 0, 1162, 0, 0, 0, 1140,
+	1141, 0, 0, 0, 0, 0, 0, 0, 0, 148,
+	286, 343, 1161, 1160, 0, 0, 147, 133, 189, 288,
+	164, 179, 161, 0, 0, 0, 0, 131, 1151, 1168,
+	434, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
+	1184, 1185, 1192, 393, 238, 140, 191, 235, 323, 1194,
+	1193, 1197, 1195, 1196, 431, 381, 1190, 1191, 1173, 388,
+	395, 193, 141, 1154, 146, 257, 280, 354, 1147, 0,
+	1142, 0, 0, 1143, 249, 0, 155, 0, 0, 0,
+	0, 177, 218, 0, 344, 357, 185, 355, 373, 0,
+	262, 0, 0, 0, 0, 138, 248, 1155, 1174, 1156,
+	0, 92, 310, 139, 0, 0, 202, 0, 375, 209,
+	0, 0, 0, 0, 441, 0, 108, 82, 120, 406,
+	0, 128, 316, 0, 246, 182, 371, 445, 142, 349,
+	199, 87, 112, 171, 208, 317, 0, 207, 297, 0,
+	144, 126, 302, 96, 176, 292, 447, 0, 0, 123,
+	290, 0, 320, 430, 219, 306, 372, 0, 113, 197,
+	321, 332, 0, 291, 314, 392, 173, 312, 192, 200,
+	217, 236, 240, 394, 184, 187, 275, 352, 0, 224,
+	351, 414, 453, 0, 345, 259, 266, 260, 274, 334,
+	336, 358, 410, 391, 174, 305, 365, 0, 0, 151,
+	86, 178, 0, 186, 325, 359, 409, 201, 130, 162,
+	353, 338, 415, 421, 98, 245, 347, 93, 231, 279,
+	361, 135, 227, 379, 109, 153, 295, 329, 228, 251,
+	272, 341, 416, 0, 134, 175, 337, 0, 241, 315,
+	425, 165, 263, 396, 307, 328, 356, 0, 89, 276,
+	368, 374, 0, 0, 0, 194, 243, 124, 198, 242,
+	333, 172, 215, 418, 152, 221, 253, 97, 156, 255,
+	303, 378, 0, 0, 118, 204, 327, 104, 267, 331,
+	384, 229, 85, 225, 261, 136, 367, 0, 111, 132,
+	226, 293, 0, 269, 281, 0, 90, 168, 129, 145,
+	237, 301, 296, 318, 380, 452, 150, 250, 264, 340,
+	0, 180, 424, 256, 339, 190, 319, 389, 0, 335,
+	188, 214, 0, 220, 0, 206, 0, 258, 0, 106,
+	212, 386, 436, 154, 284, 216, 265, 166, 0, 91,
+	94, 203, 294, 324, 0, 122, 181, 233, 360, 183,
+	211, 443, 0, 270, 287, 232, 313, 350, 0, 101,
+	298, 408, 448, 115, 252, 309, 446, 195, 322, 364,
+	0, 95, 114, 159, 100, 234, 247, 382, 390, 160,
+	326, 444, 0, 308, 127, 230, 417, 99, 102, 103,
+	282, 385, 254, 439, 0, 119, 137, 222, 402, 244,
+	299, 0, 116, 428, 273, 342, 0, 125, 285, 300,
+	370, 84, 277, 420, 449, 205, 377, 0, 83, 107,
+	0, 1166, 268, 369, 196, 239, 429, 0, 0, 440,
+	0, 426, 451, 0, 88, 271, 383, 450, 110, 210,
+	346, 362, 366, 143, 149, 289, 0, 0, 0, 163,
+	167, 348, 387, 419, 283, 170, 169, 278, 0, 121,
+	311, 376, 117, 0, 0, 0, 0, 0, 0, 0,
+	158, 363, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 330, 304, 223, 0, 0, 213, 0, 0, 0,
+	0, 0, 1148, 1649, 0, 0, 0, 0, 0, 1162,
+	0, 0, 0, 1140, 1141, 0, 0, 0, 0, 0,
+	0, 0, 0, 148, 286, 343, 1161, 1160, 0, 0,
+	147, 133, 189, 288, 164, 179, 161, 0, 0, 0,
+	0, 131, 1151, 1168, 434, 1175, 1176, 1177, 1178, 1179,
+	1180, 1181, 1182, 1183, 1184, 1185, 1192, 393, 238, 140,
+	191, 235, 323, 1194, 1193, 1197, 1195, 1196, 431, 381,
+	1190, 1191, 1173, 388, 395, 193, 141, 1154, 146, 257,
+	280, 354, 1147, 0, 1142, 0, 0, 1143, 249, 0,
+	155, 0, 0, 0, 0, 177, 218, 0, 344, 357,
+	185, 355, 373, 0, 262, 0, 0, 0, 0, 138,
+	248, 1155, 1174, 1156, 0, 92, 310, 139, 0, 0,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 241, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 360, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 1166, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 223, 0, 0,
+	213, 0, 0, 0, 0, 0, 1148, 0, 0, 1633,
+	0, 0, 0, 1162, 0, 0, 0, 1140, 1141, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	1161, 1160, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 1151, 1168, 434, 1175,
+	1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185,
+	1192, 393, 238, 140, 191, 235, 323, 1194, 1193, 1197,
+	1195, 1196, 431, 381, 1190, 1191, 1173, 388, 395, 193,
+	141, 1154, 146, 257, 280, 354, 1147, 0, 1142, 0,
+	0, 1143, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 0, 262, 0,
+	0, 0, 0, 138, 248, 1155, 1174, 1156, 0, 92,
+	310, 139, 0, 0, 202, 0, 375, 209, 0, 0,
+	0, 0, 441, 0, 108, 82, 120, 406, 0, 128,
+	316, 0, 246, 182, 371, 445, 142, 349, 199, 87,
+	112, 171, 208, 317, 0, 207, 297, 0, 144, 126,
+	302, 96, 176, 292, 447, 0, 0, 123, 290, 0,
+	320, 430, 219, 306, 372, 0, 113, 197, 321, 332,
+	0, 291, 314, 392, 173, 312, 192, 200, 217, 236,
+	240, 394, 184, 187, 275, 352, 0, 224, 351, 414,
+	453, 0, 345, 259, 266, 260, 274, 334, 336, 358,
+	410, 391, 174, 305, 365, 0, 0, 151, 86, 178,
+	0, 186, 325, 359, 409, 201, 130, 162, 353, 338,
+	415, 421, 98, 245, 347, 93, 231, 279, 361, 135,
+	227, 379, 109, 153, 295, 329, 228, 251, 272, 341,
+	416, 0, 134, 175, 337, 0, 241, 315, 425, 165,
+	263, 396, 307, 328, 356, 0, 89, 276, 368, 374,
+	0, 0, 0, 194, 243, 124, 198, 242, 333, 172,
+	215, 418, 152, 221, 253, 97, 156, 255, 303, 378,
+	0, 0, 118, 204, 327, 104, 267, 331, 384, 229,
+	85, 225, 261, 136, 367, 0, 111, 132, 226, 293,
+	0, 269, 281, 0, 90, 168, 129, 145, 237, 301,
+	296, 318, 380, 452, 150, 250, 264, 340, 0, 180,
+	424, 256, 339, 190, 319, 389, 0, 335, 188, 214,
+	0, 220, 0, 206, 0, 258, 0, 106, 212, 386,
+	436, 154, 284, 216, 265, 166, 0, 91, 94, 203,
+	294, 324, 0, 122, 181, 233, 360, 183, 211, 443,
+	0, 270, 287, 232, 313, 350, 0, 101, 298, 408,
+	448, 115, 252, 309, 446, 195, 322, 364, 0, 95,
+	114, 159, 100, 234, 247, 382, 390, 160, 326, 444,
+	0, 308, 127, 230, 417, 99, 102, 103, 282, 385,
+	254, 439, 0, 119, 137, 222, 402, 244, 299, 0,
+	116, 428, 273, 342, 0, 125, 285, 300, 370, 84,
+	277, 420, 449, 205, 377, 0, 83, 107, 0, 1166,
+	268, 369, 196, 239, 429, 0, 0, 440, 0, 426,
+	451, 0, 88, 271, 383, 450, 110, 210, 346, 362,
+	366, 143, 149, 289, 0, 0, 0, 163, 167, 348,
+	387, 419, 283, 170, 169, 278, 0, 121, 311, 376,
+	117, 0, 0, 0, 0, 0, 0, 0, 158, 363,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 330,
+	304, 223, 1316, 0, 213, 0, 0, 0, 0, 0,
+	1148, 0, 0, 0, 0, 0, 0, 1162, 0, 0,
+	0, 1140, 1141, 0, 0, 0, 0, 0, 0, 0,
+	0, 148, 286, 343, 1161, 1160, 0, 0, 147, 133,
+	189, 288, 164, 179, 161, 0, 0, 0, 0, 131,
+	1151, 1168, 434, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
+	1182, 1183, 1184, 1185, 1192, 393, 238, 140, 191, 235,
+	323, 1194, 1193, 1197, 1195, 1196, 431, 381, 1190, 1191,
+	1173, 388, 395, 193, 141, 1154, 146, 257, 280, 354,
+	1147, 0, 1142, 0, 0, 1143, 249, 0, 155, 0,
+	0, 0, 0, 177, 218, 0, 344, 357, 185, 355,
+	373, 0, 262, 0, 0, 0, 0, 138, 248, 1155,
+	1174, 1156, 0, 92, 310, 139, 0, 0, 202, 0,
+	375, 209, 0, 0, 0, 0, 441, 0, 108, 82,
+	120, 406, 0, 128, 316, 0, 246, 182, 371, 445,
+	142, 349, 199, 87, 112, 171, 208, 317, 0, 207,
+	297, 0, 144, 126, 302, 96, 176, 292, 447, 0,
+	0, 123, 290, 0, 320, 430, 219, 306, 372, 0,
+	113, 197, 321, 332, 0, 291, 314, 392, 173, 312,
+	192, 200, 217, 236, 240, 394, 184, 187, 275, 352,
+	0, 224, 351, 414, 453, 0, 345, 259, 266, 260,
+	274, 334, 336, 358, 410, 391, 174, 305, 365, 0,
+	0, 151, 86, 178, 0, 186, 325, 359, 409, 201,
+	130, 162, 353, 338, 415, 421, 98, 245, 347, 93,
+	231, 279, 361, 135, 227, 379, 109, 153, 295, 329,
+	228, 251, 272, 341, 416, 0, 134, 175, 337, 0,
+	241, 315, 425, 165, 263, 396, 307, 328, 356, 0,
+	89, 276, 368, 374, 0, 0, 0, 194, 243, 124,
+	198, 242, 333, 172, 215, 418, 152, 221, 253, 97,
+	156, 255, 303, 378, 0, 0, 118, 204, 327, 104,
+	267, 331, 384, 229, 85, 225, 261, 136, 367, 0,
+	111, 132, 226, 293, 0, 269, 281, 0, 90, 168,
+	129, 145, 237, 301, 296, 318, 380, 452, 150, 250,
+	264, 340, 0, 180, 424, 256, 339, 190, 319, 389,
+	0, 335, 188, 214, 0, 220, 0, 206, 0, 258,
+	0, 106, 212, 386, 436, 154, 284, 216, 265, 166,
+	0, 91, 94, 203, 294, 324, 0, 122, 181, 233,
+	360, 183, 211, 443, 0, 270, 287, 232, 313, 350,
+	0, 101, 298, 408, 448, 115, 252, 309, 446, 195,
+	322, 364, 0, 95, 114, 159, 100, 234, 247, 382,
+	390, 160, 326, 444, 0, 308, 127, 230, 417, 99,
+	102, 103, 282, 385, 254, 439, 0, 119, 137, 222,
+	402, 244, 299, 0, 116, 428, 273, 342, 0, 125,
+	285, 300, 370, 84, 277, 420, 449, 205, 377, 0,
+	83, 107, 0, 1166, 268, 369, 196, 239, 429, 0,
+	0, 440, 0, 426, 451, 0, 88, 271, 383, 450,
+	110, 210, 346, 362, 366, 143, 149, 289, 0, 0,
+	0, 163, 167, 348, 387, 419, 283, 170, 169, 278,
+	0, 121, 311, 376, 117, 0, 0, 0, 0, 0,
+	0, 0, 158, 363, 0, 0, 0, 0, 105, 0,
+	0, 0, 0, 330, 304, 223, 0, 0, 213, 0,
+	0, 0, 0, 0, 1148, 0, 0, 0, 0, 0,
+	0, 1162, 0, 0, 0, 1140, 1141, 0, 0, 0,
+	0, 0, 0, 0, 0, 148, 286, 343, 1161, 1160,
+	0, 0, 147, 133, 189, 288, 164, 179, 161, 0,
+	0, 0, 0, 131, 1151, 1168, 434, 1175, 1176, 1177,
+	1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1192, 393,
+	238, 140, 191, 235, 323, 1194, 1193, 1197, 1195, 1196,
+	431, 381, 1190, 1191, 1173, 388, 395, 193, 141, 1154,
+	146, 257, 280, 354, 1147, 0, 1142, 0, 0, 1143,
+	249, 0, 155, 0, 0, 0, 0, 177, 218, 0,
+	344, 357, 185, 355, 373, 0, 262, 0, 0, 0,
+	0, 138, 248, 1155, 1174, 1156, 0, 92, 310, 139,
+	0, 0, 202, 0, 375, 209, 0, 0, 0, 0,
+	441, 0, 108, 82, 120, 406, 0, 128, 316, 0,
+	246, 182, 371, 445, 142, 349, 199, 87, 112, 171,
+	208, 317, 0, 207, 297, 0, 144, 126, 302, 96,
+	176, 292, 447, 0, 0, 123, 290, 0, 320, 430,
+	219, 306, 372, 0, 113, 197, 321, 332, 0, 291,
+	314, 392, 173, 2140, 192, 200, 217, 236, 240, 394,
+	184, 187, 275, 352, 0, 224, 351, 414, 453, 0,
+	345, 259, 266, 260, 274, 334, 336, 358, 410, 391,
+	174, 305, 365, 0, 0, 151, 86, 178, 0, 186,
+	325, 359, 2141, 201, 130, 162, 353, 338, 415, 421,
+	98, 245, 347, 93, 231, 279, 361, 135, 227, 379,
+	109, 153, 295, 329, 228, 251, 272, 341, 416, 0,
+	2139, 175, 337, 0, 241, 315, 425, 165, 263, 396,
+	307, 328, 356, 0, 89, 276, 368, 374, 0, 0,
+	0, 194, 243, 124, 198, 242, 333, 172, 215, 418,
+	152, 221, 253, 97, 156, 255, 303, 378, 0, 0,
+	118, 204, 327, 104, 267, 331, 384, 229, 85, 225,
+	261, 136, 367, 0, 111, 132, 226, 293, 0, 269,
+	281, 0, 90, 168, 129, 145, 237, 301, 296, 318,
+	380, 452, 150, 250, 264, 340, 0, 180, 424, 256,
+	339, 190, 319, 389, 0, 335, 188, 214, 0, 220,
+	0, 206, 0, 258, 0, 106, 212, 386, 436, 154,
+	284, 216, 265, 166, 0, 91, 94, 203, 294, 324,
+	0, 122, 181, 233, 360, 183, 211, 443, 0, 270,
+	287, 232, 313, 350, 0, 101, 298, 408, 448, 115,
+	252, 309, 446, 195, 322, 364, 0, 95, 114, 159,
+	100, 234, 247, 382, 390, 160, 326, 444, 0, 308,
+	127, 230, 417, 99, 102, 103, 282, 385, 254, 439,
+	0, 119, 137, 222, 402, 244, 299, 0, 116, 428,
+	273, 342, 0, 125, 285, 300, 370, 84, 277, 420,
+	449, 205, 377, 0, 83, 107, 0, 1166, 268, 369,
+	196, 239, 429, 0, 0, 440, 0, 426, 451, 0,
+	88, 271, 383, 450, 110, 210, 346, 362, 366, 143,
+	149, 289, 0, 0, 0, 163, 167, 348, 387, 419,
+	283, 170, 169, 278, 0, 121, 311, 376, 117, 0,
+	0, 0, 0, 0, 0, 0, 158, 363, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 330, 304, 223,
+	0, 0, 213, 0, 0, 0, 0, 0, 2138, 0,
+	0, 0, 0, 0, 0, 1162, 0, 0, 0, 1140,
+	1141, 0, 0, 0, 0, 0, 0, 0, 0, 148,
+	286, 343, 1161, 1160, 0, 0, 147, 133, 189, 288,
+	164, 179, 161, 0, 0, 0, 0, 131, 1151, 1168,
+	434, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
+	1184, 1185, 1192, 393, 238, 140, 191, 235, 323, 1194,
+	1193, 1197, 1195, 1196, 431, 381, 1190, 1191, 1173, 388,
+	395, 193, 141, 1154, 146, 257, 280, 354, 1147, 0,
+	1142, 0, 0, 1143, 249, 0, 155, 0, 0, 0,
+	0, 177, 218, 0, 344, 357, 185, 355, 373, 0,
+	262, 0, 0, 0, 0, 138, 248, 1155, 1174, 1569,
+	0, 92, 310, 139, 0, 0, 202, 0, 375, 209,
+	0, 0, 0, 0, 441, 0, 108, 82, 120, 406,
+	0, 128, 316, 0, 246, 182, 371, 445, 142, 349,
+	199, 87, 112, 171, 208, 317, 0, 207, 297, 0,
+	144, 126, 302, 96, 176, 292, 447, 0, 0, 123,
+	290, 0, 320, 430, 219, 306, 372, 0, 113, 197,
+	321, 332, 0, 291, 314, 392, 173, 312, 192, 200,
+	217, 236, 240, 394, 184, 187, 275, 352, 0, 224,
+	351, 414, 453, 0, 345, 259, 266, 260, 274, 334,
+	336, 358, 410, 391, 174, 305, 365, 0, 0, 151,
+	86, 178, 0, 186, 325, 359, 409, 201, 130, 162,
+	353, 338, 415, 421, 98, 245, 347, 93, 231, 279,
+	361, 135, 227, 379, 109, 153, 295, 329, 228, 251,
+	272, 341, 416, 0, 134, 175, 337, 0, 241, 315,
+	425, 165, 263, 396, 307, 328, 356, 0, 89, 276,
+	368, 374, 0, 0, 0, 194, 243, 124, 198, 242,
+	333, 172, 215, 418, 152, 221, 253, 97, 156, 255,
+	303, 378, 0, 0, 118, 204, 327, 104, 267, 331,
+	384, 229, 85, 225, 261, 136, 367, 0, 111, 132,
+	226, 293, 0, 269, 281, 0, 90, 168, 129, 145,
+	237, 301, 296, 318, 380, 452, 150, 250, 264, 340,
+	0, 180, 424, 256, 339, 190, 319, 389, 0, 335,
+	188, 214, 0, 220, 0, 206, 0, 258, 0, 106,
+	212, 386, 436, 154, 284, 216, 265, 166, 0, 91,
+	94, 203, 294, 324, 0, 122, 181, 233, 360, 183,
+	211, 443, 0, 270, 287, 232, 313, 350, 0, 101,
+	298, 408, 448, 115, 252, 309, 446, 195, 322, 364,
+	0, 95, 114, 159, 100, 234, 247, 382, 390, 160,
+	326, 444, 0, 308, 127, 230, 417, 99, 102, 103,
+	282, 385, 254, 439, 0, 119, 137, 222, 402, 244,
+	299, 0, 116, 428, 273, 342, 0, 125, 285, 300,
+	370, 84, 277, 420, 449, 205, 377, 0, 83, 107,
+	0, 1166, 268, 369, 196, 239, 429, 0, 0, 440,
+	0, 426, 451, 0, 88, 271, 383, 450, 110, 210,
+	346, 362, 366, 143, 149, 289, 0, 0, 0, 163,
+	167, 348, 387, 419, 283, 170, 169, 278, 0, 121,
+	311, 376, 117, 0, 0, 0, 0, 0, 0, 0,
+	158, 363, 0, 0, 0, 0, 105, 0, 0, 0,
+	0, 330, 304, 223, 0, 0, 213, 0, 0, 0,
+	0, 0, 1148, 0, 0, 0, 0, 0, 0, 1162,
+	0, 0, 0, 1140, 1141, 0, 0, 0, 0, 0,
+	0, 0, 0, 148, 286, 343, 1161, 1160, 0, 0,
+	147, 133, 189, 288, 164, 179, 161, 0, 0, 0,
+	0, 131, 1151, 1168, 434, 1175, 1176, 1177, 1178, 1179,
+	1180, 1181, 1182, 1183, 1184, 1185, 1192, 393, 238, 140,
+	191, 235, 323, 1194, 1193, 1197, 1195, 1196, 431, 381,
+	1190, 1191, 1173, 388, 395, 193, 141, 1154, 146, 257,
+	280, 354, 1147, 0, 1142, 0, 0, 1143, 249, 0,
+	155, 0, 0, 0, 0, 177, 218, 0, 344, 357,
+	185, 355, 373, 0, 262, 0, 0, 0, 0, 138,
+	248, 1155, 1174, 1156, 0, 92, 310, 139, 0, 0,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 241, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 360, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 1166, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 223, 0, 0,
+	213, 0, 0, 0, 0, 0, 1148, 0, 0, 0,
+	0, 0, 0, 1162, 0, 0, 0, 1324, 1325, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	1161, 1160, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 1151, 1168, 434, 1175,
+	1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185,
+	1192, 393, 238, 140, 191, 235, 323, 1194, 1193, 1197,
+	1195, 1196, 431, 381, 1190, 1191, 1173, 388, 395, 193,
+	141, 1154, 146, 257, 280, 354, 1147, 0, 1142, 0,
+	0, 1143, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 0, 262, 0,
+	0, 0, 0, 138, 248, 1155, 1174, 1156, 0, 92,
+	310, 139, 0, 0, 202, 0, 375, 209, 0, 0,
+	0, 0, 441, 0, 108, 82, 120, 406, 0, 128,
+	316, 0, 246, 182, 371, 445, 142, 349, 199, 87,
+	112, 171, 208, 317, 0, 207, 297, 0, 144, 126,
+	302, 96, 176, 292, 447, 0, 0, 123, 290, 0,
+	320, 430, 219, 306, 372, 0, 113, 197, 321, 332,
+	0, 291, 314, 392, 173, 312, 192, 200, 217, 236,
+	240, 394, 184, 187, 275, 352, 0, 224, 351, 414,
+	453, 0, 345, 259, 266, 260, 274, 334, 336, 358,
+	410, 391, 174, 305, 365, 0, 0, 151, 86, 178,
+	0, 186, 325, 359, 409, 201, 130, 162, 353, 338,
+	415, 421, 98, 245, 347, 93, 231, 279, 361, 135,
+	227, 379, 109, 153, 295, 329, 228, 251, 272, 341,
+	416, 0, 134, 175, 337, 0, 241, 315, 425, 165,
+	263, 396, 307, 328, 356, 0, 89, 276, 368, 374,
+	0, 0, 0, 194, 243, 124, 198, 242, 333, 172,
+	215, 418, 152, 221, 253, 97, 156, 255, 303, 378,
+	0, 0, 118, 204, 327, 104, 267, 331, 384, 229,
+	85, 225, 261, 136, 367, 0, 111, 132, 226, 293,
+	0, 269, 281, 0, 90, 168, 129, 145, 237, 301,
+	296, 318, 380, 452, 150, 250, 264, 340, 0, 180,
+	424, 256, 339, 190, 319, 389, 0, 335, 188, 214,
+	0, 220, 0, 206, 0, 258, 0, 106, 212, 386,
+	436, 154, 284, 216, 265, 166, 0, 91, 94, 203,
+	294, 324, 0, 122, 181, 233, 360, 183, 211, 443,
+	0, 270, 287, 232, 313, 350, 0, 101, 298, 408,
+	448, 115, 252, 309, 446, 195, 322, 364, 0, 95,
+	114, 159, 100, 234, 247, 382, 390, 160, 326, 444,
+	0, 308, 127, 230, 417, 99, 102, 103, 282, 385,
+	254, 439, 0, 119, 137, 222, 402, 244, 299, 0,
+	116, 428, 273, 342, 0, 125, 285, 300, 370, 84,
+	277, 420, 449, 205, 377, 0, 83, 107, 0, 1166,
+	268, 369, 196, 239, 429, 0, 0, 440, 0, 426,
+	451, 0, 88, 271, 383, 450, 110, 210, 346, 362,
+	366, 143, 149, 289, 0, 0, 0, 163, 167, 348,
+	387, 419, 283, 170, 169, 278, 0, 121, 311, 376,
+	117, 0, 0, 0, 0, 0, 0, 0, 158, 363,
+	0, 0, 0, 0, 105, 0, 0, 0, 0, 330,
+	304, 223, 0, 0, 213, 0, 0, 0, 0, 0,
+	1564, 0, 0, 0, 0, 0, 0, 1162, 0, 0,
+	0, 1759, 1760, 0, 0, 0, 0, 0, 0, 0,
+	0, 148, 286, 343, 1161, 1160, 0, 0, 147, 133,
+	189, 288, 164, 179, 161, 0, 0, 0, 0, 131,
+	1151, 1168, 434, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
+	1182, 1183, 1184, 1185, 1192, 393, 238, 140, 191, 235,
+	323, 1194, 1193, 1197, 1195, 1196, 431, 381, 1190, 1191,
+	1173, 388, 395, 193, 141, 1154, 146, 257, 280, 354,
+	1147, 0, 0, 0, 0, 0, 249, 0, 155, 0,
+	0, 0, 0, 177, 218, 0, 344, 357, 185, 355,
+	373, 0, 262, 0, 0, 0, 0, 138, 248, 1155,
+	1174, 1156, 0, 92, 310, 139, 0, 0, 202, 0,
+	375, 209, 0, 0, 0, 0, 441, 0, 108, 82,
+	120, 406, 0, 128, 316, 0, 246, 182, 371, 445,
+	142, 349, 199, 87, 112, 171, 208, 317, 0, 207,
+	297, 0, 144, 126, 302, 96, 176, 292, 447, 0,
+	0, 123, 290, 0, 320, 430, 219, 306, 372, 0,
+	113, 197, 321, 332, 0, 291, 314, 392, 173, 312,
+	192, 200, 217, 236, 240, 394, 184, 187, 275, 352,
+	0, 224, 351, 414, 453, 0, 345, 259, 266, 260,
+	274, 334, 336, 358, 410, 391, 174, 305, 365, 0,
+	0, 151, 86, 178, 0, 186, 325, 359, 409, 201,
+	130, 162, 353, 338, 415, 421, 98, 245, 347, 93,
+	231, 279, 361, 135, 227, 379, 109, 153, 295, 329,
+	228, 251, 272, 341, 416, 0, 134, 175, 337, 0,
+	241, 1563, 425, 165, 263, 396, 307, 328, 356, 0,
+	89, 276, 368, 374, 0, 0, 0, 194, 243, 124,
+	198, 242, 333, 172, 215, 418, 152, 221, 253, 97,
+	156, 255, 303, 378, 0, 0, 118, 204, 327, 104,
+	267, 331, 384, 229, 85, 225, 261, 136, 367, 0,
+	111, 132, 226, 293, 0, 269, 281, 0, 90, 168,
+	129, 145, 237, 301, 296, 318, 380, 452, 150, 250,
+	264, 340, 0, 180, 424, 256, 339, 190, 319, 389,
+	0, 335, 188, 214, 0, 220, 0, 206, 0, 258,
+	0, 106, 212, 386, 436, 154, 284, 216, 265, 166,
+	0, 91, 94, 203, 294, 324, 0, 122, 181, 233,
+	360, 183, 211, 443, 0, 270, 287, 232, 313, 350,
+	0, 101, 298, 408, 448, 115, 252, 309, 446, 195,
+	322, 364, 0, 95, 114, 159, 100, 234, 247, 382,
+	390, 160, 326, 444, 0, 308, 127, 230, 417, 99,
+	102, 103, 282, 385, 254, 439, 0, 119, 137, 222,
+	402, 244, 299, 0, 116, 428, 273, 342, 0, 125,
+	285, 300, 370, 84, 277, 420, 449, 205, 377, 0,
+	83, 107, 0, 1166, 268, 369, 196, 239, 429, 0,
+	0, 440, 0, 426, 451, 0, 88, 271, 383, 450,
+	110, 210, 346, 362, 366, 143, 149, 289, 0, 0,
+	0, 163, 167, 348, 387, 419, 283, 170, 169, 278,
+	0, 121, 311, 376, 117, 0, 0, 0, 0, 0,
+	0, 0, 158, 363, 0, 0, 0, 0, 105, 0,
+	0, 0, 0, 330, 304, 223, 0, 0, 213, 0,
+	0, 0, 0, 0, 1564, 0, 0, 0, 0, 0,
+	0, 1162, 0, 0, 0, 1560, 1561, 0, 0, 0,
+	0, 0, 0, 0, 0, 148, 286, 343, 1161, 1160,
+	0, 0, 147, 133, 189, 288, 164, 179, 161, 0,
+	0, 0, 0, 131, 1151, 1562, 434, 1175, 1176, 1177,
+	1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1192, 393,
+	238, 140, 191, 235, 323, 1194, 1193, 1197, 1195, 1196,
+	431, 381, 1190, 1191, 1173, 388, 395, 193, 141, 1154,
+	146, 257, 280, 354, 1147, 0, 0, 0, 0, 0,
+	249, 0, 155, 0, 0, 0, 0, 177, 218, 0,
+	344, 357, 185, 355, 373, 0, 262, 0, 0, 0,
+	79, 138, 248, 92, 310, 139, 0, 58, 202, 56,
+	375, 209, 0, 0, 0, 0, 1368, 0, 108, 82,
+	120, 406, 0, 128, 316, 0, 246, 182, 371, 445,
+	142, 349, 199, 87, 112, 171, 208, 317, 0, 207,
+	297, 0, 144, 126, 302, 96, 176, 292, 447, 0,
+	0, 123, 290, 0, 320, 430, 219, 306, 372, 0,
+	113, 197, 321, 332, 0, 291, 314, 392, 173, 312,
+	192, 200, 217, 236, 240, 394, 184, 187, 275, 352,
+	0, 224, 351, 414, 453, 0, 345, 259, 266, 260,
+	274, 334, 336, 358, 410, 391, 174, 305, 365, 0,
+	0, 151, 86, 178, 0, 186, 325, 359, 409, 201,
+	130, 162, 353, 338, 415, 421, 98, 245, 347, 93,
+	231, 279, 361, 135, 227, 379, 109, 153, 295, 329,
+	228, 251, 272, 341, 416, 0, 134, 175, 337, 0,
+	241, 315, 425, 165, 263, 396, 307, 328, 356, 0,
+	89, 276, 368, 374, 0, 0, 0, 194, 243, 124,
+	198, 242, 333, 172, 215, 418, 152, 221, 253, 97,
+	156, 255, 303, 378, 0, 0, 118, 204, 327, 104,
+	267, 331, 384, 229, 85, 225, 261, 136, 367, 0,
+	111, 132, 226, 293, 0, 269, 281, 0, 90, 168,
+	129, 145, 237, 301, 296, 318, 380, 452, 150, 250,
+	264, 340, 0, 180, 424, 256, 339, 190, 319, 389,
+	0, 335, 188, 214, 0, 220, 0, 206, 0, 258,
+	0, 106, 212, 386, 436, 154, 284, 216, 265, 166,
+	0, 91, 94, 203, 294, 324, 0, 122, 181, 233,
+	360, 183, 211, 443, 0, 270, 287, 232, 313, 350,
+	0, 101, 298, 408, 448, 115, 252, 309, 446, 195,
+	322, 364, 0, 95, 114, 159, 100, 234, 247, 382,
+	390, 160, 326, 444, 0, 308, 127, 230, 417, 99,
+	102, 103, 282, 385, 254, 439, 0, 119, 137, 222,
+	402, 244, 299, 0, 116, 428, 273, 342, 0, 125,
+	285, 300, 370, 84, 277, 420, 449, 205, 377, 0,
+	83, 107, 0, 0, 268, 369, 196, 239, 429, 0,
+	0, 440, 0, 426, 451, 0, 88, 271, 383, 450,
+	110, 210, 346, 362, 366, 143, 149, 289, 0, 0,
+	0, 163, 167, 348, 387, 419, 283, 170, 169, 278,
+	0, 121, 311, 376, 117, 0, 0, 0, 0, 0,
+	0, 0, 158, 363, 0, 0, 0, 0, 105, 0,
+	0, 0, 0, 330, 304, 223, 0, 0, 213, 0,
+	0, 0, 0, 0, 1736, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 148, 286, 343, 0, 0,
+	0, 0, 147, 133, 189, 288, 164, 179, 161, 0,
+	0, 0, 0, 131, 0, 433, 434, 411, 412, 435,
+	397, 432, 407, 157, 404, 403, 427, 399, 398, 393,
+	238, 140, 191, 235, 323, 401, 400, 442, 422, 423,
+	431, 381, 438, 437, 413, 388, 395, 193, 141, 405,
+	146, 257, 280, 354, 0, 0, 0, 0, 0, 0,
+	249, 0, 155, 0, 0, 0, 0, 177, 218, 0,
+	344, 357, 185, 355, 373, 1733, 262, 60, 59, 0,
+	0, 138, 248, 1155, 79, 1156, 0, 92, 310, 139,
+	0, 0, 202, 0, 375, 209, 0, 0, 0, 0,
+	441, 0, 108, 82, 120, 406, 0, 128, 316, 0,
+	246, 182, 371, 445, 142, 349, 199, 87, 112, 171,
+	208, 317, 0, 207, 297, 0, 144, 126, 302, 96,
+	176, 292, 447, 0, 0, 123, 290, 0, 320, 430,
+	219, 306, 372, 0, 113, 197, 321, 332, 0, 291,
+	314, 392, 173, 312, 192, 200, 217, 236, 240, 394,
+	184, 187, 275, 352, 0, 224, 351, 414, 453, 0,
+	345, 259, 266, 260, 274, 334, 336, 358, 410, 391,
+	174, 305, 365, 0, 0, 151, 86, 178, 0, 186,
+	325, 359, 409, 201, 130, 162, 353, 338, 415, 421,
+	98, 245, 347, 93, 231, 279, 361, 135, 227, 379,
+	109, 153, 295, 329, 228, 251, 272, 341, 416, 0,
+	134, 175, 337, 0, 241, 315, 425, 165, 263, 396,
+	307, 328, 356, 0, 89, 276, 368, 374, 0, 0,
+	0, 194, 243, 124, 198, 242, 333, 172, 215, 418,
+	152, 221, 253, 97, 156, 255, 303, 378, 0, 0,
+	118, 204, 327, 104, 267, 331, 384, 229, 85, 225,
+	261, 136, 367, 0, 111, 132, 226, 293, 0, 269,
+	281, 0, 90, 168, 129, 145, 237, 301, 296, 318,
+	380, 452, 150, 250, 264, 340, 0, 180, 424, 256,
+	339, 190, 319, 389, 0, 335, 188, 214, 0, 220,
+	0, 206, 0, 258, 0, 106, 212, 386, 436, 154,
+	284, 216, 265, 166, 0, 91, 94, 203, 294, 324,
+	0, 122, 181, 233, 360, 183, 211, 443, 0, 270,
+	287, 232, 313, 350, 0, 101, 298, 408, 448, 115,
+	252, 309, 446, 195, 322, 364, 0, 95, 114, 159,
+	100, 234, 247, 382, 390, 160, 326, 444, 0, 308,
+	127, 230, 417, 99, 102, 103, 282, 385, 254, 439,
+	0, 119, 137, 222, 402, 244, 299, 0, 116, 428,
+	273, 342, 0, 125, 285, 300, 370, 84, 277, 420,
+	449, 205, 377, 0, 83, 107, 0, 0, 268, 369,
+	196, 239, 429, 0, 0, 440, 0, 426, 451, 0,
+	88, 271, 383, 450, 110, 210, 346, 362, 366, 143,
+	149, 289, 0, 0, 0, 163, 167, 348, 387, 419,
+	283, 170, 169, 278, 0, 121, 311, 376, 117, 0,
+	0, 0, 0, 0, 0, 0, 158, 363, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 330, 304, 223,
+	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 148,
+	286, 343, 1161, 1160, 0, 0, 147, 133, 189, 288,
+	164, 179, 161, 0, 0, 0, 0, 131, 0, 433,
+	434, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
+	1184, 1185, 1192, 393, 238, 140, 191, 235, 323, 1194,
+	1193, 1197, 1195, 1196, 431, 381, 1190, 1191, 1173, 388,
+	395, 193, 141, 405, 146, 257, 280, 354, 0, 0,
+	0, 0, 0, 0, 249, 0, 155, 0, 0, 0,
+	0, 177, 218, 0, 344, 357, 185, 355, 373, 79,
+	262, 0, 92, 310, 139, 138, 248, 202, 0, 375,
+	209, 0, 0, 0, 0, 441, 0, 108, 82, 120,
+	406, 0, 128, 316, 0, 246, 182, 371, 445, 142,
+	349, 199, 87, 112, 171, 208, 317, 0, 207, 297,
+	0, 144, 126, 302, 96, 176, 292, 447, 0, 0,
+	123, 290, 0, 320, 430, 219, 306, 372, 0, 113,
+	197, 321, 332, 0, 291, 314, 392, 173, 312, 192,
+	200, 217, 236, 240, 394, 184, 187, 275, 352, 0,
+	224, 351, 414, 453, 0, 345, 259, 266, 260, 274,
+	334, 336, 358, 410, 391, 174, 305, 365, 0, 0,
+	151, 86, 178, 0, 186, 325, 359, 409, 201, 130,
+	162, 353, 338, 415, 421, 98, 245, 347, 93, 231,
+	279, 361, 135, 227, 379, 109, 153, 295, 329, 228,
+	251, 272, 341, 416, 0, 134, 175, 337, 0, 241,
+	315, 425, 165, 263, 396, 307, 328, 356, 0, 89,
+	276, 368, 374, 0, 0, 0, 194, 243, 124, 198,
+	242, 333, 172, 215, 418, 152, 221, 253, 97, 156,
+	255, 303, 378, 0, 0, 118, 204, 327, 104, 267,
+	331, 384, 229, 85, 225, 261, 136, 367, 0, 111,
+	132, 226, 293, 0, 269, 281, 0, 90, 168, 129,
+	145, 237, 301, 296, 318, 380, 452, 150, 250, 264,
+	340, 0, 180, 424, 256, 339, 190, 319, 389, 0,
+	335, 188, 214, 0, 220, 0, 206, 0, 258, 0,
+	106, 212, 386, 436, 154, 284, 216, 265, 166, 0,
+	91, 94, 203, 294, 324, 0, 122, 181, 233, 360,
+	183, 211, 443, 0, 270, 287, 232, 313, 350, 0,
+	101, 298, 408, 448, 115, 252, 309, 446, 195, 322,
+	364, 0, 95, 114, 159, 100, 234, 247, 382, 390,
+	160, 326, 444, 0, 308, 127, 230, 417, 99, 102,
+	103, 282, 385, 254, 439, 0, 119, 137, 222, 402,
+	244, 299, 0, 116, 428, 273, 342, 0, 125, 285,
+	300, 370, 84, 277, 420, 449, 205, 377, 0, 83,
+	107, 0, 0, 268, 369, 196, 239, 429, 0, 0,
+	440, 0, 426, 451, 0, 88, 271, 383, 450, 110,
+	210, 346, 362, 366, 143, 149, 289, 0, 0, 0,
+	163, 167, 348, 387, 419, 283, 170, 169, 278, 0,
+	121, 311, 376, 117, 0, 0, 0, 0, 0, 0,
+	0, 158, 363, 0, 0, 0, 0, 105, 0, 0,
+	0, 0, 330, 304, 223, 0, 0, 213, 0, 0,
+	0, 0, 0, 1736, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 148, 286, 343, 0, 0, 0,
+	0, 147, 133, 189, 288, 164, 179, 161, 0, 0,
+	0, 0, 131, 0, 433, 434, 411, 412, 435, 397,
+	432, 407, 157, 404, 403, 427, 399, 398, 393, 238,
+	140, 191, 235, 323, 401, 400, 442, 422, 423, 431,
+	381, 438, 437, 413, 388, 395, 193, 141, 405, 146,
+	257, 280, 354, 0, 0, 0, 0, 0, 0, 249,
+	0, 155, 0, 0, 0, 0, 177, 218, 0, 344,
+	357, 185, 355, 373, 1733, 262, 0, 0, 0, 79,
+	138, 248, 92, 310, 139, 0, 0, 202, 0, 375,
+	209, 0, 0, 0, 0, 441, 0, 108, 82, 120,
+	406, 0, 128, 316, 0, 246, 182, 371, 445, 142,
+	349, 199, 87, 112, 171, 208, 317, 0, 207, 297,
+	0, 144, 126, 302, 96, 176, 292, 447, 0, 0,
+	123, 290, 0, 320, 430, 219, 306, 372, 0, 113,
+	197, 321, 332, 0, 291, 314, 392, 173, 312, 192,
+	200, 217, 236, 240, 394, 184, 187, 275, 352, 0,
+	224, 351, 414, 453, 0, 345, 259, 266, 260, 274,
+	334, 336, 358, 410, 391, 174, 305, 365, 0, 0,
+	151, 86, 178, 0, 186, 325, 359, 409, 201, 130,
+	162, 353, 338, 415, 421, 98, 245, 347, 93, 231,
+	279, 361, 135, 227, 379, 109, 153, 295, 329, 228,
+	251, 272, 341, 416, 0, 134, 175, 337, 0, 241,
+	315, 425, 165, 263, 396, 307, 328, 356, 0, 89,
+	276, 368, 374, 0, 0, 0, 194, 243, 124, 198,
+	242, 333, 172, 215, 418, 152, 221, 253, 97, 156,
+	255, 303, 378, 0, 0, 118, 204, 327, 104, 267,
+	331, 384, 229, 85, 225, 261, 136, 367, 0, 111,
+	132, 226, 293, 0, 269, 281, 0, 90, 168, 129,
+	145, 237, 301, 296, 318, 380, 452, 150, 250, 264,
+	340, 0, 180, 424, 256, 339, 190, 319, 389, 0,
+	335, 188, 214, 0, 220, 0, 206, 0, 258, 0,
+	106, 212, 386, 436, 154, 284, 216, 265, 166, 0,
+	91, 94, 203, 294, 324, 0, 122, 181, 233, 360,
+	183, 211, 443, 0, 270, 287, 232, 313, 350, 0,
+	101, 298, 408, 448, 115, 252, 309, 446, 195, 322,
+	364, 0, 95, 114, 159, 100, 234, 247, 382, 390,
+	160, 326, 444, 0, 308, 127, 230, 417, 99, 102,
+	103, 282, 385, 254, 439, 0, 119, 137, 222, 402,
+	244, 299, 0, 116, 428, 273, 342, 0, 125, 285,
+	300, 370, 84, 277, 420, 449, 205, 377, 0, 83,
+	107, 0, 0, 268, 369, 196, 239, 429, 0, 0,
+	440, 0, 426, 451, 0, 88, 271, 383, 450, 110,
+	210, 346, 362, 366, 143, 149, 289, 0, 0, 0,
+	163, 167, 348, 387, 419, 283, 170, 169, 278, 0,
+	121, 311, 376, 117, 0, 0, 0, 0, 0, 0,
+	0, 158, 363, 0, 0, 0, 0, 105, 0, 0,
+	0, 0, 330, 304, 223, 0, 1688, 213, 1687, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 148, 286, 343, 0, 0, 0,
+	0, 147, 133, 189, 288, 164, 179, 161, 0, 0,
+	0, 0, 131, 0, 433, 434, 411, 412, 435, 397,
+	432, 407, 157, 404, 403, 427, 399, 398, 393, 238,
+	140, 191, 235, 323, 401, 400, 442, 422, 423, 431,
+	381, 438, 437, 413, 388, 395, 193, 141, 405, 146,
+	257, 280, 354, 0, 0, 0, 0, 0, 0, 249,
+	0, 155, 0, 0, 0, 0, 177, 218, 0, 344,
+	357, 185, 355, 373, 79, 262, 0, 92, 310, 139,
+	138, 248, 202, 0, 375, 209, 0, 0, 0, 0,
+	441, 0, 108, 82, 120, 406, 0, 128, 316, 0,
+	246, 182, 371, 445, 142, 349, 199, 87, 112, 171,
+	208, 317, 0, 207, 297, 0, 144, 126, 302, 96,
+	176, 292, 447, 0, 0, 123, 290, 0, 320, 430,
+	219, 306, 372, 0, 113, 197, 321, 332, 0, 291,
+	314, 392, 173, 312, 192, 200, 217, 236, 240, 394,
+	184, 187, 275, 352, 0, 224, 351, 414, 453, 0,
+	345, 259, 266, 260, 274, 334, 336, 358, 410, 391,
+	174, 305, 365, 0, 0, 151, 86, 178, 0, 186,
+	325, 359, 409, 201, 130, 162, 353, 338, 415, 421,
+	98, 245, 347, 93, 231, 279, 361, 135, 227, 379,
+	109, 153, 295, 329, 228, 251, 272, 341, 416, 0,
+	134, 175, 337, 0, 241, 315, 425, 165, 263, 396,
+	307, 328, 356, 0, 89, 276, 368, 374, 0, 0,
+	0, 194, 243, 124, 198, 242, 333, 172, 215, 418,
+	152, 221, 253, 97, 156, 255, 303, 378, 0, 0,
+	118, 204, 327, 104, 267, 331, 384, 229, 85, 225,
+	261, 136, 367, 0, 111, 132, 226, 293, 0, 269,
+	281, 0, 90, 168, 129, 145, 237, 301, 296, 318,
+	380, 452, 150, 250, 264, 340, 0, 180, 424, 256,
+	339, 190, 319, 389, 0, 335, 188, 214, 0, 220,
+	0, 206, 0, 258, 0, 106, 212, 386, 436, 154,
+	284, 216, 265, 166, 0, 91, 94, 203, 294, 324,
+	0, 122, 181, 233, 360, 183, 211, 443, 0, 270,
+	287, 232, 313, 350, 0, 101, 298, 408, 448, 115,
+	252, 309, 446, 195, 322, 364, 0, 95, 114, 159,
+	100, 234, 247, 382, 390, 160, 326, 444, 0, 308,
+	127, 230, 417, 99, 102, 103, 282, 385, 254, 439,
+	0, 119, 137, 222, 402, 244, 299, 0, 116, 428,
+	273, 342, 0, 125, 285, 300, 370, 84, 277, 420,
+	449, 205, 377, 0, 83, 107, 0, 0, 268, 369,
+	196, 239, 429, 0, 0, 440, 0, 426, 451, 0,
+	88, 271, 383, 450, 110, 210, 346, 362, 366, 143,
+	149, 289, 0, 0, 0, 163, 167, 348, 387, 419,
+	283, 170, 169, 278, 0, 121, 311, 376, 117, 0,
+	0, 0, 0, 0, 0, 0, 158, 363, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 330, 304, 223,
+	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2243, 0, 0, 0, 0, 0, 0, 0, 148,
+	286, 343, 0, 0, 0, 0, 147, 133, 189, 288,
+	164, 179, 161, 0, 0, 0, 0, 131, 0, 433,
+	434, 411, 412, 435, 397, 432, 407, 157, 404, 403,
+	427, 399, 398, 393, 238, 140, 191, 235, 323, 401,
+	400, 442, 422, 423, 431, 381, 438, 437, 413, 388,
+	395, 193, 141, 405, 146, 257, 280, 354, 0, 0,
+	0, 0, 0, 0, 249, 0, 155, 0, 0, 0,
+	0, 177, 218, 0, 344, 357, 185, 355, 373, 79,
+	262, 0, 92, 310, 139, 138, 248, 202, 0, 375,
+	209, 0, 0, 0, 0, 441, 0, 108, 82, 120,
+	406, 0, 128, 316, 0, 246, 182, 371, 445, 142,
+	349, 199, 87, 112, 171, 208, 317, 0, 207, 297,
+	0, 144, 126, 302, 96, 176, 292, 447, 0, 0,
+	123, 290, 0, 320, 430, 219, 306, 372, 0, 113,
+	197, 321, 332, 0, 291, 314, 392, 173, 312, 192,
+	200, 217, 236, 240, 394, 184, 187, 275, 352, 0,
+	224, 351, 414, 453, 0, 345, 259, 266, 260, 274,
+	334, 336, 358, 410, 391, 174, 305, 365, 0, 0,
+	151, 86, 178, 0, 186, 325, 359, 409, 201, 130,
+	162, 353, 338, 415, 421, 98, 245, 347, 93, 231,
+	279, 361, 135, 227, 379, 109, 153, 295, 329, 228,
+	251, 272, 341, 416, 0, 134, 175, 337, 0, 241,
+	315, 425, 165, 263, 396, 307, 328, 356, 0, 89,
+	276, 368, 374, 0, 0, 0, 194, 243, 124, 198,
+	242, 333, 172, 215, 418, 152, 221, 253, 97, 156,
+	255, 303, 378, 0, 0, 118, 204, 327, 104, 267,
+	331, 384, 229, 85, 225, 261, 136, 367, 0, 111,
+	132, 226, 293, 0, 269, 281, 0, 90, 168, 129,
+	145, 237, 301, 296, 318, 380, 452, 150, 250, 264,
+	340, 0, 180, 424, 256, 339, 190, 319, 389, 0,
+	335, 188, 214, 0, 220, 0, 206, 0, 258, 0,
+	106, 212, 386, 436, 154, 284, 216, 265, 166, 0,
+	91, 94, 203, 294, 324, 0, 122, 181, 233, 360,
+	183, 211, 443, 0, 270, 287, 232, 313, 350, 0,
+	101, 298, 408, 448, 115, 252, 309, 446, 195, 322,
+	364, 0, 95, 114, 159, 100, 234, 247, 382, 390,
+	160, 326, 444, 0, 308, 127, 230, 417, 99, 102,
+	103, 282, 385, 254, 439, 0, 119, 137, 222, 402,
+	244, 299, 0, 116, 428, 273, 342, 0, 125, 285,
+	300, 370, 84, 277, 420, 449, 205, 377, 0, 83,
+	107, 0, 0, 268, 369, 196, 239, 429, 0, 0,
+	440, 0, 426, 451, 0, 88, 271, 383, 450, 110,
+	210, 346, 362, 366, 143, 149, 289, 0, 0, 0,
+	163, 167, 348, 387, 419, 283, 170, 169, 278, 0,
+	121, 311, 376, 117, 0, 0, 0, 0, 0, 0,
+	0, 158, 363, 0, 0, 0, 0, 105, 0, 0,
+	0, 0, 330, 304, 223, 0, 0, 213, 0, 0,
+	0, 0, 0, 2029, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 148, 286, 343, 0, 0, 0,
+	0, 147, 133, 189, 288, 164, 179, 161, 0, 0,
+	0, 0, 131, 0, 433, 434, 411, 412, 435, 397,
+	432, 407, 157, 404, 403, 427, 399, 398, 393, 238,
+	140, 191, 235, 323, 401, 400, 442, 422, 423, 431,
+	381, 438, 437, 413, 388, 395, 193, 141, 405, 146,
+	257, 280, 354, 0, 0, 0, 0, 0, 0, 249,
+	0, 155, 0, 0, 0, 0, 177, 218, 0, 344,
+	357, 185, 355, 373, 79, 262, 0, 92, 310, 139,
+	138, 248, 202, 0, 375, 209, 0, 0, 0, 0,
+	441, 0, 108, 82, 120, 406, 0, 128, 316, 0,
+	246, 182, 371, 445, 142, 349, 199, 87, 112, 171,
+	208, 317, 0, 207, 297, 0, 144, 126, 302, 96,
+	176, 292, 447, 0, 0, 123, 290, 0, 320, 430,
+	219, 306, 372, 0, 113, 197, 321, 332, 0, 291,
+	314, 392, 173, 312, 192, 200, 217, 236, 240, 394,
+	184, 187, 275, 352, 0, 224, 351, 414, 453, 0,
+	345, 259, 266, 260, 274, 334, 336, 358, 410, 391,
+	174, 305, 365, 0, 0, 151, 86, 178, 0, 186,
+	325, 359, 409, 201, 130, 162, 353, 338, 415, 421,
+	98, 245, 347, 93, 231, 279, 361, 135, 227, 379,
+	109, 153, 295, 329, 228, 251, 272, 341, 416, 0,
+	134, 175, 337, 0, 241, 315, 425, 165, 263, 396,
+	307, 328, 356, 0, 89, 276, 368, 374, 0, 0,
+	0, 194, 243, 124, 198, 242, 333, 172, 215, 418,
+	152, 221, 253, 97, 156, 255, 303, 378, 0, 0,
+	118, 204, 327, 104, 267, 331, 384, 229, 85, 225,
+	261, 136, 367, 0, 111, 132, 226, 293, 0, 269,
+	281, 0, 90, 168, 129, 145, 237, 301, 296, 318,
+	380, 452, 150, 250, 264, 340, 0, 180, 424, 256,
+	339, 190, 319, 389, 0, 335, 188, 214, 0, 220,
+	0, 206, 0, 258, 0, 106, 212, 386, 436, 154,
+	284, 216, 265, 166, 0, 91, 94, 203, 294, 324,
+	0, 122, 181, 233, 360, 183, 211, 443, 0, 270,
+	287, 232, 313, 350, 0, 101, 298, 408, 448, 115,
+	252, 309, 446, 195, 322, 364, 0, 95, 114, 159,
+	100, 234, 247, 382, 390, 160, 326, 444, 0, 308,
+	127, 230, 417, 99, 102, 103, 282, 385, 254, 439,
+	0, 119, 137, 222, 402, 244, 299, 0, 116, 428,
+	273, 342, 0, 125, 285, 300, 370, 84, 277, 420,
+	449, 205, 377, 0, 83, 107, 0, 0, 268, 369,
+	196, 239, 429, 0, 0, 440, 0, 426, 451, 0,
+	88, 271, 383, 450, 110, 210, 346, 362, 366, 143,
+	149, 289, 0, 0, 0, 163, 167, 348, 387, 419,
+	283, 170, 169, 278, 0, 121, 311, 376, 117, 0,
+	0, 0, 0, 0, 0, 0, 158, 363, 0, 0,
+	0, 0, 105, 0, 0, 0, 0, 330, 304, 223,
+	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 148,
+	286, 343, 0, 0, 0, 0, 147, 133, 189, 288,
+	164, 179, 161, 0, 0, 0, 0, 131, 0, 433,
+	434, 411, 412, 435, 397, 432, 407, 157, 404, 403,
+	427, 399, 398, 393, 238, 140, 191, 235, 323, 401,
+	400, 442, 422, 423, 431, 381, 438, 437, 413, 388,
+	395, 193, 141, 405, 146, 257, 280, 354, 0, 0,
+	0, 0, 0, 2010, 249, 0, 155, 0, 0, 0,
+	0, 177, 218, 0, 344, 357, 185, 355, 373, 1867,
+	262, 0, 92, 310, 139, 138, 248, 202, 0, 375,
+	209, 0, 0, 0, 0, 441, 0, 108, 82, 120,
+	406, 0, 128, 316, 0, 246, 182, 371, 445, 142,
+	349, 199, 87, 112, 171, 208, 317, 0, 207, 297,
+	0, 144, 126, 302, 96, 176, 292, 447, 0, 0,
+	123, 290, 0, 320, 430, 219, 306, 372, 0, 113,
+	197, 321, 332, 0, 291, 314, 392, 173, 312, 192,
+	200, 217, 236, 240, 394, 184, 187, 275, 352, 0,
+	224, 351, 414, 453, 0, 345, 259, 266, 260, 274,
+	334, 336, 358, 410, 391, 174, 305, 365, 0, 0,
+	151, 86, 178, 0, 186, 325, 359, 409, 201, 130,
+	162, 353, 338, 415, 421, 98, 245, 347, 93, 231,
+	279, 361, 135, 227, 379, 109, 153, 295, 329, 228,
+	251, 272, 341, 416, 0, 134, 175, 337, 0, 241,
+	315, 425, 165, 263, 396, 307, 328, 356, 0, 89,
+	276, 368, 374, 0, 0, 0, 194, 243, 124, 198,
+	242, 333, 172, 215, 418, 152, 221, 253, 97, 156,
+	255, 303, 378, 0, 0, 118, 204, 327, 104, 267,
+	331, 384, 229, 85, 225, 261, 136, 367, 0, 111,
+	132, 226, 293, 0, 269, 281, 0, 90, 168, 129,
+	145, 237, 301, 296, 318, 380, 452, 150, 250, 264,
+	340, 0, 180, 424, 256, 339, 190, 319, 389, 0,
+	335, 188, 214, 0, 220, 0, 206, 0, 258, 0,
+	106, 212, 386, 436, 154, 284, 216, 265, 166, 0,
+	91, 94, 203, 294, 324, 0, 122, 181, 233, 360,
+	183, 211, 443, 0, 270, 287, 232, 313, 350, 0,
+	101, 298, 408, 448, 115, 252, 309, 446, 195, 322,
+	364, 0, 95, 114, 159, 100, 234, 247, 382, 390,
+	160, 326, 444, 0, 308, 127, 230, 417, 99, 102,
+	103, 282, 385, 254, 439, 0, 119, 137, 222, 402,
+	244, 299, 0, 116, 428, 273, 342, 0, 125, 285,
+	300, 370, 84, 277, 420, 449, 205, 377, 0, 83,
+	107, 0, 0, 268, 369, 196, 239, 429, 0, 0,
+	440, 0, 426, 451, 0, 88, 271, 383, 450, 110,
+	210, 346, 362, 366, 143, 149, 289, 0, 0, 0,
+	163, 167, 348, 387, 419, 283, 170, 169, 278, 0,
+	121, 311, 376, 117, 0, 0, 0, 0, 0, 0,
+	0, 158, 363, 0, 0, 0, 0, 105, 0, 0,
+	0, 0, 330, 304, 223, 0, 0, 213, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 148, 286, 343, 0, 0, 0,
+	0, 147, 133, 189, 288, 164, 179, 161, 0, 0,
+	0, 0, 131, 0, 433, 434, 411, 412, 435, 397,
+	432, 407, 157, 404, 403, 427, 399, 398, 393, 238,
+	140, 191, 235, 323, 401, 400, 442, 422, 423, 431,
+	381, 438, 437, 413, 388, 395, 193, 141, 405, 146,
+	257, 280, 354, 0, 0, 0, 0, 0, 0, 249,
+	0, 155, 0, 0, 0, 0, 177, 218, 0, 344,
+	357, 185, 355, 373, 0, 262, 0, 0, 0, 1865,
+	138, 248, 1867, 0, 0, 92, 310, 139, 0, 0,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 241, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 360, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 0, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 223, 0, 0,
+	213, 0, 0, 0, 0, 0, 1969, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	0, 0, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 433, 434, 411,
+	412, 435, 397, 432, 407, 157, 404, 403, 427, 399,
+	398, 393, 238, 140, 191, 235, 323, 401, 400, 442,
+	422, 423, 431, 381, 438, 437, 413, 388, 395, 193,
+	141, 405, 146, 257, 280, 354, 0, 0, 0, 0,
+	0, 0, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 79, 262, 0,
+	92, 310, 139, 138, 248, 202, 0, 375, 209, 0,
+	0, 0, 0, 441, 0, 108, 82, 120, 406, 0,
+	128, 316, 0, 246, 182, 371, 445, 142, 349, 199,
+	87, 112, 171, 208, 317, 0, 207, 297, 0, 144,
+	126, 302, 96, 176, 292, 447, 0, 0, 123, 290,
+	0, 320, 430, 219, 306, 372, 0, 113, 197, 321,
+	332, 0, 291, 314, 392, 173, 312, 192, 200, 217,
+	236, 240, 394, 184, 187, 275, 352, 0, 224, 351,
+	414, 453, 0, 345, 259, 266, 260, 274, 334, 336,
+	358, 410, 391, 174, 305, 365, 0, 0, 151, 86,
+	178, 0, 186, 325, 359, 409, 201, 130, 162, 353,
+	338, 415, 421, 98, 245, 347, 93, 231, 279, 361,
+	135, 227, 379, 109, 153, 295, 329, 228, 251, 272,
+	341, 416, 0, 134, 175, 337, 0, 241, 315, 425,
+	165, 263, 396, 307, 328, 356, 0, 89, 276, 368,
+	374, 0, 0, 0, 194, 243, 124, 198, 242, 333,
+	172, 215, 418, 152, 221, 253, 97, 156, 255, 303,
+	378, 0, 0, 118, 204, 327, 104, 267, 331, 384,
+	229, 85, 225, 261, 136, 367, 0, 111, 132, 226,
+	293, 0, 269, 281, 0, 90, 168, 129, 145, 237,
+	301, 296, 318, 380, 452, 150, 250, 264, 340, 0,
+	180, 424, 256, 339, 190, 319, 389, 0, 335, 188,
+	214, 0, 220, 0, 206, 0, 258, 0, 106, 212,
+	386, 436, 154, 284, 216, 265, 166, 0, 91, 94,
+	203, 294, 324, 0, 122, 181, 233, 360, 183, 211,
+	443, 0, 270, 287, 232, 313, 350, 0, 101, 298,
+	408, 448, 115, 252, 309, 446, 195, 322, 364, 0,
+	95, 114, 159, 100, 234, 247, 382, 390, 160, 326,
+	444, 0, 308, 127, 230, 417, 99, 102, 103, 282,
+	385, 254, 439, 0, 119, 137, 222, 402, 244, 299,
+	0, 116, 428, 273, 342, 0, 125, 285, 300, 370,
+	84, 277, 420, 449, 205, 377, 0, 83, 107, 0,
+	0, 268, 369, 196, 239, 429, 0, 0, 440, 0,
+	426, 451, 0, 88, 271, 383, 450, 110, 210, 346,
+	362, 366, 143, 149, 289, 0, 0, 0, 163, 167,
+	348, 387, 419, 283, 170, 169, 278, 0, 121, 311,
+	376, 117, 0, 0, 0, 0, 0, 0, 0, 158,
+	363, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	330, 304, 223, 0, 0, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1651, 0, 0, 0, 0, 0,
+	0, 0, 148, 286, 343, 0, 0, 0, 0, 147,
+	133, 189, 288, 164, 179, 161, 0, 0, 0, 0,
+	131, 0, 433, 434, 411, 412, 435, 397, 432, 407,
+	157, 404, 403, 427, 399, 398, 393, 238, 140, 191,
+	235, 323, 401, 400, 442, 422, 423, 431, 381, 438,
+	437, 413, 388, 395, 193, 141, 405, 146, 257, 280,
+	354, 0, 0, 0, 0, 0, 0, 249, 0, 155,
+	0, 0, 0, 0, 177, 218, 0, 344, 357, 185,
+	355, 373, 0, 262, 0, 0, 0, 79, 138, 248,
+	92, 310, 139, 0, 1590, 202, 0, 375, 209, 0,
+	0, 0, 0, 441, 0, 108, 82, 120, 406, 0,
+	128, 316, 0, 246, 182, 371, 445, 142, 349, 199,
+	87, 112, 171, 208, 317, 0, 207, 297, 0, 144,
+	126, 302, 96, 176, 292, 447, 0, 0, 123, 290,
+	0, 320, 430, 219, 306, 372, 0, 113, 197, 321,
+	332, 0, 291, 314, 392, 173, 312, 192, 200, 217,
+	236, 240, 394, 184, 187, 275, 352, 0, 224, 351,
+	414, 453, 0, 345, 259, 266, 260, 274, 334, 336,
+	358, 410, 391, 174, 305, 365, 0, 0, 151, 86,
+	178, 0, 186, 325, 359, 409, 201, 130, 162, 353,
+	338, 415, 421, 98, 245, 347, 93, 231, 279, 361,
+	135, 227, 379, 109, 153, 295, 329, 228, 251, 272,
+	341, 416, 0, 134, 175, 337, 0, 241, 315, 425,
+	165, 263, 396, 307, 328, 356, 0, 89, 276, 368,
+	374, 0, 0, 0, 194, 243, 124, 198, 242, 333,
+	172, 215, 418, 152, 221, 253, 97, 156, 255, 303,
+	378, 0, 0, 118, 204, 327, 104, 267, 331, 384,
+	229, 85, 225, 261, 136, 367, 0, 111, 132, 226,
+	293, 0, 269, 281, 0, 90, 168, 129, 145, 237,
+	301, 296, 318, 380, 452, 150, 250, 264, 340, 0,
+	180, 424, 256, 339, 190, 319, 389, 0, 335, 188,
+	214, 0, 220, 0, 206, 0, 258, 0, 106, 212,
+	386, 436, 154, 284, 216, 265, 166, 0, 91, 94,
+	203, 294, 324, 0, 122, 181, 233, 360, 183, 211,
+	443, 0, 270, 287, 232, 313, 350, 0, 101, 298,
+	408, 448, 115, 252, 309, 446, 195, 322, 364, 0,
+	95, 114, 159, 100, 234, 247, 382, 390, 160, 326,
+	444, 0, 308, 127, 230, 417, 99, 102, 103, 282,
+	385, 254, 439, 0, 119, 137, 222, 402, 244, 299,
+	0, 116, 428, 273, 342, 0, 125, 285, 300, 370,
+	84, 277, 420, 449, 205, 377, 0, 83, 107, 0,
+	0, 268, 369, 196, 239, 429, 0, 0, 440, 0,
+	426, 451, 0, 88, 271, 383, 450, 110, 210, 346,
+	362, 366, 143, 149, 289, 0, 0, 0, 163, 167,
+	348, 387, 419, 283, 170, 169, 278, 0, 121, 311,
+	376, 117, 0, 0, 0, 0, 0, 0, 0, 158,
+	363, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	330, 304, 1587, 0, 0, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 286, 343, 0, 0, 0, 0, 147,
+	133, 189, 288, 164, 179, 161, 0, 0, 0, 0,
+	131, 0, 433, 434, 411, 412, 435, 397, 432, 407,
+	157, 404, 403, 427, 399, 398, 393, 238, 140, 191,
+	235, 323, 401, 400, 442, 422, 423, 431, 381, 438,
+	437, 413, 388, 395, 193, 141, 405, 146, 257, 280,
+	354, 0, 0, 0, 0, 0, 0, 249, 0, 155,
+	0, 0, 0, 0, 177, 218, 0, 344, 1585, 1588,
+	1586, 1589, 79, 262, 0, 92, 310, 139, 138, 248,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 241, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 360, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 0, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 223, 1101, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	0, 0, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 433, 434, 411,
+	412, 435, 397, 432, 407, 157, 404, 403, 427, 399,
+	398, 393, 238, 140, 191, 235, 323, 401, 400, 442,
+	422, 423, 431, 381, 438, 437, 413, 388, 395, 193,
+	141, 405, 146, 257, 280, 354, 0, 0, 0, 0,
+	0, 0, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 79, 262, 0,
+	92, 483, 139, 138, 248, 202, 0, 375, 209, 0,
+	0, 0, 0, 441, 0, 108, 82, 120, 406, 0,
+	128, 316, 0, 246, 182, 371, 445, 142, 349, 199,
+	87, 112, 171, 208, 317, 0, 207, 297, 0, 144,
+	126, 302, 96, 176, 292, 447, 0, 0, 123, 290,
+	0, 320, 430, 219, 306, 372, 0, 1050, 197, 321,
+	332, 0, 291, 314, 392, 173, 312, 192, 200, 217,
+	236, 240, 394, 184, 187, 275, 352, 1051, 224, 351,
+	414, 453, 0, 345, 259, 266, 260, 274, 334, 336,
+	358, 410, 391, 174, 305, 365, 0, 0, 151, 86,
+	178, 0, 186, 325, 359, 409, 201, 130, 162, 353,
+	338, 415, 421, 98, 245, 347, 93, 231, 279, 361,
+	135, 227, 379, 109, 153, 295, 1048, 228, 251, 272,
+	341, 416, 0, 134, 175, 337, 0, 482, 315, 425,
+	165, 263, 396, 307, 328, 356, 0, 89, 276, 368,
+	374, 0, 0, 0, 194, 243, 124, 198, 242, 333,
+	172, 215, 418, 152, 221, 253, 97, 156, 255, 303,
+	378, 0, 0, 118, 204, 327, 104, 267, 331, 384,
+	229, 85, 225, 261, 136, 367, 0, 480, 132, 226,
+	293, 0, 269, 281, 0, 90, 168, 129, 145, 237,
+	301, 296, 318, 380, 452, 150, 250, 264, 340, 0,
+	180, 424, 256, 339, 190, 481, 389, 0, 335, 188,
+	214, 0, 220, 0, 206, 0, 258, 0, 106, 212,
+	386, 436, 154, 284, 216, 265, 166, 0, 91, 94,
+	203, 294, 324, 0, 122, 181, 233, 475, 183, 211,
+	443, 0, 270, 287, 232, 313, 350, 0, 101, 298,
+	408, 448, 115, 252, 309, 446, 195, 322, 364, 0,
+	95, 114, 159, 100, 234, 247, 382, 390, 160, 326,
+	444, 0, 308, 127, 230, 417, 99, 102, 103, 282,
+	385, 254, 439, 0, 119, 137, 222, 402, 244, 299,
+	0, 116, 428, 273, 342, 0, 125, 285, 300, 370,
+	84, 277, 420, 449, 205, 377, 0, 83, 107, 0,
+	0, 268, 369, 196, 239, 429, 0, 0, 440, 0,
+	426, 451, 0, 88, 271, 383, 450, 110, 210, 346,
+	362, 366, 143, 149, 289, 0, 0, 0, 163, 167,
+	348, 387, 419, 283, 170, 169, 278, 0, 121, 311,
+	376, 117, 0, 0, 0, 0, 0, 0, 0, 158,
+	363, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	330, 304, 223, 0, 0, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 286, 343, 0, 0, 0, 0, 147,
+	133, 189, 288, 164, 179, 161, 0, 0, 0, 0,
+	131, 0, 433, 434, 411, 412, 435, 397, 432, 407,
+	157, 404, 403, 427, 399, 398, 393, 238, 140, 191,
+	235, 323, 401, 400, 442, 422, 423, 431, 381, 438,
+	479, 413, 388, 395, 193, 141, 405, 146, 257, 280,
+	354, 0, 0, 0, 0, 0, 0, 249, 0, 155,
+	0, 0, 0, 0, 177, 218, 0, 344, 357, 185,
+	355, 373, 79, 262, 0, 92, 310, 139, 138, 248,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 241, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 360, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 0, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 498, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 223, 499, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	0, 0, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 433, 434, 411,
+	412, 435, 397, 432, 407, 157, 404, 403, 427, 399,
+	398, 393, 238, 140, 191, 235, 323, 401, 400, 442,
+	422, 423, 431, 381, 438, 437, 413, 388, 395, 193,
+	141, 405, 146, 257, 280, 354, 0, 0, 0, 0,
+	0, 0, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 79, 262, 0,
+	92, 310, 139, 138, 248, 202, 0, 375, 209, 0,
+	0, 0, 0, 441, 0, 108, 82, 120, 406, 0,
+	128, 316, 0, 246, 182, 371, 445, 142, 349, 199,
+	87, 112, 171, 208, 317, 0, 207, 297, 0, 144,
+	126, 302, 96, 176, 292, 447, 0, 0, 123, 290,
+	0, 320, 430, 219, 306, 372, 0, 113, 197, 321,
+	332, 0, 291, 314, 392, 173, 312, 192, 200, 217,
+	236, 240, 394, 184, 187, 275, 352, 0, 224, 351,
+	414, 453, 0, 345, 259, 266, 260, 274, 334, 336,
+	358, 410, 391, 174, 305, 365, 0, 0, 151, 86,
+	178, 0, 186, 325, 359, 409, 201, 130, 162, 353,
+	338, 415, 421, 98, 245, 347, 93, 231, 279, 361,
+	135, 227, 379, 109, 153, 295, 495, 228, 251, 272,
+	341, 416, 0, 134, 175, 337, 0, 241, 315, 425,
+	165, 263, 396, 307, 328, 356, 0, 89, 276, 368,
+	374, 0, 0, 0, 194, 243, 124, 198, 242, 333,
+	172, 215, 418, 152, 221, 253, 97, 156, 255, 303,
+	378, 0, 0, 118, 204, 327, 104, 267, 331, 384,
+	229, 85, 225, 261, 136, 367, 0, 111, 132, 226,
+	293, 0, 269, 281, 0, 90, 168, 129, 145, 237,
+	301, 296, 318, 380, 452, 150, 250, 264, 340, 0,
+	180, 424, 256, 339, 190, 319, 389, 0, 335, 188,
+	214, 0, 220, 0, 206, 0, 258, 0, 106, 212,
+	386, 436, 154, 284, 216, 265, 166, 0, 91, 94,
+	203, 294, 324, 0, 122, 181, 233, 494, 183, 211,
+	443, 0, 270, 287, 232, 313, 350, 0, 101, 298,
+	408, 448, 115, 252, 309, 446, 195, 322, 364, 0,
+	95, 114, 159, 100, 234, 247, 382, 390, 160, 326,
+	444, 0, 308, 127, 230, 417, 99, 102, 103, 282,
+	385, 254, 439, 0, 119, 137, 222, 402, 244, 299,
+	0, 116, 428, 273, 342, 0, 125, 285, 300, 370,
+	84, 277, 420, 449, 205, 377, 0, 83, 107, 0,
+	0, 268, 369, 196, 239, 429, 0, 0, 440, 0,
+	426, 451, 0, 88, 271, 383, 450, 110, 210, 346,
+	362, 366, 143, 149, 289, 0, 0, 0, 163, 167,
+	348, 387, 419, 283, 170, 169, 278, 0, 121, 311,
+	376, 117, 0, 0, 0, 0, 0, 0, 0, 158,
+	363, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	330, 304, 223, 496, 0, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 286, 343, 0, 0, 0, 0, 147,
+	133, 189, 288, 164, 179, 161, 0, 0, 0, 0,
+	131, 0, 433, 434, 411, 412, 435, 397, 432, 407,
+	157, 404, 403, 427, 399, 398, 393, 238, 140, 191,
+	235, 323, 401, 400, 442, 422, 423, 431, 381, 438,
+	493, 413, 388, 395, 193, 141, 405, 146, 257, 280,
+	354, 0, 0, 0, 0, 0, 0, 249, 0, 155,
+	0, 0, 0, 0, 177, 218, 0, 344, 357, 185,
+	355, 373, 79, 262, 0, 92, 310, 139, 138, 248,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 489, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 241, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 488, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 0, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 223, 491, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	0, 0, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 433, 434, 411,
+	412, 435, 397, 432, 407, 157, 404, 403, 427, 399,
+	398, 393, 238, 140, 191, 235, 323, 401, 400, 442,
+	422, 423, 431, 381, 438, 487, 413, 388, 395, 193,
+	141, 405, 146, 257, 280, 354, 0, 0, 0, 0,
+	0, 0, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 79, 262, 0,
+	92, 310, 139, 138, 248, 202, 0, 375, 209, 0,
+	0, 0, 0, 441, 0, 108, 82, 120, 406, 0,
+	128, 316, 0, 246, 182, 371, 445, 142, 349, 199,
+	87, 112, 171, 208, 317, 0, 207, 297, 0, 144,
+	126, 302, 96, 176, 292, 447, 0, 0, 123, 290,
+	0, 320, 430, 219, 306, 372, 0, 113, 197, 321,
+	332, 0, 291, 314, 392, 173, 312, 192, 200, 217,
+	236, 240, 394, 184, 187, 275, 352, 0, 224, 351,
+	414, 453, 0, 345, 259, 266, 260, 274, 334, 336,
+	358, 410, 391, 174, 305, 365, 0, 0, 151, 86,
+	178, 0, 186, 325, 359, 409, 201, 130, 162, 353,
+	338, 415, 421, 98, 245, 347, 93, 231, 279, 361,
+	135, 227, 379, 109, 153, 295, 329, 228, 251, 272,
+	341, 416, 0, 134, 175, 337, 0, 241, 315, 425,
+	165, 263, 396, 307, 328, 356, 0, 89, 276, 368,
+	374, 0, 0, 0, 194, 243, 124, 198, 242, 333,
+	172, 215, 418, 152, 221, 253, 97, 156, 255, 303,
+	378, 0, 0, 118, 204, 327, 104, 267, 331, 384,
+	229, 85, 225, 261, 136, 367, 0, 111, 132, 226,
+	293, 0, 269, 281, 0, 90, 168, 129, 145, 237,
+	301, 296, 318, 380, 452, 150, 250, 264, 340, 0,
+	180, 424, 256, 339, 190, 319, 389, 0, 335, 188,
+	214, 0, 220, 0, 206, 0, 258, 0, 106, 212,
+	386, 436, 154, 284, 216, 265, 166, 0, 91, 94,
+	203, 294, 324, 0, 122, 181, 233, 360, 183, 211,
+	443, 0, 270, 287, 232, 313, 350, 0, 101, 298,
+	408, 448, 115, 252, 309, 446, 195, 322, 364, 0,
+	95, 114, 159, 100, 234, 247, 382, 390, 160, 326,
+	444, 0, 308, 127, 230, 417, 99, 102, 103, 282,
+	385, 254, 439, 0, 119, 137, 222, 402, 244, 299,
+	0, 116, 428, 273, 342, 0, 125, 285, 300, 370,
+	84, 277, 420, 449, 205, 377, 0, 83, 107, 0,
+	0, 268, 369, 196, 239, 429, 0, 0, 440, 0,
+	426, 451, 0, 88, 271, 383, 450, 110, 210, 346,
+	362, 366, 143, 149, 289, 0, 0, 0, 163, 167,
+	348, 387, 419, 283, 170, 169, 278, 0, 121, 311,
+	376, 117, 0, 0, 0, 0, 0, 0, 0, 158,
+	363, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	330, 304, 223, 0, 0, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 286, 343, 0, 0, 0, 0, 147,
+	133, 189, 288, 164, 179, 161, 0, 0, 0, 0,
+	131, 0, 433, 434, 411, 412, 435, 397, 432, 407,
+	157, 404, 403, 427, 399, 398, 393, 238, 140, 191,
+	235, 323, 401, 400, 442, 422, 423, 431, 381, 438,
+	437, 413, 388, 395, 193, 141, 405, 146, 257, 280,
+	354, 0, 0, 0, 0, 0, 0, 249, 0, 155,
+	0, 0, 0, 0, 177, 218, 0, 344, 357, 185,
+	355, 373, 79, 262, 0, 92, 310, 139, 138, 248,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 241, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 360, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 0, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 223, 0, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	0, 0, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 433, 434, 411,
+	412, 435, 397, 432, 407, 157, 404, 403, 427, 399,
+	398, 393, 238, 140, 191, 235, 323, 401, 400, 442,
+	422, 423, 431, 381, 438, 437, 413, 388, 395, 1262,
+	141, 405, 146, 257, 280, 354, 0, 0, 0, 0,
+	0, 0, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 79, 262, 0,
+	92, 310, 139, 138, 248, 202, 0, 375, 209, 0,
+	0, 0, 0, 441, 0, 108, 82, 120, 406, 0,
+	128, 316, 0, 246, 182, 371, 445, 142, 349, 199,
+	87, 112, 171, 208, 1217, 0, 207, 297, 0, 144,
+	126, 302, 96, 176, 292, 447, 0, 0, 123, 290,
+	0, 320, 430, 219, 306, 372, 0, 113, 197, 321,
+	332, 0, 291, 314, 392, 173, 312, 192, 200, 217,
+	236, 240, 394, 184, 187, 275, 352, 0, 224, 351,
+	414, 453, 0, 345, 259, 266, 260, 274, 334, 336,
+	358, 410, 391, 174, 305, 365, 0, 0, 151, 86,
+	178, 0, 186, 325, 359, 409, 201, 130, 162, 353,
+	338, 415, 421, 98, 245, 347, 93, 231, 279, 361,
+	135, 227, 379, 109, 153, 295, 329, 228, 251, 272,
+	341, 416, 0, 134, 175, 337, 0, 241, 315, 425,
+	165, 263, 396, 307, 328, 356, 0, 89, 276, 368,
+	374, 0, 0, 0, 194, 243, 124, 198, 242, 333,
+	172, 215, 418, 152, 221, 253, 97, 156, 255, 303,
+	378, 0, 0, 118, 204, 327, 104, 267, 331, 384,
+	229, 85, 225, 261, 136, 367, 0, 111, 132, 226,
+	293, 0, 269, 281, 0, 90, 168, 129, 145, 237,
+	301, 296, 318, 380, 452, 150, 250, 264, 340, 0,
+	180, 424, 256, 339, 190, 319, 389, 0, 335, 188,
+	214, 0, 220, 0, 206, 0, 258, 0, 106, 212,
+	386, 436, 154, 284, 216, 265, 166, 0, 91, 94,
+	203, 294, 324, 0, 122, 181, 233, 360, 183, 211,
+	443, 0, 270, 287, 232, 313, 350, 0, 101, 298,
+	408, 448, 115, 252, 309, 446, 195, 322, 364, 0,
+	95, 114, 159, 100, 234, 247, 382, 390, 160, 326,
+	444, 0, 308, 127, 230, 417, 99, 102, 103, 282,
+	385, 254, 439, 0, 119, 137, 222, 402, 244, 299,
+	0, 116, 428, 273, 342, 0, 125, 285, 300, 370,
+	84, 277, 420, 449, 205, 377, 0, 83, 107, 0,
+	0, 268, 369, 196, 239, 429, 0, 0, 440, 0,
+	426, 451, 0, 88, 271, 383, 450, 110, 210, 346,
+	362, 366, 143, 149, 289, 0, 0, 0, 163, 167,
+	348, 387, 419, 283, 170, 169, 278, 0, 121, 311,
+	376, 117, 0, 0, 0, 0, 0, 0, 0, 158,
+	363, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	330, 304, 223, 0, 0, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 286, 343, 0, 0, 0, 0, 147,
+	133, 189, 288, 164, 179, 161, 0, 0, 0, 0,
+	131, 0, 433, 434, 411, 412, 435, 397, 432, 407,
+	157, 404, 403, 427, 399, 398, 393, 238, 140, 191,
+	235, 323, 401, 400, 442, 422, 423, 431, 381, 438,
+	437, 413, 388, 395, 193, 141, 405, 146, 257, 280,
+	354, 0, 0, 0, 0, 0, 0, 249, 0, 155,
+	0, 0, 0, 0, 177, 218, 0, 344, 357, 185,
+	355, 373, 79, 262, 0, 92, 483, 139, 138, 248,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 1048, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 482, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 480, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	481, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 475, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 0, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 223, 0, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	0, 0, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 433, 434, 411,
+	412, 435, 397, 432, 407, 157, 404, 403, 427, 399,
+	398, 393, 238, 140, 191, 235, 323, 401, 400, 442,
+	422, 423, 431, 381, 438, 479, 413, 388, 395, 193,
+	141, 405, 146, 257, 280, 354, 0, 0, 0, 0,
+	0, 0, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 79, 262, 0,
+	92, 310, 139, 138, 248, 202, 0, 375, 209, 0,
+	0, 0, 0, 441, 0, 108, 82, 120, 406, 0,
+	128, 316, 0, 246, 182, 371, 445, 142, 349, 199,
+	87, 112, 171, 208, 317, 0, 207, 297, 0, 144,
+	126, 302, 96, 176, 292, 447, 0, 0, 123, 290,
+	0, 320, 430, 219, 306, 372, 0, 113, 197, 321,
+	332, 0, 291, 314, 392, 173, 312, 192, 200, 217,
+	236, 240, 394, 184, 187, 275, 352, 0, 224, 351,
+	414, 453, 0, 345, 259, 266, 260, 274, 334, 336,
+	358, 410, 391, 174, 305, 365, 0, 0, 151, 86,
+	178, 0, 186, 325, 359, 409, 201, 130, 162, 353,
+	338, 415, 421, 98, 245, 347, 93, 231, 279, 361,
+	135, 227, 379, 109, 153, 295, 329, 228, 251, 272,
+	341, 416, 0, 134, 175, 337, 0, 241, 315, 425,
+	165, 263, 396, 307, 328, 356, 0, 89, 276, 368,
+	374, 0, 0, 0, 194, 243, 124, 198, 242, 333,
+	172, 215, 418, 152, 221, 253, 97, 156, 255, 303,
+	378, 0, 0, 118, 204, 327, 104, 267, 331, 384,
+	229, 85, 225, 261, 136, 367, 0, 111, 132, 226,
+	1010, 0, 269, 281, 0, 90, 168, 129, 145, 237,
+	301, 296, 318, 380, 452, 150, 250, 264, 340, 0,
+	180, 424, 256, 339, 190, 319, 389, 0, 335, 188,
+	214, 0, 220, 0, 206, 0, 258, 0, 106, 212,
+	386, 436, 154, 284, 216, 265, 166, 0, 91, 94,
+	203, 294, 324, 0, 122, 181, 233, 360, 183, 211,
+	443, 0, 270, 287, 232, 313, 350, 0, 101, 298,
+	408, 448, 115, 252, 309, 446, 195, 322, 364, 0,
+	95, 114, 159, 100, 234, 247, 382, 390, 160, 326,
+	444, 0, 308, 127, 230, 417, 99, 102, 103, 282,
+	385, 254, 439, 0, 119, 137, 222, 402, 244, 299,
+	0, 116, 428, 273, 342, 0, 125, 285, 300, 370,
+	84, 277, 420, 449, 205, 377, 0, 83, 107, 0,
+	0, 268, 369, 196, 239, 429, 0, 0, 440, 0,
+	426, 451, 0, 88, 271, 383, 450, 110, 210, 346,
+	362, 366, 143, 149, 289, 0, 0, 0, 163, 167,
+	348, 387, 419, 283, 170, 169, 278, 0, 121, 311,
+	376, 117, 0, 0, 0, 0, 0, 0, 0, 158,
+	363, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	330, 304, 223, 0, 0, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 286, 343, 0, 0, 0, 0, 147,
+	133, 189, 288, 164, 179, 161, 0, 0, 0, 0,
+	131, 0, 433, 434, 411, 412, 435, 397, 432, 407,
+	157, 404, 403, 427, 399, 398, 393, 238, 140, 191,
+	235, 323, 401, 400, 442, 422, 423, 431, 381, 438,
+	437, 413, 388, 395, 193, 141, 405, 146, 257, 280,
+	354, 0, 0, 0, 0, 0, 0, 249, 0, 155,
+	0, 0, 0, 0, 177, 218, 0, 344, 357, 185,
+	355, 373, 79, 262, 0, 92, 483, 139, 138, 248,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 317,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 474, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 482, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 480, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	481, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 475, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 0, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 473, 0, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	0, 0, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 433, 434, 411,
+	412, 435, 397, 432, 407, 157, 404, 403, 427, 399,
+	398, 393, 238, 140, 191, 235, 323, 401, 400, 442,
+	422, 423, 431, 381, 438, 479, 413, 388, 395, 193,
+	141, 405, 146, 257, 280, 354, 0, 0, 0, 0,
+	0, 0, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 79, 262, 0,
+	92, 310, 139, 138, 248, 202, 0, 375, 209, 0,
+	0, 0, 0, 441, 0, 108, 82, 120, 406, 0,
+	128, 316, 0, 246, 182, 371, 445, 142, 349, 199,
+	87, 112, 171, 208, 317, 0, 207, 297, 0, 144,
+	126, 302, 96, 176, 292, 447, 0, 0, 123, 290,
+	0, 320, 430, 219, 306, 372, 0, 113, 197, 321,
+	332, 0, 291, 314, 392, 173, 312, 192, 200, 217,
+	236, 240, 394, 184, 187, 275, 352, 0, 224, 351,
+	414, 453, 0, 345, 259, 266, 260, 274, 334, 336,
+	358, 410, 391, 174, 305, 365, 0, 0, 151, 86,
+	178, 0, 186, 325, 359, 409, 201, 130, 162, 353,
+	338, 415, 421, 98, 245, 347, 93, 231, 279, 361,
+	135, 227, 379, 109, 153, 295, 329, 228, 251, 272,
+	341, 416, 0, 134, 175, 337, 0, 241, 315, 425,
+	165, 263, 396, 307, 328, 356, 0, 89, 276, 368,
+	374, 0, 0, 0, 194, 243, 124, 198, 242, 333,
+	172, 215, 418, 152, 221, 253, 97, 156, 255, 303,
+	378, 0, 0, 118, 204, 327, 104, 267, 331, 384,
+	229, 85, 225, 261, 136, 367, 0, 111, 132, 226,
+	293, 0, 269, 281, 0, 90, 168, 129, 145, 237,
+	301, 296, 318, 380, 452, 150, 250, 264, 340, 0,
+	180, 424, 256, 339, 190, 319, 389, 0, 335, 188,
+	214, 0, 220, 0, 206, 0, 258, 0, 106, 212,
+	386, 436, 154, 284, 216, 265, 166, 0, 91, 94,
+	203, 294, 324, 0, 122, 181, 233, 456, 183, 211,
+	443, 0, 270, 287, 232, 313, 350, 0, 101, 298,
+	408, 448, 115, 252, 309, 446, 195, 322, 364, 0,
+	95, 114, 159, 100, 234, 247, 382, 390, 160, 326,
+	444, 0, 308, 127, 230, 417, 99, 102, 103, 282,
+	385, 254, 439, 0, 119, 137, 222, 402, 244, 299,
+	0, 116, 428, 273, 342, 0, 125, 285, 300, 370,
+	84, 277, 420, 449, 205, 377, 0, 83, 107, 0,
+	0, 268, 369, 196, 239, 429, 0, 0, 440, 0,
+	426, 451, 0, 88, 271, 383, 450, 110, 210, 346,
+	362, 366, 143, 149, 289, 0, 0, 0, 163, 167,
+	348, 387, 419, 283, 170, 169, 278, 0, 121, 311,
+	376, 117, 0, 0, 0, 0, 0, 0, 0, 158,
+	363, 0, 0, 0, 0, 105, 0, 0, 0, 0,
+	330, 304, 223, 0, 0, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 286, 343, 0, 0, 0, 0, 147,
+	133, 189, 288, 164, 179, 161, 0, 0, 0, 0,
+	131, 0, 433, 434, 411, 412, 435, 397, 432, 407,
+	157, 404, 403, 427, 399, 398, 393, 238, 140, 191,
+	235, 323, 401, 400, 442, 422, 423, 431, 381, 438,
+	437, 413, 388, 395, 193, 141, 405, 146, 257, 280,
+	354, 0, 0, 0, 0, 0, 0, 249, 0, 155,
+	0, 0, 0, 0, 177, 218, 0, 344, 357, 185,
+	355, 373, 79, 262, 0, 92, 310, 139, 138, 248,
+	202, 0, 375, 209, 0, 0, 0, 0, 441, 0,
+	108, 82, 120, 406, 0, 128, 316, 0, 246, 182,
+	371, 445, 142, 349, 199, 87, 112, 171, 208, 454,
+	0, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	447, 0, 0, 123, 290, 0, 320, 430, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 0, 224, 351, 414, 453, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 410, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	409, 201, 130, 162, 353, 338, 415, 421, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 416, 0, 134, 175,
+	337, 0, 241, 315, 425, 165, 263, 396, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 0, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 418, 152, 221,
+	253, 97, 156, 255, 303, 378, 0, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 452,
+	150, 250, 264, 340, 0, 180, 424, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	0, 258, 0, 106, 212, 386, 436, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 360, 183, 211, 443, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 408, 448, 115, 252, 309,
+	446, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 444, 0, 308, 127, 230,
+	417, 99, 102, 103, 282, 385, 254, 439, 0, 119,
+	137, 222, 402, 244, 299, 0, 116, 428, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 420, 449, 205,
+	377, 0, 83, 107, 0, 0, 268, 369, 196, 239,
+	429, 0, 0, 440, 0, 426, 451, 0, 88, 271,
+	383, 450, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 419, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 0,
+	0, 0, 0, 0, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 0, 330, 304, 223, 0, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	0, 0, 0, 0, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 433, 434, 411,
+	412, 435, 397, 432, 407, 157, 404, 403, 427, 399,
+	398, 393, 238, 140, 191, 235, 323, 401, 400, 442,
+	422, 423, 431, 381, 438, 437, 413, 388, 395, 193,
+	141, 405, 146, 257, 280, 354, 0, 0, 0, 0,
+	0, 0, 249, 0, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 0, 262, 0,
+	0, 0, 1248, 138, 248, 92, 310, 139, 1086, 0,
+	202, 0, 375, 209, 0, 0, 0, 0, 0, 0,
+	108, 82, 120, 0, 0, 128, 316, 0, 246, 182,
+	371, 0, 142, 349, 199, 87, 112, 171, 208, 317,
+	1074, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	0, 0, 0, 123, 290, 0, 320, 0, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 1071, 224, 351, 0, 0, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 0, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	0, 201, 130, 162, 353, 338, 0, 0, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 0, 1076, 134, 175,
+	337, 1089, 241, 315, 0, 165, 263, 0, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 1079, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 0, 152, 221,
+	253, 97, 156, 255, 303, 378, 1092, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 0,
+	150, 250, 264, 340, 0, 180, 0, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	1085, 258, 0, 106, 212, 386, 0, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 360, 183, 211, 0, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 0, 0, 115, 252, 309,
+	0, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 0, 0, 308, 127, 230,
+	0, 99, 102, 103, 282, 385, 254, 0, 0, 119,
+	137, 222, 0, 244, 299, 0, 116, 0, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 0, 0, 205,
+	377, 0, 83, 107, 1073, 0, 268, 369, 196, 239,
+	0, 1091, 0, 0, 0, 0, 0, 1093, 88, 271,
+	383, 0, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 0, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 1088,
+	1078, 1090, 1084, 1080, 158, 363, 0, 0, 0, 0,
+	105, 0, 0, 0, 1075, 330, 304, 223, 0, 0,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1082, 0, 1083, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	0, 0, 1072, 1077, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 0, 1223, 1231,
+	1232, 1233, 1234, 1235, 1236, 1181, 1237, 1238, 1239, 1240,
+	1251, 393, 238, 140, 191, 235, 323, 1252, 1253, 1254,
+	1255, 1256, 0, 381, 1245, 1246, 1247, 388, 395, 193,
+	141, 0, 146, 257, 280, 354, 0, 1081, 0, 0,
+	0, 0, 249, 1087, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 0, 262, 0,
+	0, 0, 1248, 138, 248, 92, 310, 139, 1086, 0,
+	202, 0, 375, 209, 0, 0, 0, 0, 0, 0,
+	108, 82, 120, 0, 0, 128, 316, 0, 246, 182,
+	371, 0, 142, 349, 199, 87, 112, 171, 208, 317,
+	1074, 207, 297, 0, 144, 126, 302, 96, 176, 292,
+	0, 0, 0, 123, 290, 0, 320, 0, 219, 306,
+	372, 0, 113, 197, 321, 332, 0, 291, 314, 392,
+	173, 312, 192, 200, 217, 236, 240, 394, 184, 187,
+	275, 352, 1071, 224, 351, 0, 0, 0, 345, 259,
+	266, 260, 274, 334, 336, 358, 0, 391, 174, 305,
+	365, 0, 0, 151, 86, 178, 0, 186, 325, 359,
+	0, 201, 130, 162, 353, 338, 0, 0, 98, 245,
+	347, 93, 231, 279, 361, 135, 227, 379, 109, 153,
+	295, 329, 228, 251, 272, 341, 0, 1076, 134, 175,
+	337, 1089, 241, 315, 0, 165, 263, 0, 307, 328,
+	356, 0, 89, 276, 368, 374, 0, 1079, 0, 194,
+	243, 124, 198, 242, 333, 172, 215, 0, 152, 221,
+	253, 97, 156, 255, 303, 378, 1092, 0, 118, 204,
+	327, 104, 267, 331, 384, 229, 85, 225, 261, 136,
+	367, 0, 111, 132, 226, 293, 0, 269, 281, 0,
+	90, 168, 129, 145, 237, 301, 296, 318, 380, 0,
+	150, 250, 264, 340, 0, 180, 0, 256, 339, 190,
+	319, 389, 0, 335, 188, 214, 0, 220, 0, 206,
+	1085, 258, 0, 106, 212, 386, 0, 154, 284, 216,
+	265, 166, 0, 91, 94, 203, 294, 324, 0, 122,
+	181, 233, 360, 183, 211, 0, 0, 270, 287, 232,
+	313, 350, 0, 101, 298, 0, 0, 115, 252, 309,
+	0, 195, 322, 364, 0, 95, 114, 159, 100, 234,
+	247, 382, 390, 160, 326, 0, 0, 308, 127, 230,
+	0, 99, 102, 103, 282, 385, 254, 0, 0, 119,
+	137, 222, 0, 244, 299, 0, 116, 0, 273, 342,
+	0, 125, 285, 300, 370, 84, 277, 0, 0, 205,
+	377, 0, 83, 107, 1073, 0, 268, 369, 196, 239,
+	0, 1091, 0, 0, 0, 0, 0, 1093, 88, 271,
+	383, 0, 110, 210, 346, 362, 366, 143, 149, 289,
+	0, 0, 0, 163, 167, 348, 387, 0, 283, 170,
+	169, 278, 0, 121, 311, 376, 117, 0, 0, 1088,
+	1078, 1090, 1084, 1080, 158, 363, 0, 0, 0, 0,
+	105, 1355, 0, 0, 1075, 330, 304, 223, 0, 0,
+	213, 0, 1356, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1082, 0, 1083, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 286, 343,
+	0, 0, 1072, 1077, 147, 133, 189, 288, 164, 179,
+	161, 0, 0, 0, 0, 131, 0, 0, 0, 1231,
+	1232, 1233, 1234, 1235, 1236, 1181, 1237, 1238, 1239, 1240,
+	1251, 393, 238, 140, 191, 235, 323, 1252, 1253, 1254,
+	1255, 1256, 0, 381, 1245, 1246, 1247, 388, 395, 193,
+	141, 0, 146, 257, 280, 354, 0, 1081, 0, 0,
+	0, 0, 249, 1087, 155, 0, 0, 0, 0, 177,
+	218, 0, 344, 357, 185, 355, 373, 0, 262, 0,
+	0, 0, 0, 138, 248, 2151, 2149, 0, 527, 2085,
+	2091, 2092, 0, 1446, 2090, 1486, 2074, 2075, 511, 512,
+	513, 1444, 2076, 1445, 0, 0, 0, 0, 1491, 0,
+	0, 1475, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1463, 0, 0,
+	0, 0, 0, 0, 0, 1468, 1478, 0, 0, 1459,
+	0, 0, 0, 0, 0, 1494, 0, 0, 0, 0,
+	1465, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1466, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 597, 618, 0, 0, 0,
+	1493, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1348, 0, 0, 1353, 0,
+	0, 1337, 1338, 1339, 1340, 1344, 1347, 1343, 1346, 1341,
+	1342, 0, 0, 0, 0, 598, 0, 0, 0, 0,
+	596, 0, 1477, 0, 0, 1345, 1349, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1350, 0, 1351,
+	0, 1476, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1488, 0, 0, 0, 0,
+	1464, 0, 0, 1485, 0, 0, 0, 0, 0, 0,
+	0, 1352, 0, 0, 0, 0, 0, 1354, 1497, 0,
+	0, 0, 0, 0, 0, 0, 1481, 0, 0, 0,
+	1480, 0, 1460, 0, 0, 0, 1487, 0, 0, 1357,
+	0, 0, 1336, 0, 0, 0, 1492, 0, 0, 0,
+	0, 0, 1496, 0, 0, 0, 0, 0, 0, 0,
+	1490, 0, 0, 0, 0, 0, 1457, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1471, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1482, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 599, 0, 0, 0, 0, 1355, 0, 1454,
+	0, 0, 0, 0, 1467, 0, 0, 0, 1356, 0,
+	0, 0, 0, 0, 0, 1469, 0, 0, 0, 1458,
+	0, 0, 0, 0, 0, 0, 1489, 0, 1442, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1474, 602, 1462, 0, 0, 0,
+	0, 0, 0, 2077, 2078, 2079, 521, 2080, 2081, 2086,
+	2087, 1447, 540, 0, 0, 0, 0, 0, 2088, 2089,
+	544, 543, 537, 538, 2093, 542, 541, 624, 2096, 2082,
+	2083, 2084, 1443, 1450, 2094, 1449, 1451, 1495, 548, 547,
+	545, 546, 0, 0, 0, 0, 0, 1484, 0, 566,
+	2095, 557, 0, 556, 560, 0, 0, 0, 0, 558,
+	559, 0, 0, 0, 1453, 1452, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 567, 1448, 551, 0,
+	573, 0, 0, 2098, 2101, 0, 0, 0, 2099, 2100,
+	0, 0, 0, 0, 0, 0, 0, 2151, 0, 0,
+	527, 2085, 2091, 2092, 0, 1446, 2090, 1486, 2074, 2075,
+	511, 512, 513, 1444, 2076, 1445, 0, 0, 0, 0,
+	1491, 2097, 1483, 1475, 1473, 1470, 0, 0, 0, 1456,
+	1455, 1472, 0, 0, 0, 616, 0, 0, 0, 1463,
+	0, 1479, 0, 626, 625, 1461, 550, 1468, 1478, 0,
+	0, 1459, 0, 0, 0, 0, 0, 1494, 0, 0,
+	0, 0, 1465, 0, 0, 0, 0, 0, 0, 0,
+	1350, 0, 1351, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1466, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 597, 618, 1903,
+	0, 1348, 1493, 0, 1353, 0, 0, 1337, 1338, 1339,
+	1340, 1344, 1347, 1343, 1346, 1341, 1342, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1345, 1349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 598, 0, 0,
+	0, 0, 596, 0, 1477, 0, 0, 0, 35, 45,
+	0, 0, 0, 58, 0, 56, 49, 48, 50, 0,
+	0, 0, 61, 1476, 0, 25, 0, 1352, 1350, 0,
+	1351, 0, 0, 1354, 0, 0, 0, 1488, 0, 0,
+	0, 0, 1464, 29, 0, 1485, 0, 0, 0, 0,
+	1355, 0, 0, 0, 1350, 1357, 0, 0, 1336, 0,
+	1497, 1356, 0, 0, 0, 0, 0, 0, 1481, 0,
+	0, 0, 1480, 0, 1460, 0, 0, 0, 1487, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1492, 0,
+	0, 0, 0, 0, 1496, 0, 0, 0, 0, 0,
+	0, 0, 1490, 0, 0, 0, 0, 0, 1457, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1471, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1482, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 599, 0, 0, 0, 0, 0,
+	0, 1454, 0, 0, 0, 0, 1467, 0, 1355, 0,
+	0, 0, 0, 0, 0, 0, 0, 1469, 38, 1356,
+	0, 1458, 0, 0, 0, 0, 0, 0, 1489, 0,
+	1442, 33, 0, 0, 1355, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1356, 1474, 602, 1462, 0,
+	0, 0, 0, 0, 0, 2077, 2078, 2079, 521, 2080,
+	2081, 2086, 2087, 1447, 540, 0, 0, 26, 0, 0,
+	2088, 2089, 544, 543, 537, 538, 2093, 542, 541, 624,
+	2096, 2082, 2083, 2084, 1443, 1450, 2094, 1449, 1451, 1495,
+	548, 547, 545, 546, 0, 0, 0, 0, 0, 1484,
+	0, 566, 2095, 557, 0, 556, 560, 0, 0, 0,
+	0, 558, 559, 0, 0, 0, 1453, 1452, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 567, 1448,
+	551, 0, 573, 0, 0, 2098, 2101, 0, 0, 0,
+	2099, 2100, 0, 0, 0, 0, 0, 0, 0, 0,
+	1631, 0, 0, 0, 1348, 0, 0, 1353, 30, 0,
+	1337, 1338, 1339, 1340, 1344, 1347, 1343, 1346, 1341, 1342,
+	0, 0, 0, 2097, 1483, 0, 1473, 1470, 0, 0,
+	0, 1456, 1455, 1472, 1345, 1349, 0, 616, 0, 0,
+	0, 0, 0, 1479, 0, 626, 625, 1461, 550, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 24, 34, 31, 32, 27, 28, 40,
+	41, 42, 0, 0, 0, 0, 0, 0, 44, 43,
+	1352, 0, 0, 0, 0, 0, 1354, 0, 0, 36,
+	37, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	55, 0, 0, 0, 0, 0, 0, 0, 1357, 0,
+	0, 1336, 1348, 0, 0, 1353, 0, 0, 1337, 1338,
+	1339, 1340, 1344, 1347, 1343, 1346, 1341, 1342, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1348, 51,
+	0, 1353, 1345, 1349, 1337, 1338, 1339, 1340, 1344, 1347,
+	1343, 1346, 1341, 1342, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1345, 1349,
+	0, 0, 0, 0, 39, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1352, 0,
+	0, 0, 0, 0, 1354, 0, 0, 0, 0, 0,
+	0, 0, 0, 60, 59, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1352, 0, 1357, 0, 0, 1336,
+	1354, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1357, 0, 0, 1336,
+}
+
+var yyPact = [...]int16{
+	28241, -1000, 58, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 58,
+	58, 58, 58, 58, 220, 763, 738, 866, 863, 22662,
+	26127, 25632, 763, 763, 22662, 241, 25137, 22167, 21672, 21177,
+	3643, 3643, 3643, 3643, 3643, 3643, -1000, -1000, 598, -69,
+	1065, -20, -1000, 812, 484, 231, -109, 479, 22662, 24642,
+	22662, 402, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1037, -1000, -1000, 254, 1037, 1176, 236, 1173, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 22662, -1000, 1172, 435, -1000, 254,
+	1037, 314, -169, 3643, 3643, 3643, 1087, -1000, -1000, -241,
+	-252, -1000, -1000, 24147, 20682, 287, -1000, -1000, 277, -162,
+	1171, 1170, 274, 7159, -1000, -1000, -1000, -163, 758, 962,
+	55, -1000, 55, -165, 757, 961, -1000, -1000, 20187, -1000,
+	-1000, 3643, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 3643, -1000, 22662, 22662, -1000, -69, 22662, 207, -1000,
+	-110, -110, -110, -1000, 510, 540, 812, -1000, -1000, 248,
+	224, 9175, 9175, -1000, 23, 434, -1000, -1000, 54, 477,
+	22662, -1000, 423, 477, 11695, -1000, 140, -1000, 244, -1000,
+	775, 283, -1000, 704, -1000, -1000, -1000, 23652, -1000, -1000,
+	-1000, -310, 26627, -1000, -1000, -1000, 11695, 1108, -195, -1000,
+	-1000, -1000, 23157, -1000, -1000, -1000, -1000, -1000, 983, -1000,
+	-318, 6659, 244, 1169, 5155, 4651, 908, 22662, 352, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 773, -1000, -1000, 771, -1000,
+	-1000, -1000, -1000, -1000, 420, 499, 22662, 404, 239, -1000,
+	-1000, -1000, -1000, 484, -1000, -1000, 484, 484, 513, 547,
+	553, 149, 517, 537, -1000, 11191, 66, 13207, 849, 11695,
+	-1000, 510, -1000, -1000, 585, 444, -1000, 413, -1000, -1000,
+	11695, 11695, 11695, -1000, -1000, -1000, -1000, -1000, 8671, -1000,
+	-1000, 91, -1000, -1000, 386, -1000, -1000, 640, 1168, 625,
+	-1000, -1000, -1000, 31, -1000, -1000, 11695, -1000, 388, -1000,
+	-1000, -1000, -1000, -1000, 34, -1000, -1000, -1000, -1000, -1000,
+	415, -131, 410, 410, 410, -1000, -1000, -1000, -1000, -1000,
+	-206, -210, -134, -134, -134, -121, -134, -1000, 419, 445,
+	416, 11695, 2636, 22662, 477, -311, 22662, 267, 28248, -1000,
+	1014, 254, -1000, 963, -1000, -1000, -1000, 22662, -1000, 207,
+	266, -1000, -78, 27127, -1000, -1000, -1000, -1000, -1000, -63,
+	410, -1000, -1000, -1000, -1000, -1000, 415, 410, 410, 410,
+	-1000, -1000, -1000, -1000, -1000, -206, -210, -1000, -1000, -1000,
+	-1000, -134, -134, -134, -1000, -121, -134, 255, 407, -1000,
+	-172, -317, -201, 404, -318, 736, -1000, -1000, -1000, 474,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 474, -1000,
+	-1000, -1000, -1000, -1000, -1000, 624, -1000, -1000, -1000, -1000,
+	231, -1000, 22662, 22662, 483, 247, 22662, -231, 620, -1000,
+	620, -1000, -1000, -1000, -1000, -1000, 162, -71, -1000, 492,
+	-1000, 981, -1000, -1000, -356, 28248, -1000, 14215, -1000, -1000,
+	-1000, 201, 28248, -1000, 12703, 12703, 764, 22662, 473, -1000,
+	365, 513, 547, 1058, 19692, 9175, 26627, 11695, 11695, 11695,
+	11695, 11695, 11695, 11695, 11695, 11695, 11695, 11695, 11695, 11695,
+	11695, 11695, -135, -1000, -1000, -216, 387, 865, 694, 1107,
+	-1000, -243, -243, 27361, 49, 472, 28140, 224, 402, -1000,
+	-1000, 10687, -1000, 8167, -1000, -1000, -55, 1166, 2636, 789,
+	28248, 10183, 19192, -1000, 1164, -1000, -1000, 11695, -1000, -1000,
+	1163, -1000, -155, -158, 1161, -1000, 396, -1000, -1000, -1000,
+	-134, -134, -1000, 1102, 1058, 11695, 240, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 169,
+	237, -1000, 11695, -1000, -1000, -1000, -1000, 725, 993, -1000,
+	-1000, -1000, 26627, -1000, 92, 93, -90, -1000, 1156, -232,
+	-1000, -235, -236, -268, -1000, 391, -1000, -1000, 16214, -1000,
+	610, -174, -272, 254, 5659, -55, 1154, 12, 225, -1000,
+	642, -1000, -209, -1000, 1040, 1104, -50, -1000, -1000, 222,
+	-1000, -1000, -50, -1000, 1078, -1000, 11695, 201, -233, -1000,
+	1095, 1095, 388, -1000, 11695, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 816, 471, -1000, 11695, -230, -1000, -1000,
+	-1000, -1000, 1040, 15714, -1000, 1085, 1085, -256, -278, 1085,
+	22662, -1000, -1000, -1000, -243, -243, -264, -264, -264, -284,
+	30, 30, 30, 30, 30, 30, 28248, 27361, 28274, -1000,
+	-132, -1000, -1000, 1057, -1000, -1000, 13711, -1000, -1000, -1000,
+	8671, 387, 386, -1000, -1000, -1000, -1000, -1000, 3139, 11695,
+	11695, -1000, 85, -1000, -1000, 376, 11695, 11695, 11695, 218,
+	-1000, 28248, -1000, 205, -1000, 978, -1000, 11695, 217, -1000,
+	-1000, -1000, 202, 214, 199, -166, -167, 198, 11695, -1000,
+	-1000, 195, 1040, 213, -1000, 374, -1000, 912, 22662, -1000,
+	28248, -1000, -1000, -1000, -1000, 466, 1146, 92, 88, 183,
+	-122, -116, -99, -128, 1145, 210, 26627, 491, 489, 22662,
+	22662, -19, -101, -177, 244, -1000, -1000, 165, -73, 664,
+	22662, -1000, 22662, 1040, 11695, 11695, 49, -73, 11695, -1000,
+	469, -1000, 229, -1000, -1000, 22662, 229, -1000, -1000, -234,
+	-1000, 933, -1000, -1000, 22662, -1000, -1000, -244, 520, 468,
+	465, 178, 18194, 22662, 178, -1000, 14715, -288, 32, -1000,
+	371, 22662, -1000, 22662, 1085, 1085, 1085, 1085, 22662, -1000,
+	-1000, -1000, -1000, 1052, -1000, -1000, 11695, 524, -1000, 13711,
+	13711, -1000, 203, -1000, -1000, -1000, -1000, 27787, 363, 2049,
+	-1000, 161, 9679, -1000, 539, 355, 355, -1000, 1135, 842,
+	-1000, 11695, 1062, -1000, -1000, -1000, -265, -1000, -1000, -265,
+	194, -1000, 520, -1000, 207, -1000, -1000, -1000, 82, 80,
+	1130, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 157,
+	16214, -272, 996, 368, 367, 314, -319, -182, 604, -1000,
+	-1000, 2636, -1000, 1127, -1000, 9175, 48, -1000, -1000, -73,
+	28248, 28248, -1000, -1000, 28248, 1104, -14, -1000, -1000, -1000,
+	-1000, -302, -1000, 932, -1000, -1000, -1000, -1000, 679, 536,
+	15714, 7, 5, 15714, 35, 557, 557, 557, -1000, -1000,
+	-1000, 1104, -1000, -1000, -1000, 18697, 366, -1000, 18194, 31,
+	-1000, 156, 176, 465, -1000, -285, 2636, 8167, -1000, -1000,
+	22662, 22662, 22662, 22662, -1000, 11695, 11, 11695, 13711, 13711,
+	13711, 13711, 13711, 13711, 13711, 13711, 13711, 13711, 13711, 13711,
+	45, 45, -1000, -1000, 11695, -1000, 1110, 11695, -1000, 152,
+	146, 11695, 136, -1000, -1000, 28248, 11695, -1000, -1000, -1000,
+	679, 129, -1000, -1000, 70, -1000, 451, 3643, 825, -1000,
+	17699, 1104, 22662, -290, 609, -1000, 22662, -87, 193, -1000,
+	-37, -1000, 444, 622, 17204, 1005, -1000, -1000, -302, 1126,
+	-39, 347, -1000, -1000, -1000, 505, 11695, -110, 465, 15714,
+	15714, 623, -2, 15714, -1000, -1000, -1000, -1000, -1000, 22662,
+	362, 22662, -1000, 178, -1000, -1000, 376, -1000, -1000, -1000,
+	-1000, 11, -245, 45, 45, -33, -33, -33, -1000, 67,
+	67, 67, 67, 67, 67, 69, 28248, -1000, 539, -1000,
+	-1000, -1000, 28248, 505, 711, -1000, -1000, -1000, 349, -1000,
+	-1000, 128, 190, -1000, -347, 22662, -1000, -1000, 883, 881,
+	-1000, 2636, 308, 2636, 994, 189, -1000, -220, -308, 11695,
+	22662, 347, -1000, 1125, -1000, 519, 2636, -1000, 3643, 28248,
+	12199, -1000, 623, -1000, 329, 11695, 15714, -1000, 186, -1000,
+	27631, 22662, 181, -1000, 123, -1000, 117, -1000, 676, 828,
+	323, 2636, 369, 369, -1000, -324, 314, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -54, 498, -1000, 1040, 17204, -1000, 22662, 28083,
+	-1000, 496, -1000, 1040, -1000, -1000, -1000, -1000, -321, -321,
+	-1000, -1000, -321, -321, -123, 1124, 180, -1000, 4147, -1000,
+	-1000, 437, -1000, 28248, -1000, -1000, -1000, -1000, 7663, 313,
+	281, 831, -1000, 22662, 28248, -1000, 22662, -1000, -307, -307,
+	-1000, -1000, 177, -1000, -1000, -1000, -1000, 22662, -219, -225,
+	-1000, 22662, -1000, -1000, 142, -1000, 370, 269, 13711, -1000,
+	995, 172, -1000, 1123, -1000, -1000, 1104, -1000, -1000, -290,
+	308, 22662, -1000, -1000, -214, 253, -1000, -1000, -308, -1000,
+	1116, -1000, 1115, 1112, 1111, 16709, 28, 22662, -1000, 2636,
+	-1000, -1000, -1000, -1000, 6159, 12199, -1000, 11695, 11695, 170,
+	168, -1000, -1000, 28083, -1000, -1000, 364, 522, 521, 46,
+	-1000, -1000, -293, 11695, 549, -1000, -1000, -1000, 2636, -1000,
+	-1000, 163, -1000, -1000, 635, -230, -214, -1000, -1000, -1000,
+	-1000, -1000, 356, -1000, 22662, 356, -1000, 151, -1000, -1000,
+	-1000, 147, 111, 12199, -1000, -1000, 22662, 22662, 22662, -1000,
+	26, 482, 141, 446, -1000, 1104, -1000, -1000, -230, 356,
+	-1000, 6159, -1000, -1000, 106, -157, 361, 261, -1000, 1067,
+	-1000, 2636, -329, 100, -1000, -1000, -1000, 15219, 22662, 22662,
+	22662, -191, 103, -1000, -64, -1000, 839, -1000, -205, 556,
+	-1000, -1000, -1000, 22662, -1000, 2636, 308, -1000, 15219, 11695,
+	-1000, -1000, -1000, 448, 355, 22662, 97, -1000, 1110,
+}
+
+var yyPgo = [...]int16{
+	0, 1380, 1054, 10, 24, 1377, 14, 103, 16, 55,
+	87, 1375, 1374, 1371, 1368, 19, 1366, 1349, 1346, 1345,
+	1344, 1, 1341, 1333, 1331, 1327, 1325, 18, 114, 1322,
+	1321, 1320, 136, 153, 1319, 1319, 1318, 51, 1317, 78,
+	85, 152, 80, 116, 1316, 1315, 15, 71, 12, 58,
+	473, 106, 1314, 1313, 1311, 1309, 1308, 1307, 42, 11,
+	36, 1306, 48, 133, 1073, 1071, 1061, 1305, 1304, 1303,
+	1302, 1301, 1300, 1299, 1296, 1295, 1102, 28, 54, 81,
+	34, 96, 6, 157, 53, 45, 1292, 1291, 1290, 146,
+	1289, 129, 77, 1288, 1287, 1286, 1285, 20, 8, 82,
+	1284, 126, 118, 132, 107, 1283, 143, 140, 131, 138,
+	1283, 1282, 1281, 144, 63, 1280, 1280, 1280, 1280, 1056,
+	117, 147, 1279, 1278, 319, 1277, 1276, 1273, 1273, 1268,
+	1263, 1257, 149, 2, 1256, 142, 72, 83, 1256, 1256,
+	1256, 1256, 1256, 1255, 1254, 1077, 1254, 1254, 1254, 1254,
+	1253, 1253, 1252, 122, 1250, 75, 1249, 1248, 1247, 23,
+	26, 0, 1247, 1246, 1244, 9, 1244, 1244, 1244, 1243,
+	1242, 22, 1241, 1241, 1241, 1241, 67, 1240, 1240, 1240,
+	1239, 5, 130, 1238, 162, 88, 1237, 7, 21, 1236,
+	1235, 1234, 1233, 1232, 86, 1231, 56, 66, 52, 1231,
+	684, 1230, 1229, 73, 27, 76, 154, 84, 65, 69,
+	97, 163, 1229, 29, 50, 1229, 98, 1228, 345, 128,
+	125, 127, 120, 110, 148, 135, 1227, 145, 123, 1226,
+	1224, 119, 115, 1222, 1221, 1220, 102, 139, 1219, 1217,
+	141, 124, 105, 1215, 1213, 1211, 121, 1211, 57, 1210,
+	1210, 150, 1210, 1210, 1210, 1210, 1209, 1206, 62, 4,
+	104, 79, 1205, 1199, 3, 1198, 1196, 1192, 1191, 1190,
+	95, 376, 1190, 47, 1189, 1188, 17, 13, 1187, 99,
+	108, 68, 137, 1185, 1185, 74, 1184, 43, 1183, 1182,
+	25, 33, 64, 1182, 1182,
+}
+
+var yyR1 = [...]int16{
+	0, 256, 76, 76, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 60, 60, 60, 60,
+	60, 60, 60, 60, 60, 60, 60, 60, 60, 60,
+	60, 60, 60, 60, 60, 60, 60, 60, 60, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 184, 184, 184, 184,
+	184, 209, 209, 211, 211, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 257, 4, 4, 5, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 242, 242, 242,
+	242, 242, 242, 241, 241, 241, 240, 240, 240, 240,
+	240, 240, 240, 239, 239, 239, 239, 243, 238, 237,
+	237, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 235, 235, 234, 234, 233, 233, 232, 231,
+	171, 173, 173, 172, 172, 258, 258, 258, 258, 259,
+	259, 259, 230, 230, 229, 229, 228, 227, 226, 226,
+	226, 226, 226, 226, 225, 225, 224, 224, 224, 224,
+	223, 222, 222, 222, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 220, 220,
+	195, 195, 195, 195, 195, 195, 195, 195, 260, 160,
+	160, 160, 77, 77, 6, 6, 215, 215, 214, 214,
+	213, 213, 78, 78, 78, 78, 78, 78, 78, 43,
+	46, 46, 82, 82, 82, 82, 82, 82, 82, 82,
+	82, 82, 81, 159, 159, 265, 265, 244, 244, 266,
+	266, 56, 56, 55, 267, 267, 267, 268, 249, 249,
+	249, 249, 248, 248, 247, 247, 246, 246, 51, 51,
+	52, 269, 269, 270, 53, 53, 54, 54, 49, 49,
+	49, 49, 49, 49, 49, 49, 49, 49, 49, 48,
+	48, 48, 48, 48, 48, 48, 48, 48, 48, 48,
+	48, 48, 48, 48, 48, 48, 48, 48, 48, 48,
+	48, 48, 48, 48, 48, 48, 48, 48, 48, 48,
+	48, 48, 48, 48, 48, 48, 48, 48, 48, 157,
+	158, 156, 156, 156, 162, 50, 50, 50, 50, 50,
+	50, 50, 50, 50, 50, 50, 50, 50, 50, 50,
+	50, 8, 8, 154, 154, 155, 155, 153, 153, 153,
+	153, 131, 131, 131, 132, 132, 68, 68, 68, 124,
+	124, 124, 123, 123, 123, 123, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 119, 119, 121, 121,
+	120, 120, 217, 217, 217, 217, 133, 133, 133, 133,
+	122, 122, 122, 122, 122, 122, 129, 126, 126, 126,
+	126, 127, 127, 128, 128, 130, 130, 130, 130, 130,
+	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
+	143, 143, 144, 144, 145, 145, 145, 216, 216, 216,
+	216, 216, 219, 219, 219, 218, 218, 271, 271, 271,
+	2, 2, 2, 2, 2, 2, 272, 272, 273, 273,
+	24, 24, 23, 22, 22, 21, 21, 20, 20, 15,
+	15, 15, 15, 15, 18, 18, 18, 18, 13, 13,
+	17, 17, 17, 275, 275, 14, 14, 16, 16, 27,
+	25, 26, 19, 19, 205, 205, 205, 205, 57, 57,
+	69, 69, 69, 69, 70, 71, 72, 73, 75, 74,
+	276, 276, 276, 277, 277, 277, 264, 264, 251, 278,
+	278, 279, 250, 250, 252, 252, 253, 253, 254, 254,
+	255, 255, 161, 161, 161, 243, 243, 243, 164, 164,
+	164, 164, 198, 198, 198, 198, 198, 201, 201, 200,
+	200, 28, 28, 29, 29, 41, 151, 151, 208, 208,
+	207, 207, 80, 44, 44, 47, 47, 47, 47, 47,
+	79, 40, 40, 40, 40, 40, 40, 280, 280, 281,
+	281, 281, 281, 9, 9, 42, 42, 42, 42, 42,
+	42, 114, 114, 115, 135, 135, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 137, 137, 136, 136, 136,
+	113, 113, 113, 113, 113, 113, 113, 112, 112, 111,
+	111, 110, 110, 109, 109, 109, 109, 108, 108, 107,
+	107, 107, 107, 107, 107, 106, 106, 105, 105, 104,
+	103, 103, 103, 102, 101, 101, 100, 100, 99, 99,
+	98, 98, 97, 97, 97, 97, 97, 96, 95, 94,
+	93, 92, 92, 282, 282, 91, 91, 90, 90, 89,
+	88, 88, 88, 88, 87, 87, 86, 86, 146, 147,
+	147, 148, 148, 148, 148, 148, 148, 148, 148, 149,
+	149, 150, 150, 150, 63, 63, 85, 85, 84, 84,
+	84, 84, 84, 84, 84, 84, 34, 34, 34, 33,
+	33, 32, 283, 283, 283, 30, 30, 30, 31, 31,
+	31, 35, 35, 83, 83, 176, 176, 284, 284, 177,
+	182, 182, 185, 181, 181, 180, 180, 178, 178, 179,
+	285, 285, 11, 11, 11, 12, 12, 12, 286, 286,
+	288, 288, 289, 289, 290, 290, 287, 287, 287, 67,
+	245, 245, 39, 39, 39, 39, 38, 38, 38, 37,
+	37, 36, 36, 36, 36, 10, 10, 64, 206, 206,
+	204, 204, 163, 210, 210, 65, 203, 203, 202, 202,
+	66, 193, 193, 190, 190, 188, 186, 186, 186, 186,
+	183, 183, 187, 196, 196, 191, 191, 291, 291, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 45, 45, 7, 7, 292, 292, 292, 116,
+	116, 116, 117, 117, 117, 117, 117, 117, 118, 174,
+	175, 175, 175, 175, 175, 175, 293, 293, 293, 261,
+	261, 261, 261, 262, 262, 262, 262, 263, 263, 274,
+	274, 294, 194, 194, 194, 192, 192, 192, 152, 152,
+	138, 138, 139, 139, 140, 140, 141, 141, 142, 142,
+	169, 169, 170, 170, 165, 166, 167, 197, 168, 199,
+}
+
+var yyR2 = [...]int8{
+	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 2, 0, 2, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 3, 5,
+	6, 2, 3, 3, 4, 0, 1, 1, 1, 1,
+	1, 2, 4, 1, 1, 1, 1, 1, 2, 3,
+	0, 1, 1, 1, 1, 1, 2, 2, 2, 2,
+	2, 1, 3, 0, 1, 1, 1, 1, 5, 2,
+	3, 2, 0, 1, 3, 3, 1, 5, 3, 1,
+	1, 1, 1, 1, 1, 1, 4, 1, 2, 2,
+	1, 3, 3, 2, 1, 0, 5, 2, 5, 2,
+	1, 3, 3, 0, 1, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 0, 1, 4,
+	1, 1, 2, 2, 2, 2, 1, 0, 5, 2,
+	3, 0, 2, 0, 1, 3, 1, 0, 1, 3,
+	3, 3, 3, 5, 6, 8, 6, 6, 4, 1,
+	1, 3, 1, 1, 2, 6, 2, 3, 5, 1,
+	1, 1, 1, 1, 3, 1, 0, 1, 3, 3,
+	2, 4, 3, 5, 1, 1, 1, 1, 2, 2,
+	3, 5, 1, 0, 1, 2, 0, 2, 1, 3,
+	5, 1, 2, 4, 2, 0, 1, 0, 1, 1,
+	2, 3, 1, 1, 2, 2, 1, 1, 2, 1,
+	3, 2, 2, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 3,
+	2, 4, 2, 3, 4, 3, 4, 5, 6, 6,
+	3, 4, 4, 3, 4, 3, 4, 1, 1, 3,
+	3, 1, 1, 1, 1, 1, 2, 2, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	1, 0, 2, 1, 0, 1, 3, 3, 2, 1,
+	1, 1, 1, 0, 1, 1, 2, 3, 3, 2,
+	5, 1, 3, 3, 3, 3, 1, 3, 3, 2,
+	2, 2, 2, 3, 3, 3, 1, 3, 1, 3,
+	1, 1, 2, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 3, 5, 1, 1, 2, 1, 2, 3,
+	2, 1, 1, 2, 1, 2, 3, 4, 3, 2,
+	3, 3, 3, 3, 2, 3, 2, 5, 4, 3,
+	3, 3, 3, 3, 1, 1, 0, 3, 2, 2,
+	1, 2, 1, 3, 2, 1, 0, 2, 3, 0,
+	2, 2, 2, 2, 2, 2, 0, 2, 0, 5,
+	2, 0, 3, 1, 3, 3, 1, 2, 0, 2,
+	1, 4, 5, 2, 1, 2, 2, 2, 2, 0,
+	3, 1, 1, 2, 2, 1, 0, 4, 0, 2,
+	1, 1, 1, 3, 5, 6, 6, 3, 3, 0,
+	9, 3, 3, 3, 2, 2, 2, 2, 2, 2,
+	1, 1, 0, 2, 2, 0, 1, 0, 3, 1,
+	3, 3, 0, 1, 0, 2, 0, 2, 0, 2,
+	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	3, 2, 0, 1, 3, 1, 1, 3, 2, 1,
+	0, 1, 1, 2, 0, 1, 4, 5, 4, 0,
+	2, 2, 2, 3, 2, 1, 4, 1, 0, 2,
+	2, 2, 1, 4, 2, 3, 4, 5, 4, 5,
+	4, 2, 0, 1, 2, 0, 3, 3, 4, 4,
+	4, 4, 3, 2, 1, 1, 0, 1, 1, 0,
+	9, 9, 1, 2, 4, 4, 4, 1, 5, 1,
+	0, 1, 1, 2, 2, 1, 1, 1, 0, 2,
+	4, 5, 6, 4, 5, 2, 3, 1, 1, 1,
+	1, 2, 2, 1, 1, 1, 1, 1, 4, 0,
+	1, 3, 1, 1, 1, 1, 1, 2, 4, 4,
+	5, 2, 0, 1, 3, 1, 0, 1, 2, 3,
+	2, 4, 2, 3, 2, 0, 4, 5, 5, 3,
+	0, 2, 2, 2, 3, 1, 3, 1, 2, 1,
+	0, 1, 2, 0, 1, 1, 3, 3, 1, 2,
+	4, 4, 2, 3, 5, 5, 2, 2, 3, 1,
+	3, 9, 1, 2, 0, 7, 7, 0, 10, 6,
+	0, 1, 0, 1, 1, 1, 3, 0, 1, 3,
+	3, 0, 1, 1, 3, 0, 1, 1, 3, 3,
+	0, 1, 8, 5, 0, 4, 3, 0, 1, 3,
+	2, 0, 1, 0, 4, 5, 2, 2, 4, 5,
+	3, 0, 1, 1, 1, 1, 3, 9, 12, 3,
+	0, 2, 3, 2, 3, 2, 0, 7, 0, 1,
+	1, 3, 2, 3, 3, 8, 1, 3, 0, 2,
+	7, 1, 0, 1, 3, 2, 1, 1, 3, 0,
+	1, 3, 1, 1, 3, 2, 0, 1, 0, 1,
+	1, 3, 3, 1, 1, 3, 3, 3, 3, 4,
+	3, 2, 1, 1, 1, 0, 1, 1, 0, 2,
+	4, 3, 1, 1, 1, 1, 1, 1, 7, 1,
+	0, 3, 2, 2, 2, 2, 0, 2, 2, 2,
+	2, 2, 0, 3, 4, 4, 0, 2, 0, 4,
+	0, 3, 1, 1, 1, 2, 3, 0, 11, 9,
+	1, 1, 1, 0, 1, 0, 1, 0, 1, 0,
+	1, 3, 3, 0, 1, 1, 1, 1, 1, 1,
+}
+
+var yyChk = [...]int16{
+	-1000, -256, -1, -2, -143, -144, -38, -68, -129, -130,
+	-67, -36, -71, -72, -73, -74, -75, -69, -70, -63,
+	-64, -65, -66, -152, 362, 24, 216, 366, 367, 42,
+	307, 364, 365, 180, 363, 7, 388, 389, 167, 473,
+	368, 369, 370, 378, 377, 8, -84, -85, 16, 15,
+	17, 438, -113, -83, -34, 399, 14, -86, 12, 503,
+	502, 21, -76, 403, -76, -76, -76, -76, -76, -2,
+	-145, 224, 255, 255, -145, 126, -145, 126, -161, 5,
+	-62, -59, 24, 325, 318, 189, 107, 38, 341, 155,
+	203, 246, 8, 124, 247, 278, 50, 174, 121, 294,
+	281, 266, 295, 296, 184, 383, 236, 326, 23, 131,
+	345, 195, 39, 65, 279, 270, 309, 369, 181, 302,
+	25, 366, 252, 56, 164, 314, 48, 291, 28, 205,
+	115, 438, 196, 428, 141, 128, 192, 303, 506, 10,
+	456, 473, 35, 350, 47, 206, 475, 427, 420, 351,
+	213, 106, 171, 132, 240, 487, 175, 448, 377, 280,
+	286, 433, 116, 356, 431, 148, 244, 357, 204, 363,
+	362, 40, 168, 73, 101, 142, 51, 492, 108, 432,
+	218, 253, 32, 256, 81, 497, 110, 82, 227, 429,
+	222, 457, 75, 472, 162, 274, 331, 66, 165, 37,
+	76, 114, 13, 248, 182, 322, 232, 44, 41, 16,
+	346, 257, 237, 393, 228, 169, 242, 77, 493, 61,
+	230, 172, 304, 390, 86, 190, 197, 129, 135, 188,
+	292, 125, 262, 254, 282, 458, 78, 207, 455, 332,
+	79, 145, 166, 163, 306, 122, 31, 283, 507, 485,
+	214, 136, 271, 173, 299, 176, 220, 476, 234, 92,
+	94, 191, 501, 149, 215, 243, 93, 185, 329, 200,
+	260, 342, 137, 311, 95, 83, 156, 319, 364, 126,
+	477, 201, 297, 361, 241, 315, 421, 261, 430, 352,
+	57, 70, 52, 198, 249, 133, 209, 45, 267, 307,
+	316, 208, 49, 177, 389, 102, 62, 151, 290, 272,
+	9, 367, 74, 263, 71, 146, 29, 42, 210, 223,
+	59, 67, 275, 459, 250, 111, 287, 183, 152, 134,
+	388, 186, 68, 167, 96, 226, 97, 143, 118, 221,
+	216, 138, 312, 422, 495, 91, 347, 123, 358, 36,
+	264, 87, 84, 117, 478, 498, 153, 496, 98, 112,
+	255, 127, 348, 378, 276, 103, 349, 193, 157, 330,
+	317, 33, 63, 499, 158, 15, 368, 323, 178, 130,
+	211, 466, 284, 343, 187, 298, 238, 359, 470, 224,
+	285, 100, 72, 454, 80, 471, 150, 445, 453, 452,
+	461, 460, 305, 450, 449, 474, 26, 447, 268, 113,
+	99, 442, 443, 469, 88, 119, 139, 293, 170, 360,
+	320, 120, 463, 464, 219, 147, 338, 451, 310, 333,
+	60, 465, 446, 440, 441, 444, 239, 468, 467, 300,
+	336, 21, 462, 258, 288, 34, 273, 53, 269, 321,
+	344, 339, 212, 89, 42, -161, 255, -165, -161, -145,
+	-145, -165, 12, 13, 9, 10, -195, 496, 498, 390,
+	497, 499, -124, 390, 134, 255, -125, -123, -119, 468,
+	195, 223, 145, 9, -161, -126, -127, 468, 255, 134,
+	-119, 391, -119, 468, 255, 134, 391, -165, 364, 391,
+	-209, -184, -58, 4, 5, -62, -61, 337, 391, 15,
+	16, 17, 18, 19, 20, 22, 21, 12, 362, 363,
+	364, 365, 366, 367, 388, 389, 390, 7, 8, 368,
+	369, 370, 377, 378, 13, 9, 10, 381, 382, 383,
+	371, 385, 384, 380, 379, 399, 400, 398, 397, 436,
+	505, 437, 393, 394, 392, 395, 412, 410, 418, 419,
+	413, 409, 387, 480, 424, 423, 408, 435, 442, 447,
+	448, 443, 308, 439, 489, 488, 265, 328, 58, 231,
+	504, 355, 46, 199, 69, 90, 313, 54, 324, 484,
+	277, 490, 483, 353, 30, 180, 159, 104, 154, 301,
+	161, 55, 354, 500, 229, 225, 289, 481, 406, 202,
+	14, 235, 194, 335, 259, 27, 494, 245, 105, 109,
+	64, 396, 251, 217, 386, 503, 502, 24, 325, 318,
+	189, 107, 38, 341, 155, 203, 246, 124, 247, 278,
+	50, 174, 121, 294, 281, 266, 295, 296, 184, 236,
+	326, 23, 131, 345, 195, 39, 65, 279, 270, 309,
+	181, 302, 25, 252, 56, 164, 314, 48, 291, 28,
+	205, 115, 438, 196, 428, 141, 128, 192, 303, 506,
+	473, 35, 350, 47, 206, 475, 427, 420, 351, 213,
+	106, 171, 132, 240, 487, 175, 280, 286, 433, 116,
+	356, 431, 148, 244, 357, 204, 40, 168, 73, 101,
+	51, 492, 108, 432, 218, 253, 32, 256, 81, 497,
+	110, 82, 227, 429, 222, 75, 472, 162, 274, 331,
+	66, 165, 37, 76, 114, 248, 182, 322, 232, 44,
+	41, 346, 257, 237, 228, 169, 242, 77, 493, 61,
+	230, 172, 304, 86, 190, 197, 129, 135, 188, 292,
+	125, 262, 254, 282, 78, 207, 332, 79, 145, 166,
+	163, 306, 122, 31, 283, 507, 485, 214, 136, 271,
+	173, 299, 176, 220, 476, 234, 92, 94, 191, 501,
+	149, 243, 93, 185, 329, 200, 260, 342, 137, 311,
+	95, 83, 156, 319, 126, 477, 201, 297, 361, 241,
+	315, 421, 261, 430, 352, 57, 70, 52, 198, 249,
+	133, 209, 45, 267, 307, 316, 208, 49, 177, 102,
+	62, 151, 290, 272, 74, 263, 71, 146, 29, 42,
+	210, 223, 59, 67, 275, 250, 111, 287, 183, 152,
+	134, 186, 68, 167, 96, 226, 97, 143, 118, 221,
+	216, 138, 312, 422, 495, 91, 347, 123, 358, 36,
+	264, 87, 84, 117, 478, 498, 153, 496, 98, 112,
+	255, 127, 348, 276, 103, 349, 193, 157, 330, 317,
+	33, 63, 499, 158, 323, 178, 130, 211, 284, 343,
+	187, 298, 238, 224, 285, 100, 72, 80, 471, 85,
+	150, 445, 425, 453, 452, 305, 327, 43, 140, 450,
+	449, 474, 26, 426, 373, 268, 113, 160, 376, 99,
+	469, 88, 119, 139, 293, 170, 360, 320, 120, 375,
+	233, 463, 11, 464, 219, 147, 338, 451, 310, 372,
+	333, 60, 446, 374, 440, 441, 334, 444, 239, 179,
+	468, 467, 300, 336, 462, 340, 258, 288, 34, 273,
+	53, 269, 321, 344, 339, 212, 89, -209, -209, -209,
+	-211, -184, -211, 371, -206, 436, 18, -193, 399, 425,
+	105, 354, 159, -251, -264, 381, -83, -113, -85, -84,
+	-85, -111, -112, 391, 483, 398, -41, -200, -161, -33,
+	198, -32, -165, -33, 399, -271, 20, -218, -219, -216,
+	237, 57, 277, 481, -271, 4, -271, 494, 4, -161,
+	4, -245, 399, -218, -271, -37, 399, -57, 472, -211,
+	-209, -209, 12, 496, 498, 496, 498, -124, 134, -124,
+	65, 85, -219, 97, 494, 434, 18, 405, 471, 4,
+	4, -131, 4, 484, -132, -164, 4, 5, -62, -59,
+	-60, 85, 425, 327, 43, 387, 140, 426, 373, 160,
+	376, 480, 407, 409, 375, 233, 11, 486, 372, 144,
+	374, 334, 179, 340, 471, 237, 85, 471, 237, 85,
+	-165, 391, -209, -211, -41, -41, -206, -200, -39, -63,
+	-64, -65, -66, -136, 391, 483, -136, -136, -282, -109,
+	-90, 380, -107, -106, -89, 385, 379, 384, -88, 383,
+	-251, -264, 400, 400, -154, -155, -153, -48, 412, -49,
+	410, 411, 481, 484, -85, -82, -156, 479, 399, -52,
+	-81, 439, -55, -56, 474, 4, 6, -6, -239, -223,
+	424, 423, 406, -161, -157, -158, 328, -78, 440, -236,
+	-233, -229, -224, 469, 5, 442, 443, 444, 445, 446,
+	447, 448, 449, 450, 451, 452, -232, -231, -228, -227,
+	467, 468, 453, 461, 460, 463, 464, 462, -226, -155,
+	408, 399, 405, 398, -33, -170, 399, -159, -48, 39,
+	507, 398, -216, 230, 436, 100, 277, 42, -161, 505,
+	-244, -242, -240, 441, -238, -236, -234, -230, -224, -223,
+	-243, 442, 443, 444, 445, 446, 447, 449, 450, 451,
+	452, -232, -231, -228, -227, 467, 468, 469, 5, -62,
+	-60, 453, 460, 461, 462, 463, 464, -159, -5, 5,
+	481, -260, 472, -200, 65, 505, -132, 484, 4, -121,
+	484, -120, -133, 6, 424, 423, 408, -132, -121, 484,
+	128, -161, -122, 4, 5, -223, 484, 390, 230, 230,
+	-180, -177, 399, 388, -41, -182, 399, 400, -83, -83,
+	-83, -108, -109, -91, -282, -89, 380, 57, 15, 507,
+	68, 393, -106, -107, -105, -48, 391, -100, 492, 163,
+	-104, -103, -48, -49, 410, 411, -87, 176, -278, -279,
+	-48, -282, -109, -135, 371, 398, 511, 410, 411, 412,
+	413, 418, 419, 416, 414, 434, 417, 415, 404, 435,
+	20, 22, 480, 407, 486, 150, 161, 508, -268, 505,
+	5, -48, -48, -48, -246, -159, -48, -85, 21, -85,
+	-266, 401, -85, 399, 4, 4, 4, 399, 405, -54,
+	-48, 399, 405, -235, 399, 465, -237, 399, -237, -237,
+	399, -222, 503, 470, 399, -222, -225, 466, -225, -225,
+	460, 461, -225, 399, -135, 399, -159, -197, -198, 5,
+	-62, -59, -60, -58, 8, 383, 369, 366, 10, 448,
+	377, 363, 362, 13, 16, 393, 390, 364, 389, 9,
+	367, 388, 378, 15, 368, 447, 442, 443, 21, 387,
+	480, 409, 337, 391, 20, 22, 12, 370, 436, 394,
+	392, 395, 424, 423, 308, 489, 488, 265, 328, 58,
+	231, 504, 355, 46, 199, 69, 90, 313, 54, 324,
+	484, 277, 490, 483, 353, 30, 180, 161, 55, 500,
+	229, 225, 289, 481, 406, 202, 14, 235, 194, 335,
+	259, 27, 245, 109, 64, 396, 251, 217, -32, 505,
+	-169, -165, 398, 400, 39, -216, -217, 57, 208, 183,
+	-161, -39, 398, 400, -241, 439, -240, -221, 399, 454,
+	455, 456, 457, 458, -220, 459, -237, 400, 399, 474,
+	505, 481, -182, 255, 398, 4, 399, -63, -176, -161,
+	-204, -210, -163, -161, -202, 396, -45, 18, 494, -181,
+	-185, -161, 494, 436, 393, 68, 512, -103, -101, -49,
+	410, 411, 440, 146, 399, -101, 440, 146, -102, 6,
+	-102, -150, 271, 226, -201, -200, 398, -276, 489, 490,
+	-108, -91, -28, 18, -134, 496, 498, 390, 497, 499,
+	12, -200, -153, -242, -48, -48, -48, -48, -48, -48,
+	-48, -48, -48, -48, -48, -48, -48, -48, -48, 406,
+	481, 424, 423, 483, 487, 485, -265, 488, -51, -85,
+	399, 161, -267, 308, 194, 391, 5, -249, 405, 401,
+	398, 400, -159, 402, 400, -46, 251, 391, 483, 412,
+	-43, -48, -221, 4, -197, -269, -270, 217, -159, 400,
+	-161, 412, 4, -159, 4, 468, 468, 4, 399, -225,
+	-225, 6, -28, -159, 400, -283, 125, 481, 398, 400,
+	-48, 330, 252, 57, -242, 401, 401, -241, 439, 4,
+	494, 494, 494, 494, 399, -205, -161, 394, 392, 363,
+	474, -261, 503, 470, -219, -120, -221, 4, -11, 408,
+	398, 400, 398, -28, 434, 494, -246, -8, 19, -203,
+	-3, 5, -7, 421, 400, 398, -7, 15, -104, -101,
+	436, 503, 6, 197, 398, -279, -277, 491, -8, -29,
+	-40, -41, -79, 500, -85, -42, 399, -80, -161, -78,
+	-6, -137, 12, -137, 496, 498, 496, 498, -137, -200,
+	406, 424, 423, 483, 487, 485, 18, -50, -49, 410,
+	411, -81, -159, -51, -85, -197, 412, -48, -248, -48,
+	402, -264, 398, -251, -43, -46, -46, 400, 400, -53,
+	-270, 30, -48, 400, 400, 400, 400, 471, 471, 400,
+	-159, 400, -8, 400, 399, 125, -165, 402, 4, 4,
+	401, 400, 455, 457, 458, -220, 458, -220, -220, 4,
+	398, 400, -242, 393, 393, -165, -260, -262, 408, -171,
+	476, 399, 476, 400, -10, 437, 314, -161, -210, -8,
+	-48, -48, -285, -10, -48, 398, -194, 4, 422, 495,
+	-185, -194, 436, 503, 112, -200, 492, 493, -99, 382,
+	398, 387, -281, 409, 11, 373, 375, 374, 376, -207,
+	-208, 505, -3, -47, -208, 505, -161, 5, -79, -161,
+	-207, -42, -85, -40, -44, 503, 405, 399, -200, -200,
+	-137, -137, -137, -137, -200, 18, -48, 20, 410, 411,
+	412, 413, 418, 419, 416, 414, 434, 417, 415, 404,
+	-50, -50, 400, 402, 397, 400, 400, 251, -43, -264,
+	-264, 398, -264, 4, 180, -48, 27, -222, -222, 400,
+	-99, -39, 402, 402, 4, 400, -205, -261, -14, -275,
+	56, 399, 399, -37, 505, -263, 478, 366, -172, -258,
+	-198, 4, -155, -12, 399, 408, -285, -203, -192, 420,
+	396, -292, 503, 502, 112, -92, 301, 383, -40, 409,
+	409, -40, -281, 409, -280, 372, -280, -280, -3, 399,
+	-161, 399, -47, 400, 501, -197, -46, -200, -200, -200,
+	-200, -48, -48, -50, -50, -50, -50, -50, -50, -50,
+	-50, -50, -50, -50, -50, -248, -48, 4, -43, 400,
+	400, 400, -48, -92, 400, 402, -209, -16, 191, -161,
+	484, -3, -176, -160, 503, 363, -165, 377, 475, 477,
+	400, 398, 434, 405, 353, -286, -287, -161, -80, 399,
+	46, -292, 4, 420, -196, -191, 399, -114, 386, -48,
+	-136, -40, -40, -9, 396, 408, 409, -40, -214, -213,
+	-161, 399, -214, -208, -264, 402, -264, -114, -30, 275,
+	-13, 399, 400, 400, 506, 507, -165, 146, 146, -258,
+	-259, -58, 6, 4, 15, 16, 21, 362, 363, 364,
+	366, 367, 388, 389, 390, 8, 368, 369, 377, 378,
+	13, 9, 10, 383, 393, 409, 387, 480, 442, 447,
+	448, 443, -198, 15, 214, 400, 398, -290, -288, 504,
+	-290, -48, -165, -196, 4, -189, 425, 426, 427, 406,
+	428, 429, 430, 431, 432, 433, -190, -188, -198, -115,
+	-209, -98, -97, -48, -96, -94, -95, -93, 399, 141,
+	74, 113, -9, 399, -48, -40, 398, 400, -4, 5,
+	-58, 4, -214, 400, 400, 400, -31, 303, 213, 184,
+	-17, 46, -15, -18, 481, 406, 392, 231, 484, 277,
+	55, -19, -27, -25, -198, -273, 395, -273, 506, -37,
+	434, 388, -8, -287, -289, -257, -161, -4, 400, -8,
+	-291, 505, -291, -291, -291, 430, 481, 406, 4, 398,
+	400, -186, -133, 412, 399, 398, 400, 399, 399, 186,
+	-181, -213, -77, 504, -77, 400, -181, 492, 492, -165,
+	406, 277, 393, 399, -50, 47, 162, 400, 398, -26,
+	4, -3, -160, -259, -204, -276, -171, -290, 4, 4,
+	4, 4, -181, 412, 406, -181, -188, -183, -187, -133,
+	-97, -159, -159, 399, 400, -4, 388, 383, 383, -15,
+	481, -20, 503, -48, -27, 399, -8, -277, -276, -181,
+	400, 398, 400, 400, -98, -161, -181, -181, -274, 396,
+	-23, 399, 400, -3, -277, -187, 400, 494, 396, 388,
+	388, 13, -22, -21, -198, -24, 507, 400, -82, -6,
+	-161, -161, -161, 478, 400, 398, 434, 182, 484, 399,
+	-165, -21, -259, -82, -46, 396, -264, -161, 400,
+}
+
+var yyDef = [...]int16{
+	985, -2, 2, 986, 987, 988, 989, 990, 991, 992,
+	993, 994, 995, 996, 997, 998, 999, 1000, 1001, 2,
+	2, 2, 2, 2, 0, 1346, 0, 1346, 1346, 0,
+	0, 0, 1346, 1346, 0, 0, 0, 0, 0, 0,
+	981, 981, 981, 981, 983, 983, 1604, -2, 0, 1688,
+	0, 1702, -2, 1427, 0, 0, 1530, 1522, 0, 0,
+	0, 0, 1, 3, 1360, 1361, 1362, 1363, 1364, 1365,
+	1359, 1344, 1345, 1356, 1359, 0, 1359, 0, 1334, 1442,
+	1443, 1444, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83, 84, 85, 86, 87, 88, 89, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 189, 190, 191,
+	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
+	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
+	252, 253, 254, 255, 256, 257, 258, 259, 260, 261,
+	262, 263, 264, 265, 266, 267, 268, 269, 270, 271,
+	272, 273, 274, 275, 276, 277, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 297, 298, 299, 300, 301,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
+	322, 323, 324, 325, 326, 327, 328, 329, 330, 331,
+	332, 333, 334, 335, 336, 337, 338, 339, 340, 341,
+	342, 343, 344, 345, 346, 347, 348, 349, 350, 351,
+	352, 353, 354, 355, 356, 357, 358, 359, 360, 361,
+	362, 363, 364, 365, 366, 367, 368, 369, 370, 371,
+	372, 373, 374, 375, 239, 1336, 282, 1671, 1794, 1356,
+	1359, 1680, 1409, 983, 981, 981, 0, 1110, 1111, 0,
+	0, 1116, 1276, 145, 251, 282, 1281, 1286, 0, 359,
+	33, 241, -2, 232, 1296, 1316, 1317, 359, 282, 251,
+	1321, 1322, 1325, 359, 282, 251, 1329, 1681, 200, 1683,
+	1415, 981, 976, 977, 978, 979, 980, 399, 400, 297,
+	131, 403, 404, 405, 406, 407, 408, 409, 92, 91,
+	200, 413, 43, 233, 252, 226, 145, 420, 14, 298,
+	39, 424, 80, 285, 124, 232, 61, 430, 431, 27,
+	433, 434, 435, 436, 437, 438, 439, 440, 441, 442,
+	443, 444, 135, 446, 447, 448, 449, 450, 451, 452,
+	453, 454, 455, 456, 457, 458, 459, 460, 461, 462,
+	79, 464, 467, 468, 470, 471, 472, 473, 474, 475,
+	476, 477, 478, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 499, 500, 501,
+	502, 503, 504, 506, 507, 509, 510, 511, 512, 518,
+	522, 523, 524, 525, 526, 528, 529, 530, 532, 533,
+	534, 535, 536, 537, 539, 540, 541, 4, 5, 6,
+	7, 8, 9, 10, 11, 12, 13, 15, 16, 17,
+	18, 19, 20, 21, 22, 23, 24, 25, 26, 28,
+	29, 30, 31, 32, 33, 34, 35, 36, 37, 38,
+	40, 41, 42, 44, 45, 46, 47, 48, 49, 50,
+	51, 52, 53, 54, 55, 56, 57, 58, 59, 60,
+	63, 64, 65, 66, 67, 68, 69, 70, 71, 72,
+	73, 74, 75, 76, 77, 78, 81, 82, 83, 84,
+	85, 86, 87, 88, 89, 90, 93, 94, 95, 96,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 125, 126, 127, 128, 129,
+	130, 132, 133, 134, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 146, 147, 148, 149, 150, 151, 152,
+	153, 154, 155, 156, 158, 159, 161, 162, 163, 164,
+	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
+	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
+	185, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 198, 199, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 227,
+	228, 229, 230, 231, 234, 235, 236, 237, 238, 239,
+	240, 241, 242, 243, 244, 246, 247, 248, 249, 250,
+	251, 253, 254, 255, 256, 257, 258, 259, 260, 261,
+	262, 263, 264, 265, 266, 267, 268, 269, 270, 271,
+	272, 273, 274, 275, 276, 277, 278, 279, 280, 281,
+	282, 283, 284, 286, 287, 288, 289, 290, 291, 292,
+	293, 294, 295, 296, 299, 300, 301, 302, 304, 305,
+	306, 307, 308, 311, 312, 313, 314, 316, 317, 568,
+	572, 573, 574, 575, 576, 595, 597, 605, 623, 632,
+	633, 669, 674, 685, 686, 692, 693, 700, 716, 717,
+	724, 729, 730, 731, 732, 733, 734, 735, 745, 746,
+	748, 770, 771, 772, 780, 781, 788, 790, 803, 804,
+	805, 819, 835, 856, 862, 880, 884, 887, 903, 911,
+	919, 920, 924, 926, 950, 952, 963, 964, 965, 966,
+	967, 968, 969, 970, 971, 972, 973, 1416, 1417, 1419,
+	1418, 983, 1414, 0, 0, 1689, 1688, 0, 0, 1701,
+	1519, 1519, 1519, -2, 0, 0, -2, 1633, 1634, 0,
+	1634, 1264, 0, 1529, 1527, 0, 1523, 1465, 1459, 1616,
+	215, 1619, 1793, 1617, 0, 1330, 0, 1331, 1355, 1352,
+	0, 0, 1350, 0, 1332, 1340, 1333, 0, 1341, 1335,
+	1339, 0, 0, 1342, 1343, 1676, 0, 0, 0, 1411,
+	1412, 1413, 0, 1112, 1113, 1114, 1115, 1277, 251, 1278,
+	35, 0, 1279, 0, 0, 0, 0, 0, 0, 1289,
+	1290, 1291, 1271, 1272, 1292, 1274, 1275, 1448, 1449, 1450,
+	1451, 376, 377, 378, 379, 380, 381, 382, 383, 384,
+	385, 386, 387, 388, 389, 390, 391, 392, 393, 394,
+	395, 396, 397, 398, 1318, 0, 1320, 1326, 0, 1328,
+	1682, 1684, 982, 984, 1645, 0, 0, 1641, 0, 1672,
+	1673, 1674, 1675, 0, 1517, 1518, 0, 0, 1538, 1576,
+	1573, 0, 1535, 1536, 1577, 0, 0, 0, 1585, 0,
+	-2, 0, 1606, 1607, 1505, 1263, 1265, 1269, 1270, 1199,
+	0, 0, 0, 1237, 1238, 1188, 1189, 1176, 0, 1192,
+	1193, 0, 1196, 1197, 327, 1142, 1143, 0, 0, 0,
+	1149, 1150, 1151, -2, 1242, 1243, 1187, 1152, 355, 1033,
+	1034, 1035, 1036, 335, 1442, 333, 334, 357, 319, 354,
+	329, 79, 326, 325, 349, 321, 1056, 1057, 1074, 1075,
+	360, 359, 320, 322, 323, 344, 345, 364, 1077, 1505,
+	0, 0, 0, 0, 1618, 0, 0, 0, 1153, 1357,
+	0, 0, 1354, 0, 1348, 1349, 1351, 239, 1338, 0,
+	0, 1157, 1025, 0, 1026, 1027, 1028, 1029, 1030, 1107,
+	1040, 1041, 1042, 1043, 1044, 1045, 1053, 1040, 1040, 1040,
+	1051, 1054, 1055, 1072, 1073, 1093, 1093, 1090, 1037, 1446,
+	1447, 1085, 1085, 1085, 1080, 0, 1085, 0, 0, 1006,
+	0, 0, 115, 1641, 0, 0, 1293, 1294, 1295, 1282,
+	1284, 1298, 1300, 1301, 1306, 1307, 1308, 1309, 1283, 1285,
+	1287, 1297, 1288, 1310, 1311, 0, 1314, 1315, 1319, 1327,
+	0, 1646, 0, 0, 1698, 0, 0, 0, 1524, 1525,
+	1526, 1610, 1537, 1611, 1575, 1578, 0, 0, 1580, 0,
+	1582, 0, 1533, 1534, 1539, 1547, 1548, 0, 1556, 1557,
+	1545, 0, 1549, -2, 0, 0, 1603, 0, 1428, 1429,
+	1422, 1538, 1576, 1462, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1167,
+	0, 0, 0, 1220, 1222, 1156, 0, 0, 0, 0,
+	1268, 1201, 1202, 1218, 1190, 0, 1153, -2, 363, 1194,
+	1195, 0, 1198, 0, 1144, 1146, 1107, 0, 0, 0,
+	1186, 0, 0, 1046, 0, 1047, 1048, 0, 1049, 1050,
+	0, 1087, 0, 0, 0, 1089, 1059, 1084, 1079, 1078,
+	1085, 1085, 1083, 0, 1462, 0, 0, 1460, 1797, 1452,
+	1453, 1454, 1455, 1456, 14, 27, 39, 43, 61, 79,
+	80, 91, 92, 124, 131, 135, 145, 200, 226, 232,
+	233, 252, 285, 297, 298, 329, 333, 334, 363, 380,
+	386, 388, 399, 400, 406, 407, 409, 424, 442, 446,
+	447, 448, 457, 458, 467, 470, 471, 472, 473, 474,
+	475, 476, 477, 478, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 502, 503, 506,
+	507, 509, 510, 511, 512, 518, 522, 523, 524, 525,
+	526, 528, 530, 533, 534, 535, 536, 537, 1620, 1624,
+	0, 1790, 0, 1586, 1358, 1353, 1347, 0, 0, 1305,
+	1337, 1669, 0, 1670, 1017, 1021, 1025, 1031, 0, 1094,
+	1095, 1096, 1097, 1098, 1099, 1108, 1038, 1679, 0, 1408,
+	0, 0, 1762, 0, 0, 1107, 0, 1654, 0, 1635,
+	1462, 1690, 0, 1176, 1261, 0, 1735, 1732, 1733, 0,
+	1643, 1642, 1735, 1574, 0, 1583, 0, 0, 0, 1550,
+	0, 0, -2, -2, 0, 1546, 1554, 1555, 1551, -2,
+	1552, 1579, 1601, 0, 1584, 1457, 0, 1425, 1420, 1421,
+	1614, 1615, 1261, 0, 1504, -2, -2, 145, 107, -2,
+	0, 1514, 1266, 1200, 1203, 1204, 1205, 1206, 1207, 1208,
+	-2, -2, -2, -2, -2, -2, 1215, 1216, 1217, 1219,
+	0, 1223, 1225, 0, 1233, 1235, 0, 1155, 1230, 1178,
+	0, 0, 0, 1164, 1165, 1166, 1267, 1177, 0, 1173,
+	0, 1191, 0, 1160, 1132, 1427, 0, 0, 0, 0,
+	1140, 1139, 1147, 0, 1125, 1185, 1181, 0, 0, 1162,
+	1239, 1240, 0, 0, 0, 0, 0, 0, 0, 1081,
+	1082, 0, 1261, 0, 1587, 0, 1622, 0, 0, 1792,
+	1154, 1302, 1303, 1304, 1158, 0, 0, 1018, 1022, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1766, 0, 0, 1280, 1299, 1312, 0, 1686, 0,
+	0, 1639, 0, 1261, 0, 0, 1692, 1650, 0, 1699,
+	1696, 1002, 0, 1734, 1640, 0, 0, 1581, 1540, 0,
+	1543, 0, 1553, 1602, 0, 1430, 1431, 0, 1559, 1461,
+	1463, 1470, 1479, 0, 1470, 1485, 0, 1474, -2, 1472,
+	0, 0, 1515, 0, 1516, 1516, 1516, 1516, 0, 1513,
+	1221, 1224, 1226, 0, 1234, 1236, 0, 0, 1245, 0,
+	0, 1193, 0, 1231, 1232, 1168, 449, 1172, 0, 1154,
+	1159, 0, 0, 1426, 1427, 1427, 1427, 1138, 0, 0,
+	1182, 0, 0, 1161, 1052, 1039, 1093, 1091, 1092, 1093,
+	0, 1076, 1559, 1528, 0, 1623, 1791, 1023, 0, 0,
+	0, 1032, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 0,
+	0, 1762, 1396, 0, 0, 1680, 0, 1768, 0, 1759,
+	1760, 0, 1761, 0, 1687, 0, 1657, 1636, 1691, 1650,
+	1693, 1694, 1700, 1651, 1262, 0, 1777, 1772, 1773, 1774,
+	1644, 1738, 1541, 0, 1544, 1458, 1423, 1424, 1572, 0,
+	0, 0, 0, 0, 0, 1488, 1488, 1488, 1492, 1481,
+	1471, 0, 1469, 1482, 1475, 0, 0, -2, 1479, 1124,
+	1484, 1485, -2, 0, 1480, 0, 0, 0, 1506, 1507,
+	0, 0, 0, 0, 1512, 0, -2, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1246, 1247, 1179, 1170, 1173, 1163, 1133, 0, 1141, 0,
+	0, 0, 0, 1148, 1180, 1184, 0, 1086, 1088, 1058,
+	1572, 0, 1024, 1019, 0, 1109, 1407, 981, 1398, 1395,
+	0, 0, 0, 1121, 0, 1118, 0, 0, 0, 1063,
+	1066, 1313, 1685, 0, 0, 0, 1695, 1697, 1738, 0,
+	0, 1716, 1736, 1737, 1542, 1502, 0, 1519, 1464, 0,
+	0, 0, 0, 0, 1489, 1487, 1490, 1491, 1468, 0,
+	0, 0, 1483, 1495, 1473, -2, 1427, 1508, 1509, 1510,
+	1511, -2, -2, 1248, 1249, 1250, 1251, 1252, 1253, -2,
+	-2, -2, -2, -2, -2, 0, 1172, 1145, 1427, 1134,
+	1136, 1137, 1183, 1502, 1627, 1020, 1410, 1389, 0, 1393,
+	1394, 0, 0, 1677, 0, 0, 1767, 1763, 0, 0,
+	1060, 0, 0, 0, 0, 0, 1658, -2, 1661, 0,
+	0, 1716, 1775, 0, 1779, 1713, 0, 1520, 981, 1571,
+	0, 1496, 0, 1498, 0, 0, 0, 1500, 0, 1128,
+	0, 0, 0, 1486, 0, 1171, 0, 1521, 1630, 0,
+	1404, 0, 1368, 1368, 1119, 0, 1680, 1764, 1765, 1064,
+	1065, 1069, 1070, 1071, 401, 402, 408, 410, 411, 412,
+	414, 415, 416, 417, 418, 421, 422, 423, 425, 426,
+	427, 428, 429, 432, 445, 454, 455, 456, 461, 462,
+	463, 464, 1068, 0, 1653, 1261, 0, 1666, 1663, 0,
+	1667, 0, 1656, 1261, 1776, 1715, 1719, 1720, 1718, 1718,
+	1723, 1724, 1718, 1718, 0, 0, 0, 1703, 1709, 1501,
+	1503, 1558, 1560, 1562, 1563, 1564, 1565, 1566, 0, 56,
+	234, 331, 1497, 0, 1494, 1499, 0, 1476, 1123, 1123,
+	1004, 1005, 0, 1478, 1133, 1135, 1621, 0, 0, 0,
+	1388, 0, 1391, 1392, 0, 1380, 0, 0, 0, 1384,
+	0, 0, 1402, 0, 1400, 1405, 0, 1406, 1120, 1121,
+	0, 0, 1655, 1659, 1422, 1662, 1003, 1660, 1661, 1778,
+	0, 1717, 0, 0, 0, 0, 0, 0, 1731, 0,
+	1714, 1705, 1706, 1707, 0, 0, 1567, 0, 0, 0,
+	0, 1129, 1130, 0, 1131, 1477, 0, 0, 0, 0,
+	1379, 1385, 1378, 0, 1383, 1386, 1387, 1397, 0, 1399,
+	1401, 0, 1678, 1067, 1261, 1425, 1422, 1668, 1721, 1722,
+	1725, 1726, 1727, 1728, 0, 1730, 1704, 0, 1710, 1712,
+	1561, 0, 0, 0, 1493, 1122, 0, 0, 0, 1390,
+	0, 1770, 0, 0, 1403, 0, 1652, 1664, 1425, 1729,
+	1708, 0, 1569, 1568, 0, 0, 0, 0, 1381, 0,
+	1377, 0, 1371, 0, 1665, 1711, 1570, 0, 0, 0,
+	0, 0, 0, 1373, 1376, 1382, 0, 1369, 0, 0,
+	1629, 1625, 1626, 0, 1372, 0, 0, 1370, 0, 0,
+	1769, 1374, 1375, 0, 1427, 0, 0, 1628, 0,
+}
+
+var yyTok1 = [...]int16{
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 512,
+}
+
+var yyTok2 = [...]int16{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83, 84, 85, 86, 87, 88, 89, 90, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 189, 190, 191,
+	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
+	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
+	252, 253, 254, 255, 256, 257,
+}
+
+var yyTok3 = [...]uint16{
+	57600, 258, 57601, 259, 57602, 260, 57603, 261, 57604, 262,
+	57605, 263, 57606, 264, 57607, 265, 57608, 266, 57609, 267,
+	57610, 268, 57611, 269, 57612, 270, 57613, 271, 57614, 272,
+	57615, 273, 57616, 274, 57617, 275, 57618, 276, 57619, 277,
+	57620, 278, 57621, 279, 57622, 280, 57623, 281, 57624, 282,
+	57625, 283, 57626, 284, 57627, 285, 57628, 286, 57629, 287,
+	57630, 288, 57631, 289, 57632, 290, 57633, 291, 57634, 292,
+	57635, 293, 57636, 294, 57637, 295, 57638, 296, 57639, 297,
+	57640, 298, 57641, 299, 57642, 300, 57643, 301, 57644, 302,
+	57645, 303, 57646, 304, 57647, 305, 57648, 306, 57649, 307,
+	57650, 308, 57651, 309, 57652, 310, 57653, 311, 57654, 312,
+	57655, 313, 57656, 314, 57657, 315, 57658, 316, 57659, 317,
+	57660, 318, 57661, 319, 57662, 320, 57663, 321, 57664, 322,
+	57665, 323, 57666, 324, 57667, 325, 57668, 326, 57669, 327,
+	57670, 328, 57671, 329, 57672, 330, 57673, 331, 57674, 332,
+	57675, 333, 57676, 334, 57677, 335, 57678, 336, 57679, 337,
+	57680, 338, 57681, 339, 57682, 340, 57683, 341, 57684, 342,
+	57685, 343, 57686, 344, 57687, 345, 57688, 346, 57689, 347,
+	57690, 348, 57691, 349, 57692, 350, 57693, 351, 57694, 352,
+	57695, 353, 57696, 354, 57697, 355, 57698, 356, 57699, 357,
+	57700, 358, 57701, 359, 57702, 360, 57703, 361, 57704, 362,
+	57705, 363, 57706, 364, 57707, 365, 57708, 366, 57709, 367,
+	57710, 368, 57711, 369, 57712, 370, 57713, 371, 57714, 372,
+	57715, 373, 57716, 374, 57717, 375, 57718, 376, 57719, 377,
+	57720, 378, 57721, 379, 57722, 380, 57723, 381, 57724, 382,
+	57725, 383, 57726, 384, 57727, 385, 57728, 386, 57729, 387,
+	57730, 388, 57731, 389, 57732, 390, 57733, 391, 57734, 392,
+	57735, 393, 57736, 394, 57737, 395, 57738, 396, 57739, 397,
+	57740, 398, 57741, 399, 57742, 400, 57743, 401, 57744, 402,
+	57745, 403, 57746, 404, 57747, 405, 57748, 406, 57749, 407,
+	57750, 408, 57751, 409, 57752, 410, 57753, 411, 57754, 412,
+	57755, 413, 57756, 414, 57757, 415, 57758, 416, 57759, 417,
+	57760, 418, 57761, 419, 57762, 420, 57763, 421, 57764, 422,
+	57765, 423, 57766, 424, 57767, 425, 57768, 426, 57769, 427,
+	57770, 428, 57771, 429, 57772, 430, 57773, 431, 57774, 432,
+	57775, 433, 57776, 434, 57777, 435, 57778, 436, 57779, 437,
+	57780, 438, 57781, 439, 57782, 440, 57783, 441, 57784, 442,
+	57785, 443, 57786, 444, 57787, 445, 57788, 446, 57789, 447,
+	57790, 448, 57791, 449, 57792, 450, 57793, 451, 57794, 452,
+	57795, 453, 57796, 454, 57797, 455, 57798, 456, 57799, 457,
+	57800, 458, 57801, 459, 57802, 460, 57803, 461, 57804, 462,
+	57805, 463, 57806, 464, 57807, 465, 57808, 466, 57809, 467,
+	57810, 468, 57811, 469, 57812, 470, 57813, 471, 57814, 472,
+	57815, 473, 57816, 474, 57817, 475, 57818, 476, 57819, 477,
+	57820, 478, 57821, 479, 57822, 480, 57823, 481, 57824, 482,
+	57825, 483, 57826, 484, 57827, 485, 57828, 486, 57829, 487,
+	57830, 488, 57831, 489, 57832, 490, 57833, 491, 57834, 492,
+	57835, 493, 57836, 494, 57837, 495, 57838, 496, 57839, 497,
+	57840, 498, 57841, 499, 57842, 500, 57843, 501, 57844, 502,
+	57845, 503, 57846, 504, 57847, 505, 57848, 506, 57849, 507,
+	57850, 508, 57851, 509, 57852, 510, 57853, 511, 0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := int(yyPact[state])
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := int(yyExca[i])
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = int(yyTok1[0])
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = int(yyTok1[char])
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = int(yyTok2[char-yyPrivate])
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = int(yyTok3[i+0])
+		if token == char {
+			token = int(yyTok3[i+1])
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = int(yyTok2[1]) /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = int(yyPact[yystate])
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = int(yyDef[yystate])
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = int(yyExca[xi+0])
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = int(yyExca[xi+1])
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= int(yyR2[yyn])
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = int(yyAct[yyg])
+	} else {
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 2:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:505
+		{
+		}
+	case 3:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:506
+		{
+
+		}
+	case 318:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:854
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 319:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:855
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 320:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:856
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 321:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:857
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 322:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:858
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 323:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:859
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 324:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:860
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 325:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:861
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 326:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:862
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 327:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:863
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 328:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:864
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 329:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:865
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 330:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:866
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 331:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:867
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 332:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:868
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 333:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:869
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 334:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:870
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 335:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:871
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 336:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:872
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 337:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:873
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 338:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:874
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 339:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:875
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 340:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:876
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 341:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:877
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 342:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:878
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 343:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:879
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:880
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 345:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:881
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 346:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:882
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 347:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:883
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 348:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:884
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 349:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:885
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 350:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:886
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 351:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:887
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 352:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:888
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 353:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:889
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 354:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:890
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 355:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:891
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 356:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:892
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 357:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:893
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 358:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:894
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 359:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:895
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 360:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:896
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 361:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:897
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 362:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:898
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 363:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:899
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 364:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:900
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 365:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:901
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 366:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:902
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 367:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:903
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 368:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:904
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 369:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:905
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 370:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:906
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 371:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:907
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 372:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:908
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 373:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:909
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 374:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:910
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 375:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:911
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 376:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:925
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 377:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:926
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 378:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:927
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 379:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:928
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:929
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 381:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:930
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 382:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:931
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:932
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 384:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:933
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 385:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:934
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 386:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:935
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 387:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:936
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 388:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:937
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 389:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:938
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 390:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:939
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 391:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:940
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 392:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:941
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:942
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 394:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:943
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 395:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:944
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 396:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:945
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 397:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:946
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 398:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:947
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 399:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:957
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 400:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:958
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 401:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:959
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 402:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:960
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 403:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:961
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 404:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:962
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 405:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:963
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 406:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:964
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 407:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:965
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 408:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:966
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 409:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:967
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 410:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:968
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 411:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:969
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 412:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:970
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 413:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:971
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 414:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:972
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 415:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:973
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 416:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:974
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 417:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:975
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 418:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:976
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 419:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:977
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 420:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:978
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 421:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:979
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 422:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:980
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 423:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:981
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 424:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:982
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 425:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:983
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 426:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:984
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 427:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:985
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 428:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:986
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 429:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:987
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 430:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:988
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 431:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:989
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 432:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:990
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 433:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:991
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 434:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:992
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 435:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:993
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 436:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:994
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 437:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:995
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 438:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:996
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 439:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:997
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 440:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:998
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 441:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:999
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 442:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1000
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 443:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1001
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 444:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1002
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 445:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1003
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 446:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1004
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 447:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1005
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 448:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1006
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 449:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1007
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 450:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1008
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 451:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1009
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 452:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1010
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 453:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1011
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 454:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1012
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 455:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1013
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 456:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1014
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 457:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1015
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 458:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1016
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 459:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1017
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 460:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1018
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 461:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1019
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 462:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1020
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 463:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1021
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 464:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1022
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 465:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1023
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 466:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1024
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 467:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1025
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 468:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1026
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 469:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1027
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 470:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1028
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 471:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1029
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 472:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1030
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 473:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1031
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 474:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1032
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 475:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1033
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 476:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1034
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 477:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1035
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 478:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1036
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 479:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1037
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 480:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1038
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 481:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1039
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 482:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1040
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 483:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1041
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 484:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1042
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 485:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1043
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 486:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1044
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 487:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1045
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 488:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1046
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 489:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1047
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 490:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1048
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 491:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1049
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 492:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1050
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 493:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1051
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 494:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1052
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 495:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1053
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 496:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1054
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 497:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1055
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 498:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1056
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 499:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1057
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 500:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1058
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 501:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1059
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 502:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1060
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 503:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1061
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 504:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1062
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 505:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1063
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 506:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1064
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 507:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1065
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 508:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1066
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 509:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1067
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 510:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1068
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 511:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1069
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 512:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1070
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 513:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1071
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 514:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1072
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 515:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1073
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 516:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1074
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1075
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 518:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1076
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 519:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1077
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 520:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1078
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 521:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1079
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 522:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1080
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 523:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1081
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 524:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1082
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 525:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1083
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 526:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1084
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 527:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1085
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 528:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1086
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 529:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1087
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1088
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1089
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 532:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1090
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 533:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1091
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 534:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1092
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 535:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1093
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 536:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1094
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 537:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1095
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 538:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1096
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 539:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1097
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 540:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1098
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 541:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1099
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 542:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1112
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 543:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1113
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 544:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1114
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 545:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1115
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 546:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1116
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 547:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1117
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 548:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1118
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 549:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1119
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 550:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1120
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 551:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1121
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 552:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1122
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 553:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1123
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 554:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1124
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 555:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1125
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 556:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1126
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 557:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1127
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 558:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1128
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 559:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1129
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 560:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1130
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 561:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1131
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 562:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1132
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 563:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1133
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 564:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1134
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 565:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1135
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 566:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1136
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 567:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1137
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 568:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1138
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 569:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1139
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 570:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1140
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 571:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1141
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 572:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1142
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 573:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1143
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 574:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1144
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 575:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1145
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 576:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1146
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1147
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 578:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1148
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 579:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1149
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 580:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1150
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 581:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1151
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 582:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1152
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1153
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 584:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1154
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 585:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1155
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 586:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1156
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 587:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1157
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 588:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1158
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 589:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1159
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 590:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1160
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 591:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1161
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 592:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1162
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 593:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1163
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 594:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1164
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 595:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1165
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 596:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1166
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 597:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1167
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 598:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1168
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 599:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1169
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 600:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1170
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 601:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1171
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 602:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1172
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 603:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1173
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 604:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1174
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 605:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1175
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 606:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1176
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 607:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1177
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 608:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1178
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 609:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1179
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 610:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1180
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 611:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1181
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 612:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1182
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 613:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1183
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 614:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1184
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 615:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1185
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 616:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1186
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 617:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1187
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 618:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1188
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 619:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1189
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 620:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1190
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 621:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1191
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 622:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1192
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 623:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1193
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 624:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1194
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 625:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1195
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 626:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1196
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 627:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1197
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 628:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1198
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 629:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1199
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 630:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1200
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 631:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1201
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 632:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1202
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 633:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1203
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 634:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1204
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 635:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1205
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 636:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1206
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 637:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1207
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 638:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1208
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 639:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1209
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 640:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1210
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 641:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1211
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 642:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1212
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 643:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1213
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 644:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1214
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 645:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1215
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 646:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1216
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 647:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1217
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 648:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1218
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 649:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1219
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 650:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1220
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 651:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1221
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 652:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1222
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 653:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1223
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 654:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1224
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 655:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1225
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 656:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1226
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 657:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1227
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 658:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1228
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 659:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1229
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 660:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1230
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 661:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1231
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 662:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1232
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 663:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1233
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 664:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1234
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 665:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1235
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 666:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1236
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 667:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1237
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 668:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1238
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 669:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1239
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 670:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1240
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 671:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1241
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 672:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1242
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 673:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1243
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 674:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1244
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 675:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1245
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 676:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1246
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 677:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1247
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 678:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1248
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 679:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1249
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 680:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1250
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 681:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1251
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 682:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1252
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 683:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1253
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 684:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1254
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 685:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1255
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 686:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1256
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 687:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1257
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 688:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1258
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 689:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1259
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 690:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1260
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 691:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1261
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 692:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1262
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 693:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1263
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 694:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1264
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 695:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1265
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 696:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1266
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 697:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1267
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 698:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1268
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 699:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1269
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 700:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1270
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 701:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1271
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 702:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1272
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 703:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1273
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 704:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1274
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 705:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1275
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 706:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1276
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 707:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1277
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 708:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1278
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 709:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1279
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 710:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1280
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 711:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1281
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 712:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1282
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 713:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1283
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 714:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1284
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 715:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1285
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 716:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1286
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 717:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1287
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 718:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1288
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 719:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1289
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 720:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1290
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 721:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1291
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 722:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1292
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 723:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1293
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 724:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1294
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 725:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1295
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 726:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1296
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 727:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1297
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 728:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1298
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 729:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1299
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 730:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1300
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 731:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1301
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 732:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1302
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 733:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1303
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 734:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1304
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 735:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1305
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 736:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1306
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 737:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1307
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 738:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1308
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 739:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1309
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 740:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1310
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 741:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1311
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 742:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1312
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 743:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1313
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 744:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1314
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 745:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1315
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 746:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1316
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 747:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1317
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 748:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1318
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 749:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1319
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 750:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1320
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 751:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1321
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 752:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1322
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 753:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1323
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 754:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1324
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 755:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1325
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 756:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1326
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 757:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1327
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 758:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1328
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 759:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1329
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 760:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1330
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 761:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1331
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 762:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1332
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 763:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1333
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 764:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1334
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 765:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1335
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 766:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1336
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 767:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1337
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 768:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1338
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 769:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1339
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 770:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1340
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 771:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1341
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 772:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1342
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 773:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1343
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 774:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1344
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 775:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1345
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 776:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1346
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 777:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1347
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 778:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1348
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 779:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1349
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 780:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1350
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 781:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1351
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 782:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1352
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 783:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1353
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 784:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1354
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 785:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1355
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 786:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1356
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 787:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1357
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 788:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1358
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 789:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1359
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 790:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1360
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 791:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1361
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 792:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1362
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 793:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1363
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 794:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1364
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 795:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1365
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 796:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1366
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 797:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1367
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 798:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1368
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 799:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1369
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 800:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1370
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 801:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1371
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 802:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1372
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 803:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1373
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 804:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1374
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 805:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1375
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 806:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1376
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 807:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1377
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 808:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1378
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 809:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1379
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 810:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1380
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 811:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1381
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 812:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1382
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 813:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1383
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 814:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1384
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 815:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1385
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 816:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1386
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 817:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1387
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 818:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1388
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 819:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1389
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 820:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1390
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 821:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1391
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 822:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1392
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 823:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1393
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 824:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1394
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 825:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1395
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 826:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1396
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 827:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1397
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 828:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1398
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 829:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1399
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 830:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1400
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 831:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1401
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 832:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1402
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 833:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1403
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 834:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1404
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 835:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1405
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 836:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1406
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 837:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1407
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 838:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1408
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 839:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1409
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 840:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1410
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 841:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1411
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 842:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1412
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 843:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1413
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 844:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1414
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 845:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1415
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 846:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1416
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 847:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1417
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 848:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1418
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 849:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1419
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 850:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1420
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 851:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1421
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 852:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1422
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 853:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1423
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 854:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1424
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 855:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1425
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 856:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1426
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 857:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1427
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 858:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1428
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 859:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1429
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 860:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1430
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 861:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1431
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 862:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1432
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 863:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1433
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 864:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1434
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 865:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1435
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 866:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1436
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 867:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1437
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 868:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1438
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 869:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1439
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 870:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1440
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 871:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1441
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 872:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1442
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 873:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1443
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 874:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1444
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 875:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1445
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 876:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1446
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 877:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1447
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 878:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1448
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 879:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1449
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 880:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1450
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 881:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1451
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 882:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1452
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 883:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1453
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 884:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1454
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 885:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1455
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 886:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1456
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 887:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1457
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 888:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1458
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 889:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1459
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 890:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1460
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 891:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1461
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 892:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1462
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 893:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1463
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 894:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1464
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 895:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1465
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 896:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1466
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 897:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1467
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 898:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1468
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 899:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1469
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 900:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1470
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 901:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1471
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 902:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1472
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 903:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1473
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 904:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1474
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 905:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1475
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 906:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1476
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 907:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1477
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 908:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1478
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 909:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1479
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 910:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1480
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 911:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1481
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 912:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1482
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 913:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1483
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 914:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1484
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 915:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1485
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 916:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1486
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 917:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1487
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 918:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1488
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 919:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1489
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 920:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1490
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 921:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1491
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 922:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1492
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 923:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1493
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 924:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1494
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 925:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1495
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 926:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1496
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 927:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1497
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 928:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1498
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 929:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1499
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 930:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1500
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 931:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1501
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 932:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1502
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 933:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1503
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 934:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1504
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 935:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1505
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 936:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1506
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 937:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1507
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 938:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1508
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 939:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1509
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 940:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1510
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 941:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1511
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 942:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1512
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 943:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1513
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 944:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1514
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 945:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1515
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 946:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1516
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 947:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1517
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 948:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1518
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 949:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1519
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 950:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1520
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 951:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1521
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 952:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1522
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 953:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1523
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 954:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1524
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 955:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1525
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 956:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1526
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 957:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1527
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 958:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1528
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 959:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1529
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 960:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1530
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 961:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1531
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 962:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1532
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 963:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1533
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 964:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1534
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 965:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1535
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 966:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1536
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 967:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1537
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 968:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1538
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 969:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1539
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 970:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1540
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 971:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1541
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 972:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1542
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 973:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1543
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 974:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1544
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 975:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1545
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 976:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1549
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 977:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1549
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 978:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1549
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 979:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1549
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 980:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1549
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 981:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:1552
+		{
+		}
+	case 982:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1553
+		{
+		}
+	case 983:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:1556
+		{
+			yyVAL.strlist = nil
+		}
+	case 984:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1557
+		{
+			yyVAL.strlist = append([]string{yyDollar[1].str}, yyDollar[2].strlist...)
+		}
+	case 985:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:1563
+		{
+			yyVAL.node = nil
+		}
+	case 986:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1565
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 987:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1567
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 988:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1569
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 989:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1571
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 990:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1573
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 991:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1575
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 992:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1577
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 993:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1579
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 994:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1581
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 995:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1583
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 996:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1585
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 997:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1587
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 998:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1589
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 999:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1591
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 1000:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1593
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 1001:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1595
+		{
+			setParseTree(yylex, yyDollar[1].node)
+		}
+	case 1002:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1601
+		{
+			yyVAL.str = string(yyDollar[1].str)
+		}
+	case 1003:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1605
+		{
+		}
+	case 1004:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1611
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1005:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1615
+		{
+			yyVAL.str = string(yyDollar[1].str)
+		}
+	case 1006:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1621
+		{
+			yyVAL.str = string(yyDollar[1].str)
+		}
+	case 1007:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1626
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1008:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1628
+		{
+			yyVAL.str = "AND"
+		}
+	case 1009:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1630
+		{
+			yyVAL.str = "OR"
+		}
+	case 1010:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1632
+		{
+			yyVAL.str = "!="
+		}
+	case 1011:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1634
+		{
+			yyVAL.str = "="
+		}
+	case 1012:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1636
+		{
+			yyVAL.str = "<"
+		}
+	case 1013:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1638
+		{
+			yyVAL.str = ">"
+		}
+	case 1014:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1640
+		{
+			yyVAL.str = ">="
+		}
+	case 1015:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1642
+		{
+			yyVAL.str = "<="
+		}
+	case 1016:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1644
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1017:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1660
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1018:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1664
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 1019:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:1669
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1020:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:1673
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 1021:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1677
+		{
+
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1022:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1682
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 1023:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1690
+		{
+		}
+	case 1024:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:1692
+		{
+		}
+	case 1025:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:1694
+		{
+		}
+	case 1026:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1698
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1027:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1700
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1028:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1702
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1029:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1704
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1030:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1706
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1031:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1709
+		{
+
+		}
+	case 1032:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:1713
+		{
+
+		}
+	case 1033:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1729
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1034:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1730
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1035:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1731
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1036:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1732
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1037:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1736
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1038:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1749
+		{
+
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1039:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1758
+		{
+		}
+	case 1040:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:1759
+		{
+		}
+	case 1041:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1766
+		{
+
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1042:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1771
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1043:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1775
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1044:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1779
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1045:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1783
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1046:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1787
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1047:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1791
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1048:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1795
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1049:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1799
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1050:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1803
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1051:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1807
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1052:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1813
+		{
+			/*
+			 * Check FLOAT() precision limits assuming IEEE floating
+			 * types - thomas 1997-09-18
+			 */
+
+		}
+	case 1053:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:1821
+		{
+		}
+	case 1054:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1830
+		{
+		}
+	case 1055:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1833
+		{
+		}
+	case 1056:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1840
+		{
+		}
+	case 1057:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1843
+		{
+		}
+	case 1058:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:1849
+		{
+
+		}
+	case 1059:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1856
+		{
+			/* bit defaults to bit(1), varbit to no limit */
+
+		}
+	case 1060:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1865
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 1061:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1868
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 1062:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:1869
+		{
+		}
+	case 1063:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1873
+		{
+		}
+	case 1064:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1874
+		{
+		}
+	case 1065:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1880
+		{
+
+		}
+	case 1066:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1884
+		{
+		}
+	case 1067:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:1887
+		{
+		}
+	case 1068:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1890
+		{
+		}
+	case 1069:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1898
+		{
+		}
+	case 1070:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1901
+		{
+		}
+	case 1071:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1902
+		{
+		}
+	case 1072:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1913
+		{
+		}
+	case 1073:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1916
+		{
+		}
+	case 1074:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1921
+		{
+		}
+	case 1075:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1924
+		{
+			/* Length was not specified so allow to be unrestricted.
+			 * This handles problems with fixed-length (bpchar) strings
+			 * which in column definitions must default to a length
+			 * of one, but should not be constrained if the length
+			 * was not specified.
+			 */
+
+		}
+	case 1076:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:1937
+		{
+
+		}
+	case 1077:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1943
+		{
+			/* char defaults to char(1), varchar to no limit */
+
+		}
+	case 1078:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1951
+		{
+		}
+	case 1079:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1953
+		{
+		}
+	case 1080:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1955
+		{
+		}
+	case 1081:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1957
+		{
+		}
+	case 1082:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:1959
+		{
+		}
+	case 1083:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1961
+		{
+		}
+	case 1084:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1965
+		{
+		}
+	case 1085:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:1966
+		{
+		}
+	case 1086:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:1975
+		{
+
+		}
+	case 1087:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1979
+		{
+
+		}
+	case 1088:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:1983
+		{
+
+		}
+	case 1089:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:1987
+		{
+
+		}
+	case 1090:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:1995
+		{
+
+		}
+	case 1091:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2001
+		{
+		}
+	case 1092:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2002
+		{
+		}
+	case 1093:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:2003
+		{
+		}
+	case 1094:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2008
+		{
+		}
+	case 1095:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2010
+		{
+		}
+	case 1096:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2012
+		{
+		}
+	case 1097:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2014
+		{
+		}
+	case 1098:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2016
+		{
+		}
+	case 1099:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2018
+		{
+		}
+	case 1100:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2020
+		{
+
+		}
+	case 1101:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2024
+		{
+
+		}
+	case 1102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2028
+		{
+
+		}
+	case 1103:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2032
+		{
+
+		}
+	case 1104:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2036
+		{
+
+		}
+	case 1105:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2040
+		{
+
+		}
+	case 1106:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2044
+		{
+
+		}
+	case 1107:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:2048
+		{
+		}
+	case 1108:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2053
+		{
+		}
+	case 1109:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2056
+		{
+		}
+	case 1110:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2073
+		{
+		}
+	case 1111:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2074
+		{
+		}
+	case 1112:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2075
+		{
+		}
+	case 1113:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2076
+		{
+		}
+	case 1114:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2078
+		{
+		}
+	case 1115:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2081
+		{
+		}
+	case 1116:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2083
+		{
+		}
+	case 1117:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:2084
+		{
+		}
+	case 1118:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:2091
+		{
+
+		}
+	case 1119:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2097
+		{
+		}
+	case 1120:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2098
+		{
+		}
+	case 1121:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:2099
+		{
+		}
+	case 1122:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2127
+		{
+
+		}
+	case 1123:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:2130
+		{
+		}
+	case 1124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2134
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1125:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2134
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1126:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2138
+		{
+			yyVAL.strlist = yyDollar[1].strlist
+		}
+	case 1127:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:2139
+		{
+			yyVAL.strlist = nil
+		}
+	case 1128:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2144
+		{
+			yyVAL.strlist = []string{yyDollar[1].str}
+		}
+	case 1129:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2148
+		{
+			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
+		}
+	case 1130:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2154
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1131:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2158
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1132:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2166
+		{
+			yyVAL.node = &FuncApplication{
+				Name: yyDollar[1].str,
+			}
+		}
+	case 1133:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:2172
+		{
+			yyVAL.node = &FuncApplication{
+				Name: yyDollar[1].str,
+				Args: yyDollar[3].nodeList,
+			}
+		}
+	case 1134:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:2179
+		{
+			yyVAL.node = &FuncApplication{
+				Name: yyDollar[1].str,
+			}
+		}
+	case 1135:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line lyx/gram.y:2185
+		{
+			yyVAL.node = &FuncApplication{
+				Name: yyDollar[1].str,
+			}
+		}
+	case 1136:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:2191
+		{
+
+			/* Ideally we'd mark the FuncCall node to indicate
+			 * "must be an aggregate", but there's no provision
+			 * for that in FuncCall at the moment.
+			 */
+			yyVAL.node = &FuncApplication{
+				Name: yyDollar[1].str,
+			}
+		}
+	case 1137:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:2202
+		{
+			yyVAL.node = &FuncApplication{
+				Name: yyDollar[1].str,
+			}
+		}
+	case 1138:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2209
+		{
+			/*
+			 * We consider AGGREGATE(*) to invoke a parameterless
+			 * aggregate.  This does the right thing for COUNT(*),
+			 * and there are no other aggregates in SQL that accept
+			 * '*' as parameter.
+			 *
+			 * The FuncCall node is also marked agg_star = true,
+			 * so that later processing can detect what the argument
+			 * really was.
+			 */
+
+			yyVAL.node = &FuncApplication{
+				Name: yyDollar[1].str,
+			}
+		}
+	case 1139:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2227
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1140:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2232
+		{
+			yyVAL.nodeList = []Node{yyDollar[1].node}
+		}
+	case 1141:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2236
+		{
+			yyVAL.nodeList = append(yyDollar[1].nodeList, yyDollar[3].node)
+		}
+	case 1142:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2245
+		{
+			yyVAL.node = &AExprSConst{
+				Value: yyDollar[1].str,
+			}
+		}
+	case 1143:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2250
+		{
+			yyVAL.node = &AExprIConst{
+				Value: yyDollar[1].int,
+			}
+		}
+	case 1144:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2256
+		{
+			/* generic type 'literal' syntax */
+
+		}
+	case 1145:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:2261
+		{
+			/* generic syntax with a type modifier */
+
+			/*
+			 * We must use func_arg_list and opt_sort_clause in the
+			 * production to avoid reduce/reduce conflicts, but we
+			 * don't actually wish to allow NamedArgExpr in this
+			 * context, nor ORDER BY.
+			 */
+		}
+	case 1146:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2273
+		{
+		}
+	case 1147:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2276
+		{
+
+		}
+	case 1148:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:2280
+		{
+
+		}
+	case 1149:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2284
+		{
+			yyVAL.node = &AExprBConst{
+				Value: true,
+			}
+		}
+	case 1150:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2290
+		{
+			yyVAL.node = &AExprBConst{
+				Value: false,
+			}
+		}
+	case 1151:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2296
+		{
+			yyVAL.node = &AExprNConst{}
+		}
+	case 1153:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2306
+		{
+			yyVAL.nodeList = []Node{yyDollar[1].node}
+		}
+	case 1154:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2310
+		{
+			yyVAL.nodeList = append(yyDollar[1].nodeList, yyDollar[3].node)
+		}
+	case 1157:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2319
+		{
+		}
+	case 1158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2320
+		{
+		}
+	case 1159:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2324
+		{
+
+		}
+	case 1160:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2332
+		{
+
+		}
+	case 1161:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2337
+		{
+			yyVAL.node = &AExprList{List: yyDollar[3].nodeList}
+		}
+	case 1162:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2338
+		{
+			yyVAL.node = &AExprList{}
+		}
+	case 1163:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:2342
+		{
+			yyVAL.node = &AExprList{List: append(yyDollar[2].nodeList, yyDollar[4].node)}
+		}
+	case 1164:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2345
+		{
+		}
+	case 1165:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2346
+		{
+		}
+	case 1166:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2347
+		{
+		}
+	case 1167:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2354
+		{
+		}
+	case 1168:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2358
+		{
+		}
+	case 1169:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2361
+		{
+		}
+	case 1170:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2364
+		{
+
+		}
+	case 1171:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:2368
+		{
+
+		}
+	case 1172:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2374
+		{
+		}
+	case 1173:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:2375
+		{
+		}
+	case 1174:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2379
+		{
+		}
+	case 1175:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2380
+		{
+		}
+	case 1176:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:2384
+		{
+		}
+	case 1177:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2385
+		{
+		}
+	case 1178:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2392
+		{
+			/* other fields will be filled later */
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1179:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2397
+		{
+			if len(yyDollar[2].nodeList) > 0 {
+				yyVAL.node = yyDollar[2].nodeList[0]
+			} else {
+				yyVAL.node = nil
+			}
+		}
+	case 1180:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:2415
+		{
+		}
+	case 1181:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2422
+		{
+		}
+	case 1182:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2423
+		{
+		}
+	case 1183:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2428
+		{
+		}
+	case 1184:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2433
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1185:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:2434
+		{
+		}
+	case 1186:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2437
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1187:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:2438
+		{
+		}
+	case 1188:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2460
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1189:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2461
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1190:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2465
+		{
+			yyVAL.node = &ParamRef{
+				Number: yyDollar[1].int,
+			}
+		}
+	case 1191:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2470
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1192:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2473
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1193:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2476
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1194:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2480
+		{
+			yyVAL.node = &SubLink{
+				SubSelect: yyDollar[2].node,
+			}
+		}
+	case 1195:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2486
+		{
+		}
+	case 1196:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2488
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1197:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2489
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1198:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2491
+		{
+
+		}
+	case 1199:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2496
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1200:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2498
+		{
+		}
+	case 1201:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2526
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1202:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2528
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1203:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2530
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1204:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2538
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1205:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2546
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1206:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2554
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1207:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2562
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1208:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2570
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1209:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2578
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1210:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2586
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1211:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2594
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1212:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2602
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1213:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2610
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1214:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2618
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1215:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2627
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1216:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2640
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1217:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2648
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    yyDollar[2].str,
+			}
+		}
+	case 1218:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2656
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1219:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2766
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1220:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2768
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1221:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2770
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1222:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2772
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1223:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2791
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1224:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2793
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1225:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2795
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1226:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2797
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1227:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:2817
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[5].node,
+				Op:    yyDollar[3].str,
+			}
+		}
+	case 1228:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:2825
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[6].node,
+				Op:    yyDollar[3].str,
+			}
+		}
+	case 1229:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:2833
+		{
+			yyVAL.node = &AExprOp{
+				Left: yyDollar[1].node,
+				Right: &AExprList{
+					List: []Node{
+						yyDollar[4].node,
+						yyDollar[6].node,
+					},
+				},
+				Op: "BETWEEN",
+			}
+		}
+	case 1230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2870
+		{
+			yyVAL.node = &AExprOp{
+				Left:  yyDollar[1].node,
+				Right: yyDollar[3].node,
+				Op:    "IN",
+			}
+		}
+	case 1231:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2878
+		{
+
+		}
+	case 1232:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2882
+		{
+			yyVAL.node = &SubLink{
+				SubSelect: yyDollar[4].node,
+			}
+		}
+	case 1233:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2911
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1234:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2913
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2915
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1236:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:2919
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1237:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2929
+		{
+			/*
+			 * The SQL spec only allows DEFAULT in "contextually typed
+			 * expressions", but for us, it's easier to allow it in
+			 * any a_expr and then throw error during parse analysis
+			 * if it's in an inappropriate context.  This way also
+			 * lets us say something smarter than "syntax error".
+			 */
+
+		}
+	case 1238:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2940
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1239:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2945
+		{
+			yyVAL.node = &ColumnRef{
+				ColName:    yyDollar[3].str,
+				TableAlias: yyDollar[1].str,
+			}
+		}
+	case 1240:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2953
+		{
+			yyVAL.node = &AExprEmpty{}
+		}
+	case 1241:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2958
+		{
+			yyVAL.node = &ColumnRef{
+				ColName: yyDollar[1].str,
+			}
+		}
+	case 1242:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2962
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1243:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2964
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1244:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2969
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1245:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:2982
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1246:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2984
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1247:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:2986
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1248:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2988
+		{ /* result not matter */
+		}
+	case 1249:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2990
+		{ /* result not matter */
+		}
+	case 1250:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2992
+		{ /* result not matter */
+		}
+	case 1251:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2994
+		{ /* result not matter */
+		}
+	case 1252:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2996
+		{ /* result not matter */
+		}
+	case 1253:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:2998
+		{ /* result not matter */
+		}
+	case 1254:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3000
+		{ /* result not matter */
+		}
+	case 1255:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3002
+		{ /* result not matter */
+		}
+	case 1256:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3004
+		{ /* result not matter */
+		}
+	case 1257:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3006
+		{ /* result not matter */
+		}
+	case 1258:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3008
+		{ /* result not matter */
+		}
+	case 1259:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3010
+		{ /* result not matter */
+		}
+	case 1260:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3011
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1261:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3018
+		{
+			yyVAL.node = &AExprEmpty{}
+		}
+	case 1262:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3022
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1263:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3027
+		{
+			yyVAL.nodeList = yyDollar[1].nodeList
+		}
+	case 1264:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3028
+		{
+			yyVAL.nodeList = nil
+		}
+	case 1265:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3032
+		{
+			yyVAL.nodeList = []Node{yyDollar[1].node}
+		}
+	case 1266:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3033
+		{
+			yyVAL.nodeList = append(yyDollar[1].nodeList, yyDollar[3].node)
+		}
+	case 1267:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3037
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1268:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3041
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1269:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3045
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1270:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3049
+		{
+			yyVAL.node = &AExprEmpty{}
+		}
+	case 1271:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3055
+		{
+		}
+	case 1272:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3056
+		{
+		}
+	case 1273:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3057
+		{
+		}
+	case 1274:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3061
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1275:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3062
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1276:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3078
+		{
+			yyVAL.node = yyDollar[2].node
+			if yyVAL.node != nil {
+				yyVAL.node.(*VariableSetStmt).Kind = VarTypeSet
+			}
+		}
+	case 1277:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3085
+		{
+			yyVAL.node = yyDollar[3].node
+			if yyVAL.node != nil {
+				yyVAL.node.(*VariableSetStmt).IsLocal = true
+				yyVAL.node.(*VariableSetStmt).Kind = VarTypeSet
+			}
+		}
+	case 1278:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3093
+		{
+			yyVAL.node = yyDollar[3].node
+
+			if yyVAL.node != nil {
+				yyVAL.node.(*VariableSetStmt).Session = true
+				yyVAL.node.(*VariableSetStmt).Kind = VarTypeSet
+			}
+		}
+	case 1279:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3105
+		{
+			yyVAL.node = &VariableSetStmt{
+				TxMode: yyDollar[2].txModeList.items,
+			}
+		}
+	case 1280:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:3111
+		{
+			yyVAL.node = &VariableSetStmt{
+				TxMode: yyDollar[5].txModeList.items,
+			}
+		}
+	case 1281:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3116
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1282:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3122
+		{
+			yyVAL.node = &VariableSetStmt{
+				Name:  yyDollar[1].str,
+				Value: yyDollar[3].strlist,
+			}
+		}
+	case 1283:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3129
+		{
+			yyVAL.node = &VariableSetStmt{
+				Name:  yyDollar[1].str,
+				Value: yyDollar[3].strlist,
+			}
+		}
+	case 1284:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3136
+		{
+			yyVAL.node = &VariableSetStmt{
+				Name:    yyDollar[1].str,
+				Value:   nil,
+				Default: true,
+			}
+		}
+	case 1285:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3144
+		{
+			yyVAL.node = &VariableSetStmt{
+				Name:    yyDollar[1].str,
+				Value:   nil,
+				Default: true,
+			}
+		}
+	case 1286:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3154
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1287:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3157
+		{
+		}
+	case 1288:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3161
+		{
+		}
+	case 1289:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3164
+		{
+
+		}
+	case 1290:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3168
+		{
+		}
+	case 1291:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3171
+		{
+		}
+	case 1292:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3174
+		{
+		}
+	case 1293:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3177
+		{
+		}
+	case 1294:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3180
+		{
+		}
+	case 1295:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3187
+		{
+		}
+	case 1296:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3191
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1297:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3193
+		{
+			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
+		}
+	case 1298:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3196
+		{
+			yyVAL.strlist = []string{yyDollar[1].str}
+		}
+	case 1299:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3197
+		{
+			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
+		}
+	case 1300:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3201
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1301:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3203
+		{
+			yyVAL.str = fmt.Sprintf("%d", yyDollar[1].int)
+		}
+	case 1302:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3206
+		{
+			yyVAL.str = IsolationReadUncommitted
+		}
+	case 1303:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3207
+		{
+			yyVAL.str = IsolationReadCommitted
+		}
+	case 1304:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3208
+		{
+			yyVAL.str = IsolationRepeatableRead
+		}
+	case 1305:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3209
+		{
+			yyVAL.str = IsolationSerializable
+		}
+	case 1306:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3213
+		{
+			yyVAL.str = "true"
+		}
+	case 1307:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3214
+		{
+			yyVAL.str = "false"
+		}
+	case 1308:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3215
+		{
+			yyVAL.str = "true"
+		}
+	case 1309:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3221
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1310:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3234
+		{
+		}
+	case 1311:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3237
+		{
+		}
+	case 1312:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3240
+		{
+
+		}
+	case 1313:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:3244
+		{
+
+		}
+	case 1314:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3248
+		{
+		}
+	case 1315:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3249
+		{
+		}
+	case 1316:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3256
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1317:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3260
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1318:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3262
+		{
+		}
+	case 1319:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3265
+		{
+		}
+	case 1320:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3268
+		{
+		}
+	case 1321:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3274
+		{
+			yyVAL.node = &VariableSetStmt{
+				Kind: VarTypeReset,
+				Name: yyDollar[1].str,
+			}
+		}
+	case 1322:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3281
+		{
+
+			yyVAL.node = &VariableSetStmt{
+				Kind: VarTypeResetAll,
+				Name: yyDollar[1].str,
+			}
+		}
+	case 1323:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3292
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1324:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3293
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1325:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3300
+		{
+			yyVAL.node = &VariableShowStmt{
+				Name: yyDollar[2].str,
+			}
+		}
+	case 1326:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3306
+		{
+			yyVAL.node = &VariableShowStmt{
+				Name: "timezone",
+			}
+		}
+	case 1327:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:3312
+		{
+			yyVAL.node = &VariableShowStmt{
+				Name: "transaction_isolation",
+			}
+		}
+	case 1328:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3318
+		{
+			yyVAL.node = &VariableShowStmt{
+				Name: "session_authorization",
+			}
+		}
+	case 1329:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3324
+		{
+			yyVAL.node = &VariableShowStmt{
+				Name: "all",
+			}
+		}
+	case 1330:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3345
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind: TRANS_STMT_ROLLBACK,
+			}
+		}
+	case 1331:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3351
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind:           TRANS_STMT_START,
+				Options:        yyDollar[3].txModeList.items,
+				IsolationLevel: yyDollar[3].txModeList.isolation,
+			}
+		}
+	case 1332:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3359
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind: TRANS_STMT_COMMIT,
+			}
+		}
+	case 1333:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3365
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind: TRANS_STMT_ROLLBACK,
+			}
+		}
+	case 1334:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3371
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind: TRANS_STMT_SAVEPOINT,
+			}
+		}
+	case 1335:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3377
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind: TRANS_STMT_RELEASE,
+				Name: yyDollar[3].str,
+			}
+		}
+	case 1336:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3384
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind: TRANS_STMT_RELEASE,
+				Name: yyDollar[2].str,
+			}
+		}
+	case 1337:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:3391
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind:          TRANS_STMT_ROLLBACK_TO,
+				SavepointName: yyDollar[5].str,
+			}
+		}
+	case 1338:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:3398
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind:          TRANS_STMT_ROLLBACK_TO,
+				SavepointName: yyDollar[4].str,
+			}
+		}
+	case 1339:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3405
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind: TRANS_STMT_PREPARE,
+				Gid:  yyDollar[3].str,
+			}
+		}
+	case 1340:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3412
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind: TRANS_STMT_COMMIT_PREPARED,
+				Gid:  yyDollar[3].str,
+			}
+		}
+	case 1341:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3419
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind: TRANS_STMT_ROLLBACK_PREPARED,
+				Gid:  yyDollar[3].str,
+			}
+		}
+	case 1342:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3429
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind:           TRANS_STMT_BEGIN,
+				Options:        yyDollar[3].txModeList.items,
+				IsolationLevel: yyDollar[3].txModeList.isolation,
+			}
+		}
+	case 1343:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3437
+		{
+			yyVAL.node = &TransactionStmt{
+				Kind:    TRANS_STMT_COMMIT,
+				Options: nil,
+			}
+		}
+	case 1344:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3445
+		{
+		}
+	case 1345:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3446
+		{
+		}
+	case 1346:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3447
+		{
+		}
+	case 1347:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3452
+		{
+			yyVAL.txMode = transactionMode{item: TransactionIsolation, isolation: yyDollar[3].str}
+		}
+	case 1348:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3454
+		{
+			yyVAL.txMode = transactionMode{item: TransactionReadOnly}
+		}
+	case 1349:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3456
+		{
+			yyVAL.txMode = transactionMode{item: TransactionReadWrite}
+		}
+	case 1350:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3458
+		{
+			yyVAL.txMode = transactionMode{item: TransactionDeferrable}
+		}
+	case 1351:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3460
+		{
+			yyVAL.txMode = transactionMode{item: TransactionNotDeferrable}
+		}
+	case 1352:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3466
+		{
+			yyVAL.txModeList = transactionModes{}.add(yyDollar[1].txMode)
+		}
+	case 1353:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3468
+		{
+			yyVAL.txModeList = yyDollar[1].txModeList.add(yyDollar[3].txMode)
+		}
+	case 1354:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3470
+		{
+			yyVAL.txModeList = yyDollar[1].txModeList.add(yyDollar[2].txMode)
+		}
+	case 1355:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3474
+		{
+			yyVAL.txModeList = yyDollar[1].txModeList
+		}
+	case 1356:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3476
+		{
+			yyVAL.txModeList = transactionModes{}
+		}
+	case 1357:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3480
+		{
+		}
+	case 1358:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3481
+		{
+		}
+	case 1359:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3482
+		{
+		}
+	case 1360:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3487
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1361:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3489
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1362:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3491
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1363:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3493
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1364:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3495
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1365:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3497
+		{
+			yyVAL.node = &Explain{
+				Stmt: yyDollar[2].node,
+			}
+		}
+	case 1366:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3504
+		{
+		}
+	case 1367:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3505
+		{
+		}
+	case 1368:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3508
+		{
+		}
+	case 1369:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:3509
+		{
+		}
+	case 1370:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3513
+		{
+		}
+	case 1371:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3514
+		{
+		}
+	case 1372:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3517
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1373:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3520
+		{
+		}
+	case 1374:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3521
+		{
+		}
+	case 1375:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3525
+		{
+		}
+	case 1376:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3528
+		{
+		}
+	case 1377:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3534
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1378:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3535
+		{
+			yyVAL.node = nil
+		}
+	case 1379:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3555
+		{
+
+		}
+	case 1380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3559
+		{
+
+		}
+	case 1381:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:3567
+		{
+
+		}
+	case 1382:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:3571
+		{
+
+		}
+	case 1383:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3575
+		{
+
+		}
+	case 1384:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3611
+		{
+
+		}
+	case 1385:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3615
+		{
+
+		}
+	case 1386:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3619
+		{
+
+		}
+	case 1387:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3623
+		{
+
+		}
+	case 1388:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3631
+		{
+		}
+	case 1389:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3632
+		{
+			yyVAL.node = nil
+		}
+	case 1390:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3637
+		{
+
+		}
+	case 1391:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3640
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1392:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3641
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1393:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3649
+		{
+		}
+	case 1394:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3650
+		{
+		}
+	case 1395:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3654
+		{
+		}
+	case 1396:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3655
+		{
+			yyVAL.node = nil
+		}
+	case 1397:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:3660
+		{
+			yyVAL.node = yyDollar[3].node
+		}
+	case 1398:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3661
+		{
+			yyVAL.node = nil
+		}
+	case 1399:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3666
+		{
+		}
+	case 1400:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3671
+		{
+		}
+	case 1401:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3676
+		{
+		}
+	case 1402:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3682
+		{
+		}
+	case 1403:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3685
+		{
+		}
+	case 1404:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:3692
+		{
+			yyVAL.tableelt = []TableElt{
+				{
+					ColName: yyDollar[1].str,
+					ColType: yyDollar[2].str,
+				},
+			}
+		}
+	case 1405:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:3700
+		{
+			yyVAL.tableelt = nil
+		}
+	case 1406:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:3703
+		{
+			yyVAL.tableelt = nil
+		}
+	case 1407:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3706
+		{
+			yyVAL.tableelt = append(yyDollar[1].tableelt, yyDollar[3].tableelt...)
+		}
+	case 1408:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3711
+		{
+		}
+	case 1409:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3711
+		{
+		}
+	case 1410:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lyx/gram.y:3714
+		{
+			yyVAL.node = &CreateTable{
+				TableName: yyDollar[4].str,
+				TableElts: yyDollar[6].tableelt,
+			}
+		}
+	case 1411:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3719
+		{
+			yyVAL.node = &Index{
+				Concurrently: hasConcurrently(yyDollar[3].strlist, 0),
+			}
+		}
+	case 1412:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3723
+		{
+			yyVAL.node = &CreateRole{}
+		}
+	case 1413:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3727
+		{
+			yyVAL.node = &CreateDatabase{}
+		}
+	case 1414:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3734
+		{
+			yyVAL.node = &Alter{
+				ObjectType: objectType(yyDollar[2].strlist),
+			}
+		}
+	case 1415:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3741
+		{
+			yyVAL.node = &Vacuum{}
+		}
+	case 1416:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3748
+		{
+			yyVAL.node = &Cluster{}
+		}
+	case 1417:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3756
+		{
+			yyVAL.node = &Analyze{}
+		}
+	case 1418:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3763
+		{
+			yyVAL.node = &Drop{
+				ObjectType:   objectType(yyDollar[2].strlist),
+				Concurrently: hasConcurrently(yyDollar[2].strlist, 1),
+			}
+		}
+	case 1419:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3772
+		{
+			yyVAL.node = &Truncate{}
+		}
+	case 1420:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3780
+		{
+		}
+	case 1421:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3781
+		{
+		}
+	case 1422:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3782
+		{
+		}
+	case 1423:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3786
+		{
+		}
+	case 1424:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3787
+		{
+		}
+	case 1425:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3788
+		{
+		}
+	case 1426:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3794
+		{
+		}
+	case 1427:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3795
+		{
+		}
+	case 1428:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3799
+		{
+		}
+	case 1429:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3803
+		{
+		}
+	case 1430:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3804
+		{
+		}
+	case 1431:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3813
+		{
+			/* no operator */
+		}
+	case 1432:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3819
+		{
+		}
+	case 1433:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3819
+		{
+		}
+	case 1434:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3820
+		{
+		}
+	case 1435:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3820
+		{
+		}
+	case 1436:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3821
+		{
+		}
+	case 1437:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3821
+		{
+		}
+	case 1438:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3822
+		{
+		}
+	case 1439:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3822
+		{
+		}
+	case 1440:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3823
+		{
+		}
+	case 1441:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3823
+		{
+		}
+	case 1442:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3838
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1443:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3839
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1444:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3840
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1445:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3845
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1446:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3846
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1447:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3847
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1448:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3852
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1449:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3853
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1450:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3854
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1451:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3855
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1452:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3861
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1453:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3862
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1454:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3863
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1455:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3864
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1456:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3865
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1457:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3876
+		{
+		}
+	case 1458:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3877
+		{
+		}
+	case 1459:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3888
+		{
+			yyVAL.from = &RangeVar{
+				SchemaName:   "",
+				RelationName: yyDollar[1].str,
+				Alias:        "",
+			}
+		}
+	case 1460:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3896
+		{
+			yyVAL.from = &RangeVar{
+				SchemaName:   yyDollar[1].str,
+				RelationName: yyDollar[3].str,
+				Alias:        "",
+			}
+		}
+	case 1461:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3911
+		{
+			yyVAL.from_list = yyDollar[2].from_list
+		}
+	case 1462:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3912
+		{
+		}
+	case 1463:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3916
+		{
+			yyVAL.from_list = []FromClauseNode{yyDollar[1].from}
+		}
+	case 1464:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3917
+		{
+			yyVAL.from_list = append(yyDollar[1].from_list, yyDollar[3].from)
+		}
+	case 1465:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3922
+		{
+			/* inheritance query, implicitly */
+			yyVAL.tableref = yyDollar[1].from
+		}
+	case 1466:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3934
+		{
+		}
+	case 1467:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:3935
+		{
+		}
+	case 1468:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3940
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 1469:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3944
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1470:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3950
+		{
+			yyVAL.str = ""
+		}
+	case 1472:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3961
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1473:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:3966
+		{
+			yyVAL.bool = true
+		}
+	case 1474:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3967
+		{
+			yyVAL.bool = false
+		}
+	case 1475:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:3978
+		{
+			yyVAL.node = nil
+		}
+	case 1476:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:3982
+		{
+			yyVAL.node = nil
+		}
+	case 1477:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:3986
+		{
+
+		}
+	case 1478:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:3990
+		{
+
+		}
+	case 1479:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:3994
+		{
+			yyVAL.node = nil
+		}
+	case 1480:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4012
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1481:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4020
+		{
+			yyDollar[1].tableref.SetAlias(yyDollar[2].str)
+			yyVAL.from = yyDollar[1].tableref
+		}
+	case 1482:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4025
+		{
+
+		}
+	case 1483:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4029
+		{
+
+		}
+	case 1484:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4033
+		{
+		}
+	case 1485:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4036
+		{
+			yyVAL.from = yyDollar[1].tableref
+		}
+	case 1486:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4040
+		{
+			yyDollar[2].tableref.SetAlias(yyDollar[4].str)
+			yyVAL.from = yyDollar[2].tableref
+		}
+	case 1487:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4048
+		{
+		}
+	case 1488:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4049
+		{
+		}
+	case 1489:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4053
+		{
+		}
+	case 1490:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4054
+		{
+		}
+	case 1491:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4055
+		{
+		}
+	case 1492:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4056
+		{
+		}
+	case 1493:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4063
+		{
+			yyVAL.joinExpr = &JoinExpr{
+				Using: yyDollar[3].strlist,
+			}
+		}
+	case 1494:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4069
+		{
+			yyVAL.joinExpr = &JoinExpr{
+				Quals: yyDollar[2].node,
+			}
+		}
+	case 1495:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4079
+		{
+			yyVAL.tableref = yyDollar[2].tableref
+		}
+	case 1496:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4083
+		{
+			/* CROSS JOIN is same as unqualified inner join */
+			yyVAL.tableref = &JoinExpr{
+				Larg: yyDollar[1].from,
+				Rarg: yyDollar[4].from,
+			}
+		}
+	case 1497:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4091
+		{
+			yyDollar[5].joinExpr.Larg = yyDollar[1].from
+			yyDollar[5].joinExpr.Rarg = yyDollar[4].from
+			yyVAL.tableref = yyDollar[5].joinExpr
+		}
+	case 1498:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4097
+		{
+			/* letting join_type reduce to empty doesn't work */
+			yyDollar[4].joinExpr.Larg = yyDollar[1].from
+			yyDollar[4].joinExpr.Rarg = yyDollar[3].from
+			yyVAL.tableref = yyDollar[4].joinExpr
+		}
+	case 1499:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4104
+		{
+			yyVAL.tableref = &JoinExpr{
+				Larg: yyDollar[1].from,
+				Rarg: yyDollar[5].from,
+			}
+		}
+	case 1500:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4111
+		{
+			/* letting join_type reduce to empty doesn't work */
+			yyVAL.tableref = &JoinExpr{
+				Larg: yyDollar[1].from,
+				Rarg: yyDollar[4].from,
+			}
+		}
+	case 1501:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4125
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1502:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4126
+		{
+
+		}
+	case 1503:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4132
+		{
+		}
+	case 1504:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4140
+		{
+
+		}
+	case 1505:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4144
+		{
+		}
+	case 1506:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4155
+		{
+			// $$ = $3;
+		}
+	case 1507:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4159
+		{
+			// $$ = $3;
+		}
+	case 1508:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4163
+		{
+			// $$ = $4;
+		}
+	case 1509:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4167
+		{
+			// $$ = $4;
+		}
+	case 1510:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4171
+		{
+			// $$ = $4;
+		}
+	case 1511:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4175
+		{
+			// $$ = $4;
+		}
+	case 1512:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4179
+		{
+			// $$ = $3;
+		}
+	case 1513:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4183
+		{
+			// $$ = $2;
+		}
+	case 1514:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4187
+		{
+			// $$ = $1;
+		}
+	case 1515:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4192
+		{
+		}
+	case 1516:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4193
+		{
+		}
+	case 1517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4197
+		{
+		}
+	case 1518:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4198
+		{
+		}
+	case 1519:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4199
+		{
+		}
+	case 1520:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lyx/gram.y:4235
+		{
+			yyVAL.node = &Select{
+				TargetList: yyDollar[3].nodeList,
+				FromClause: yyDollar[5].from_list,
+				Where:      yyDollar[6].node,
+			}
+		}
+	case 1521:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lyx/gram.y:4245
+		{
+			yyVAL.node = &Select{
+				TargetList: yyDollar[3].nodeList,
+				FromClause: yyDollar[5].from_list,
+				Where:      yyDollar[6].node,
+			}
+		}
+	case 1522:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4252
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1523:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4254
+		{
+			/* same as SELECT * FROM relation_expr */
+
+		}
+	case 1524:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4259
+		{
+			yyVAL.node = &Select{
+				Op:   SetOpUnion,
+				LArg: yyDollar[1].node,
+				RArg: yyDollar[4].node,
+			}
+		}
+	case 1525:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4267
+		{
+			yyVAL.node = &Select{
+				Op:   SetOpIntersect,
+				LArg: yyDollar[1].node,
+				RArg: yyDollar[4].node,
+			}
+		}
+	case 1526:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4275
+		{
+			yyVAL.node = &Select{
+				Op:   SetOpExcept,
+				LArg: yyDollar[1].node,
+				RArg: yyDollar[4].node,
+			}
+		}
+	case 1527:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4289
+		{
+		}
+	case 1528:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4290
+		{
+		}
+	case 1529:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4294
+		{
+		}
+	case 1530:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4295
+		{
+		}
+	case 1531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4299
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1532:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4300
+		{
+		}
+	case 1533:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4306
+		{
+		}
+	case 1534:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4309
+		{
+		}
+	case 1535:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4312
+		{
+		}
+	case 1536:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4315
+		{
+		}
+	case 1537:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4321
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1538:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4322
+		{
+		}
+	case 1539:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4327
+		{
+
+		}
+	case 1540:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4331
+		{
+			// XXXX: todo forbid
+
+		}
+	case 1541:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4343
+		{
+		}
+	case 1542:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:4346
+		{
+		}
+	case 1543:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4349
+		{
+		}
+	case 1544:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4352
+		{
+		}
+	case 1545:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4358
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1546:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4361
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1547:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4365
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1548:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4367
+		{
+		}
+	case 1549:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4372
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1550:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4392
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1551:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4394
+		{
+		}
+	case 1552:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4396
+		{
+		}
+	case 1553:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4400
+		{
+		}
+	case 1554:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4405
+		{
+		}
+	case 1555:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4406
+		{
+		}
+	case 1556:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4409
+		{
+		}
+	case 1557:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4410
+		{
+		}
+	case 1558:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4436
+		{
+
+		}
+	case 1559:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4440
+		{
+		}
+	case 1560:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4445
+		{
+		}
+	case 1561:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4446
+		{
+		}
+	case 1562:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4450
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1563:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4451
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1564:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4452
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1565:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4453
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1566:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4454
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1567:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4459
+		{
+		}
+	case 1568:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4471
+		{
+		}
+	case 1569:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4477
+		{
+		}
+	case 1570:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4483
+		{
+		}
+	case 1571:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4488
+		{
+		}
+	case 1572:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4489
+		{
+		}
+	case 1573:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4493
+		{
+		}
+	case 1574:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4494
+		{
+		}
+	case 1575:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4498
+		{
+		}
+	case 1576:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4499
+		{
+		}
+	case 1577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4503
+		{
+		}
+	case 1578:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4504
+		{
+		}
+	case 1579:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4509
+		{
+		}
+	case 1580:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4514
+		{
+		}
+	case 1581:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4515
+		{
+		}
+	case 1582:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4516
+		{
+		}
+	case 1583:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4517
+		{
+		}
+	case 1584:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4522
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1585:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4523
+		{
+		}
+	case 1586:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4533
+		{
+			yyVAL.node = &ValueClause{
+				Values:    yyDollar[3].nodeList,
+				Locations: []Location{{Start: yyDollar[2].loc, End: yyDollar[4].loc + 1}},
+			}
+		}
+	case 1587:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4540
+		{
+			vc := yyDollar[1].node.(*ValueClause)
+			vc.Rest = append(vc.Rest, yyDollar[4].nodeList)
+			vc.Locations = append(vc.Locations, Location{Start: yyDollar[3].loc, End: yyDollar[5].loc + 1})
+			yyVAL.node = vc
+		}
+	case 1588:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4559
+		{
+
+		}
+	case 1589:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4564
+		{
+		}
+	case 1590:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4565
+		{
+		}
+	case 1591:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4568
+		{
+		}
+	case 1592:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4569
+		{
+		}
+	case 1593:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4570
+		{
+		}
+	case 1594:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4571
+		{
+		}
+	case 1595:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4572
+		{
+		}
+	case 1596:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4573
+		{
+		}
+	case 1597:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4574
+		{
+		}
+	case 1598:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4575
+		{
+		}
+	case 1599:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4578
+		{
+		}
+	case 1600:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4579
+		{
+		}
+	case 1601:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4583
+		{
+		}
+	case 1602:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4584
+		{
+		}
+	case 1603:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4585
+		{
+		}
+	case 1604:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4634
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1605:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4635
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1606:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4639
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1607:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4640
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1608:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4655
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1609:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4657
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1610:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4661
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1611:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4665
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1612:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4669
+		{
+			yyDollar[2].node.(*Select).WithClause = yyDollar[1].cteList
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1613:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4674
+		{
+			yyDollar[2].node.(*Select).WithClause = yyDollar[1].cteList
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1614:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4679
+		{
+			yyDollar[2].node.(*Select).WithClause = yyDollar[1].cteList
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1615:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4684
+		{
+			yyDollar[2].node.(*Select).WithClause = yyDollar[1].cteList
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1616:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4700
+		{
+			yyVAL.cteList = yyDollar[2].cteList
+		}
+	case 1617:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4704
+		{
+			yyVAL.cteList = yyDollar[2].cteList
+		}
+	case 1618:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4708
+		{
+			yyVAL.cteList = yyDollar[3].cteList
+		}
+	case 1619:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4714
+		{
+			yyVAL.cteList = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 1620:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4715
+		{
+			yyVAL.cteList = append(yyDollar[1].cteList, yyDollar[3].cte)
+		}
+	case 1621:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lyx/gram.y:4719
+		{
+			yyVAL.cte = &CommonTableExpr{
+				Name:     yyDollar[1].str,
+				SubQuery: yyDollar[6].node,
+			}
+		}
+	case 1622:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4728
+		{
+		}
+	case 1623:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4729
+		{
+		}
+	case 1624:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4730
+		{
+		}
+	case 1625:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line lyx/gram.y:4735
+		{
+
+		}
+	case 1626:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line lyx/gram.y:4739
+		{
+
+		}
+	case 1627:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4743
+		{
+			yyVAL.node = nil
+		}
+	case 1628:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line lyx/gram.y:4750
+		{
+
+		}
+	case 1629:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lyx/gram.y:4754
+		{
+
+		}
+	case 1630:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4758
+		{
+			yyVAL.node = nil
+		}
+	case 1631:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4764
+		{
+			yyVAL.cteList = yyDollar[1].cteList
+		}
+	case 1632:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4765
+		{
+			yyVAL.cteList = nil
+		}
+	case 1633:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4770
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1634:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4771
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1635:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4776
+		{
+			yyVAL.strlist = []string{yyDollar[1].str}
+		}
+	case 1636:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4777
+		{
+			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
+		}
+	case 1637:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4782
+		{
+		}
+	case 1638:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4782
+		{
+		}
+	case 1639:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4785
+		{
+			yyVAL.strlist = yyDollar[2].strlist
+		}
+	case 1640:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4791
+		{
+			yyVAL.strlist = yyDollar[2].strlist
+		}
+	case 1641:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4792
+		{
+			yyVAL.strlist = nil
+		}
+	case 1642:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4797
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1643:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4803
+		{
+			yyVAL.strlist = []string{yyDollar[1].str}
+		}
+	case 1644:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4804
+		{
+			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
+		}
+	case 1645:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4809
+		{
+			yyVAL.strlist = nil
+		}
+	case 1646:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4812
+		{
+			yyVAL.strlist = yyDollar[1].strlist
+		}
+	case 1647:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4820
+		{
+			yyVAL.nodeList = []Node{yyDollar[1].node}
+		}
+	case 1648:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4822
+		{
+			yyVAL.nodeList = append(yyDollar[1].nodeList, yyDollar[3].node)
+		}
+	case 1649:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4827
+		{
+			yyVAL.nodeList = yyDollar[2].nodeList
+		}
+	case 1650:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4833
+		{
+		}
+	case 1651:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4834
+		{
+		}
+	case 1652:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line lyx/gram.y:4839
+		{
+
+		}
+	case 1653:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4844
+		{
+
+		}
+	case 1654:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4848
+		{
+		}
+	case 1655:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4854
+		{
+		}
+	case 1656:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4858
+		{
+		}
+	case 1657:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4861
+		{
+		}
+	case 1658:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4866
+		{
+		}
+	case 1659:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4867
+		{
+		}
+	case 1660:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4870
+		{
+		}
+	case 1661:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4871
+		{
+		}
+	case 1662:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4874
+		{
+		}
+	case 1663:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4875
+		{
+		}
+	case 1664:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4880
+		{
+		}
+	case 1665:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4883
+		{
+		}
+	case 1666:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4893
+		{
+		}
+	case 1667:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4896
+		{
+		}
+	case 1668:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:4899
+		{
+		}
+	case 1669:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lyx/gram.y:4914
+		{
+
+			yyVAL.node = &PrepareStmt{
+				Name:      yyDollar[2].str,
+				Statement: yyDollar[5].node,
+			}
+		}
+	case 1670:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4923
+		{
+		}
+	case 1671:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4924
+		{
+		}
+	case 1672:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4928
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1673:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4929
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1674:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4930
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1675:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:4931
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1676:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4942
+		{
+			yyVAL.node = &ExecuteStmt{
+				Name: yyDollar[2].str,
+			}
+		}
+	case 1677:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lyx/gram.y:4949
+		{
+		}
+	case 1678:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line lyx/gram.y:4953
+		{
+
+		}
+	case 1679:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4958
+		{
+		}
+	case 1680:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4959
+		{
+		}
+	case 1681:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4970
+		{
+			yyVAL.node = &DeallocateStmt{
+				yyDollar[2].str,
+			}
+		}
+	case 1682:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4976
+		{
+			yyVAL.node = &DeallocateStmt{
+				yyDollar[3].str,
+			}
+		}
+	case 1683:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4982
+		{
+			yyVAL.node = &DeallocateStmt{}
+		}
+	case 1684:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:4987
+		{
+			yyVAL.node = &DeallocateStmt{}
+		}
+	case 1685:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:4996
+		{
+		}
+	case 1686:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:4997
+		{
+		}
+	case 1687:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line lyx/gram.y:5004
+		{
+			yyVAL.node = &Insert{
+				TableRef:  yyDollar[3].tableref,
+				Columns:   yyDollar[4].strlist,
+				SubSelect: yyDollar[5].node,
+			}
+		}
+	case 1688:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5013
+		{
+		}
+	case 1689:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5014
+		{
+		}
+	case 1690:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5020
+		{
+			yyVAL.strlist = []string{yyDollar[1].str}
+		}
+	case 1691:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5021
+		{
+			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
+		}
+	case 1692:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5027
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1693:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5034
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1694:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5039
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1695:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line lyx/gram.y:5049
+		{
+			yyVAL.node = &Update{
+				TableRef: yyDollar[3].tableref,
+				Where:    yyDollar[7].node,
+			}
+		}
+	case 1696:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5060
+		{
+			yyVAL.strlist = []string{yyDollar[1].str}
+		}
+	case 1697:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5061
+		{
+			yyVAL.strlist = append(yyDollar[3].strlist, yyDollar[1].str)
+		}
+	case 1698:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5066
+		{
+		}
+	case 1699:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5067
+		{
+		}
+	case 1700:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line lyx/gram.y:5071
+		{
+			yyVAL.node = &Delete{
+				TableRef: yyDollar[4].tableref,
+				Where:    yyDollar[6].node,
+			}
+		}
+	case 1701:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5080
+		{
+			yyVAL.bool = true
+		}
+	case 1702:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5083
+		{
+			yyVAL.bool = false
+		}
+	case 1703:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5089
+		{
+			yyVAL.copyOptList = []*CopyOption{yyDollar[1].copyOpt}
+		}
+	case 1704:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5093
+		{
+			yyVAL.copyOptList = append(yyDollar[1].copyOptList, yyDollar[3].copyOpt)
+		}
+	case 1705:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5100
+		{
+			yyVAL.copyOpt = &CopyOption{
+				Name:  strings.ToLower(yyDollar[1].str),
+				Value: yyDollar[2].str,
+			}
+		}
+	case 1706:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5110
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1707:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5112
+		{
+			yyVAL.str = "*"
+		}
+	case 1708:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5113
+		{
+			yyVAL.str = ""
+		}
+	case 1709:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5114
+		{
+			yyVAL.str = ""
+		}
+	case 1710:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5119
+		{
+			yyVAL.strlist = []string{yyDollar[1].str}
+		}
+	case 1711:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5123
+		{
+			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
+		}
+	case 1712:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5130
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1713:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5133
+		{
+			yyVAL.copyOptList = yyDollar[1].copyOptList
+		}
+	case 1714:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5134
+		{
+			yyVAL.copyOptList = yyDollar[2].copyOptList
+		}
+	case 1715:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5139
+		{
+			yyVAL.copyOptList = append(yyDollar[1].copyOptList, yyDollar[2].copyOpt)
+		}
+	case 1716:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5140
+		{
+			yyVAL.copyOptList = nil
+		}
+	case 1719:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5150
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "format", Value: "binary"}
+		}
+	case 1720:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5154
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "freeze", Value: "true"}
+		}
+	case 1721:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5158
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "delimiter", Value: yyDollar[3].str}
+		}
+	case 1722:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5162
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "null", Value: yyDollar[3].str}
+		}
+	case 1723:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5166
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "format", Value: "csv"}
+		}
+	case 1724:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5170
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "header", Value: "true"}
+		}
+	case 1725:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5174
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "quote", Value: yyDollar[3].str}
+		}
+	case 1726:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5178
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "escape", Value: yyDollar[3].str}
+		}
+	case 1727:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5182
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "force_quote"}
+		}
+	case 1728:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5186
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "force_quote", Value: "*"}
+		}
+	case 1729:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:5190
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "force_not_null"}
+		}
+	case 1730:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5194
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "force_null"}
+		}
+	case 1731:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5198
+		{
+			yyVAL.copyOpt = &CopyOption{Name: "encoding", Value: yyDollar[2].str}
+		}
+	case 1732:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5205
+		{
+			yyVAL.bool = true
+		}
+	case 1733:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5206
+		{
+			yyVAL.bool = false
+		}
+	case 1734:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5210
+		{
+			yyVAL.bool = true
+		}
+	case 1735:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5211
+		{
+			yyVAL.bool = false
+		}
+	case 1736:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5215
+		{
+		}
+	case 1737:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5216
+		{
+		}
+	case 1738:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5217
+		{
+		}
+	case 1739:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5232
+		{
+			yyVAL.node = yyDollar[2].node
+		}
+	case 1740:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:5236
+		{
+			yyVAL.node = yyDollar[4].node
+		}
+	case 1741:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5240
+		{
+			yyVAL.node = yyDollar[3].node
+		}
+	case 1742:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5250
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1743:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5251
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1744:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5252
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1745:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5253
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1746:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5255
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1747:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5259
+		{
+			yyVAL.node = yyDollar[1].node
+		}
+	case 1748:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line lyx/gram.y:5271
+		{
+
+			yyVAL.node = yyDollar[7].node
+		}
+	case 1749:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5277
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1750:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5280
+		{
+		}
+	case 1751:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5281
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1752:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5282
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1753:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5283
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1754:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5284
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1755:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5285
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1756:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5288
+		{
+		}
+	case 1757:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5289
+		{
+		}
+	case 1758:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5290
+		{
+		}
+	case 1759:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5297
+		{
+		}
+	case 1760:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5298
+		{
+		}
+	case 1761:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5299
+		{
+		}
+	case 1762:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5300
+		{
+		}
+	case 1763:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5303
+		{
+		}
+	case 1764:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:5304
+		{
+		}
+	case 1765:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:5305
+		{
+		}
+	case 1766:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5306
+		{
+		}
+	case 1767:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5309
+		{
+		}
+	case 1768:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5310
+		{
+		}
+	case 1769:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lyx/gram.y:5313
+		{
+		}
+	case 1770:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5314
+		{
+		}
+	case 1771:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5317
+		{
+		}
+	case 1772:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5323
+		{
+			yyVAL.bool = false
+		}
+	case 1773:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5324
+		{
+			yyVAL.bool = true
+		}
+	case 1774:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5325
+		{
+			yyVAL.bool = true
+		}
+	case 1775:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lyx/gram.y:5331
+		{
+			yyVAL.copyOptList = []*CopyOption{{Name: "delimiter", Value: yyDollar[2].str}}
+		}
+	case 1776:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5335
+		{
+			yyVAL.copyOptList = []*CopyOption{{Name: "delimiter", Value: yyDollar[3].str}}
+		}
+	case 1777:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5338
+		{
+			yyVAL.copyOptList = nil
+		}
+	case 1778:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line lyx/gram.y:5346
+		{
+			var opts []*CopyOption
+			if yyDollar[2].bool {
+				opts = append(opts, &CopyOption{Name: "format", Value: "binary"})
+			}
+			opts = append(opts, yyDollar[8].copyOptList...)
+			yyVAL.node = &Copy{
+				TableRef: yyDollar[3].from,
+				Where:    yyDollar[11].node,
+				IsFrom:   yyDollar[5].bool,
+				Columns:  yyDollar[4].strlist,
+				Stdio:    !yyDollar[6].bool && yyDollar[7].bool,
+				Options:  append(opts, yyDollar[10].copyOptList...),
+			}
+		}
+	case 1779:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lyx/gram.y:5362
+		{
+			yyVAL.node = &Copy{
+				IsFrom:  false,
+				SubStmt: yyDollar[3].node,
+				Stdio:   !yyDollar[6].bool && yyDollar[7].bool,
+				Options: yyDollar[9].copyOptList,
+			}
+		}
+	case 1780:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5375
+		{
+		}
+	case 1781:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5376
+		{
+		}
+	case 1782:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5381
+		{
+		}
+	case 1783:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5382
+		{
+		}
+	case 1784:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5386
+		{
+		}
+	case 1785:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5387
+		{
+		}
+	case 1786:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5390
+		{
+		}
+	case 1787:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5391
+		{
+		}
+	case 1788:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5394
+		{
+		}
+	case 1789:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5395
+		{
+		}
+	case 1790:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5401
+		{
+		}
+	case 1791:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5403
+		{
+		}
+	case 1792:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lyx/gram.y:5408
+		{
+			yyVAL.strlist = yyDollar[2].strlist
+		}
+	case 1793:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lyx/gram.y:5409
+		{
+			yyVAL.strlist = nil
+		}
+	case 1794:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5414
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1795:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5417
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1796:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5420
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1797:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5422
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1798:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5424
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1799:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lyx/gram.y:5426
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	}
+	goto yystack /* stack new state and value */
+}
@@ -0,0 +1,20978 @@
+//line lyx/lexer.rl:1
+package lyx
+
+import (
+	"strconv"
+)
+
+//line lyx/lexer.go:11
+const lexer_start int = 9
+const lexer_first_final int = 9
+const lexer_error int = 0
+
+const lexer_en_main int = 9
+
+//line lyx/lexer.rl:13
+
+type Lexer struct {
+	data        []byte
+	p, pe, cs   int
+	ts, te, act int
+
+	result []string
+}
+
+func NewLexer(data []byte) *Lexer {
+	lex := &Lexer{
+		data: data,
+		pe:   len(data),
+	}
+
+//line lyx/lexer.go:36
+	{
+		lex.cs = lexer_start
+		lex.ts = 0
+		lex.te = 0
+		lex.act = 0
+	}
+
+//line lyx/lexer.rl:29
+	return lex
+}
+
+func ResetLexer(lex *Lexer, data []byte) {
+	lex.pe = len(data)
+	lex.data = data
+
+//line lyx/lexer.go:52
+	{
+		lex.cs = lexer_start
+		lex.ts = 0
+		lex.te = 0
+		lex.act = 0
+	}
+
+//line lyx/lexer.rl:36
+}
+
+func (l *Lexer) Error(msg string) {
+	println(msg)
+}
+
+func (lex *Lexer) Lex(lval *yySymType) int {
+	eof := lex.pe
+	var tok int
+
+//line lyx/lexer.go:73
+	{
+		if (lex.p) == (lex.pe) {
+			goto _test_eof
+		}
+		switch lex.cs {
+		case 9:
+			goto st_case_9
+		case 0:
+			goto st_case_0
+		case 10:
+			goto st_case_10
+		case 11:
+			goto st_case_11
+		case 12:
+			goto st_case_12
+		case 1:
+			goto st_case_1
+		case 2:
+			goto st_case_2
+		case 3:
+			goto st_case_3
+		case 13:
+			goto st_case_13
+		case 4:
+			goto st_case_4
+		case 14:
+			goto st_case_14
+		case 15:
+			goto st_case_15
+		case 16:
+			goto st_case_16
+		case 5:
+			goto st_case_5
+		case 17:
+			goto st_case_17
+		case 18:
+			goto st_case_18
+		case 6:
+			goto st_case_6
+		case 7:
+			goto st_case_7
+		case 8:
+			goto st_case_8
+		case 19:
+			goto st_case_19
+		case 20:
+			goto st_case_20
+		case 21:
+			goto st_case_21
+		case 22:
+			goto st_case_22
+		case 23:
+			goto st_case_23
+		case 24:
+			goto st_case_24
+		case 25:
+			goto st_case_25
+		case 26:
+			goto st_case_26
+		case 27:
+			goto st_case_27
+		case 28:
+			goto st_case_28
+		case 29:
+			goto st_case_29
+		case 30:
+			goto st_case_30
+		case 31:
+			goto st_case_31
+		case 32:
+			goto st_case_32
+		case 33:
+			goto st_case_33
+		case 34:
+			goto st_case_34
+		case 35:
+			goto st_case_35
+		case 36:
+			goto st_case_36
+		case 37:
+			goto st_case_37
+		case 38:
+			goto st_case_38
+		case 39:
+			goto st_case_39
+		case 40:
+			goto st_case_40
+		case 41:
+			goto st_case_41
+		case 42:
+			goto st_case_42
+		case 43:
+			goto st_case_43
+		case 44:
+			goto st_case_44
+		case 45:
+			goto st_case_45
+		case 46:
+			goto st_case_46
+		case 47:
+			goto st_case_47
+		case 48:
+			goto st_case_48
+		case 49:
+			goto st_case_49
+		case 50:
+			goto st_case_50
+		case 51:
+			goto st_case_51
+		case 52:
+			goto st_case_52
+		case 53:
+			goto st_case_53
+		case 54:
+			goto st_case_54
+		case 55:
+			goto st_case_55
+		case 56:
+			goto st_case_56
+		case 57:
+			goto st_case_57
+		case 58:
+			goto st_case_58
+		case 59:
+			goto st_case_59
+		case 60:
+			goto st_case_60
+		case 61:
+			goto st_case_61
+		case 62:
+			goto st_case_62
+		case 63:
+			goto st_case_63
+		case 64:
+			goto st_case_64
+		case 65:
+			goto st_case_65
+		case 66:
+			goto st_case_66
+		case 67:
+			goto st_case_67
+		case 68:
+			goto st_case_68
+		case 69:
+			goto st_case_69
+		case 70:
+			goto st_case_70
+		case 71:
+			goto st_case_71
+		case 72:
+			goto st_case_72
+		case 73:
+			goto st_case_73
+		case 74:
+			goto st_case_74
+		case 75:
+			goto st_case_75
+		case 76:
+			goto st_case_76
+		case 77:
+			goto st_case_77
+		case 78:
+			goto st_case_78
+		case 79:
+			goto st_case_79
+		case 80:
+			goto st_case_80
+		case 81:
+			goto st_case_81
+		case 82:
+			goto st_case_82
+		case 83:
+			goto st_case_83
+		case 84:
+			goto st_case_84
+		case 85:
+			goto st_case_85
+		case 86:
+			goto st_case_86
+		case 87:
+			goto st_case_87
+		case 88:
+			goto st_case_88
+		case 89:
+			goto st_case_89
+		case 90:
+			goto st_case_90
+		case 91:
+			goto st_case_91
+		case 92:
+			goto st_case_92
+		case 93:
+			goto st_case_93
+		case 94:
+			goto st_case_94
+		case 95:
+			goto st_case_95
+		case 96:
+			goto st_case_96
+		case 97:
+			goto st_case_97
+		case 98:
+			goto st_case_98
+		case 99:
+			goto st_case_99
+		case 100:
+			goto st_case_100
+		case 101:
+			goto st_case_101
+		case 102:
+			goto st_case_102
+		case 103:
+			goto st_case_103
+		case 104:
+			goto st_case_104
+		case 105:
+			goto st_case_105
+		case 106:
+			goto st_case_106
+		case 107:
+			goto st_case_107
+		case 108:
+			goto st_case_108
+		case 109:
+			goto st_case_109
+		case 110:
+			goto st_case_110
+		case 111:
+			goto st_case_111
+		case 112:
+			goto st_case_112
+		case 113:
+			goto st_case_113
+		case 114:
+			goto st_case_114
+		case 115:
+			goto st_case_115
+		case 116:
+			goto st_case_116
+		case 117:
+			goto st_case_117
+		case 118:
+			goto st_case_118
+		case 119:
+			goto st_case_119
+		case 120:
+			goto st_case_120
+		case 121:
+			goto st_case_121
+		case 122:
+			goto st_case_122
+		case 123:
+			goto st_case_123
+		case 124:
+			goto st_case_124
+		case 125:
+			goto st_case_125
+		case 126:
+			goto st_case_126
+		case 127:
+			goto st_case_127
+		case 128:
+			goto st_case_128
+		case 129:
+			goto st_case_129
+		case 130:
+			goto st_case_130
+		case 131:
+			goto st_case_131
+		case 132:
+			goto st_case_132
+		case 133:
+			goto st_case_133
+		case 134:
+			goto st_case_134
+		case 135:
+			goto st_case_135
+		case 136:
+			goto st_case_136
+		case 137:
+			goto st_case_137
+		case 138:
+			goto st_case_138
+		case 139:
+			goto st_case_139
+		case 140:
+			goto st_case_140
+		case 141:
+			goto st_case_141
+		case 142:
+			goto st_case_142
+		case 143:
+			goto st_case_143
+		case 144:
+			goto st_case_144
+		case 145:
+			goto st_case_145
+		case 146:
+			goto st_case_146
+		case 147:
+			goto st_case_147
+		case 148:
+			goto st_case_148
+		case 149:
+			goto st_case_149
+		case 150:
+			goto st_case_150
+		case 151:
+			goto st_case_151
+		case 152:
+			goto st_case_152
+		case 153:
+			goto st_case_153
+		case 154:
+			goto st_case_154
+		case 155:
+			goto st_case_155
+		case 156:
+			goto st_case_156
+		case 157:
+			goto st_case_157
+		case 158:
+			goto st_case_158
+		case 159:
+			goto st_case_159
+		case 160:
+			goto st_case_160
+		case 161:
+			goto st_case_161
+		case 162:
+			goto st_case_162
+		case 163:
+			goto st_case_163
+		case 164:
+			goto st_case_164
+		case 165:
+			goto st_case_165
+		case 166:
+			goto st_case_166
+		case 167:
+			goto st_case_167
+		case 168:
+			goto st_case_168
+		case 169:
+			goto st_case_169
+		case 170:
+			goto st_case_170
+		case 171:
+			goto st_case_171
+		case 172:
+			goto st_case_172
+		case 173:
+			goto st_case_173
+		case 174:
+			goto st_case_174
+		case 175:
+			goto st_case_175
+		case 176:
+			goto st_case_176
+		case 177:
+			goto st_case_177
+		case 178:
+			goto st_case_178
+		case 179:
+			goto st_case_179
+		case 180:
+			goto st_case_180
+		case 181:
+			goto st_case_181
+		case 182:
+			goto st_case_182
+		case 183:
+			goto st_case_183
+		case 184:
+			goto st_case_184
+		case 185:
+			goto st_case_185
+		case 186:
+			goto st_case_186
+		case 187:
+			goto st_case_187
+		case 188:
+			goto st_case_188
+		case 189:
+			goto st_case_189
+		case 190:
+			goto st_case_190
+		case 191:
+			goto st_case_191
+		case 192:
+			goto st_case_192
+		case 193:
+			goto st_case_193
+		case 194:
+			goto st_case_194
+		case 195:
+			goto st_case_195
+		case 196:
+			goto st_case_196
+		case 197:
+			goto st_case_197
+		case 198:
+			goto st_case_198
+		case 199:
+			goto st_case_199
+		case 200:
+			goto st_case_200
+		case 201:
+			goto st_case_201
+		case 202:
+			goto st_case_202
+		case 203:
+			goto st_case_203
+		case 204:
+			goto st_case_204
+		case 205:
+			goto st_case_205
+		case 206:
+			goto st_case_206
+		case 207:
+			goto st_case_207
+		case 208:
+			goto st_case_208
+		case 209:
+			goto st_case_209
+		case 210:
+			goto st_case_210
+		case 211:
+			goto st_case_211
+		case 212:
+			goto st_case_212
+		case 213:
+			goto st_case_213
+		case 214:
+			goto st_case_214
+		case 215:
+			goto st_case_215
+		case 216:
+			goto st_case_216
+		case 217:
+			goto st_case_217
+		case 218:
+			goto st_case_218
+		case 219:
+			goto st_case_219
+		case 220:
+			goto st_case_220
+		case 221:
+			goto st_case_221
+		case 222:
+			goto st_case_222
+		case 223:
+			goto st_case_223
+		case 224:
+			goto st_case_224
+		case 225:
+			goto st_case_225
+		case 226:
+			goto st_case_226
+		case 227:
+			goto st_case_227
+		case 228:
+			goto st_case_228
+		case 229:
+			goto st_case_229
+		case 230:
+			goto st_case_230
+		case 231:
+			goto st_case_231
+		case 232:
+			goto st_case_232
+		case 233:
+			goto st_case_233
+		case 234:
+			goto st_case_234
+		case 235:
+			goto st_case_235
+		case 236:
+			goto st_case_236
+		case 237:
+			goto st_case_237
+		case 238:
+			goto st_case_238
+		case 239:
+			goto st_case_239
+		case 240:
+			goto st_case_240
+		case 241:
+			goto st_case_241
+		case 242:
+			goto st_case_242
+		case 243:
+			goto st_case_243
+		case 244:
+			goto st_case_244
+		case 245:
+			goto st_case_245
+		case 246:
+			goto st_case_246
+		case 247:
+			goto st_case_247
+		case 248:
+			goto st_case_248
+		case 249:
+			goto st_case_249
+		case 250:
+			goto st_case_250
+		case 251:
+			goto st_case_251
+		case 252:
+			goto st_case_252
+		case 253:
+			goto st_case_253
+		case 254:
+			goto st_case_254
+		case 255:
+			goto st_case_255
+		case 256:
+			goto st_case_256
+		case 257:
+			goto st_case_257
+		case 258:
+			goto st_case_258
+		case 259:
+			goto st_case_259
+		case 260:
+			goto st_case_260
+		case 261:
+			goto st_case_261
+		case 262:
+			goto st_case_262
+		case 263:
+			goto st_case_263
+		case 264:
+			goto st_case_264
+		case 265:
+			goto st_case_265
+		case 266:
+			goto st_case_266
+		case 267:
+			goto st_case_267
+		case 268:
+			goto st_case_268
+		case 269:
+			goto st_case_269
+		case 270:
+			goto st_case_270
+		case 271:
+			goto st_case_271
+		case 272:
+			goto st_case_272
+		case 273:
+			goto st_case_273
+		case 274:
+			goto st_case_274
+		case 275:
+			goto st_case_275
+		case 276:
+			goto st_case_276
+		case 277:
+			goto st_case_277
+		case 278:
+			goto st_case_278
+		case 279:
+			goto st_case_279
+		case 280:
+			goto st_case_280
+		case 281:
+			goto st_case_281
+		case 282:
+			goto st_case_282
+		case 283:
+			goto st_case_283
+		case 284:
+			goto st_case_284
+		case 285:
+			goto st_case_285
+		case 286:
+			goto st_case_286
+		case 287:
+			goto st_case_287
+		case 288:
+			goto st_case_288
+		case 289:
+			goto st_case_289
+		case 290:
+			goto st_case_290
+		case 291:
+			goto st_case_291
+		case 292:
+			goto st_case_292
+		case 293:
+			goto st_case_293
+		case 294:
+			goto st_case_294
+		case 295:
+			goto st_case_295
+		case 296:
+			goto st_case_296
+		case 297:
+			goto st_case_297
+		case 298:
+			goto st_case_298
+		case 299:
+			goto st_case_299
+		case 300:
+			goto st_case_300
+		case 301:
+			goto st_case_301
+		case 302:
+			goto st_case_302
+		case 303:
+			goto st_case_303
+		case 304:
+			goto st_case_304
+		case 305:
+			goto st_case_305
+		case 306:
+			goto st_case_306
+		case 307:
+			goto st_case_307
+		case 308:
+			goto st_case_308
+		case 309:
+			goto st_case_309
+		case 310:
+			goto st_case_310
+		case 311:
+			goto st_case_311
+		case 312:
+			goto st_case_312
+		case 313:
+			goto st_case_313
+		case 314:
+			goto st_case_314
+		case 315:
+			goto st_case_315
+		case 316:
+			goto st_case_316
+		case 317:
+			goto st_case_317
+		case 318:
+			goto st_case_318
+		case 319:
+			goto st_case_319
+		case 320:
+			goto st_case_320
+		case 321:
+			goto st_case_321
+		case 322:
+			goto st_case_322
+		case 323:
+			goto st_case_323
+		case 324:
+			goto st_case_324
+		case 325:
+			goto st_case_325
+		case 326:
+			goto st_case_326
+		case 327:
+			goto st_case_327
+		case 328:
+			goto st_case_328
+		case 329:
+			goto st_case_329
+		case 330:
+			goto st_case_330
+		case 331:
+			goto st_case_331
+		case 332:
+			goto st_case_332
+		case 333:
+			goto st_case_333
+		case 334:
+			goto st_case_334
+		case 335:
+			goto st_case_335
+		case 336:
+			goto st_case_336
+		case 337:
+			goto st_case_337
+		case 338:
+			goto st_case_338
+		case 339:
+			goto st_case_339
+		case 340:
+			goto st_case_340
+		case 341:
+			goto st_case_341
+		case 342:
+			goto st_case_342
+		case 343:
+			goto st_case_343
+		case 344:
+			goto st_case_344
+		case 345:
+			goto st_case_345
+		case 346:
+			goto st_case_346
+		case 347:
+			goto st_case_347
+		case 348:
+			goto st_case_348
+		case 349:
+			goto st_case_349
+		case 350:
+			goto st_case_350
+		case 351:
+			goto st_case_351
+		case 352:
+			goto st_case_352
+		case 353:
+			goto st_case_353
+		case 354:
+			goto st_case_354
+		case 355:
+			goto st_case_355
+		case 356:
+			goto st_case_356
+		case 357:
+			goto st_case_357
+		case 358:
+			goto st_case_358
+		case 359:
+			goto st_case_359
+		case 360:
+			goto st_case_360
+		case 361:
+			goto st_case_361
+		case 362:
+			goto st_case_362
+		case 363:
+			goto st_case_363
+		case 364:
+			goto st_case_364
+		case 365:
+			goto st_case_365
+		case 366:
+			goto st_case_366
+		case 367:
+			goto st_case_367
+		case 368:
+			goto st_case_368
+		case 369:
+			goto st_case_369
+		case 370:
+			goto st_case_370
+		case 371:
+			goto st_case_371
+		case 372:
+			goto st_case_372
+		case 373:
+			goto st_case_373
+		case 374:
+			goto st_case_374
+		case 375:
+			goto st_case_375
+		case 376:
+			goto st_case_376
+		case 377:
+			goto st_case_377
+		case 378:
+			goto st_case_378
+		case 379:
+			goto st_case_379
+		case 380:
+			goto st_case_380
+		case 381:
+			goto st_case_381
+		case 382:
+			goto st_case_382
+		case 383:
+			goto st_case_383
+		case 384:
+			goto st_case_384
+		case 385:
+			goto st_case_385
+		case 386:
+			goto st_case_386
+		case 387:
+			goto st_case_387
+		case 388:
+			goto st_case_388
+		case 389:
+			goto st_case_389
+		case 390:
+			goto st_case_390
+		case 391:
+			goto st_case_391
+		case 392:
+			goto st_case_392
+		case 393:
+			goto st_case_393
+		case 394:
+			goto st_case_394
+		case 395:
+			goto st_case_395
+		case 396:
+			goto st_case_396
+		case 397:
+			goto st_case_397
+		case 398:
+			goto st_case_398
+		case 399:
+			goto st_case_399
+		case 400:
+			goto st_case_400
+		case 401:
+			goto st_case_401
+		case 402:
+			goto st_case_402
+		case 403:
+			goto st_case_403
+		case 404:
+			goto st_case_404
+		case 405:
+			goto st_case_405
+		case 406:
+			goto st_case_406
+		case 407:
+			goto st_case_407
+		case 408:
+			goto st_case_408
+		case 409:
+			goto st_case_409
+		case 410:
+			goto st_case_410
+		case 411:
+			goto st_case_411
+		case 412:
+			goto st_case_412
+		case 413:
+			goto st_case_413
+		case 414:
+			goto st_case_414
+		case 415:
+			goto st_case_415
+		case 416:
+			goto st_case_416
+		case 417:
+			goto st_case_417
+		case 418:
+			goto st_case_418
+		case 419:
+			goto st_case_419
+		case 420:
+			goto st_case_420
+		case 421:
+			goto st_case_421
+		case 422:
+			goto st_case_422
+		case 423:
+			goto st_case_423
+		case 424:
+			goto st_case_424
+		case 425:
+			goto st_case_425
+		case 426:
+			goto st_case_426
+		case 427:
+			goto st_case_427
+		case 428:
+			goto st_case_428
+		case 429:
+			goto st_case_429
+		case 430:
+			goto st_case_430
+		case 431:
+			goto st_case_431
+		case 432:
+			goto st_case_432
+		case 433:
+			goto st_case_433
+		case 434:
+			goto st_case_434
+		case 435:
+			goto st_case_435
+		case 436:
+			goto st_case_436
+		case 437:
+			goto st_case_437
+		case 438:
+			goto st_case_438
+		case 439:
+			goto st_case_439
+		case 440:
+			goto st_case_440
+		case 441:
+			goto st_case_441
+		case 442:
+			goto st_case_442
+		case 443:
+			goto st_case_443
+		case 444:
+			goto st_case_444
+		case 445:
+			goto st_case_445
+		case 446:
+			goto st_case_446
+		case 447:
+			goto st_case_447
+		case 448:
+			goto st_case_448
+		case 449:
+			goto st_case_449
+		case 450:
+			goto st_case_450
+		case 451:
+			goto st_case_451
+		case 452:
+			goto st_case_452
+		case 453:
+			goto st_case_453
+		case 454:
+			goto st_case_454
+		case 455:
+			goto st_case_455
+		case 456:
+			goto st_case_456
+		case 457:
+			goto st_case_457
+		case 458:
+			goto st_case_458
+		case 459:
+			goto st_case_459
+		case 460:
+			goto st_case_460
+		case 461:
+			goto st_case_461
+		case 462:
+			goto st_case_462
+		case 463:
+			goto st_case_463
+		case 464:
+			goto st_case_464
+		case 465:
+			goto st_case_465
+		case 466:
+			goto st_case_466
+		case 467:
+			goto st_case_467
+		case 468:
+			goto st_case_468
+		case 469:
+			goto st_case_469
+		case 470:
+			goto st_case_470
+		case 471:
+			goto st_case_471
+		case 472:
+			goto st_case_472
+		case 473:
+			goto st_case_473
+		case 474:
+			goto st_case_474
+		case 475:
+			goto st_case_475
+		case 476:
+			goto st_case_476
+		case 477:
+			goto st_case_477
+		case 478:
+			goto st_case_478
+		case 479:
+			goto st_case_479
+		case 480:
+			goto st_case_480
+		case 481:
+			goto st_case_481
+		case 482:
+			goto st_case_482
+		case 483:
+			goto st_case_483
+		case 484:
+			goto st_case_484
+		case 485:
+			goto st_case_485
+		case 486:
+			goto st_case_486
+		case 487:
+			goto st_case_487
+		case 488:
+			goto st_case_488
+		case 489:
+			goto st_case_489
+		case 490:
+			goto st_case_490
+		case 491:
+			goto st_case_491
+		case 492:
+			goto st_case_492
+		case 493:
+			goto st_case_493
+		case 494:
+			goto st_case_494
+		case 495:
+			goto st_case_495
+		case 496:
+			goto st_case_496
+		}
+		goto st_out
+	tr2:
+//line lyx/lexer.rl:299
+		lex.te = (lex.p) + 1
+		{
+			lval.str = string(lex.data[lex.ts+1 : lex.te-1])
+			tok = IDENT
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr5:
+//line lyx/lexer.rl:301
+		lex.te = (lex.p) + 1
+		{
+			lval.str = string(lex.data[lex.ts+1 : lex.te-1])
+			tok = SCONST
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr6:
+//line lyx/lexer.rl:315
+		(lex.p) = (lex.te) - 1
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TMINUS
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr9:
+//line NONE:1
+		switch lex.act {
+		case 0:
+			{
+				{
+					goto st0
+				}
+			}
+		case 2:
+			{
+				(lex.p) = (lex.te) - 1
+				/* nothing */
+			}
+		case 6:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SCONST
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 8:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SETOF
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 10:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = INTEGER
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 11:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SMALLINT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 12:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = BIGINT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 13:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = REAL
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 14:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = FLOAT_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 15:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DOUBLE_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 16:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DECIMAL_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 18:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = NUMERIC
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 19:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = BOOLEAN_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 20:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = BIT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 21:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = INTERVAL
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 22:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = YEAR_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 23:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = MONTH_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 24:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DAY_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 25:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = HOUR_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 26:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = MINUTE_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 27:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SECOND_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 30:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = VARCHAR
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 31:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = NATIONAL
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 32:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = NCHAR
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 33:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = WITHOUT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 34:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TIME
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 35:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ZONE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 36:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = IF_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 37:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = PREPARE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 38:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DEALLOCATE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 39:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = EXECUTE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 40:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SELECT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 41:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = INSERT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 42:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = INTO
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 43:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = VALUES
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 44:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = UPDATE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 45:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DELETE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 46:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = CREATE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 47:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TRUNCATE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 48:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TABLE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 49:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DATABASE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 50:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ROLE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 51:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = PRIMARY
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 52:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = FOREIGN
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 53:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = REFERENCES
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 54:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = KEY
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 56:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SHOW
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 57:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = FROM
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 58:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = WHERE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 59:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ORDER
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 60:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = GROUP
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 61:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = BY
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 62:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = HAVING
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 64:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = AND
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 66:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = EXPLAIN
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 67:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = RETURNING
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 68:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DEFAULT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 69:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = COPY
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 70:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TO
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 71:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = STDOUT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 72:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = LIMIT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 73:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DISTINCT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 75:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ISNULL
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 77:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = NULLS_LA
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 79:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = NOTNULL
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 80:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = LATERAL_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 81:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ORDINALITY
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 83:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TRUE_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 84:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = FALSE_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 85:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = FIRST_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 86:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = LAST_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 87:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ASC
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 88:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DESC
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 89:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = CHARACTERISTICS
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 90:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SESSION
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 91:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ISOLATION
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 92:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = LEVEL
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 93:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = COMMITTED
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 94:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ARRAY
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 95:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ROW
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 96:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = EXISTS
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 97:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = JOIN
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 98:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = CROSS
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 99:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = LEFT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 100:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = RIGHT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 101:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = FULL
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 102:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = OUTER_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 103:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = INNER_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 107:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = LOCKED
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 108:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SKIP
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 109:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = RECURSIVE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 110:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SEARCH
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 111:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = CYCLE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 112:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = BREADTH
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 113:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DEPTH
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 114:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SHARE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 115:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = VACUUM
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 116:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = CLUSTER
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 117:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ANALYZE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 118:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ALTER
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 119:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = INDEX
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 120:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = BINARY
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 121:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DELIMITERS
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 123:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = CSV
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 124:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = HEADER_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 125:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = QUOTE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 126:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ESCAPE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 127:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ENCODING
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 128:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = PROGRAM
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 129:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = STDIN
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 130:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ASYMMETRIC
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 131:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = BETWEEN
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 132:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DROP
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 133:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = BEGIN
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 134:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TRANSACTION
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 135:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = READ
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 136:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ONLY
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 137:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = WRITE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 138:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = DEFERRABLE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 141:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = UNCOMMITTED
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 143:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = REPEATABLE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 144:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = SERIALIZABLE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 145:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = START
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 146:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ABORT_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 147:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = END_P
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 148:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ROLLBACK
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 150:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = CASE
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 151:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = WHEN
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 152:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = THEN
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 154:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = CONFLICT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 156:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = UNION
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 157:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = EXCEPT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 158:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = INTERSECT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 159:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = ALL
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 161:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = identToken(lval.str)
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 168:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TDOT
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 171:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TPLUS
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 173:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TMUL
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 174:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TMOD
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 175:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TPOW
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 178:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TEQ
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 179:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TNOT_EQUALS
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 180:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TLESS_EQUALS
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 181:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TGREATER_EQUALS
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 182:
+			{
+				(lex.p) = (lex.te) - 1
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = TNOT_EQUALS
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		case 183:
+			{
+				(lex.p) = (lex.te) - 1
+
+				lval.str = string(lex.data[lex.ts:lex.te])
+				tok = int(OP)
+				{
+					(lex.p)++
+					lex.cs = 9
+					goto _out
+				}
+			}
+		}
+
+		goto st9
+	tr18:
+//line lyx/lexer.rl:307
+		lex.te = (lex.p) + 1
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TOPENBR
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr19:
+//line lyx/lexer.rl:308
+		lex.te = (lex.p) + 1
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TCLOSEBR
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr22:
+//line lyx/lexer.rl:306
+		lex.te = (lex.p) + 1
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TCOMMA
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr29:
+//line lyx/lexer.rl:312
+		lex.te = (lex.p) + 1
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TSEMICOLON
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr59:
+//line lyx/lexer.rl:309
+		lex.te = (lex.p) + 1
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TSQOPENBR
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr60:
+//line lyx/lexer.rl:310
+		lex.te = (lex.p) + 1
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TSQCLOSEBR
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr62:
+//line lyx/lexer.rl:91
+		lex.te = (lex.p)
+		(lex.p)--
+		{ /* do nothing */
+		}
+		goto st9
+	tr63:
+//line lyx/lexer.rl:330
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = int(OP)
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr65:
+//line lyx/lexer.rl:96
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			i, _ := strconv.Atoi(string(lex.data[lex.ts+1 : lex.te]))
+			lval.int = int(i)
+			tok = PARAM
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr66:
+//line lyx/lexer.rl:315
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TMINUS
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr70:
+//line lyx/lexer.rl:93
+		lex.te = (lex.p)
+		(lex.p)--
+		{ /* nothing */
+		}
+		goto st9
+	tr72:
+//line lyx/lexer.rl:102
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.int, _ = strconv.Atoi(string(lex.data[lex.ts:lex.te]))
+			tok = ICONST
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr73:
+//line lyx/lexer.rl:101
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.int, _ = strconv.Atoi(string(lex.data[lex.ts:lex.te]))
+			tok = ICONST
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr74:
+//line lyx/lexer.rl:313
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TCOLON
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr75:
+//line lyx/lexer.rl:107
+		lex.te = (lex.p) + 1
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TYPECAST
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr76:
+//line lyx/lexer.rl:320
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TLESS
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr79:
+//line lyx/lexer.rl:321
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = TGREATER
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr81:
+//line lyx/lexer.rl:300
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = identToken(lval.str)
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr103:
+//line lyx/lexer.rl:173
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = AS
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr156:
+//line lyx/lexer.rl:134
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = CHAR_P
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr162:
+//line lyx/lexer.rl:133
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = CHARACTER
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr180:
+//line lyx/lexer.rl:284
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = COMMIT
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr226:
+//line lyx/lexer.rl:118
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = DEC
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr251:
+//line lyx/lexer.rl:253
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = DELIMITER
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr262:
+//line lyx/lexer.rl:292
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = DO
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr317:
+//line lyx/lexer.rl:229
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = FOR
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr346:
+//line lyx/lexer.rl:228
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = IN_P
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr358:
+//line lyx/lexer.rl:110
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = INT_P
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr372:
+//line lyx/lexer.rl:189
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = IS
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr435:
+//line lyx/lexer.rl:193
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = NOT
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr443:
+//line lyx/lexer.rl:191
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = NULL_P
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr452:
+//line lyx/lexer.rl:227
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = ON
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr455:
+//line lyx/lexer.rl:175
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = OR
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr569:
+//line lyx/lexer.rl:165
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = SET
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	tr663:
+//line lyx/lexer.rl:197
+		lex.te = (lex.p)
+		(lex.p)--
+		{
+			lval.str = string(lex.data[lex.ts:lex.te])
+			tok = WITH
+			{
+				(lex.p)++
+				lex.cs = 9
+				goto _out
+			}
+		}
+		goto st9
+	st9:
+//line NONE:1
+		lex.ts = 0
+
+//line NONE:1
+		lex.act = 0
+
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof9
+		}
+	st_case_9:
+//line NONE:1
+		lex.ts = (lex.p)
+
+//line lyx/lexer.go:1759
+		switch lex.data[(lex.p)] {
+		case 32:
+			goto st10
+		case 33:
+			goto st11
+		case 34:
+			goto st1
+		case 36:
+			goto st3
+		case 37:
+			goto tr17
+		case 39:
+			goto st4
+		case 40:
+			goto tr18
+		case 41:
+			goto tr19
+		case 42:
+			goto tr20
+		case 43:
+			goto tr21
+		case 44:
+			goto tr22
+		case 45:
+			goto tr23
+		case 46:
+			goto tr24
+		case 47:
+			goto st6
+		case 55:
+			goto st20
+		case 58:
+			goto st23
+		case 59:
+			goto tr29
+		case 60:
+			goto st24
+		case 61:
+			goto tr31
+		case 62:
+			goto st25
+		case 65:
+			goto st26
+		case 66:
+			goto st49
+		case 67:
+			goto st74
+		case 68:
+			goto st118
+		case 69:
+			goto st171
+		case 70:
+			goto st197
+		case 71:
+			goto st216
+		case 72:
+			goto st220
+		case 73:
+			goto st231
+		case 74:
+			goto st260
+		case 75:
+			goto st263
+		case 76:
+			goto st265
+		case 77:
+			goto st283
+		case 78:
+			goto st291
+		case 79:
+			goto st313
+		case 80:
+			goto st328
+		case 81:
+			goto st342
+		case 82:
+			goto st346
+		case 83:
+			goto st384
+		case 84:
+			goto st429
+		case 85:
+			goto st451
+		case 86:
+			goto st467
+		case 87:
+			goto st479
+		case 88:
+			goto tr56
+		case 89:
+			goto st491
+		case 90:
+			goto st494
+		case 91:
+			goto tr59
+		case 93:
+			goto tr60
+		case 94:
+			goto tr61
+		case 95:
+			goto tr56
+		case 97:
+			goto st26
+		case 98:
+			goto st49
+		case 99:
+			goto st74
+		case 100:
+			goto st118
+		case 101:
+			goto st171
+		case 102:
+			goto st197
+		case 103:
+			goto st216
+		case 104:
+			goto st220
+		case 105:
+			goto st231
+		case 106:
+			goto st260
+		case 107:
+			goto st263
+		case 108:
+			goto st265
+		case 109:
+			goto st283
+		case 110:
+			goto st291
+		case 111:
+			goto st313
+		case 112:
+			goto st328
+		case 113:
+			goto st342
+		case 114:
+			goto st346
+		case 115:
+			goto st384
+		case 116:
+			goto st429
+		case 117:
+			goto st451
+		case 118:
+			goto st467
+		case 119:
+			goto st479
+		case 120:
+			goto tr56
+		case 121:
+			goto st491
+		case 122:
+			goto st494
+		case 124:
+			goto tr15
+		case 126:
+			goto tr15
+		}
+		switch {
+		case lex.data[(lex.p)] < 48:
+			switch {
+			case lex.data[(lex.p)] > 13:
+				if 35 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 38 {
+					goto tr15
+				}
+			case lex.data[(lex.p)] >= 9:
+				goto st10
+			}
+		case lex.data[(lex.p)] > 51:
+			switch {
+			case lex.data[(lex.p)] > 57:
+				if 63 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 96 {
+					goto tr15
+				}
+			case lex.data[(lex.p)] >= 52:
+				goto st22
+			}
+		default:
+			goto st20
+		}
+		goto st0
+	st_case_0:
+	st0:
+		lex.cs = 0
+		goto _out
+	st10:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof10
+		}
+	st_case_10:
+		if lex.data[(lex.p)] == 32 {
+			goto st10
+		}
+		if 9 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 13 {
+			goto st10
+		}
+		goto tr62
+	st11:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch lex.data[(lex.p)] {
+		case 33:
+			goto tr15
+		case 35:
+			goto tr15
+		case 45:
+			goto tr15
+		case 61:
+			goto tr64
+		case 92:
+			goto tr15
+		case 94:
+			goto tr15
+		case 96:
+			goto tr15
+		case 124:
+			goto tr15
+		case 126:
+			goto tr15
+		}
+		switch {
+		case lex.data[(lex.p)] < 42:
+			if 37 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 38 {
+				goto tr15
+			}
+		case lex.data[(lex.p)] > 43:
+			if 60 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 64 {
+				goto tr15
+			}
+		default:
+			goto tr15
+		}
+		goto tr63
+	tr15:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:330
+		lex.act = 183
+		goto st12
+	tr17:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:318
+		lex.act = 174
+		goto st12
+	tr20:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:316
+		lex.act = 173
+		goto st12
+	tr21:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:314
+		lex.act = 171
+		goto st12
+	tr31:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:322
+		lex.act = 178
+		goto st12
+	tr61:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:319
+		lex.act = 175
+		goto st12
+	tr64:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:327
+		lex.act = 182
+		goto st12
+	tr77:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:325
+		lex.act = 180
+		goto st12
+	tr78:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:324
+		lex.act = 179
+		goto st12
+	tr80:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:326
+		lex.act = 181
+		goto st12
+	st12:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof12
+		}
+	st_case_12:
+//line lyx/lexer.go:2070
+		switch lex.data[(lex.p)] {
+		case 33:
+			goto tr15
+		case 35:
+			goto tr15
+		case 45:
+			goto tr15
+		case 92:
+			goto tr15
+		case 94:
+			goto tr15
+		case 96:
+			goto tr15
+		case 124:
+			goto tr15
+		case 126:
+			goto tr15
+		}
+		switch {
+		case lex.data[(lex.p)] < 42:
+			if 37 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 38 {
+				goto tr15
+			}
+		case lex.data[(lex.p)] > 43:
+			if 60 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 64 {
+				goto tr15
+			}
+		default:
+			goto tr15
+		}
+		goto tr9
+	st1:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch lex.data[(lex.p)] {
+		case 55:
+			goto st2
+		case 95:
+			goto st2
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 51 {
+				goto st2
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto st2
+			}
+		default:
+			goto st2
+		}
+		goto st0
+	st2:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof2
+		}
+	st_case_2:
+		switch lex.data[(lex.p)] {
+		case 34:
+			goto tr2
+		case 36:
+			goto st2
+		case 95:
+			goto st2
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto st2
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto st2
+			}
+		default:
+			goto st2
+		}
+		goto st0
+	st3:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof3
+		}
+	st_case_3:
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+			goto st13
+		}
+		goto st0
+	st13:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof13
+		}
+	st_case_13:
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+			goto st13
+		}
+		goto tr65
+	st4:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof4
+		}
+	st_case_4:
+		if lex.data[(lex.p)] == 39 {
+			goto tr5
+		}
+		goto st4
+	tr23:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+		goto st14
+	st14:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof14
+		}
+	st_case_14:
+//line lyx/lexer.go:2189
+		switch lex.data[(lex.p)] {
+		case 33:
+			goto tr15
+		case 35:
+			goto tr15
+		case 45:
+			goto st15
+		case 46:
+			goto st5
+		case 92:
+			goto tr15
+		case 94:
+			goto tr15
+		case 96:
+			goto tr15
+		case 124:
+			goto tr15
+		case 126:
+			goto tr15
+		}
+		switch {
+		case lex.data[(lex.p)] < 42:
+			if 37 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 38 {
+				goto tr15
+			}
+		case lex.data[(lex.p)] > 43:
+			switch {
+			case lex.data[(lex.p)] > 57:
+				if 60 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 64 {
+					goto tr15
+				}
+			case lex.data[(lex.p)] >= 48:
+				goto st18
+			}
+		default:
+			goto tr15
+		}
+		goto tr66
+	st15:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch lex.data[(lex.p)] {
+		case 10:
+			goto tr70
+		case 13:
+			goto tr70
+		case 33:
+			goto st15
+		case 35:
+			goto st15
+		case 45:
+			goto st15
+		case 92:
+			goto st15
+		case 94:
+			goto st15
+		case 96:
+			goto st15
+		case 124:
+			goto st15
+		case 126:
+			goto st15
+		}
+		switch {
+		case lex.data[(lex.p)] < 42:
+			if 37 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 38 {
+				goto st15
+			}
+		case lex.data[(lex.p)] > 43:
+			if 60 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 64 {
+				goto st15
+			}
+		default:
+			goto st15
+		}
+		goto st16
+	st16:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof16
+		}
+	st_case_16:
+		switch lex.data[(lex.p)] {
+		case 10:
+			goto tr70
+		case 13:
+			goto tr70
+		}
+		goto st16
+	st5:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof5
+		}
+	st_case_5:
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+			goto tr7
+		}
+		goto tr6
+	tr7:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:104
+		lex.act = 6
+		goto st17
+	tr24:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:311
+		lex.act = 168
+		goto st17
+	st17:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof17
+		}
+	st_case_17:
+//line lyx/lexer.go:2308
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+			goto tr7
+		}
+		goto tr9
+	st18:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof18
+		}
+	st_case_18:
+		if lex.data[(lex.p)] == 46 {
+			goto tr7
+		}
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+			goto st18
+		}
+		goto tr72
+	st6:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof6
+		}
+	st_case_6:
+		if lex.data[(lex.p)] == 42 {
+			goto st7
+		}
+		goto st0
+	st7:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof7
+		}
+	st_case_7:
+		if lex.data[(lex.p)] == 42 {
+			goto st8
+		}
+		goto st7
+	st8:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch lex.data[(lex.p)] {
+		case 42:
+			goto st8
+		case 47:
+			goto tr11
+		}
+		goto st7
+	tr11:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:93
+		lex.act = 2
+		goto st19
+	st19:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof19
+		}
+	st_case_19:
+//line lyx/lexer.go:2367
+		if lex.data[(lex.p)] == 42 {
+			goto st8
+		}
+		goto st7
+	st20:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 46:
+			goto tr7
+		case 95:
+			goto tr56
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto st20
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr73
+	tr56:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:300
+		lex.act = 161
+		goto st21
+	tr89:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:281
+		lex.act = 146
+		goto st21
+	tr90:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:297
+		lex.act = 159
+		goto st21
+	tr93:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:247
+		lex.act = 118
+		goto st21
+	tr95:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:174
+		lex.act = 64
+		goto st21
+	tr99:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:245
+		lex.act = 117
+		goto st21
+	tr102:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:214
+		lex.act = 94
+		goto st21
+	tr104:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:203
+		lex.act = 87
+		goto st21
+	tr112:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:262
+		lex.act = 130
+		goto st21
+	tr117:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:171
+		lex.act = 61
+		goto st21
+	tr121:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:267
+		lex.act = 133
+		goto st21
+	tr125:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:263
+		lex.act = 131
+		goto st21
+	tr128:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:121
+		lex.act = 20
+		goto st21
+	tr131:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:113
+		lex.act = 12
+		goto st21
+	tr134:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:251
+		lex.act = 120
+		goto st21
+	tr139:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:120
+		lex.act = 19
+		goto st21
+	tr144:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:238
+		lex.act = 112
+		goto st21
+	tr153:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:286
+		lex.act = 150
+		goto st21
+	tr168:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:206
+		lex.act = 89
+		goto st21
+	tr173:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:244
+		lex.act = 116
+		goto st21
+	tr183:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:211
+		lex.act = 93
+		goto st21
+	tr188:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:291
+		lex.act = 154
+		goto st21
+	tr189:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:182
+		lex.act = 69
+		goto st21
+	tr194:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:156
+		lex.act = 46
+		goto st21
+	tr196:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:221
+		lex.act = 98
+		goto st21
+	tr197:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:254
+		lex.act = 123
+		goto st21
+	tr200:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:237
+		lex.act = 111
+		goto st21
+	tr207:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:127
+		lex.act = 24
+		goto st21
+	tr212:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:159
+		lex.act = 49
+		goto st21
+	tr225:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:147
+		lex.act = 38
+		goto st21
+	tr230:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:117
+		lex.act = 16
+		goto st21
+	tr235:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:180
+		lex.act = 68
+		goto st21
+	tr241:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:272
+		lex.act = 138
+		goto st21
+	tr245:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:155
+		lex.act = 45
+		goto st21
+	tr252:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:252
+		lex.act = 121
+		goto st21
+	tr254:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:239
+		lex.act = 113
+		goto st21
+	tr255:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:204
+		lex.act = 88
+		goto st21
+	tr261:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:187
+		lex.act = 73
+		goto st21
+	tr266:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:116
+		lex.act = 15
+		goto st21
+	tr268:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:264
+		lex.act = 132
+		goto st21
+	tr273:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:282
+		lex.act = 147
+		goto st21
+	tr278:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:258
+		lex.act = 127
+		goto st21
+	tr282:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:257
+		lex.act = 126
+		goto st21
+	tr289:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:295
+		lex.act = 157
+		goto st21
+	tr293:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:148
+		lex.act = 39
+		goto st21
+	tr296:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:217
+		lex.act = 96
+		goto st21
+	tr300:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:177
+		lex.act = 66
+		goto st21
+	tr309:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:199
+		lex.act = 84
+		goto st21
+	tr312:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:201
+		lex.act = 85
+		goto st21
+	tr315:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:115
+		lex.act = 14
+		goto st21
+	tr321:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:162
+		lex.act = 52
+		goto st21
+	tr323:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:167
+		lex.act = 57
+		goto st21
+	tr325:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:224
+		lex.act = 101
+		goto st21
+	tr329:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:170
+		lex.act = 60
+		goto st21
+	tr336:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:172
+		lex.act = 62
+		goto st21
+	tr340:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:255
+		lex.act = 124
+		goto st21
+	tr342:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:128
+		lex.act = 25
+		goto st21
+	tr343:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:144
+		lex.act = 36
+		goto st21
+	tr352:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:249
+		lex.act = 119
+		goto st21
+	tr354:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:226
+		lex.act = 103
+		goto st21
+	tr357:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:151
+		lex.act = 41
+		goto st21
+	tr360:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:152
+		lex.act = 42
+		goto st21
+	tr364:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:111
+		lex.act = 10
+		goto st21
+	tr369:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:296
+		lex.act = 158
+		goto st21
+	tr371:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:124
+		lex.act = 21
+		goto st21
+	tr377:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:190
+		lex.act = 75
+		goto st21
+	tr383:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:208
+		lex.act = 91
+		goto st21
+	tr386:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:220
+		lex.act = 97
+		goto st21
+	tr388:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:164
+		lex.act = 54
+		goto st21
+	tr395:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:202
+		lex.act = 86
+		goto st21
+	tr399:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:195
+		lex.act = 80
+		goto st21
+	tr402:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:222
+		lex.act = 99
+		goto st21
+	tr404:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:209
+		lex.act = 92
+		goto st21
+	tr407:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:186
+		lex.act = 72
+		goto st21
+	tr411:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:232
+		lex.act = 107
+		goto st21
+	tr417:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:129
+		lex.act = 26
+		goto st21
+	tr420:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:126
+		lex.act = 23
+		goto st21
+	tr430:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:136
+		lex.act = 31
+		goto st21
+	tr433:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:137
+		lex.act = 32
+		goto st21
+	tr439:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:194
+		lex.act = 79
+		goto st21
+	tr444:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:192
+		lex.act = 77
+		goto st21
+	tr448:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:119
+		lex.act = 18
+		goto st21
+	tr454:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:270
+		lex.act = 136
+		goto st21
+	tr459:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:169
+		lex.act = 59
+		goto st21
+	tr465:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:196
+		lex.act = 81
+		goto st21
+	tr468:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:225
+		lex.act = 102
+		goto st21
+	tr476:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:146
+		lex.act = 37
+		goto st21
+	tr480:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:161
+		lex.act = 51
+		goto st21
+	tr484:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:259
+		lex.act = 128
+		goto st21
+	tr488:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:256
+		lex.act = 125
+		goto st21
+	tr497:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:269
+		lex.act = 135
+		goto st21
+	tr498:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:114
+		lex.act = 13
+		goto st21
+	tr504:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:235
+		lex.act = 109
+		goto st21
+	tr511:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:163
+		lex.act = 53
+		goto st21
+	tr518:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:277
+		lex.act = 143
+		goto st21
+	tr524:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:179
+		lex.act = 67
+		goto st21
+	tr527:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:223
+		lex.act = 100
+		goto st21
+	tr529:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:216
+		lex.act = 95
+		goto st21
+	tr530:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:160
+		lex.act = 50
+		goto st21
+	tr535:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:283
+		lex.act = 148
+		goto st21
+	tr549:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:236
+		lex.act = 110
+		goto st21
+	tr552:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:130
+		lex.act = 27
+		goto st21
+	tr555:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:150
+		lex.act = 40
+		goto st21
+	tr564:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:278
+		lex.act = 144
+		goto st21
+	tr568:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:207
+		lex.act = 90
+		goto st21
+	tr571:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:109
+		lex.act = 8
+		goto st21
+	tr575:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:241
+		lex.act = 114
+		goto st21
+	tr576:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:166
+		lex.act = 56
+		goto st21
+	tr578:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:233
+		lex.act = 108
+		goto st21
+	tr584:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:112
+		lex.act = 11
+		goto st21
+	tr588:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:280
+		lex.act = 145
+		goto st21
+	tr591:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:260
+		lex.act = 129
+		goto st21
+	tr593:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:184
+		lex.act = 71
+		goto st21
+	tr597:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:183
+		lex.act = 70
+		goto st21
+	tr601:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:158
+		lex.act = 48
+		goto st21
+	tr603:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:288
+		lex.act = 152
+		goto st21
+	tr605:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:141
+		lex.act = 34
+		goto st21
+	tr615:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:268
+		lex.act = 134
+		goto st21
+	tr616:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:198
+		lex.act = 83
+		goto st21
+	tr621:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:157
+		lex.act = 47
+		goto st21
+	tr633:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:275
+		lex.act = 141
+		goto st21
+	tr635:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:294
+		lex.act = 156
+		goto st21
+	tr639:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:154
+		lex.act = 44
+		goto st21
+	tr646:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:243
+		lex.act = 115
+		goto st21
+	tr649:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:153
+		lex.act = 43
+		goto st21
+	tr653:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:135
+		lex.act = 30
+		goto st21
+	tr658:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:287
+		lex.act = 151
+		goto st21
+	tr660:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:168
+		lex.act = 58
+		goto st21
+	tr666:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:140
+		lex.act = 33
+		goto st21
+	tr669:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:271
+		lex.act = 137
+		goto st21
+	tr672:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:125
+		lex.act = 22
+		goto st21
+	tr675:
+//line NONE:1
+		lex.te = (lex.p) + 1
+
+//line lyx/lexer.rl:142
+		lex.act = 35
+		goto st21
+	st21:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof21
+		}
+	st_case_21:
+//line lyx/lexer.go:3327
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 95:
+			goto tr56
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr9
+	st22:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof22
+		}
+	st_case_22:
+		if lex.data[(lex.p)] == 46 {
+			goto tr7
+		}
+		if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+			goto st22
+		}
+		goto tr73
+	st23:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof23
+		}
+	st_case_23:
+		if lex.data[(lex.p)] == 58 {
+			goto tr75
+		}
+		goto tr74
+	st24:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof24
+		}
+	st_case_24:
+		switch lex.data[(lex.p)] {
+		case 33:
+			goto tr15
+		case 35:
+			goto tr15
+		case 45:
+			goto tr15
+		case 61:
+			goto tr77
+		case 62:
+			goto tr78
+		case 92:
+			goto tr15
+		case 94:
+			goto tr15
+		case 96:
+			goto tr15
+		case 124:
+			goto tr15
+		case 126:
+			goto tr15
+		}
+		switch {
+		case lex.data[(lex.p)] < 42:
+			if 37 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 38 {
+				goto tr15
+			}
+		case lex.data[(lex.p)] > 43:
+			if 60 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 64 {
+				goto tr15
+			}
+		default:
+			goto tr15
+		}
+		goto tr76
+	st25:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof25
+		}
+	st_case_25:
+		switch lex.data[(lex.p)] {
+		case 33:
+			goto tr15
+		case 35:
+			goto tr15
+		case 45:
+			goto tr15
+		case 61:
+			goto tr80
+		case 92:
+			goto tr15
+		case 94:
+			goto tr15
+		case 96:
+			goto tr15
+		case 124:
+			goto tr15
+		case 126:
+			goto tr15
+		}
+		switch {
+		case lex.data[(lex.p)] < 42:
+			if 37 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 38 {
+				goto tr15
+			}
+		case lex.data[(lex.p)] > 43:
+			if 60 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 64 {
+				goto tr15
+			}
+		default:
+			goto tr15
+		}
+		goto tr79
+	st26:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof26
+		}
+	st_case_26:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 66:
+			goto st27
+		case 76:
+			goto st30
+		case 78:
+			goto st33
+		case 82:
+			goto st38
+		case 83:
+			goto st41
+		case 95:
+			goto tr56
+		case 98:
+			goto st27
+		case 108:
+			goto st30
+		case 110:
+			goto st33
+		case 114:
+			goto st38
+		case 115:
+			goto st41
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st27:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof27
+		}
+	st_case_27:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st28
+		case 95:
+			goto tr56
+		case 111:
+			goto st28
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st28:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof28
+		}
+	st_case_28:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st29
+		case 95:
+			goto tr56
+		case 114:
+			goto st29
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st29:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof29
+		}
+	st_case_29:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr89
+		case 95:
+			goto tr56
+		case 116:
+			goto tr89
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st30:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof30
+		}
+	st_case_30:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto tr90
+		case 84:
+			goto st31
+		case 95:
+			goto tr56
+		case 108:
+			goto tr90
+		case 116:
+			goto st31
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st31:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st32
+		case 95:
+			goto tr56
+		case 101:
+			goto st32
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st32:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof32
+		}
+	st_case_32:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr93
+		case 95:
+			goto tr56
+		case 114:
+			goto tr93
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st33:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st34
+		case 68:
+			goto tr95
+		case 95:
+			goto tr56
+		case 97:
+			goto st34
+		case 100:
+			goto tr95
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st34:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof34
+		}
+	st_case_34:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st35
+		case 95:
+			goto tr56
+		case 108:
+			goto st35
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st35:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 89:
+			goto st36
+		case 95:
+			goto tr56
+		case 121:
+			goto st36
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st36:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof36
+		}
+	st_case_36:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 90:
+			goto st37
+		case 95:
+			goto tr56
+		case 122:
+			goto st37
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 89:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 121 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st37:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr99
+		case 95:
+			goto tr56
+		case 101:
+			goto tr99
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st38:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st39
+		case 95:
+			goto tr56
+		case 114:
+			goto st39
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st39:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st40
+		case 95:
+			goto tr56
+		case 97:
+			goto st40
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st40:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof40
+		}
+	st_case_40:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 89:
+			goto tr102
+		case 95:
+			goto tr56
+		case 121:
+			goto tr102
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st41:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof41
+		}
+	st_case_41:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto tr104
+		case 89:
+			goto st42
+		case 95:
+			goto tr56
+		case 99:
+			goto tr104
+		case 121:
+			goto st42
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr103
+	st42:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof42
+		}
+	st_case_42:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st43
+		case 95:
+			goto tr56
+		case 109:
+			goto st43
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st43:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof43
+		}
+	st_case_43:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st44
+		case 95:
+			goto tr56
+		case 109:
+			goto st44
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st44:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof44
+		}
+	st_case_44:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st45
+		case 95:
+			goto tr56
+		case 101:
+			goto st45
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st45:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof45
+		}
+	st_case_45:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st46
+		case 95:
+			goto tr56
+		case 116:
+			goto st46
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st46:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof46
+		}
+	st_case_46:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st47
+		case 95:
+			goto tr56
+		case 114:
+			goto st47
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st47:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof47
+		}
+	st_case_47:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st48
+		case 95:
+			goto tr56
+		case 105:
+			goto st48
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st48:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof48
+		}
+	st_case_48:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto tr112
+		case 95:
+			goto tr56
+		case 99:
+			goto tr112
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st49:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof49
+		}
+	st_case_49:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st50
+		case 73:
+			goto st57
+		case 79:
+			goto st64
+		case 82:
+			goto st69
+		case 89:
+			goto tr117
+		case 95:
+			goto tr56
+		case 101:
+			goto st50
+		case 105:
+			goto st57
+		case 111:
+			goto st64
+		case 114:
+			goto st69
+		case 121:
+			goto tr117
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st50:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof50
+		}
+	st_case_50:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 71:
+			goto st51
+		case 84:
+			goto st53
+		case 95:
+			goto tr56
+		case 103:
+			goto st51
+		case 116:
+			goto st53
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st51:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof51
+		}
+	st_case_51:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st52
+		case 95:
+			goto tr56
+		case 105:
+			goto st52
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st52:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof52
+		}
+	st_case_52:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr121
+		case 95:
+			goto tr56
+		case 110:
+			goto tr121
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st53:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof53
+		}
+	st_case_53:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 87:
+			goto st54
+		case 95:
+			goto tr56
+		case 119:
+			goto st54
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st54:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof54
+		}
+	st_case_54:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st55
+		case 95:
+			goto tr56
+		case 101:
+			goto st55
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st55:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof55
+		}
+	st_case_55:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st56
+		case 95:
+			goto tr56
+		case 101:
+			goto st56
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st56:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof56
+		}
+	st_case_56:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr125
+		case 95:
+			goto tr56
+		case 110:
+			goto tr125
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st57:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof57
+		}
+	st_case_57:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 71:
+			goto st58
+		case 78:
+			goto st61
+		case 84:
+			goto tr128
+		case 95:
+			goto tr56
+		case 103:
+			goto st58
+		case 110:
+			goto st61
+		case 116:
+			goto tr128
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st58:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof58
+		}
+	st_case_58:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st59
+		case 95:
+			goto tr56
+		case 105:
+			goto st59
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st59:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof59
+		}
+	st_case_59:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st60
+		case 95:
+			goto tr56
+		case 110:
+			goto st60
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st60:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof60
+		}
+	st_case_60:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr131
+		case 95:
+			goto tr56
+		case 116:
+			goto tr131
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st61:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof61
+		}
+	st_case_61:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st62
+		case 95:
+			goto tr56
+		case 97:
+			goto st62
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st62:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof62
+		}
+	st_case_62:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st63
+		case 95:
+			goto tr56
+		case 114:
+			goto st63
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st63:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof63
+		}
+	st_case_63:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 89:
+			goto tr134
+		case 95:
+			goto tr56
+		case 121:
+			goto tr134
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st64:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof64
+		}
+	st_case_64:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st65
+		case 95:
+			goto tr56
+		case 111:
+			goto st65
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st65:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof65
+		}
+	st_case_65:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st66
+		case 95:
+			goto tr56
+		case 108:
+			goto st66
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st66:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof66
+		}
+	st_case_66:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st67
+		case 95:
+			goto tr56
+		case 101:
+			goto st67
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st67:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof67
+		}
+	st_case_67:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st68
+		case 95:
+			goto tr56
+		case 97:
+			goto st68
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st68:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof68
+		}
+	st_case_68:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr139
+		case 95:
+			goto tr56
+		case 110:
+			goto tr139
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st69:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof69
+		}
+	st_case_69:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st70
+		case 95:
+			goto tr56
+		case 101:
+			goto st70
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st70:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof70
+		}
+	st_case_70:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st71
+		case 95:
+			goto tr56
+		case 97:
+			goto st71
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st71:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof71
+		}
+	st_case_71:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto st72
+		case 95:
+			goto tr56
+		case 100:
+			goto st72
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st72:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof72
+		}
+	st_case_72:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st73
+		case 95:
+			goto tr56
+		case 116:
+			goto st73
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st73:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof73
+		}
+	st_case_73:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 72:
+			goto tr144
+		case 95:
+			goto tr56
+		case 104:
+			goto tr144
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st74:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st75
+		case 72:
+			goto st77
+		case 76:
+			goto st90
+		case 79:
+			goto st95
+		case 82:
+			goto st108
+		case 83:
+			goto st114
+		case 89:
+			goto st115
+		case 95:
+			goto tr56
+		case 97:
+			goto st75
+		case 104:
+			goto st77
+		case 108:
+			goto st90
+		case 111:
+			goto st95
+		case 114:
+			goto st108
+		case 115:
+			goto st114
+		case 121:
+			goto st115
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st75:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof75
+		}
+	st_case_75:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st76
+		case 95:
+			goto tr56
+		case 115:
+			goto st76
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st76:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof76
+		}
+	st_case_76:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr153
+		case 95:
+			goto tr56
+		case 101:
+			goto tr153
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st77:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof77
+		}
+	st_case_77:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st78
+		case 95:
+			goto tr56
+		case 97:
+			goto st78
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st78:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof78
+		}
+	st_case_78:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st79
+		case 95:
+			goto tr56
+		case 114:
+			goto st79
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st79:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof79
+		}
+	st_case_79:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st80
+		case 95:
+			goto tr56
+		case 97:
+			goto st80
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr156
+	st80:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof80
+		}
+	st_case_80:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st81
+		case 95:
+			goto tr56
+		case 99:
+			goto st81
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st81:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof81
+		}
+	st_case_81:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st82
+		case 95:
+			goto tr56
+		case 116:
+			goto st82
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st82:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof82
+		}
+	st_case_82:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st83
+		case 95:
+			goto tr56
+		case 101:
+			goto st83
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st83:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof83
+		}
+	st_case_83:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st84
+		case 95:
+			goto tr56
+		case 114:
+			goto st84
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st84:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof84
+		}
+	st_case_84:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st85
+		case 95:
+			goto tr56
+		case 105:
+			goto st85
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr162
+	st85:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof85
+		}
+	st_case_85:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st86
+		case 95:
+			goto tr56
+		case 115:
+			goto st86
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st86:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof86
+		}
+	st_case_86:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st87
+		case 95:
+			goto tr56
+		case 116:
+			goto st87
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st87:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof87
+		}
+	st_case_87:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st88
+		case 95:
+			goto tr56
+		case 105:
+			goto st88
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st88:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof88
+		}
+	st_case_88:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st89
+		case 95:
+			goto tr56
+		case 99:
+			goto st89
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st89:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof89
+		}
+	st_case_89:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto tr168
+		case 95:
+			goto tr56
+		case 115:
+			goto tr168
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st90:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof90
+		}
+	st_case_90:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st91
+		case 95:
+			goto tr56
+		case 117:
+			goto st91
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st91:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof91
+		}
+	st_case_91:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st92
+		case 95:
+			goto tr56
+		case 115:
+			goto st92
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st92:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof92
+		}
+	st_case_92:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st93
+		case 95:
+			goto tr56
+		case 116:
+			goto st93
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st93:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof93
+		}
+	st_case_93:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st94
+		case 95:
+			goto tr56
+		case 101:
+			goto st94
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st94:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof94
+		}
+	st_case_94:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr173
+		case 95:
+			goto tr56
+		case 114:
+			goto tr173
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st95:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof95
+		}
+	st_case_95:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st96
+		case 78:
+			goto st102
+		case 80:
+			goto st107
+		case 95:
+			goto tr56
+		case 109:
+			goto st96
+		case 110:
+			goto st102
+		case 112:
+			goto st107
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st96:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof96
+		}
+	st_case_96:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st97
+		case 95:
+			goto tr56
+		case 109:
+			goto st97
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st97:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof97
+		}
+	st_case_97:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st98
+		case 95:
+			goto tr56
+		case 105:
+			goto st98
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st98:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof98
+		}
+	st_case_98:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st99
+		case 95:
+			goto tr56
+		case 116:
+			goto st99
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st99:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof99
+		}
+	st_case_99:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st100
+		case 95:
+			goto tr56
+		case 116:
+			goto st100
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr180
+	st100:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof100
+		}
+	st_case_100:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st101
+		case 95:
+			goto tr56
+		case 101:
+			goto st101
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st101:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof101
+		}
+	st_case_101:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto tr183
+		case 95:
+			goto tr56
+		case 100:
+			goto tr183
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st102:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof102
+		}
+	st_case_102:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 70:
+			goto st103
+		case 95:
+			goto tr56
+		case 102:
+			goto st103
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st103:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof103
+		}
+	st_case_103:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st104
+		case 95:
+			goto tr56
+		case 108:
+			goto st104
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st104:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof104
+		}
+	st_case_104:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st105
+		case 95:
+			goto tr56
+		case 105:
+			goto st105
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st105:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof105
+		}
+	st_case_105:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st106
+		case 95:
+			goto tr56
+		case 99:
+			goto st106
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st106:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof106
+		}
+	st_case_106:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr188
+		case 95:
+			goto tr56
+		case 116:
+			goto tr188
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st107:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof107
+		}
+	st_case_107:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 89:
+			goto tr189
+		case 95:
+			goto tr56
+		case 121:
+			goto tr189
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st108:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof108
+		}
+	st_case_108:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st109
+		case 79:
+			goto st112
+		case 95:
+			goto tr56
+		case 101:
+			goto st109
+		case 111:
+			goto st112
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st109:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof109
+		}
+	st_case_109:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st110
+		case 95:
+			goto tr56
+		case 97:
+			goto st110
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st110:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof110
+		}
+	st_case_110:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st111
+		case 95:
+			goto tr56
+		case 116:
+			goto st111
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st111:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof111
+		}
+	st_case_111:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr194
+		case 95:
+			goto tr56
+		case 101:
+			goto tr194
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st112:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof112
+		}
+	st_case_112:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st113
+		case 95:
+			goto tr56
+		case 115:
+			goto st113
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st113:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof113
+		}
+	st_case_113:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto tr196
+		case 95:
+			goto tr56
+		case 115:
+			goto tr196
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st114:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof114
+		}
+	st_case_114:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 86:
+			goto tr197
+		case 95:
+			goto tr56
+		case 118:
+			goto tr197
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st115:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof115
+		}
+	st_case_115:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st116
+		case 95:
+			goto tr56
+		case 99:
+			goto st116
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st116:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof116
+		}
+	st_case_116:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st117
+		case 95:
+			goto tr56
+		case 108:
+			goto st117
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st117:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof117
+		}
+	st_case_117:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr200
+		case 95:
+			goto tr56
+		case 101:
+			goto tr200
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st118:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof118
+		}
+	st_case_118:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st119
+		case 69:
+			goto st125
+		case 73:
+			goto st159
+		case 79:
+			goto st165
+		case 82:
+			goto st169
+		case 95:
+			goto tr56
+		case 97:
+			goto st119
+		case 101:
+			goto st125
+		case 105:
+			goto st159
+		case 111:
+			goto st165
+		case 114:
+			goto st169
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st119:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof119
+		}
+	st_case_119:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st120
+		case 89:
+			goto tr207
+		case 95:
+			goto tr56
+		case 116:
+			goto st120
+		case 121:
+			goto tr207
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st120:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof120
+		}
+	st_case_120:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st121
+		case 95:
+			goto tr56
+		case 97:
+			goto st121
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st121:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof121
+		}
+	st_case_121:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 66:
+			goto st122
+		case 95:
+			goto tr56
+		case 98:
+			goto st122
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st122:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof122
+		}
+	st_case_122:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st123
+		case 95:
+			goto tr56
+		case 97:
+			goto st123
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st123:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof123
+		}
+	st_case_123:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st124
+		case 95:
+			goto tr56
+		case 115:
+			goto st124
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st124:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof124
+		}
+	st_case_124:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr212
+		case 95:
+			goto tr56
+		case 101:
+			goto tr212
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st125:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof125
+		}
+	st_case_125:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st126
+		case 67:
+			goto st133
+		case 70:
+			goto st137
+		case 76:
+			goto st147
+		case 80:
+			goto st156
+		case 83:
+			goto st158
+		case 95:
+			goto tr56
+		case 97:
+			goto st126
+		case 99:
+			goto st133
+		case 102:
+			goto st137
+		case 108:
+			goto st147
+		case 112:
+			goto st156
+		case 115:
+			goto st158
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st126:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof126
+		}
+	st_case_126:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st127
+		case 95:
+			goto tr56
+		case 108:
+			goto st127
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st127:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof127
+		}
+	st_case_127:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st128
+		case 95:
+			goto tr56
+		case 108:
+			goto st128
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st128:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof128
+		}
+	st_case_128:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st129
+		case 95:
+			goto tr56
+		case 111:
+			goto st129
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st129:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof129
+		}
+	st_case_129:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st130
+		case 95:
+			goto tr56
+		case 99:
+			goto st130
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st130:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof130
+		}
+	st_case_130:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st131
+		case 95:
+			goto tr56
+		case 97:
+			goto st131
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st131:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof131
+		}
+	st_case_131:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st132
+		case 95:
+			goto tr56
+		case 116:
+			goto st132
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st132:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof132
+		}
+	st_case_132:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr225
+		case 95:
+			goto tr56
+		case 101:
+			goto tr225
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st133:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof133
+		}
+	st_case_133:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st134
+		case 95:
+			goto tr56
+		case 105:
+			goto st134
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr226
+	st134:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof134
+		}
+	st_case_134:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st135
+		case 95:
+			goto tr56
+		case 109:
+			goto st135
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st135:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof135
+		}
+	st_case_135:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st136
+		case 95:
+			goto tr56
+		case 97:
+			goto st136
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st136:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof136
+		}
+	st_case_136:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto tr230
+		case 95:
+			goto tr56
+		case 108:
+			goto tr230
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st137:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof137
+		}
+	st_case_137:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st138
+		case 69:
+			goto st141
+		case 95:
+			goto tr56
+		case 97:
+			goto st138
+		case 101:
+			goto st141
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st138:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof138
+		}
+	st_case_138:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st139
+		case 95:
+			goto tr56
+		case 117:
+			goto st139
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st139:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof139
+		}
+	st_case_139:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st140
+		case 95:
+			goto tr56
+		case 108:
+			goto st140
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st140:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof140
+		}
+	st_case_140:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr235
+		case 95:
+			goto tr56
+		case 116:
+			goto tr235
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st141:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof141
+		}
+	st_case_141:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st142
+		case 95:
+			goto tr56
+		case 114:
+			goto st142
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st142:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof142
+		}
+	st_case_142:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st143
+		case 95:
+			goto tr56
+		case 114:
+			goto st143
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st143:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof143
+		}
+	st_case_143:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st144
+		case 95:
+			goto tr56
+		case 97:
+			goto st144
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st144:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof144
+		}
+	st_case_144:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 66:
+			goto st145
+		case 95:
+			goto tr56
+		case 98:
+			goto st145
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st145:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof145
+		}
+	st_case_145:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st146
+		case 95:
+			goto tr56
+		case 108:
+			goto st146
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st146:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof146
+		}
+	st_case_146:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr241
+		case 95:
+			goto tr56
+		case 101:
+			goto tr241
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st147:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof147
+		}
+	st_case_147:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st148
+		case 73:
+			goto st150
+		case 95:
+			goto tr56
+		case 101:
+			goto st148
+		case 105:
+			goto st150
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st148:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof148
+		}
+	st_case_148:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st149
+		case 95:
+			goto tr56
+		case 116:
+			goto st149
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st149:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof149
+		}
+	st_case_149:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr245
+		case 95:
+			goto tr56
+		case 101:
+			goto tr245
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st150:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof150
+		}
+	st_case_150:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st151
+		case 95:
+			goto tr56
+		case 109:
+			goto st151
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st151:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof151
+		}
+	st_case_151:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st152
+		case 95:
+			goto tr56
+		case 105:
+			goto st152
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st152:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof152
+		}
+	st_case_152:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st153
+		case 95:
+			goto tr56
+		case 116:
+			goto st153
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st153:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof153
+		}
+	st_case_153:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st154
+		case 95:
+			goto tr56
+		case 101:
+			goto st154
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st154:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof154
+		}
+	st_case_154:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st155
+		case 95:
+			goto tr56
+		case 114:
+			goto st155
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st155:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof155
+		}
+	st_case_155:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto tr252
+		case 95:
+			goto tr56
+		case 115:
+			goto tr252
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr251
+	st156:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof156
+		}
+	st_case_156:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st157
+		case 95:
+			goto tr56
+		case 116:
+			goto st157
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st157:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof157
+		}
+	st_case_157:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 72:
+			goto tr254
+		case 95:
+			goto tr56
+		case 104:
+			goto tr254
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st158:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof158
+		}
+	st_case_158:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto tr255
+		case 95:
+			goto tr56
+		case 99:
+			goto tr255
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st159:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof159
+		}
+	st_case_159:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st160
+		case 95:
+			goto tr56
+		case 115:
+			goto st160
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st160:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof160
+		}
+	st_case_160:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st161
+		case 95:
+			goto tr56
+		case 116:
+			goto st161
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st161:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof161
+		}
+	st_case_161:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st162
+		case 95:
+			goto tr56
+		case 105:
+			goto st162
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st162:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof162
+		}
+	st_case_162:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st163
+		case 95:
+			goto tr56
+		case 110:
+			goto st163
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st163:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof163
+		}
+	st_case_163:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st164
+		case 95:
+			goto tr56
+		case 99:
+			goto st164
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st164:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof164
+		}
+	st_case_164:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr261
+		case 95:
+			goto tr56
+		case 116:
+			goto tr261
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st165:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof165
+		}
+	st_case_165:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st166
+		case 95:
+			goto tr56
+		case 117:
+			goto st166
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr262
+	st166:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof166
+		}
+	st_case_166:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 66:
+			goto st167
+		case 95:
+			goto tr56
+		case 98:
+			goto st167
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st167:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof167
+		}
+	st_case_167:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st168
+		case 95:
+			goto tr56
+		case 108:
+			goto st168
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st168:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof168
+		}
+	st_case_168:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr266
+		case 95:
+			goto tr56
+		case 101:
+			goto tr266
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st169:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof169
+		}
+	st_case_169:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st170
+		case 95:
+			goto tr56
+		case 111:
+			goto st170
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st170:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof170
+		}
+	st_case_170:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 80:
+			goto tr268
+		case 95:
+			goto tr56
+		case 112:
+			goto tr268
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st171:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof171
+		}
+	st_case_171:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st172
+		case 83:
+			goto st178
+		case 88:
+			goto st182
+		case 95:
+			goto tr56
+		case 110:
+			goto st172
+		case 115:
+			goto st178
+		case 120:
+			goto st182
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st172:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof172
+		}
+	st_case_172:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st173
+		case 68:
+			goto tr273
+		case 95:
+			goto tr56
+		case 99:
+			goto st173
+		case 100:
+			goto tr273
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st173:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof173
+		}
+	st_case_173:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st174
+		case 95:
+			goto tr56
+		case 111:
+			goto st174
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st174:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof174
+		}
+	st_case_174:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto st175
+		case 95:
+			goto tr56
+		case 100:
+			goto st175
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st175:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof175
+		}
+	st_case_175:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st176
+		case 95:
+			goto tr56
+		case 105:
+			goto st176
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st176:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof176
+		}
+	st_case_176:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st177
+		case 95:
+			goto tr56
+		case 110:
+			goto st177
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st177:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof177
+		}
+	st_case_177:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 71:
+			goto tr278
+		case 95:
+			goto tr56
+		case 103:
+			goto tr278
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st178:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof178
+		}
+	st_case_178:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st179
+		case 95:
+			goto tr56
+		case 99:
+			goto st179
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st179:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof179
+		}
+	st_case_179:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st180
+		case 95:
+			goto tr56
+		case 97:
+			goto st180
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st180:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof180
+		}
+	st_case_180:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 80:
+			goto st181
+		case 95:
+			goto tr56
+		case 112:
+			goto st181
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st181:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof181
+		}
+	st_case_181:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr282
+		case 95:
+			goto tr56
+		case 101:
+			goto tr282
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st182:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof182
+		}
+	st_case_182:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st183
+		case 69:
+			goto st186
+		case 73:
+			goto st190
+		case 80:
+			goto st193
+		case 95:
+			goto tr56
+		case 99:
+			goto st183
+		case 101:
+			goto st186
+		case 105:
+			goto st190
+		case 112:
+			goto st193
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st183:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof183
+		}
+	st_case_183:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st184
+		case 95:
+			goto tr56
+		case 101:
+			goto st184
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st184:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof184
+		}
+	st_case_184:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 80:
+			goto st185
+		case 95:
+			goto tr56
+		case 112:
+			goto st185
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st185:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof185
+		}
+	st_case_185:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr289
+		case 95:
+			goto tr56
+		case 116:
+			goto tr289
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st186:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof186
+		}
+	st_case_186:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st187
+		case 95:
+			goto tr56
+		case 99:
+			goto st187
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st187:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof187
+		}
+	st_case_187:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st188
+		case 95:
+			goto tr56
+		case 117:
+			goto st188
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st188:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof188
+		}
+	st_case_188:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st189
+		case 95:
+			goto tr56
+		case 116:
+			goto st189
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st189:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof189
+		}
+	st_case_189:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr293
+		case 95:
+			goto tr56
+		case 101:
+			goto tr293
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st190:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof190
+		}
+	st_case_190:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st191
+		case 95:
+			goto tr56
+		case 115:
+			goto st191
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st191:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof191
+		}
+	st_case_191:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st192
+		case 95:
+			goto tr56
+		case 116:
+			goto st192
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st192:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof192
+		}
+	st_case_192:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto tr296
+		case 95:
+			goto tr56
+		case 115:
+			goto tr296
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st193:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof193
+		}
+	st_case_193:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st194
+		case 95:
+			goto tr56
+		case 108:
+			goto st194
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st194:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof194
+		}
+	st_case_194:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st195
+		case 95:
+			goto tr56
+		case 97:
+			goto st195
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st195:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof195
+		}
+	st_case_195:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st196
+		case 95:
+			goto tr56
+		case 105:
+			goto st196
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st196:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof196
+		}
+	st_case_196:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr300
+		case 95:
+			goto tr56
+		case 110:
+			goto tr300
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st197:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof197
+		}
+	st_case_197:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st198
+		case 73:
+			goto st201
+		case 76:
+			goto st204
+		case 79:
+			goto st207
+		case 82:
+			goto st212
+		case 85:
+			goto st214
+		case 95:
+			goto tr56
+		case 97:
+			goto st198
+		case 105:
+			goto st201
+		case 108:
+			goto st204
+		case 111:
+			goto st207
+		case 114:
+			goto st212
+		case 117:
+			goto st214
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st198:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof198
+		}
+	st_case_198:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st199
+		case 95:
+			goto tr56
+		case 108:
+			goto st199
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st199:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof199
+		}
+	st_case_199:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st200
+		case 95:
+			goto tr56
+		case 115:
+			goto st200
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st200:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof200
+		}
+	st_case_200:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr309
+		case 95:
+			goto tr56
+		case 101:
+			goto tr309
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st201:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof201
+		}
+	st_case_201:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st202
+		case 95:
+			goto tr56
+		case 114:
+			goto st202
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st202:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof202
+		}
+	st_case_202:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st203
+		case 95:
+			goto tr56
+		case 115:
+			goto st203
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st203:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof203
+		}
+	st_case_203:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr312
+		case 95:
+			goto tr56
+		case 116:
+			goto tr312
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st204:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof204
+		}
+	st_case_204:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st205
+		case 95:
+			goto tr56
+		case 111:
+			goto st205
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st205:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof205
+		}
+	st_case_205:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st206
+		case 95:
+			goto tr56
+		case 97:
+			goto st206
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st206:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof206
+		}
+	st_case_206:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr315
+		case 95:
+			goto tr56
+		case 116:
+			goto tr315
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st207:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof207
+		}
+	st_case_207:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st208
+		case 95:
+			goto tr56
+		case 114:
+			goto st208
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st208:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof208
+		}
+	st_case_208:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st209
+		case 95:
+			goto tr56
+		case 101:
+			goto st209
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr317
+	st209:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof209
+		}
+	st_case_209:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st210
+		case 95:
+			goto tr56
+		case 105:
+			goto st210
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st210:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof210
+		}
+	st_case_210:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 71:
+			goto st211
+		case 95:
+			goto tr56
+		case 103:
+			goto st211
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st211:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof211
+		}
+	st_case_211:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr321
+		case 95:
+			goto tr56
+		case 110:
+			goto tr321
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st212:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof212
+		}
+	st_case_212:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st213
+		case 95:
+			goto tr56
+		case 111:
+			goto st213
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st213:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof213
+		}
+	st_case_213:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto tr323
+		case 95:
+			goto tr56
+		case 109:
+			goto tr323
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st214:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof214
+		}
+	st_case_214:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st215
+		case 95:
+			goto tr56
+		case 108:
+			goto st215
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st215:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof215
+		}
+	st_case_215:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto tr325
+		case 95:
+			goto tr56
+		case 108:
+			goto tr325
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st216:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof216
+		}
+	st_case_216:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st217
+		case 95:
+			goto tr56
+		case 114:
+			goto st217
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st217:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof217
+		}
+	st_case_217:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st218
+		case 95:
+			goto tr56
+		case 111:
+			goto st218
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st218:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof218
+		}
+	st_case_218:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st219
+		case 95:
+			goto tr56
+		case 117:
+			goto st219
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st219:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof219
+		}
+	st_case_219:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 80:
+			goto tr329
+		case 95:
+			goto tr56
+		case 112:
+			goto tr329
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st220:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof220
+		}
+	st_case_220:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st221
+		case 69:
+			goto st225
+		case 79:
+			goto st229
+		case 95:
+			goto tr56
+		case 97:
+			goto st221
+		case 101:
+			goto st225
+		case 111:
+			goto st229
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st221:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof221
+		}
+	st_case_221:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 86:
+			goto st222
+		case 95:
+			goto tr56
+		case 118:
+			goto st222
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st222:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof222
+		}
+	st_case_222:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st223
+		case 95:
+			goto tr56
+		case 105:
+			goto st223
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st223:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof223
+		}
+	st_case_223:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st224
+		case 95:
+			goto tr56
+		case 110:
+			goto st224
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st224:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof224
+		}
+	st_case_224:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 71:
+			goto tr336
+		case 95:
+			goto tr56
+		case 103:
+			goto tr336
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st225:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof225
+		}
+	st_case_225:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st226
+		case 95:
+			goto tr56
+		case 97:
+			goto st226
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st226:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof226
+		}
+	st_case_226:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto st227
+		case 95:
+			goto tr56
+		case 100:
+			goto st227
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st227:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof227
+		}
+	st_case_227:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st228
+		case 95:
+			goto tr56
+		case 101:
+			goto st228
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st228:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof228
+		}
+	st_case_228:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr340
+		case 95:
+			goto tr56
+		case 114:
+			goto tr340
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st229:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof229
+		}
+	st_case_229:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st230
+		case 95:
+			goto tr56
+		case 117:
+			goto st230
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st230:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof230
+		}
+	st_case_230:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr342
+		case 95:
+			goto tr56
+		case 114:
+			goto tr342
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st231:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof231
+		}
+	st_case_231:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 70:
+			goto tr343
+		case 78:
+			goto st232
+		case 83:
+			goto st250
+		case 95:
+			goto tr56
+		case 102:
+			goto tr343
+		case 110:
+			goto st232
+		case 115:
+			goto st250
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st232:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof232
+		}
+	st_case_232:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto st233
+		case 78:
+			goto st235
+		case 83:
+			goto st237
+		case 84:
+			goto st240
+		case 95:
+			goto tr56
+		case 100:
+			goto st233
+		case 110:
+			goto st235
+		case 115:
+			goto st237
+		case 116:
+			goto st240
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr346
+	st233:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof233
+		}
+	st_case_233:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st234
+		case 95:
+			goto tr56
+		case 101:
+			goto st234
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st234:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof234
+		}
+	st_case_234:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 88:
+			goto tr352
+		case 95:
+			goto tr56
+		case 120:
+			goto tr352
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st235:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof235
+		}
+	st_case_235:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st236
+		case 95:
+			goto tr56
+		case 101:
+			goto st236
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st236:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof236
+		}
+	st_case_236:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr354
+		case 95:
+			goto tr56
+		case 114:
+			goto tr354
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st237:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof237
+		}
+	st_case_237:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st238
+		case 95:
+			goto tr56
+		case 101:
+			goto st238
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st238:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof238
+		}
+	st_case_238:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st239
+		case 95:
+			goto tr56
+		case 114:
+			goto st239
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st239:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof239
+		}
+	st_case_239:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr357
+		case 95:
+			goto tr56
+		case 116:
+			goto tr357
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st240:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof240
+		}
+	st_case_240:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st241
+		case 79:
+			goto tr360
+		case 95:
+			goto tr56
+		case 101:
+			goto st241
+		case 111:
+			goto tr360
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr358
+	st241:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof241
+		}
+	st_case_241:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 71:
+			goto st242
+		case 82:
+			goto st244
+		case 95:
+			goto tr56
+		case 103:
+			goto st242
+		case 114:
+			goto st244
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st242:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof242
+		}
+	st_case_242:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st243
+		case 95:
+			goto tr56
+		case 101:
+			goto st243
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st243:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof243
+		}
+	st_case_243:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr364
+		case 95:
+			goto tr56
+		case 114:
+			goto tr364
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st244:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof244
+		}
+	st_case_244:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st245
+		case 86:
+			goto st248
+		case 95:
+			goto tr56
+		case 115:
+			goto st245
+		case 118:
+			goto st248
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st245:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof245
+		}
+	st_case_245:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st246
+		case 95:
+			goto tr56
+		case 101:
+			goto st246
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st246:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof246
+		}
+	st_case_246:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st247
+		case 95:
+			goto tr56
+		case 99:
+			goto st247
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st247:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof247
+		}
+	st_case_247:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr369
+		case 95:
+			goto tr56
+		case 116:
+			goto tr369
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st248:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof248
+		}
+	st_case_248:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st249
+		case 95:
+			goto tr56
+		case 97:
+			goto st249
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st249:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof249
+		}
+	st_case_249:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto tr371
+		case 95:
+			goto tr56
+		case 108:
+			goto tr371
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st250:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof250
+		}
+	st_case_250:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st251
+		case 79:
+			goto st254
+		case 95:
+			goto tr56
+		case 110:
+			goto st251
+		case 111:
+			goto st254
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr372
+	st251:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof251
+		}
+	st_case_251:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st252
+		case 95:
+			goto tr56
+		case 117:
+			goto st252
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st252:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof252
+		}
+	st_case_252:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st253
+		case 95:
+			goto tr56
+		case 108:
+			goto st253
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st253:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof253
+		}
+	st_case_253:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto tr377
+		case 95:
+			goto tr56
+		case 108:
+			goto tr377
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st254:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof254
+		}
+	st_case_254:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st255
+		case 95:
+			goto tr56
+		case 108:
+			goto st255
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st255:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof255
+		}
+	st_case_255:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st256
+		case 95:
+			goto tr56
+		case 97:
+			goto st256
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st256:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof256
+		}
+	st_case_256:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st257
+		case 95:
+			goto tr56
+		case 116:
+			goto st257
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st257:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof257
+		}
+	st_case_257:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st258
+		case 95:
+			goto tr56
+		case 105:
+			goto st258
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st258:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof258
+		}
+	st_case_258:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st259
+		case 95:
+			goto tr56
+		case 111:
+			goto st259
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st259:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof259
+		}
+	st_case_259:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr383
+		case 95:
+			goto tr56
+		case 110:
+			goto tr383
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st260:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof260
+		}
+	st_case_260:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st261
+		case 95:
+			goto tr56
+		case 111:
+			goto st261
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st261:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof261
+		}
+	st_case_261:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st262
+		case 95:
+			goto tr56
+		case 105:
+			goto st262
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st262:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof262
+		}
+	st_case_262:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr386
+		case 95:
+			goto tr56
+		case 110:
+			goto tr386
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st263:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof263
+		}
+	st_case_263:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st264
+		case 95:
+			goto tr56
+		case 101:
+			goto st264
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st264:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof264
+		}
+	st_case_264:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 89:
+			goto tr388
+		case 95:
+			goto tr56
+		case 121:
+			goto tr388
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st265:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof265
+		}
+	st_case_265:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st266
+		case 69:
+			goto st272
+		case 73:
+			goto st276
+		case 79:
+			goto st279
+		case 95:
+			goto tr56
+		case 97:
+			goto st266
+		case 101:
+			goto st272
+		case 105:
+			goto st276
+		case 111:
+			goto st279
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st266:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof266
+		}
+	st_case_266:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st267
+		case 84:
+			goto st268
+		case 95:
+			goto tr56
+		case 115:
+			goto st267
+		case 116:
+			goto st268
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st267:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof267
+		}
+	st_case_267:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr395
+		case 95:
+			goto tr56
+		case 116:
+			goto tr395
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st268:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof268
+		}
+	st_case_268:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st269
+		case 95:
+			goto tr56
+		case 101:
+			goto st269
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st269:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof269
+		}
+	st_case_269:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st270
+		case 95:
+			goto tr56
+		case 114:
+			goto st270
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st270:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof270
+		}
+	st_case_270:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st271
+		case 95:
+			goto tr56
+		case 97:
+			goto st271
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st271:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof271
+		}
+	st_case_271:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto tr399
+		case 95:
+			goto tr56
+		case 108:
+			goto tr399
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st272:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof272
+		}
+	st_case_272:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 70:
+			goto st273
+		case 86:
+			goto st274
+		case 95:
+			goto tr56
+		case 102:
+			goto st273
+		case 118:
+			goto st274
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st273:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof273
+		}
+	st_case_273:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr402
+		case 95:
+			goto tr56
+		case 116:
+			goto tr402
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st274:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof274
+		}
+	st_case_274:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st275
+		case 95:
+			goto tr56
+		case 101:
+			goto st275
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st275:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof275
+		}
+	st_case_275:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto tr404
+		case 95:
+			goto tr56
+		case 108:
+			goto tr404
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st276:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof276
+		}
+	st_case_276:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st277
+		case 95:
+			goto tr56
+		case 109:
+			goto st277
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st277:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof277
+		}
+	st_case_277:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st278
+		case 95:
+			goto tr56
+		case 105:
+			goto st278
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st278:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof278
+		}
+	st_case_278:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr407
+		case 95:
+			goto tr56
+		case 116:
+			goto tr407
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st279:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof279
+		}
+	st_case_279:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st280
+		case 95:
+			goto tr56
+		case 99:
+			goto st280
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st280:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof280
+		}
+	st_case_280:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 75:
+			goto st281
+		case 95:
+			goto tr56
+		case 107:
+			goto st281
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st281:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof281
+		}
+	st_case_281:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st282
+		case 95:
+			goto tr56
+		case 101:
+			goto st282
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st282:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof282
+		}
+	st_case_282:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto tr411
+		case 95:
+			goto tr56
+		case 100:
+			goto tr411
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st283:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof283
+		}
+	st_case_283:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st284
+		case 79:
+			goto st288
+		case 95:
+			goto tr56
+		case 105:
+			goto st284
+		case 111:
+			goto st288
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st284:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof284
+		}
+	st_case_284:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st285
+		case 95:
+			goto tr56
+		case 110:
+			goto st285
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st285:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof285
+		}
+	st_case_285:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st286
+		case 95:
+			goto tr56
+		case 117:
+			goto st286
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st286:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof286
+		}
+	st_case_286:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st287
+		case 95:
+			goto tr56
+		case 116:
+			goto st287
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st287:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof287
+		}
+	st_case_287:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr417
+		case 95:
+			goto tr56
+		case 101:
+			goto tr417
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st288:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof288
+		}
+	st_case_288:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st289
+		case 95:
+			goto tr56
+		case 110:
+			goto st289
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st289:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof289
+		}
+	st_case_289:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st290
+		case 95:
+			goto tr56
+		case 116:
+			goto st290
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st290:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof290
+		}
+	st_case_290:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 72:
+			goto tr420
+		case 95:
+			goto tr56
+		case 104:
+			goto tr420
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st291:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof291
+		}
+	st_case_291:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st292
+		case 67:
+			goto st298
+		case 79:
+			goto st301
+		case 85:
+			goto st306
+		case 95:
+			goto tr56
+		case 97:
+			goto st292
+		case 99:
+			goto st298
+		case 111:
+			goto st301
+		case 117:
+			goto st306
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st292:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof292
+		}
+	st_case_292:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st293
+		case 95:
+			goto tr56
+		case 116:
+			goto st293
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st293:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof293
+		}
+	st_case_293:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st294
+		case 95:
+			goto tr56
+		case 105:
+			goto st294
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st294:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof294
+		}
+	st_case_294:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st295
+		case 95:
+			goto tr56
+		case 111:
+			goto st295
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st295:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof295
+		}
+	st_case_295:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st296
+		case 95:
+			goto tr56
+		case 110:
+			goto st296
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st296:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof296
+		}
+	st_case_296:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st297
+		case 95:
+			goto tr56
+		case 97:
+			goto st297
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st297:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof297
+		}
+	st_case_297:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto tr430
+		case 95:
+			goto tr56
+		case 108:
+			goto tr430
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st298:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof298
+		}
+	st_case_298:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 72:
+			goto st299
+		case 95:
+			goto tr56
+		case 104:
+			goto st299
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st299:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof299
+		}
+	st_case_299:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st300
+		case 95:
+			goto tr56
+		case 97:
+			goto st300
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st300:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof300
+		}
+	st_case_300:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr433
+		case 95:
+			goto tr56
+		case 114:
+			goto tr433
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st301:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof301
+		}
+	st_case_301:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st302
+		case 95:
+			goto tr56
+		case 116:
+			goto st302
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st302:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof302
+		}
+	st_case_302:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st303
+		case 95:
+			goto tr56
+		case 110:
+			goto st303
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr435
+	st303:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof303
+		}
+	st_case_303:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st304
+		case 95:
+			goto tr56
+		case 117:
+			goto st304
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st304:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof304
+		}
+	st_case_304:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st305
+		case 95:
+			goto tr56
+		case 108:
+			goto st305
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st305:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof305
+		}
+	st_case_305:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto tr439
+		case 95:
+			goto tr56
+		case 108:
+			goto tr439
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st306:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof306
+		}
+	st_case_306:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st307
+		case 77:
+			goto st309
+		case 95:
+			goto tr56
+		case 108:
+			goto st307
+		case 109:
+			goto st309
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st307:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof307
+		}
+	st_case_307:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st308
+		case 95:
+			goto tr56
+		case 108:
+			goto st308
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st308:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof308
+		}
+	st_case_308:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto tr444
+		case 95:
+			goto tr56
+		case 115:
+			goto tr444
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr443
+	st309:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof309
+		}
+	st_case_309:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st310
+		case 95:
+			goto tr56
+		case 101:
+			goto st310
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st310:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof310
+		}
+	st_case_310:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st311
+		case 95:
+			goto tr56
+		case 114:
+			goto st311
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st311:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof311
+		}
+	st_case_311:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st312
+		case 95:
+			goto tr56
+		case 105:
+			goto st312
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st312:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof312
+		}
+	st_case_312:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto tr448
+		case 95:
+			goto tr56
+		case 99:
+			goto tr448
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st313:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof313
+		}
+	st_case_313:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st314
+		case 82:
+			goto st316
+		case 85:
+			goto st325
+		case 95:
+			goto tr56
+		case 110:
+			goto st314
+		case 114:
+			goto st316
+		case 117:
+			goto st325
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st314:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof314
+		}
+	st_case_314:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st315
+		case 95:
+			goto tr56
+		case 108:
+			goto st315
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr452
+	st315:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof315
+		}
+	st_case_315:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 89:
+			goto tr454
+		case 95:
+			goto tr56
+		case 121:
+			goto tr454
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st316:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof316
+		}
+	st_case_316:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto st317
+		case 95:
+			goto tr56
+		case 100:
+			goto st317
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr455
+	st317:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof317
+		}
+	st_case_317:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st318
+		case 73:
+			goto st319
+		case 95:
+			goto tr56
+		case 101:
+			goto st318
+		case 105:
+			goto st319
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st318:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof318
+		}
+	st_case_318:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr459
+		case 95:
+			goto tr56
+		case 114:
+			goto tr459
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st319:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof319
+		}
+	st_case_319:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st320
+		case 95:
+			goto tr56
+		case 110:
+			goto st320
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st320:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof320
+		}
+	st_case_320:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st321
+		case 95:
+			goto tr56
+		case 97:
+			goto st321
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st321:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof321
+		}
+	st_case_321:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st322
+		case 95:
+			goto tr56
+		case 108:
+			goto st322
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st322:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof322
+		}
+	st_case_322:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st323
+		case 95:
+			goto tr56
+		case 105:
+			goto st323
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st323:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof323
+		}
+	st_case_323:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st324
+		case 95:
+			goto tr56
+		case 116:
+			goto st324
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st324:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof324
+		}
+	st_case_324:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 89:
+			goto tr465
+		case 95:
+			goto tr56
+		case 121:
+			goto tr465
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st325:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof325
+		}
+	st_case_325:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st326
+		case 95:
+			goto tr56
+		case 116:
+			goto st326
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st326:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof326
+		}
+	st_case_326:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st327
+		case 95:
+			goto tr56
+		case 101:
+			goto st327
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st327:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof327
+		}
+	st_case_327:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr468
+		case 95:
+			goto tr56
+		case 114:
+			goto tr468
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st328:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof328
+		}
+	st_case_328:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st329
+		case 95:
+			goto tr56
+		case 114:
+			goto st329
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st329:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof329
+		}
+	st_case_329:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st330
+		case 73:
+			goto st334
+		case 79:
+			goto st338
+		case 95:
+			goto tr56
+		case 101:
+			goto st330
+		case 105:
+			goto st334
+		case 111:
+			goto st338
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st330:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof330
+		}
+	st_case_330:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 80:
+			goto st331
+		case 95:
+			goto tr56
+		case 112:
+			goto st331
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st331:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof331
+		}
+	st_case_331:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st332
+		case 95:
+			goto tr56
+		case 97:
+			goto st332
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st332:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof332
+		}
+	st_case_332:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st333
+		case 95:
+			goto tr56
+		case 114:
+			goto st333
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st333:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof333
+		}
+	st_case_333:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr476
+		case 95:
+			goto tr56
+		case 101:
+			goto tr476
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st334:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof334
+		}
+	st_case_334:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st335
+		case 95:
+			goto tr56
+		case 109:
+			goto st335
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st335:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof335
+		}
+	st_case_335:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st336
+		case 95:
+			goto tr56
+		case 97:
+			goto st336
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st336:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof336
+		}
+	st_case_336:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st337
+		case 95:
+			goto tr56
+		case 114:
+			goto st337
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st337:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof337
+		}
+	st_case_337:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 89:
+			goto tr480
+		case 95:
+			goto tr56
+		case 121:
+			goto tr480
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st338:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof338
+		}
+	st_case_338:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 71:
+			goto st339
+		case 95:
+			goto tr56
+		case 103:
+			goto st339
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st339:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof339
+		}
+	st_case_339:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st340
+		case 95:
+			goto tr56
+		case 114:
+			goto st340
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st340:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof340
+		}
+	st_case_340:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st341
+		case 95:
+			goto tr56
+		case 97:
+			goto st341
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st341:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof341
+		}
+	st_case_341:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto tr484
+		case 95:
+			goto tr56
+		case 109:
+			goto tr484
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st342:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof342
+		}
+	st_case_342:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st343
+		case 95:
+			goto tr56
+		case 117:
+			goto st343
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st343:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof343
+		}
+	st_case_343:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st344
+		case 95:
+			goto tr56
+		case 111:
+			goto st344
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st344:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof344
+		}
+	st_case_344:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st345
+		case 95:
+			goto tr56
+		case 116:
+			goto st345
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st345:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof345
+		}
+	st_case_345:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr488
+		case 95:
+			goto tr56
+		case 101:
+			goto tr488
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st346:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof346
+		}
+	st_case_346:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st347
+		case 73:
+			goto st375
+		case 79:
+			goto st378
+		case 95:
+			goto tr56
+		case 101:
+			goto st347
+		case 105:
+			goto st375
+		case 111:
+			goto st378
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st347:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof347
+		}
+	st_case_347:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st348
+		case 67:
+			goto st349
+		case 70:
+			goto st355
+		case 80:
+			goto st362
+		case 84:
+			goto st369
+		case 95:
+			goto tr56
+		case 97:
+			goto st348
+		case 99:
+			goto st349
+		case 102:
+			goto st355
+		case 112:
+			goto st362
+		case 116:
+			goto st369
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st348:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof348
+		}
+	st_case_348:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto tr497
+		case 76:
+			goto tr498
+		case 95:
+			goto tr56
+		case 100:
+			goto tr497
+		case 108:
+			goto tr498
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st349:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof349
+		}
+	st_case_349:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st350
+		case 95:
+			goto tr56
+		case 117:
+			goto st350
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st350:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof350
+		}
+	st_case_350:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st351
+		case 95:
+			goto tr56
+		case 114:
+			goto st351
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st351:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof351
+		}
+	st_case_351:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st352
+		case 95:
+			goto tr56
+		case 115:
+			goto st352
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st352:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof352
+		}
+	st_case_352:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st353
+		case 95:
+			goto tr56
+		case 105:
+			goto st353
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st353:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof353
+		}
+	st_case_353:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 86:
+			goto st354
+		case 95:
+			goto tr56
+		case 118:
+			goto st354
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st354:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof354
+		}
+	st_case_354:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr504
+		case 95:
+			goto tr56
+		case 101:
+			goto tr504
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st355:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof355
+		}
+	st_case_355:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st356
+		case 95:
+			goto tr56
+		case 101:
+			goto st356
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st356:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof356
+		}
+	st_case_356:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st357
+		case 95:
+			goto tr56
+		case 114:
+			goto st357
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st357:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof357
+		}
+	st_case_357:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st358
+		case 95:
+			goto tr56
+		case 101:
+			goto st358
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st358:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof358
+		}
+	st_case_358:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st359
+		case 95:
+			goto tr56
+		case 110:
+			goto st359
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st359:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof359
+		}
+	st_case_359:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st360
+		case 95:
+			goto tr56
+		case 99:
+			goto st360
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st360:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof360
+		}
+	st_case_360:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st361
+		case 95:
+			goto tr56
+		case 101:
+			goto st361
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st361:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof361
+		}
+	st_case_361:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto tr511
+		case 95:
+			goto tr56
+		case 115:
+			goto tr511
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st362:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof362
+		}
+	st_case_362:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st363
+		case 95:
+			goto tr56
+		case 101:
+			goto st363
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st363:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof363
+		}
+	st_case_363:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st364
+		case 95:
+			goto tr56
+		case 97:
+			goto st364
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st364:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof364
+		}
+	st_case_364:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st365
+		case 95:
+			goto tr56
+		case 116:
+			goto st365
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st365:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof365
+		}
+	st_case_365:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st366
+		case 95:
+			goto tr56
+		case 97:
+			goto st366
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st366:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof366
+		}
+	st_case_366:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 66:
+			goto st367
+		case 95:
+			goto tr56
+		case 98:
+			goto st367
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st367:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof367
+		}
+	st_case_367:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st368
+		case 95:
+			goto tr56
+		case 108:
+			goto st368
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st368:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof368
+		}
+	st_case_368:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr518
+		case 95:
+			goto tr56
+		case 101:
+			goto tr518
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st369:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof369
+		}
+	st_case_369:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st370
+		case 95:
+			goto tr56
+		case 117:
+			goto st370
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st370:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof370
+		}
+	st_case_370:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st371
+		case 95:
+			goto tr56
+		case 114:
+			goto st371
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st371:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof371
+		}
+	st_case_371:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st372
+		case 95:
+			goto tr56
+		case 110:
+			goto st372
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st372:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof372
+		}
+	st_case_372:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st373
+		case 95:
+			goto tr56
+		case 105:
+			goto st373
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st373:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof373
+		}
+	st_case_373:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st374
+		case 95:
+			goto tr56
+		case 110:
+			goto st374
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st374:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof374
+		}
+	st_case_374:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 71:
+			goto tr524
+		case 95:
+			goto tr56
+		case 103:
+			goto tr524
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st375:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof375
+		}
+	st_case_375:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 71:
+			goto st376
+		case 95:
+			goto tr56
+		case 103:
+			goto st376
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st376:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof376
+		}
+	st_case_376:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 72:
+			goto st377
+		case 95:
+			goto tr56
+		case 104:
+			goto st377
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st377:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof377
+		}
+	st_case_377:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr527
+		case 95:
+			goto tr56
+		case 116:
+			goto tr527
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st378:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof378
+		}
+	st_case_378:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st379
+		case 87:
+			goto tr529
+		case 95:
+			goto tr56
+		case 108:
+			goto st379
+		case 119:
+			goto tr529
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st379:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof379
+		}
+	st_case_379:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr530
+		case 76:
+			goto st380
+		case 95:
+			goto tr56
+		case 101:
+			goto tr530
+		case 108:
+			goto st380
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st380:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof380
+		}
+	st_case_380:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 66:
+			goto st381
+		case 95:
+			goto tr56
+		case 98:
+			goto st381
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st381:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof381
+		}
+	st_case_381:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st382
+		case 95:
+			goto tr56
+		case 97:
+			goto st382
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st382:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof382
+		}
+	st_case_382:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st383
+		case 95:
+			goto tr56
+		case 99:
+			goto st383
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st383:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof383
+		}
+	st_case_383:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 75:
+			goto tr535
+		case 95:
+			goto tr56
+		case 107:
+			goto tr535
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st384:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof384
+		}
+	st_case_384:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st385
+		case 72:
+			goto st410
+		case 75:
+			goto st414
+		case 77:
+			goto st416
+		case 84:
+			goto st422
+		case 95:
+			goto tr56
+		case 101:
+			goto st385
+		case 104:
+			goto st410
+		case 107:
+			goto st414
+		case 109:
+			goto st416
+		case 116:
+			goto st422
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st385:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof385
+		}
+	st_case_385:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st386
+		case 67:
+			goto st389
+		case 76:
+			goto st392
+		case 82:
+			goto st395
+		case 83:
+			goto st404
+		case 84:
+			goto st408
+		case 95:
+			goto tr56
+		case 97:
+			goto st386
+		case 99:
+			goto st389
+		case 108:
+			goto st392
+		case 114:
+			goto st395
+		case 115:
+			goto st404
+		case 116:
+			goto st408
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st386:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof386
+		}
+	st_case_386:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st387
+		case 95:
+			goto tr56
+		case 114:
+			goto st387
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st387:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof387
+		}
+	st_case_387:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st388
+		case 95:
+			goto tr56
+		case 99:
+			goto st388
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st388:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof388
+		}
+	st_case_388:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 72:
+			goto tr549
+		case 95:
+			goto tr56
+		case 104:
+			goto tr549
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st389:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof389
+		}
+	st_case_389:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st390
+		case 95:
+			goto tr56
+		case 111:
+			goto st390
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st390:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof390
+		}
+	st_case_390:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st391
+		case 95:
+			goto tr56
+		case 110:
+			goto st391
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st391:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof391
+		}
+	st_case_391:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto tr552
+		case 95:
+			goto tr56
+		case 100:
+			goto tr552
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st392:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof392
+		}
+	st_case_392:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st393
+		case 95:
+			goto tr56
+		case 101:
+			goto st393
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st393:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof393
+		}
+	st_case_393:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st394
+		case 95:
+			goto tr56
+		case 99:
+			goto st394
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st394:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof394
+		}
+	st_case_394:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr555
+		case 95:
+			goto tr56
+		case 116:
+			goto tr555
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st395:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof395
+		}
+	st_case_395:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st396
+		case 95:
+			goto tr56
+		case 105:
+			goto st396
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st396:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof396
+		}
+	st_case_396:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st397
+		case 95:
+			goto tr56
+		case 97:
+			goto st397
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st397:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof397
+		}
+	st_case_397:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st398
+		case 95:
+			goto tr56
+		case 108:
+			goto st398
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st398:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof398
+		}
+	st_case_398:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st399
+		case 95:
+			goto tr56
+		case 105:
+			goto st399
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st399:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof399
+		}
+	st_case_399:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 90:
+			goto st400
+		case 95:
+			goto tr56
+		case 122:
+			goto st400
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 89:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 121 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st400:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof400
+		}
+	st_case_400:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st401
+		case 95:
+			goto tr56
+		case 97:
+			goto st401
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st401:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof401
+		}
+	st_case_401:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 66:
+			goto st402
+		case 95:
+			goto tr56
+		case 98:
+			goto st402
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st402:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof402
+		}
+	st_case_402:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st403
+		case 95:
+			goto tr56
+		case 108:
+			goto st403
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st403:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof403
+		}
+	st_case_403:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr564
+		case 95:
+			goto tr56
+		case 101:
+			goto tr564
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st404:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof404
+		}
+	st_case_404:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st405
+		case 95:
+			goto tr56
+		case 115:
+			goto st405
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st405:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof405
+		}
+	st_case_405:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st406
+		case 95:
+			goto tr56
+		case 105:
+			goto st406
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st406:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof406
+		}
+	st_case_406:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st407
+		case 95:
+			goto tr56
+		case 111:
+			goto st407
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st407:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof407
+		}
+	st_case_407:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr568
+		case 95:
+			goto tr56
+		case 110:
+			goto tr568
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st408:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof408
+		}
+	st_case_408:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st409
+		case 95:
+			goto tr56
+		case 111:
+			goto st409
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr569
+	st409:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof409
+		}
+	st_case_409:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 70:
+			goto tr571
+		case 95:
+			goto tr56
+		case 102:
+			goto tr571
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st410:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof410
+		}
+	st_case_410:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st411
+		case 79:
+			goto st413
+		case 95:
+			goto tr56
+		case 97:
+			goto st411
+		case 111:
+			goto st413
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st411:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof411
+		}
+	st_case_411:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st412
+		case 95:
+			goto tr56
+		case 114:
+			goto st412
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st412:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof412
+		}
+	st_case_412:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr575
+		case 95:
+			goto tr56
+		case 101:
+			goto tr575
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st413:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof413
+		}
+	st_case_413:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 87:
+			goto tr576
+		case 95:
+			goto tr56
+		case 119:
+			goto tr576
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st414:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof414
+		}
+	st_case_414:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st415
+		case 95:
+			goto tr56
+		case 105:
+			goto st415
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st415:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof415
+		}
+	st_case_415:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 80:
+			goto tr578
+		case 95:
+			goto tr56
+		case 112:
+			goto tr578
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st416:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof416
+		}
+	st_case_416:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st417
+		case 95:
+			goto tr56
+		case 97:
+			goto st417
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st417:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof417
+		}
+	st_case_417:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st418
+		case 95:
+			goto tr56
+		case 108:
+			goto st418
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st418:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof418
+		}
+	st_case_418:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st419
+		case 95:
+			goto tr56
+		case 108:
+			goto st419
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st419:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof419
+		}
+	st_case_419:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st420
+		case 95:
+			goto tr56
+		case 105:
+			goto st420
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st420:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof420
+		}
+	st_case_420:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st421
+		case 95:
+			goto tr56
+		case 110:
+			goto st421
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st421:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof421
+		}
+	st_case_421:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr584
+		case 95:
+			goto tr56
+		case 116:
+			goto tr584
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st422:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof422
+		}
+	st_case_422:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st423
+		case 68:
+			goto st425
+		case 95:
+			goto tr56
+		case 97:
+			goto st423
+		case 100:
+			goto st425
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st423:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof423
+		}
+	st_case_423:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto st424
+		case 95:
+			goto tr56
+		case 114:
+			goto st424
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st424:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof424
+		}
+	st_case_424:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr588
+		case 95:
+			goto tr56
+		case 116:
+			goto tr588
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st425:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof425
+		}
+	st_case_425:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st426
+		case 79:
+			goto st427
+		case 95:
+			goto tr56
+		case 105:
+			goto st426
+		case 111:
+			goto st427
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st426:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof426
+		}
+	st_case_426:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr591
+		case 95:
+			goto tr56
+		case 110:
+			goto tr591
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st427:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof427
+		}
+	st_case_427:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st428
+		case 95:
+			goto tr56
+		case 117:
+			goto st428
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st428:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof428
+		}
+	st_case_428:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr593
+		case 95:
+			goto tr56
+		case 116:
+			goto tr593
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st429:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof429
+		}
+	st_case_429:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st430
+		case 72:
+			goto st433
+		case 73:
+			goto st435
+		case 79:
+			goto tr597
+		case 82:
+			goto st437
+		case 95:
+			goto tr56
+		case 97:
+			goto st430
+		case 104:
+			goto st433
+		case 105:
+			goto st435
+		case 111:
+			goto tr597
+		case 114:
+			goto st437
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st430:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof430
+		}
+	st_case_430:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 66:
+			goto st431
+		case 95:
+			goto tr56
+		case 98:
+			goto st431
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st431:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof431
+		}
+	st_case_431:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 76:
+			goto st432
+		case 95:
+			goto tr56
+		case 108:
+			goto st432
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st432:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof432
+		}
+	st_case_432:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr601
+		case 95:
+			goto tr56
+		case 101:
+			goto tr601
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st433:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof433
+		}
+	st_case_433:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st434
+		case 95:
+			goto tr56
+		case 101:
+			goto st434
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st434:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof434
+		}
+	st_case_434:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr603
+		case 95:
+			goto tr56
+		case 110:
+			goto tr603
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st435:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof435
+		}
+	st_case_435:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st436
+		case 95:
+			goto tr56
+		case 109:
+			goto st436
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st436:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof436
+		}
+	st_case_436:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr605
+		case 95:
+			goto tr56
+		case 101:
+			goto tr605
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st437:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof437
+		}
+	st_case_437:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st438
+		case 85:
+			goto st446
+		case 95:
+			goto tr56
+		case 97:
+			goto st438
+		case 117:
+			goto st446
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st438:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof438
+		}
+	st_case_438:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st439
+		case 95:
+			goto tr56
+		case 110:
+			goto st439
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st439:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof439
+		}
+	st_case_439:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto st440
+		case 95:
+			goto tr56
+		case 115:
+			goto st440
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st440:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof440
+		}
+	st_case_440:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st441
+		case 95:
+			goto tr56
+		case 97:
+			goto st441
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st441:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof441
+		}
+	st_case_441:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st442
+		case 95:
+			goto tr56
+		case 99:
+			goto st442
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st442:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof442
+		}
+	st_case_442:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st443
+		case 95:
+			goto tr56
+		case 116:
+			goto st443
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st443:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof443
+		}
+	st_case_443:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st444
+		case 95:
+			goto tr56
+		case 105:
+			goto st444
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st444:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof444
+		}
+	st_case_444:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st445
+		case 95:
+			goto tr56
+		case 111:
+			goto st445
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st445:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof445
+		}
+	st_case_445:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr615
+		case 95:
+			goto tr56
+		case 110:
+			goto tr615
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st446:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof446
+		}
+	st_case_446:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr616
+		case 78:
+			goto st447
+		case 95:
+			goto tr56
+		case 101:
+			goto tr616
+		case 110:
+			goto st447
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st447:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof447
+		}
+	st_case_447:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st448
+		case 95:
+			goto tr56
+		case 99:
+			goto st448
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st448:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof448
+		}
+	st_case_448:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st449
+		case 95:
+			goto tr56
+		case 97:
+			goto st449
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st449:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof449
+		}
+	st_case_449:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st450
+		case 95:
+			goto tr56
+		case 116:
+			goto st450
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st450:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof450
+		}
+	st_case_450:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr621
+		case 95:
+			goto tr56
+		case 101:
+			goto tr621
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st451:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof451
+		}
+	st_case_451:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st452
+		case 80:
+			goto st463
+		case 95:
+			goto tr56
+		case 110:
+			goto st452
+		case 112:
+			goto st463
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st452:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof452
+		}
+	st_case_452:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st453
+		case 73:
+			goto st461
+		case 95:
+			goto tr56
+		case 99:
+			goto st453
+		case 105:
+			goto st461
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st453:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof453
+		}
+	st_case_453:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st454
+		case 95:
+			goto tr56
+		case 111:
+			goto st454
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st454:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof454
+		}
+	st_case_454:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st455
+		case 95:
+			goto tr56
+		case 109:
+			goto st455
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st455:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof455
+		}
+	st_case_455:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto st456
+		case 95:
+			goto tr56
+		case 109:
+			goto st456
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st456:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof456
+		}
+	st_case_456:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st457
+		case 95:
+			goto tr56
+		case 105:
+			goto st457
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st457:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof457
+		}
+	st_case_457:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st458
+		case 95:
+			goto tr56
+		case 116:
+			goto st458
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st458:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof458
+		}
+	st_case_458:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st459
+		case 95:
+			goto tr56
+		case 116:
+			goto st459
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st459:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof459
+		}
+	st_case_459:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st460
+		case 95:
+			goto tr56
+		case 101:
+			goto st460
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st460:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof460
+		}
+	st_case_460:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto tr633
+		case 95:
+			goto tr56
+		case 100:
+			goto tr633
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st461:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof461
+		}
+	st_case_461:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st462
+		case 95:
+			goto tr56
+		case 111:
+			goto st462
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st462:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof462
+		}
+	st_case_462:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr635
+		case 95:
+			goto tr56
+		case 110:
+			goto tr635
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st463:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof463
+		}
+	st_case_463:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 68:
+			goto st464
+		case 95:
+			goto tr56
+		case 100:
+			goto st464
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st464:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof464
+		}
+	st_case_464:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st465
+		case 95:
+			goto tr56
+		case 97:
+			goto st465
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st465:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof465
+		}
+	st_case_465:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st466
+		case 95:
+			goto tr56
+		case 116:
+			goto st466
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st466:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof466
+		}
+	st_case_466:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr639
+		case 95:
+			goto tr56
+		case 101:
+			goto tr639
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st467:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof467
+		}
+	st_case_467:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st468
+		case 95:
+			goto tr56
+		case 97:
+			goto st468
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st468:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof468
+		}
+	st_case_468:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st469
+		case 76:
+			goto st472
+		case 82:
+			goto st475
+		case 95:
+			goto tr56
+		case 99:
+			goto st469
+		case 108:
+			goto st472
+		case 114:
+			goto st475
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st469:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof469
+		}
+	st_case_469:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st470
+		case 95:
+			goto tr56
+		case 117:
+			goto st470
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st470:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof470
+		}
+	st_case_470:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st471
+		case 95:
+			goto tr56
+		case 117:
+			goto st471
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st471:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof471
+		}
+	st_case_471:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 77:
+			goto tr646
+		case 95:
+			goto tr56
+		case 109:
+			goto tr646
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st472:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof472
+		}
+	st_case_472:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st473
+		case 95:
+			goto tr56
+		case 117:
+			goto st473
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st473:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof473
+		}
+	st_case_473:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st474
+		case 95:
+			goto tr56
+		case 101:
+			goto st474
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st474:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof474
+		}
+	st_case_474:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 83:
+			goto tr649
+		case 95:
+			goto tr56
+		case 115:
+			goto tr649
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st475:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof475
+		}
+	st_case_475:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 67:
+			goto st476
+		case 95:
+			goto tr56
+		case 99:
+			goto st476
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st476:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof476
+		}
+	st_case_476:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 72:
+			goto st477
+		case 95:
+			goto tr56
+		case 104:
+			goto st477
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st477:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof477
+		}
+	st_case_477:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st478
+		case 95:
+			goto tr56
+		case 97:
+			goto st478
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st478:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof478
+		}
+	st_case_478:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr653
+		case 95:
+			goto tr56
+		case 114:
+			goto tr653
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st479:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof479
+		}
+	st_case_479:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 72:
+			goto st480
+		case 73:
+			goto st483
+		case 82:
+			goto st488
+		case 95:
+			goto tr56
+		case 104:
+			goto st480
+		case 105:
+			goto st483
+		case 114:
+			goto st488
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st480:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof480
+		}
+	st_case_480:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st481
+		case 95:
+			goto tr56
+		case 101:
+			goto st481
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st481:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof481
+		}
+	st_case_481:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto tr658
+		case 82:
+			goto st482
+		case 95:
+			goto tr56
+		case 110:
+			goto tr658
+		case 114:
+			goto st482
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st482:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof482
+		}
+	st_case_482:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr660
+		case 95:
+			goto tr56
+		case 101:
+			goto tr660
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st483:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof483
+		}
+	st_case_483:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st484
+		case 95:
+			goto tr56
+		case 116:
+			goto st484
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st484:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof484
+		}
+	st_case_484:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 72:
+			goto st485
+		case 95:
+			goto tr56
+		case 104:
+			goto st485
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st485:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof485
+		}
+	st_case_485:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st486
+		case 95:
+			goto tr56
+		case 111:
+			goto st486
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr663
+	st486:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof486
+		}
+	st_case_486:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 85:
+			goto st487
+		case 95:
+			goto tr56
+		case 117:
+			goto st487
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st487:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof487
+		}
+	st_case_487:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto tr666
+		case 95:
+			goto tr56
+		case 116:
+			goto tr666
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st488:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof488
+		}
+	st_case_488:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 73:
+			goto st489
+		case 95:
+			goto tr56
+		case 105:
+			goto st489
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st489:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof489
+		}
+	st_case_489:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 84:
+			goto st490
+		case 95:
+			goto tr56
+		case 116:
+			goto st490
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st490:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof490
+		}
+	st_case_490:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr669
+		case 95:
+			goto tr56
+		case 101:
+			goto tr669
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st491:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof491
+		}
+	st_case_491:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto st492
+		case 95:
+			goto tr56
+		case 101:
+			goto st492
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st492:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof492
+		}
+	st_case_492:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 65:
+			goto st493
+		case 95:
+			goto tr56
+		case 97:
+			goto st493
+		}
+		switch {
+		case lex.data[(lex.p)] < 66:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 98 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st493:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof493
+		}
+	st_case_493:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 82:
+			goto tr672
+		case 95:
+			goto tr56
+		case 114:
+			goto tr672
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st494:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof494
+		}
+	st_case_494:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 79:
+			goto st495
+		case 95:
+			goto tr56
+		case 111:
+			goto st495
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st495:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof495
+		}
+	st_case_495:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 78:
+			goto st496
+		case 95:
+			goto tr56
+		case 110:
+			goto st496
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st496:
+		if (lex.p)++; (lex.p) == (lex.pe) {
+			goto _test_eof496
+		}
+	st_case_496:
+		switch lex.data[(lex.p)] {
+		case 36:
+			goto tr56
+		case 69:
+			goto tr675
+		case 95:
+			goto tr56
+		case 101:
+			goto tr675
+		}
+		switch {
+		case lex.data[(lex.p)] < 65:
+			if 48 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 57 {
+				goto tr56
+			}
+		case lex.data[(lex.p)] > 90:
+			if 97 <= lex.data[(lex.p)] && lex.data[(lex.p)] <= 122 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto tr81
+	st_out:
+	_test_eof9:
+		lex.cs = 9
+		goto _test_eof
+	_test_eof10:
+		lex.cs = 10
+		goto _test_eof
+	_test_eof11:
+		lex.cs = 11
+		goto _test_eof
+	_test_eof12:
+		lex.cs = 12
+		goto _test_eof
+	_test_eof1:
+		lex.cs = 1
+		goto _test_eof
+	_test_eof2:
+		lex.cs = 2
+		goto _test_eof
+	_test_eof3:
+		lex.cs = 3
+		goto _test_eof
+	_test_eof13:
+		lex.cs = 13
+		goto _test_eof
+	_test_eof4:
+		lex.cs = 4
+		goto _test_eof
+	_test_eof14:
+		lex.cs = 14
+		goto _test_eof
+	_test_eof15:
+		lex.cs = 15
+		goto _test_eof
+	_test_eof16:
+		lex.cs = 16
+		goto _test_eof
+	_test_eof5:
+		lex.cs = 5
+		goto _test_eof
+	_test_eof17:
+		lex.cs = 17
+		goto _test_eof
+	_test_eof18:
+		lex.cs = 18
+		goto _test_eof
+	_test_eof6:
+		lex.cs = 6
+		goto _test_eof
+	_test_eof7:
+		lex.cs = 7
+		goto _test_eof
+	_test_eof8:
+		lex.cs = 8
+		goto _test_eof
+	_test_eof19:
+		lex.cs = 19
+		goto _test_eof
+	_test_eof20:
+		lex.cs = 20
+		goto _test_eof
+	_test_eof21:
+		lex.cs = 21
+		goto _test_eof
+	_test_eof22:
+		lex.cs = 22
+		goto _test_eof
+	_test_eof23:
+		lex.cs = 23
+		goto _test_eof
+	_test_eof24:
+		lex.cs = 24
+		goto _test_eof
+	_test_eof25:
+		lex.cs = 25
+		goto _test_eof
+	_test_eof26:
+		lex.cs = 26
+		goto _test_eof
+	_test_eof27:
+		lex.cs = 27
+		goto _test_eof
+	_test_eof28:
+		lex.cs = 28
+		goto _test_eof
+	_test_eof29:
+		lex.cs = 29
+		goto _test_eof
+	_test_eof30:
+		lex.cs = 30
+		goto _test_eof
+	_test_eof31:
+		lex.cs = 31
+		goto _test_eof
+	_test_eof32:
+		lex.cs = 32
+		goto _test_eof
+	_test_eof33:
+		lex.cs = 33
+		goto _test_eof
+	_test_eof34:
+		lex.cs = 34
+		goto _test_eof
+	_test_eof35:
+		lex.cs = 35
+		goto _test_eof
+	_test_eof36:
+		lex.cs = 36
+		goto _test_eof
+	_test_eof37:
+		lex.cs = 37
+		goto _test_eof
+	_test_eof38:
+		lex.cs = 38
+		goto _test_eof
+	_test_eof39:
+		lex.cs = 39
+		goto _test_eof
+	_test_eof40:
+		lex.cs = 40
+		goto _test_eof
+	_test_eof41:
+		lex.cs = 41
+		goto _test_eof
+	_test_eof42:
+		lex.cs = 42
+		goto _test_eof
+	_test_eof43:
+		lex.cs = 43
+		goto _test_eof
+	_test_eof44:
+		lex.cs = 44
+		goto _test_eof
+	_test_eof45:
+		lex.cs = 45
+		goto _test_eof
+	_test_eof46:
+		lex.cs = 46
+		goto _test_eof
+	_test_eof47:
+		lex.cs = 47
+		goto _test_eof
+	_test_eof48:
+		lex.cs = 48
+		goto _test_eof
+	_test_eof49:
+		lex.cs = 49
+		goto _test_eof
+	_test_eof50:
+		lex.cs = 50
+		goto _test_eof
+	_test_eof51:
+		lex.cs = 51
+		goto _test_eof
+	_test_eof52:
+		lex.cs = 52
+		goto _test_eof
+	_test_eof53:
+		lex.cs = 53
+		goto _test_eof
+	_test_eof54:
+		lex.cs = 54
+		goto _test_eof
+	_test_eof55:
+		lex.cs = 55
+		goto _test_eof
+	_test_eof56:
+		lex.cs = 56
+		goto _test_eof
+	_test_eof57:
+		lex.cs = 57
+		goto _test_eof
+	_test_eof58:
+		lex.cs = 58
+		goto _test_eof
+	_test_eof59:
+		lex.cs = 59
+		goto _test_eof
+	_test_eof60:
+		lex.cs = 60
+		goto _test_eof
+	_test_eof61:
+		lex.cs = 61
+		goto _test_eof
+	_test_eof62:
+		lex.cs = 62
+		goto _test_eof
+	_test_eof63:
+		lex.cs = 63
+		goto _test_eof
+	_test_eof64:
+		lex.cs = 64
+		goto _test_eof
+	_test_eof65:
+		lex.cs = 65
+		goto _test_eof
+	_test_eof66:
+		lex.cs = 66
+		goto _test_eof
+	_test_eof67:
+		lex.cs = 67
+		goto _test_eof
+	_test_eof68:
+		lex.cs = 68
+		goto _test_eof
+	_test_eof69:
+		lex.cs = 69
+		goto _test_eof
+	_test_eof70:
+		lex.cs = 70
+		goto _test_eof
+	_test_eof71:
+		lex.cs = 71
+		goto _test_eof
+	_test_eof72:
+		lex.cs = 72
+		goto _test_eof
+	_test_eof73:
+		lex.cs = 73
+		goto _test_eof
+	_test_eof74:
+		lex.cs = 74
+		goto _test_eof
+	_test_eof75:
+		lex.cs = 75
+		goto _test_eof
+	_test_eof76:
+		lex.cs = 76
+		goto _test_eof
+	_test_eof77:
+		lex.cs = 77
+		goto _test_eof
+	_test_eof78:
+		lex.cs = 78
+		goto _test_eof
+	_test_eof79:
+		lex.cs = 79
+		goto _test_eof
+	_test_eof80:
+		lex.cs = 80
+		goto _test_eof
+	_test_eof81:
+		lex.cs = 81
+		goto _test_eof
+	_test_eof82:
+		lex.cs = 82
+		goto _test_eof
+	_test_eof83:
+		lex.cs = 83
+		goto _test_eof
+	_test_eof84:
+		lex.cs = 84
+		goto _test_eof
+	_test_eof85:
+		lex.cs = 85
+		goto _test_eof
+	_test_eof86:
+		lex.cs = 86
+		goto _test_eof
+	_test_eof87:
+		lex.cs = 87
+		goto _test_eof
+	_test_eof88:
+		lex.cs = 88
+		goto _test_eof
+	_test_eof89:
+		lex.cs = 89
+		goto _test_eof
+	_test_eof90:
+		lex.cs = 90
+		goto _test_eof
+	_test_eof91:
+		lex.cs = 91
+		goto _test_eof
+	_test_eof92:
+		lex.cs = 92
+		goto _test_eof
+	_test_eof93:
+		lex.cs = 93
+		goto _test_eof
+	_test_eof94:
+		lex.cs = 94
+		goto _test_eof
+	_test_eof95:
+		lex.cs = 95
+		goto _test_eof
+	_test_eof96:
+		lex.cs = 96
+		goto _test_eof
+	_test_eof97:
+		lex.cs = 97
+		goto _test_eof
+	_test_eof98:
+		lex.cs = 98
+		goto _test_eof
+	_test_eof99:
+		lex.cs = 99
+		goto _test_eof
+	_test_eof100:
+		lex.cs = 100
+		goto _test_eof
+	_test_eof101:
+		lex.cs = 101
+		goto _test_eof
+	_test_eof102:
+		lex.cs = 102
+		goto _test_eof
+	_test_eof103:
+		lex.cs = 103
+		goto _test_eof
+	_test_eof104:
+		lex.cs = 104
+		goto _test_eof
+	_test_eof105:
+		lex.cs = 105
+		goto _test_eof
+	_test_eof106:
+		lex.cs = 106
+		goto _test_eof
+	_test_eof107:
+		lex.cs = 107
+		goto _test_eof
+	_test_eof108:
+		lex.cs = 108
+		goto _test_eof
+	_test_eof109:
+		lex.cs = 109
+		goto _test_eof
+	_test_eof110:
+		lex.cs = 110
+		goto _test_eof
+	_test_eof111:
+		lex.cs = 111
+		goto _test_eof
+	_test_eof112:
+		lex.cs = 112
+		goto _test_eof
+	_test_eof113:
+		lex.cs = 113
+		goto _test_eof
+	_test_eof114:
+		lex.cs = 114
+		goto _test_eof
+	_test_eof115:
+		lex.cs = 115
+		goto _test_eof
+	_test_eof116:
+		lex.cs = 116
+		goto _test_eof
+	_test_eof117:
+		lex.cs = 117
+		goto _test_eof
+	_test_eof118:
+		lex.cs = 118
+		goto _test_eof
+	_test_eof119:
+		lex.cs = 119
+		goto _test_eof
+	_test_eof120:
+		lex.cs = 120
+		goto _test_eof
+	_test_eof121:
+		lex.cs = 121
+		goto _test_eof
+	_test_eof122:
+		lex.cs = 122
+		goto _test_eof
+	_test_eof123:
+		lex.cs = 123
+		goto _test_eof
+	_test_eof124:
+		lex.cs = 124
+		goto _test_eof
+	_test_eof125:
+		lex.cs = 125
+		goto _test_eof
+	_test_eof126:
+		lex.cs = 126
+		goto _test_eof
+	_test_eof127:
+		lex.cs = 127
+		goto _test_eof
+	_test_eof128:
+		lex.cs = 128
+		goto _test_eof
+	_test_eof129:
+		lex.cs = 129
+		goto _test_eof
+	_test_eof130:
+		lex.cs = 130
+		goto _test_eof
+	_test_eof131:
+		lex.cs = 131
+		goto _test_eof
+	_test_eof132:
+		lex.cs = 132
+		goto _test_eof
+	_test_eof133:
+		lex.cs = 133
+		goto _test_eof
+	_test_eof134:
+		lex.cs = 134
+		goto _test_eof
+	_test_eof135:
+		lex.cs = 135
+		goto _test_eof
+	_test_eof136:
+		lex.cs = 136
+		goto _test_eof
+	_test_eof137:
+		lex.cs = 137
+		goto _test_eof
+	_test_eof138:
+		lex.cs = 138
+		goto _test_eof
+	_test_eof139:
+		lex.cs = 139
+		goto _test_eof
+	_test_eof140:
+		lex.cs = 140
+		goto _test_eof
+	_test_eof141:
+		lex.cs = 141
+		goto _test_eof
+	_test_eof142:
+		lex.cs = 142
+		goto _test_eof
+	_test_eof143:
+		lex.cs = 143
+		goto _test_eof
+	_test_eof144:
+		lex.cs = 144
+		goto _test_eof
+	_test_eof145:
+		lex.cs = 145
+		goto _test_eof
+	_test_eof146:
+		lex.cs = 146
+		goto _test_eof
+	_test_eof147:
+		lex.cs = 147
+		goto _test_eof
+	_test_eof148:
+		lex.cs = 148
+		goto _test_eof
+	_test_eof149:
+		lex.cs = 149
+		goto _test_eof
+	_test_eof150:
+		lex.cs = 150
+		goto _test_eof
+	_test_eof151:
+		lex.cs = 151
+		goto _test_eof
+	_test_eof152:
+		lex.cs = 152
+		goto _test_eof
+	_test_eof153:
+		lex.cs = 153
+		goto _test_eof
+	_test_eof154:
+		lex.cs = 154
+		goto _test_eof
+	_test_eof155:
+		lex.cs = 155
+		goto _test_eof
+	_test_eof156:
+		lex.cs = 156
+		goto _test_eof
+	_test_eof157:
+		lex.cs = 157
+		goto _test_eof
+	_test_eof158:
+		lex.cs = 158
+		goto _test_eof
+	_test_eof159:
+		lex.cs = 159
+		goto _test_eof
+	_test_eof160:
+		lex.cs = 160
+		goto _test_eof
+	_test_eof161:
+		lex.cs = 161
+		goto _test_eof
+	_test_eof162:
+		lex.cs = 162
+		goto _test_eof
+	_test_eof163:
+		lex.cs = 163
+		goto _test_eof
+	_test_eof164:
+		lex.cs = 164
+		goto _test_eof
+	_test_eof165:
+		lex.cs = 165
+		goto _test_eof
+	_test_eof166:
+		lex.cs = 166
+		goto _test_eof
+	_test_eof167:
+		lex.cs = 167
+		goto _test_eof
+	_test_eof168:
+		lex.cs = 168
+		goto _test_eof
+	_test_eof169:
+		lex.cs = 169
+		goto _test_eof
+	_test_eof170:
+		lex.cs = 170
+		goto _test_eof
+	_test_eof171:
+		lex.cs = 171
+		goto _test_eof
+	_test_eof172:
+		lex.cs = 172
+		goto _test_eof
+	_test_eof173:
+		lex.cs = 173
+		goto _test_eof
+	_test_eof174:
+		lex.cs = 174
+		goto _test_eof
+	_test_eof175:
+		lex.cs = 175
+		goto _test_eof
+	_test_eof176:
+		lex.cs = 176
+		goto _test_eof
+	_test_eof177:
+		lex.cs = 177
+		goto _test_eof
+	_test_eof178:
+		lex.cs = 178
+		goto _test_eof
+	_test_eof179:
+		lex.cs = 179
+		goto _test_eof
+	_test_eof180:
+		lex.cs = 180
+		goto _test_eof
+	_test_eof181:
+		lex.cs = 181
+		goto _test_eof
+	_test_eof182:
+		lex.cs = 182
+		goto _test_eof
+	_test_eof183:
+		lex.cs = 183
+		goto _test_eof
+	_test_eof184:
+		lex.cs = 184
+		goto _test_eof
+	_test_eof185:
+		lex.cs = 185
+		goto _test_eof
+	_test_eof186:
+		lex.cs = 186
+		goto _test_eof
+	_test_eof187:
+		lex.cs = 187
+		goto _test_eof
+	_test_eof188:
+		lex.cs = 188
+		goto _test_eof
+	_test_eof189:
+		lex.cs = 189
+		goto _test_eof
+	_test_eof190:
+		lex.cs = 190
+		goto _test_eof
+	_test_eof191:
+		lex.cs = 191
+		goto _test_eof
+	_test_eof192:
+		lex.cs = 192
+		goto _test_eof
+	_test_eof193:
+		lex.cs = 193
+		goto _test_eof
+	_test_eof194:
+		lex.cs = 194
+		goto _test_eof
+	_test_eof195:
+		lex.cs = 195
+		goto _test_eof
+	_test_eof196:
+		lex.cs = 196
+		goto _test_eof
+	_test_eof197:
+		lex.cs = 197
+		goto _test_eof
+	_test_eof198:
+		lex.cs = 198
+		goto _test_eof
+	_test_eof199:
+		lex.cs = 199
+		goto _test_eof
+	_test_eof200:
+		lex.cs = 200
+		goto _test_eof
+	_test_eof201:
+		lex.cs = 201
+		goto _test_eof
+	_test_eof202:
+		lex.cs = 202
+		goto _test_eof
+	_test_eof203:
+		lex.cs = 203
+		goto _test_eof
+	_test_eof204:
+		lex.cs = 204
+		goto _test_eof
+	_test_eof205:
+		lex.cs = 205
+		goto _test_eof
+	_test_eof206:
+		lex.cs = 206
+		goto _test_eof
+	_test_eof207:
+		lex.cs = 207
+		goto _test_eof
+	_test_eof208:
+		lex.cs = 208
+		goto _test_eof
+	_test_eof209:
+		lex.cs = 209
+		goto _test_eof
+	_test_eof210:
+		lex.cs = 210
+		goto _test_eof
+	_test_eof211:
+		lex.cs = 211
+		goto _test_eof
+	_test_eof212:
+		lex.cs = 212
+		goto _test_eof
+	_test_eof213:
+		lex.cs = 213
+		goto _test_eof
+	_test_eof214:
+		lex.cs = 214
+		goto _test_eof
+	_test_eof215:
+		lex.cs = 215
+		goto _test_eof
+	_test_eof216:
+		lex.cs = 216
+		goto _test_eof
+	_test_eof217:
+		lex.cs = 217
+		goto _test_eof
+	_test_eof218:
+		lex.cs = 218
+		goto _test_eof
+	_test_eof219:
+		lex.cs = 219
+		goto _test_eof
+	_test_eof220:
+		lex.cs = 220
+		goto _test_eof
+	_test_eof221:
+		lex.cs = 221
+		goto _test_eof
+	_test_eof222:
+		lex.cs = 222
+		goto _test_eof
+	_test_eof223:
+		lex.cs = 223
+		goto _test_eof
+	_test_eof224:
+		lex.cs = 224
+		goto _test_eof
+	_test_eof225:
+		lex.cs = 225
+		goto _test_eof
+	_test_eof226:
+		lex.cs = 226
+		goto _test_eof
+	_test_eof227:
+		lex.cs = 227
+		goto _test_eof
+	_test_eof228:
+		lex.cs = 228
+		goto _test_eof
+	_test_eof229:
+		lex.cs = 229
+		goto _test_eof
+	_test_eof230:
+		lex.cs = 230
+		goto _test_eof
+	_test_eof231:
+		lex.cs = 231
+		goto _test_eof
+	_test_eof232:
+		lex.cs = 232
+		goto _test_eof
+	_test_eof233:
+		lex.cs = 233
+		goto _test_eof
+	_test_eof234:
+		lex.cs = 234
+		goto _test_eof
+	_test_eof235:
+		lex.cs = 235
+		goto _test_eof
+	_test_eof236:
+		lex.cs = 236
+		goto _test_eof
+	_test_eof237:
+		lex.cs = 237
+		goto _test_eof
+	_test_eof238:
+		lex.cs = 238
+		goto _test_eof
+	_test_eof239:
+		lex.cs = 239
+		goto _test_eof
+	_test_eof240:
+		lex.cs = 240
+		goto _test_eof
+	_test_eof241:
+		lex.cs = 241
+		goto _test_eof
+	_test_eof242:
+		lex.cs = 242
+		goto _test_eof
+	_test_eof243:
+		lex.cs = 243
+		goto _test_eof
+	_test_eof244:
+		lex.cs = 244
+		goto _test_eof
+	_test_eof245:
+		lex.cs = 245
+		goto _test_eof
+	_test_eof246:
+		lex.cs = 246
+		goto _test_eof
+	_test_eof247:
+		lex.cs = 247
+		goto _test_eof
+	_test_eof248:
+		lex.cs = 248
+		goto _test_eof
+	_test_eof249:
+		lex.cs = 249
+		goto _test_eof
+	_test_eof250:
+		lex.cs = 250
+		goto _test_eof
+	_test_eof251:
+		lex.cs = 251
+		goto _test_eof
+	_test_eof252:
+		lex.cs = 252
+		goto _test_eof
+	_test_eof253:
+		lex.cs = 253
+		goto _test_eof
+	_test_eof254:
+		lex.cs = 254
+		goto _test_eof
+	_test_eof255:
+		lex.cs = 255
+		goto _test_eof
+	_test_eof256:
+		lex.cs = 256
+		goto _test_eof
+	_test_eof257:
+		lex.cs = 257
+		goto _test_eof
+	_test_eof258:
+		lex.cs = 258
+		goto _test_eof
+	_test_eof259:
+		lex.cs = 259
+		goto _test_eof
+	_test_eof260:
+		lex.cs = 260
+		goto _test_eof
+	_test_eof261:
+		lex.cs = 261
+		goto _test_eof
+	_test_eof262:
+		lex.cs = 262
+		goto _test_eof
+	_test_eof263:
+		lex.cs = 263
+		goto _test_eof
+	_test_eof264:
+		lex.cs = 264
+		goto _test_eof
+	_test_eof265:
+		lex.cs = 265
+		goto _test_eof
+	_test_eof266:
+		lex.cs = 266
+		goto _test_eof
+	_test_eof267:
+		lex.cs = 267
+		goto _test_eof
+	_test_eof268:
+		lex.cs = 268
+		goto _test_eof
+	_test_eof269:
+		lex.cs = 269
+		goto _test_eof
+	_test_eof270:
+		lex.cs = 270
+		goto _test_eof
+	_test_eof271:
+		lex.cs = 271
+		goto _test_eof
+	_test_eof272:
+		lex.cs = 272
+		goto _test_eof
+	_test_eof273:
+		lex.cs = 273
+		goto _test_eof
+	_test_eof274:
+		lex.cs = 274
+		goto _test_eof
+	_test_eof275:
+		lex.cs = 275
+		goto _test_eof
+	_test_eof276:
+		lex.cs = 276
+		goto _test_eof
+	_test_eof277:
+		lex.cs = 277
+		goto _test_eof
+	_test_eof278:
+		lex.cs = 278
+		goto _test_eof
+	_test_eof279:
+		lex.cs = 279
+		goto _test_eof
+	_test_eof280:
+		lex.cs = 280
+		goto _test_eof
+	_test_eof281:
+		lex.cs = 281
+		goto _test_eof
+	_test_eof282:
+		lex.cs = 282
+		goto _test_eof
+	_test_eof283:
+		lex.cs = 283
+		goto _test_eof
+	_test_eof284:
+		lex.cs = 284
+		goto _test_eof
+	_test_eof285:
+		lex.cs = 285
+		goto _test_eof
+	_test_eof286:
+		lex.cs = 286
+		goto _test_eof
+	_test_eof287:
+		lex.cs = 287
+		goto _test_eof
+	_test_eof288:
+		lex.cs = 288
+		goto _test_eof
+	_test_eof289:
+		lex.cs = 289
+		goto _test_eof
+	_test_eof290:
+		lex.cs = 290
+		goto _test_eof
+	_test_eof291:
+		lex.cs = 291
+		goto _test_eof
+	_test_eof292:
+		lex.cs = 292
+		goto _test_eof
+	_test_eof293:
+		lex.cs = 293
+		goto _test_eof
+	_test_eof294:
+		lex.cs = 294
+		goto _test_eof
+	_test_eof295:
+		lex.cs = 295
+		goto _test_eof
+	_test_eof296:
+		lex.cs = 296
+		goto _test_eof
+	_test_eof297:
+		lex.cs = 297
+		goto _test_eof
+	_test_eof298:
+		lex.cs = 298
+		goto _test_eof
+	_test_eof299:
+		lex.cs = 299
+		goto _test_eof
+	_test_eof300:
+		lex.cs = 300
+		goto _test_eof
+	_test_eof301:
+		lex.cs = 301
+		goto _test_eof
+	_test_eof302:
+		lex.cs = 302
+		goto _test_eof
+	_test_eof303:
+		lex.cs = 303
+		goto _test_eof
+	_test_eof304:
+		lex.cs = 304
+		goto _test_eof
+	_test_eof305:
+		lex.cs = 305
+		goto _test_eof
+	_test_eof306:
+		lex.cs = 306
+		goto _test_eof
+	_test_eof307:
+		lex.cs = 307
+		goto _test_eof
+	_test_eof308:
+		lex.cs = 308
+		goto _test_eof
+	_test_eof309:
+		lex.cs = 309
+		goto _test_eof
+	_test_eof310:
+		lex.cs = 310
+		goto _test_eof
+	_test_eof311:
+		lex.cs = 311
+		goto _test_eof
+	_test_eof312:
+		lex.cs = 312
+		goto _test_eof
+	_test_eof313:
+		lex.cs = 313
+		goto _test_eof
+	_test_eof314:
+		lex.cs = 314
+		goto _test_eof
+	_test_eof315:
+		lex.cs = 315
+		goto _test_eof
+	_test_eof316:
+		lex.cs = 316
+		goto _test_eof
+	_test_eof317:
+		lex.cs = 317
+		goto _test_eof
+	_test_eof318:
+		lex.cs = 318
+		goto _test_eof
+	_test_eof319:
+		lex.cs = 319
+		goto _test_eof
+	_test_eof320:
+		lex.cs = 320
+		goto _test_eof
+	_test_eof321:
+		lex.cs = 321
+		goto _test_eof
+	_test_eof322:
+		lex.cs = 322
+		goto _test_eof
+	_test_eof323:
+		lex.cs = 323
+		goto _test_eof
+	_test_eof324:
+		lex.cs = 324
+		goto _test_eof
+	_test_eof325:
+		lex.cs = 325
+		goto _test_eof
+	_test_eof326:
+		lex.cs = 326
+		goto _test_eof
+	_test_eof327:
+		lex.cs = 327
+		goto _test_eof
+	_test_eof328:
+		lex.cs = 328
+		goto _test_eof
+	_test_eof329:
+		lex.cs = 329
+		goto _test_eof
+	_test_eof330:
+		lex.cs = 330
+		goto _test_eof
+	_test_eof331:
+		lex.cs = 331
+		goto _test_eof
+	_test_eof332:
+		lex.cs = 332
+		goto _test_eof
+	_test_eof333:
+		lex.cs = 333
+		goto _test_eof
+	_test_eof334:
+		lex.cs = 334
+		goto _test_eof
+	_test_eof335:
+		lex.cs = 335
+		goto _test_eof
+	_test_eof336:
+		lex.cs = 336
+		goto _test_eof
+	_test_eof337:
+		lex.cs = 337
+		goto _test_eof
+	_test_eof338:
+		lex.cs = 338
+		goto _test_eof
+	_test_eof339:
+		lex.cs = 339
+		goto _test_eof
+	_test_eof340:
+		lex.cs = 340
+		goto _test_eof
+	_test_eof341:
+		lex.cs = 341
+		goto _test_eof
+	_test_eof342:
+		lex.cs = 342
+		goto _test_eof
+	_test_eof343:
+		lex.cs = 343
+		goto _test_eof
+	_test_eof344:
+		lex.cs = 344
+		goto _test_eof
+	_test_eof345:
+		lex.cs = 345
+		goto _test_eof
+	_test_eof346:
+		lex.cs = 346
+		goto _test_eof
+	_test_eof347:
+		lex.cs = 347
+		goto _test_eof
+	_test_eof348:
+		lex.cs = 348
+		goto _test_eof
+	_test_eof349:
+		lex.cs = 349
+		goto _test_eof
+	_test_eof350:
+		lex.cs = 350
+		goto _test_eof
+	_test_eof351:
+		lex.cs = 351
+		goto _test_eof
+	_test_eof352:
+		lex.cs = 352
+		goto _test_eof
+	_test_eof353:
+		lex.cs = 353
+		goto _test_eof
+	_test_eof354:
+		lex.cs = 354
+		goto _test_eof
+	_test_eof355:
+		lex.cs = 355
+		goto _test_eof
+	_test_eof356:
+		lex.cs = 356
+		goto _test_eof
+	_test_eof357:
+		lex.cs = 357
+		goto _test_eof
+	_test_eof358:
+		lex.cs = 358
+		goto _test_eof
+	_test_eof359:
+		lex.cs = 359
+		goto _test_eof
+	_test_eof360:
+		lex.cs = 360
+		goto _test_eof
+	_test_eof361:
+		lex.cs = 361
+		goto _test_eof
+	_test_eof362:
+		lex.cs = 362
+		goto _test_eof
+	_test_eof363:
+		lex.cs = 363
+		goto _test_eof
+	_test_eof364:
+		lex.cs = 364
+		goto _test_eof
+	_test_eof365:
+		lex.cs = 365
+		goto _test_eof
+	_test_eof366:
+		lex.cs = 366
+		goto _test_eof
+	_test_eof367:
+		lex.cs = 367
+		goto _test_eof
+	_test_eof368:
+		lex.cs = 368
+		goto _test_eof
+	_test_eof369:
+		lex.cs = 369
+		goto _test_eof
+	_test_eof370:
+		lex.cs = 370
+		goto _test_eof
+	_test_eof371:
+		lex.cs = 371
+		goto _test_eof
+	_test_eof372:
+		lex.cs = 372
+		goto _test_eof
+	_test_eof373:
+		lex.cs = 373
+		goto _test_eof
+	_test_eof374:
+		lex.cs = 374
+		goto _test_eof
+	_test_eof375:
+		lex.cs = 375
+		goto _test_eof
+	_test_eof376:
+		lex.cs = 376
+		goto _test_eof
+	_test_eof377:
+		lex.cs = 377
+		goto _test_eof
+	_test_eof378:
+		lex.cs = 378
+		goto _test_eof
+	_test_eof379:
+		lex.cs = 379
+		goto _test_eof
+	_test_eof380:
+		lex.cs = 380
+		goto _test_eof
+	_test_eof381:
+		lex.cs = 381
+		goto _test_eof
+	_test_eof382:
+		lex.cs = 382
+		goto _test_eof
+	_test_eof383:
+		lex.cs = 383
+		goto _test_eof
+	_test_eof384:
+		lex.cs = 384
+		goto _test_eof
+	_test_eof385:
+		lex.cs = 385
+		goto _test_eof
+	_test_eof386:
+		lex.cs = 386
+		goto _test_eof
+	_test_eof387:
+		lex.cs = 387
+		goto _test_eof
+	_test_eof388:
+		lex.cs = 388
+		goto _test_eof
+	_test_eof389:
+		lex.cs = 389
+		goto _test_eof
+	_test_eof390:
+		lex.cs = 390
+		goto _test_eof
+	_test_eof391:
+		lex.cs = 391
+		goto _test_eof
+	_test_eof392:
+		lex.cs = 392
+		goto _test_eof
+	_test_eof393:
+		lex.cs = 393
+		goto _test_eof
+	_test_eof394:
+		lex.cs = 394
+		goto _test_eof
+	_test_eof395:
+		lex.cs = 395
+		goto _test_eof
+	_test_eof396:
+		lex.cs = 396
+		goto _test_eof
+	_test_eof397:
+		lex.cs = 397
+		goto _test_eof
+	_test_eof398:
+		lex.cs = 398
+		goto _test_eof
+	_test_eof399:
+		lex.cs = 399
+		goto _test_eof
+	_test_eof400:
+		lex.cs = 400
+		goto _test_eof
+	_test_eof401:
+		lex.cs = 401
+		goto _test_eof
+	_test_eof402:
+		lex.cs = 402
+		goto _test_eof
+	_test_eof403:
+		lex.cs = 403
+		goto _test_eof
+	_test_eof404:
+		lex.cs = 404
+		goto _test_eof
+	_test_eof405:
+		lex.cs = 405
+		goto _test_eof
+	_test_eof406:
+		lex.cs = 406
+		goto _test_eof
+	_test_eof407:
+		lex.cs = 407
+		goto _test_eof
+	_test_eof408:
+		lex.cs = 408
+		goto _test_eof
+	_test_eof409:
+		lex.cs = 409
+		goto _test_eof
+	_test_eof410:
+		lex.cs = 410
+		goto _test_eof
+	_test_eof411:
+		lex.cs = 411
+		goto _test_eof
+	_test_eof412:
+		lex.cs = 412
+		goto _test_eof
+	_test_eof413:
+		lex.cs = 413
+		goto _test_eof
+	_test_eof414:
+		lex.cs = 414
+		goto _test_eof
+	_test_eof415:
+		lex.cs = 415
+		goto _test_eof
+	_test_eof416:
+		lex.cs = 416
+		goto _test_eof
+	_test_eof417:
+		lex.cs = 417
+		goto _test_eof
+	_test_eof418:
+		lex.cs = 418
+		goto _test_eof
+	_test_eof419:
+		lex.cs = 419
+		goto _test_eof
+	_test_eof420:
+		lex.cs = 420
+		goto _test_eof
+	_test_eof421:
+		lex.cs = 421
+		goto _test_eof
+	_test_eof422:
+		lex.cs = 422
+		goto _test_eof
+	_test_eof423:
+		lex.cs = 423
+		goto _test_eof
+	_test_eof424:
+		lex.cs = 424
+		goto _test_eof
+	_test_eof425:
+		lex.cs = 425
+		goto _test_eof
+	_test_eof426:
+		lex.cs = 426
+		goto _test_eof
+	_test_eof427:
+		lex.cs = 427
+		goto _test_eof
+	_test_eof428:
+		lex.cs = 428
+		goto _test_eof
+	_test_eof429:
+		lex.cs = 429
+		goto _test_eof
+	_test_eof430:
+		lex.cs = 430
+		goto _test_eof
+	_test_eof431:
+		lex.cs = 431
+		goto _test_eof
+	_test_eof432:
+		lex.cs = 432
+		goto _test_eof
+	_test_eof433:
+		lex.cs = 433
+		goto _test_eof
+	_test_eof434:
+		lex.cs = 434
+		goto _test_eof
+	_test_eof435:
+		lex.cs = 435
+		goto _test_eof
+	_test_eof436:
+		lex.cs = 436
+		goto _test_eof
+	_test_eof437:
+		lex.cs = 437
+		goto _test_eof
+	_test_eof438:
+		lex.cs = 438
+		goto _test_eof
+	_test_eof439:
+		lex.cs = 439
+		goto _test_eof
+	_test_eof440:
+		lex.cs = 440
+		goto _test_eof
+	_test_eof441:
+		lex.cs = 441
+		goto _test_eof
+	_test_eof442:
+		lex.cs = 442
+		goto _test_eof
+	_test_eof443:
+		lex.cs = 443
+		goto _test_eof
+	_test_eof444:
+		lex.cs = 444
+		goto _test_eof
+	_test_eof445:
+		lex.cs = 445
+		goto _test_eof
+	_test_eof446:
+		lex.cs = 446
+		goto _test_eof
+	_test_eof447:
+		lex.cs = 447
+		goto _test_eof
+	_test_eof448:
+		lex.cs = 448
+		goto _test_eof
+	_test_eof449:
+		lex.cs = 449
+		goto _test_eof
+	_test_eof450:
+		lex.cs = 450
+		goto _test_eof
+	_test_eof451:
+		lex.cs = 451
+		goto _test_eof
+	_test_eof452:
+		lex.cs = 452
+		goto _test_eof
+	_test_eof453:
+		lex.cs = 453
+		goto _test_eof
+	_test_eof454:
+		lex.cs = 454
+		goto _test_eof
+	_test_eof455:
+		lex.cs = 455
+		goto _test_eof
+	_test_eof456:
+		lex.cs = 456
+		goto _test_eof
+	_test_eof457:
+		lex.cs = 457
+		goto _test_eof
+	_test_eof458:
+		lex.cs = 458
+		goto _test_eof
+	_test_eof459:
+		lex.cs = 459
+		goto _test_eof
+	_test_eof460:
+		lex.cs = 460
+		goto _test_eof
+	_test_eof461:
+		lex.cs = 461
+		goto _test_eof
+	_test_eof462:
+		lex.cs = 462
+		goto _test_eof
+	_test_eof463:
+		lex.cs = 463
+		goto _test_eof
+	_test_eof464:
+		lex.cs = 464
+		goto _test_eof
+	_test_eof465:
+		lex.cs = 465
+		goto _test_eof
+	_test_eof466:
+		lex.cs = 466
+		goto _test_eof
+	_test_eof467:
+		lex.cs = 467
+		goto _test_eof
+	_test_eof468:
+		lex.cs = 468
+		goto _test_eof
+	_test_eof469:
+		lex.cs = 469
+		goto _test_eof
+	_test_eof470:
+		lex.cs = 470
+		goto _test_eof
+	_test_eof471:
+		lex.cs = 471
+		goto _test_eof
+	_test_eof472:
+		lex.cs = 472
+		goto _test_eof
+	_test_eof473:
+		lex.cs = 473
+		goto _test_eof
+	_test_eof474:
+		lex.cs = 474
+		goto _test_eof
+	_test_eof475:
+		lex.cs = 475
+		goto _test_eof
+	_test_eof476:
+		lex.cs = 476
+		goto _test_eof
+	_test_eof477:
+		lex.cs = 477
+		goto _test_eof
+	_test_eof478:
+		lex.cs = 478
+		goto _test_eof
+	_test_eof479:
+		lex.cs = 479
+		goto _test_eof
+	_test_eof480:
+		lex.cs = 480
+		goto _test_eof
+	_test_eof481:
+		lex.cs = 481
+		goto _test_eof
+	_test_eof482:
+		lex.cs = 482
+		goto _test_eof
+	_test_eof483:
+		lex.cs = 483
+		goto _test_eof
+	_test_eof484:
+		lex.cs = 484
+		goto _test_eof
+	_test_eof485:
+		lex.cs = 485
+		goto _test_eof
+	_test_eof486:
+		lex.cs = 486
+		goto _test_eof
+	_test_eof487:
+		lex.cs = 487
+		goto _test_eof
+	_test_eof488:
+		lex.cs = 488
+		goto _test_eof
+	_test_eof489:
+		lex.cs = 489
+		goto _test_eof
+	_test_eof490:
+		lex.cs = 490
+		goto _test_eof
+	_test_eof491:
+		lex.cs = 491
+		goto _test_eof
+	_test_eof492:
+		lex.cs = 492
+		goto _test_eof
+	_test_eof493:
+		lex.cs = 493
+		goto _test_eof
+	_test_eof494:
+		lex.cs = 494
+		goto _test_eof
+	_test_eof495:
+		lex.cs = 495
+		goto _test_eof
+	_test_eof496:
+		lex.cs = 496
+		goto _test_eof
+
+	_test_eof:
+		{
+		}
+		if (lex.p) == eof {
+			switch lex.cs {
+			case 10:
+				goto tr62
+			case 11:
+				goto tr63
+			case 12:
+				goto tr9
+			case 13:
+				goto tr65
+			case 14:
+				goto tr66
+			case 15:
+				goto tr70
+			case 16:
+				goto tr70
+			case 5:
+				goto tr6
+			case 17:
+				goto tr9
+			case 18:
+				goto tr72
+			case 7:
+				goto tr9
+			case 8:
+				goto tr9
+			case 19:
+				goto tr70
+			case 20:
+				goto tr73
+			case 21:
+				goto tr9
+			case 22:
+				goto tr73
+			case 23:
+				goto tr74
+			case 24:
+				goto tr76
+			case 25:
+				goto tr79
+			case 26:
+				goto tr81
+			case 27:
+				goto tr81
+			case 28:
+				goto tr81
+			case 29:
+				goto tr81
+			case 30:
+				goto tr81
+			case 31:
+				goto tr81
+			case 32:
+				goto tr81
+			case 33:
+				goto tr81
+			case 34:
+				goto tr81
+			case 35:
+				goto tr81
+			case 36:
+				goto tr81
+			case 37:
+				goto tr81
+			case 38:
+				goto tr81
+			case 39:
+				goto tr81
+			case 40:
+				goto tr81
+			case 41:
+				goto tr103
+			case 42:
+				goto tr81
+			case 43:
+				goto tr81
+			case 44:
+				goto tr81
+			case 45:
+				goto tr81
+			case 46:
+				goto tr81
+			case 47:
+				goto tr81
+			case 48:
+				goto tr81
+			case 49:
+				goto tr81
+			case 50:
+				goto tr81
+			case 51:
+				goto tr81
+			case 52:
+				goto tr81
+			case 53:
+				goto tr81
+			case 54:
+				goto tr81
+			case 55:
+				goto tr81
+			case 56:
+				goto tr81
+			case 57:
+				goto tr81
+			case 58:
+				goto tr81
+			case 59:
+				goto tr81
+			case 60:
+				goto tr81
+			case 61:
+				goto tr81
+			case 62:
+				goto tr81
+			case 63:
+				goto tr81
+			case 64:
+				goto tr81
+			case 65:
+				goto tr81
+			case 66:
+				goto tr81
+			case 67:
+				goto tr81
+			case 68:
+				goto tr81
+			case 69:
+				goto tr81
+			case 70:
+				goto tr81
+			case 71:
+				goto tr81
+			case 72:
+				goto tr81
+			case 73:
+				goto tr81
+			case 74:
+				goto tr81
+			case 75:
+				goto tr81
+			case 76:
+				goto tr81
+			case 77:
+				goto tr81
+			case 78:
+				goto tr81
+			case 79:
+				goto tr156
+			case 80:
+				goto tr81
+			case 81:
+				goto tr81
+			case 82:
+				goto tr81
+			case 83:
+				goto tr81
+			case 84:
+				goto tr162
+			case 85:
+				goto tr81
+			case 86:
+				goto tr81
+			case 87:
+				goto tr81
+			case 88:
+				goto tr81
+			case 89:
+				goto tr81
+			case 90:
+				goto tr81
+			case 91:
+				goto tr81
+			case 92:
+				goto tr81
+			case 93:
+				goto tr81
+			case 94:
+				goto tr81
+			case 95:
+				goto tr81
+			case 96:
+				goto tr81
+			case 97:
+				goto tr81
+			case 98:
+				goto tr81
+			case 99:
+				goto tr180
+			case 100:
+				goto tr81
+			case 101:
+				goto tr81
+			case 102:
+				goto tr81
+			case 103:
+				goto tr81
+			case 104:
+				goto tr81
+			case 105:
+				goto tr81
+			case 106:
+				goto tr81
+			case 107:
+				goto tr81
+			case 108:
+				goto tr81
+			case 109:
+				goto tr81
+			case 110:
+				goto tr81
+			case 111:
+				goto tr81
+			case 112:
+				goto tr81
+			case 113:
+				goto tr81
+			case 114:
+				goto tr81
+			case 115:
+				goto tr81
+			case 116:
+				goto tr81
+			case 117:
+				goto tr81
+			case 118:
+				goto tr81
+			case 119:
+				goto tr81
+			case 120:
+				goto tr81
+			case 121:
+				goto tr81
+			case 122:
+				goto tr81
+			case 123:
+				goto tr81
+			case 124:
+				goto tr81
+			case 125:
+				goto tr81
+			case 126:
+				goto tr81
+			case 127:
+				goto tr81
+			case 128:
+				goto tr81
+			case 129:
+				goto tr81
+			case 130:
+				goto tr81
+			case 131:
+				goto tr81
+			case 132:
+				goto tr81
+			case 133:
+				goto tr226
+			case 134:
+				goto tr81
+			case 135:
+				goto tr81
+			case 136:
+				goto tr81
+			case 137:
+				goto tr81
+			case 138:
+				goto tr81
+			case 139:
+				goto tr81
+			case 140:
+				goto tr81
+			case 141:
+				goto tr81
+			case 142:
+				goto tr81
+			case 143:
+				goto tr81
+			case 144:
+				goto tr81
+			case 145:
+				goto tr81
+			case 146:
+				goto tr81
+			case 147:
+				goto tr81
+			case 148:
+				goto tr81
+			case 149:
+				goto tr81
+			case 150:
+				goto tr81
+			case 151:
+				goto tr81
+			case 152:
+				goto tr81
+			case 153:
+				goto tr81
+			case 154:
+				goto tr81
+			case 155:
+				goto tr251
+			case 156:
+				goto tr81
+			case 157:
+				goto tr81
+			case 158:
+				goto tr81
+			case 159:
+				goto tr81
+			case 160:
+				goto tr81
+			case 161:
+				goto tr81
+			case 162:
+				goto tr81
+			case 163:
+				goto tr81
+			case 164:
+				goto tr81
+			case 165:
+				goto tr262
+			case 166:
+				goto tr81
+			case 167:
+				goto tr81
+			case 168:
+				goto tr81
+			case 169:
+				goto tr81
+			case 170:
+				goto tr81
+			case 171:
+				goto tr81
+			case 172:
+				goto tr81
+			case 173:
+				goto tr81
+			case 174:
+				goto tr81
+			case 175:
+				goto tr81
+			case 176:
+				goto tr81
+			case 177:
+				goto tr81
+			case 178:
+				goto tr81
+			case 179:
+				goto tr81
+			case 180:
+				goto tr81
+			case 181:
+				goto tr81
+			case 182:
+				goto tr81
+			case 183:
+				goto tr81
+			case 184:
+				goto tr81
+			case 185:
+				goto tr81
+			case 186:
+				goto tr81
+			case 187:
+				goto tr81
+			case 188:
+				goto tr81
+			case 189:
+				goto tr81
+			case 190:
+				goto tr81
+			case 191:
+				goto tr81
+			case 192:
+				goto tr81
+			case 193:
+				goto tr81
+			case 194:
+				goto tr81
+			case 195:
+				goto tr81
+			case 196:
+				goto tr81
+			case 197:
+				goto tr81
+			case 198:
+				goto tr81
+			case 199:
+				goto tr81
+			case 200:
+				goto tr81
+			case 201:
+				goto tr81
+			case 202:
+				goto tr81
+			case 203:
+				goto tr81
+			case 204:
+				goto tr81
+			case 205:
+				goto tr81
+			case 206:
+				goto tr81
+			case 207:
+				goto tr81
+			case 208:
+				goto tr317
+			case 209:
+				goto tr81
+			case 210:
+				goto tr81
+			case 211:
+				goto tr81
+			case 212:
+				goto tr81
+			case 213:
+				goto tr81
+			case 214:
+				goto tr81
+			case 215:
+				goto tr81
+			case 216:
+				goto tr81
+			case 217:
+				goto tr81
+			case 218:
+				goto tr81
+			case 219:
+				goto tr81
+			case 220:
+				goto tr81
+			case 221:
+				goto tr81
+			case 222:
+				goto tr81
+			case 223:
+				goto tr81
+			case 224:
+				goto tr81
+			case 225:
+				goto tr81
+			case 226:
+				goto tr81
+			case 227:
+				goto tr81
+			case 228:
+				goto tr81
+			case 229:
+				goto tr81
+			case 230:
+				goto tr81
+			case 231:
+				goto tr81
+			case 232:
+				goto tr346
+			case 233:
+				goto tr81
+			case 234:
+				goto tr81
+			case 235:
+				goto tr81
+			case 236:
+				goto tr81
+			case 237:
+				goto tr81
+			case 238:
+				goto tr81
+			case 239:
+				goto tr81
+			case 240:
+				goto tr358
+			case 241:
+				goto tr81
+			case 242:
+				goto tr81
+			case 243:
+				goto tr81
+			case 244:
+				goto tr81
+			case 245:
+				goto tr81
+			case 246:
+				goto tr81
+			case 247:
+				goto tr81
+			case 248:
+				goto tr81
+			case 249:
+				goto tr81
+			case 250:
+				goto tr372
+			case 251:
+				goto tr81
+			case 252:
+				goto tr81
+			case 253:
+				goto tr81
+			case 254:
+				goto tr81
+			case 255:
+				goto tr81
+			case 256:
+				goto tr81
+			case 257:
+				goto tr81
+			case 258:
+				goto tr81
+			case 259:
+				goto tr81
+			case 260:
+				goto tr81
+			case 261:
+				goto tr81
+			case 262:
+				goto tr81
+			case 263:
+				goto tr81
+			case 264:
+				goto tr81
+			case 265:
+				goto tr81
+			case 266:
+				goto tr81
+			case 267:
+				goto tr81
+			case 268:
+				goto tr81
+			case 269:
+				goto tr81
+			case 270:
+				goto tr81
+			case 271:
+				goto tr81
+			case 272:
+				goto tr81
+			case 273:
+				goto tr81
+			case 274:
+				goto tr81
+			case 275:
+				goto tr81
+			case 276:
+				goto tr81
+			case 277:
+				goto tr81
+			case 278:
+				goto tr81
+			case 279:
+				goto tr81
+			case 280:
+				goto tr81
+			case 281:
+				goto tr81
+			case 282:
+				goto tr81
+			case 283:
+				goto tr81
+			case 284:
+				goto tr81
+			case 285:
+				goto tr81
+			case 286:
+				goto tr81
+			case 287:
+				goto tr81
+			case 288:
+				goto tr81
+			case 289:
+				goto tr81
+			case 290:
+				goto tr81
+			case 291:
+				goto tr81
+			case 292:
+				goto tr81
+			case 293:
+				goto tr81
+			case 294:
+				goto tr81
+			case 295:
+				goto tr81
+			case 296:
+				goto tr81
+			case 297:
+				goto tr81
+			case 298:
+				goto tr81
+			case 299:
+				goto tr81
+			case 300:
+				goto tr81
+			case 301:
+				goto tr81
+			case 302:
+				goto tr435
+			case 303:
+				goto tr81
+			case 304:
+				goto tr81
+			case 305:
+				goto tr81
+			case 306:
+				goto tr81
+			case 307:
+				goto tr81
+			case 308:
+				goto tr443
+			case 309:
+				goto tr81
+			case 310:
+				goto tr81
+			case 311:
+				goto tr81
+			case 312:
+				goto tr81
+			case 313:
+				goto tr81
+			case 314:
+				goto tr452
+			case 315:
+				goto tr81
+			case 316:
+				goto tr455
+			case 317:
+				goto tr81
+			case 318:
+				goto tr81
+			case 319:
+				goto tr81
+			case 320:
+				goto tr81
+			case 321:
+				goto tr81
+			case 322:
+				goto tr81
+			case 323:
+				goto tr81
+			case 324:
+				goto tr81
+			case 325:
+				goto tr81
+			case 326:
+				goto tr81
+			case 327:
+				goto tr81
+			case 328:
+				goto tr81
+			case 329:
+				goto tr81
+			case 330:
+				goto tr81
+			case 331:
+				goto tr81
+			case 332:
+				goto tr81
+			case 333:
+				goto tr81
+			case 334:
+				goto tr81
+			case 335:
+				goto tr81
+			case 336:
+				goto tr81
+			case 337:
+				goto tr81
+			case 338:
+				goto tr81
+			case 339:
+				goto tr81
+			case 340:
+				goto tr81
+			case 341:
+				goto tr81
+			case 342:
+				goto tr81
+			case 343:
+				goto tr81
+			case 344:
+				goto tr81
+			case 345:
+				goto tr81
+			case 346:
+				goto tr81
+			case 347:
+				goto tr81
+			case 348:
+				goto tr81
+			case 349:
+				goto tr81
+			case 350:
+				goto tr81
+			case 351:
+				goto tr81
+			case 352:
+				goto tr81
+			case 353:
+				goto tr81
+			case 354:
+				goto tr81
+			case 355:
+				goto tr81
+			case 356:
+				goto tr81
+			case 357:
+				goto tr81
+			case 358:
+				goto tr81
+			case 359:
+				goto tr81
+			case 360:
+				goto tr81
+			case 361:
+				goto tr81
+			case 362:
+				goto tr81
+			case 363:
+				goto tr81
+			case 364:
+				goto tr81
+			case 365:
+				goto tr81
+			case 366:
+				goto tr81
+			case 367:
+				goto tr81
+			case 368:
+				goto tr81
+			case 369:
+				goto tr81
+			case 370:
+				goto tr81
+			case 371:
+				goto tr81
+			case 372:
+				goto tr81
+			case 373:
+				goto tr81
+			case 374:
+				goto tr81
+			case 375:
+				goto tr81
+			case 376:
+				goto tr81
+			case 377:
+				goto tr81
+			case 378:
+				goto tr81
+			case 379:
+				goto tr81
+			case 380:
+				goto tr81
+			case 381:
+				goto tr81
+			case 382:
+				goto tr81
+			case 383:
+				goto tr81
+			case 384:
+				goto tr81
+			case 385:
+				goto tr81
+			case 386:
+				goto tr81
+			case 387:
+				goto tr81
+			case 388:
+				goto tr81
+			case 389:
+				goto tr81
+			case 390:
+				goto tr81
+			case 391:
+				goto tr81
+			case 392:
+				goto tr81
+			case 393:
+				goto tr81
+			case 394:
+				goto tr81
+			case 395:
+				goto tr81
+			case 396:
+				goto tr81
+			case 397:
+				goto tr81
+			case 398:
+				goto tr81
+			case 399:
+				goto tr81
+			case 400:
+				goto tr81
+			case 401:
+				goto tr81
+			case 402:
+				goto tr81
+			case 403:
+				goto tr81
+			case 404:
+				goto tr81
+			case 405:
+				goto tr81
+			case 406:
+				goto tr81
+			case 407:
+				goto tr81
+			case 408:
+				goto tr569
+			case 409:
+				goto tr81
+			case 410:
+				goto tr81
+			case 411:
+				goto tr81
+			case 412:
+				goto tr81
+			case 413:
+				goto tr81
+			case 414:
+				goto tr81
+			case 415:
+				goto tr81
+			case 416:
+				goto tr81
+			case 417:
+				goto tr81
+			case 418:
+				goto tr81
+			case 419:
+				goto tr81
+			case 420:
+				goto tr81
+			case 421:
+				goto tr81
+			case 422:
+				goto tr81
+			case 423:
+				goto tr81
+			case 424:
+				goto tr81
+			case 425:
+				goto tr81
+			case 426:
+				goto tr81
+			case 427:
+				goto tr81
+			case 428:
+				goto tr81
+			case 429:
+				goto tr81
+			case 430:
+				goto tr81
+			case 431:
+				goto tr81
+			case 432:
+				goto tr81
+			case 433:
+				goto tr81
+			case 434:
+				goto tr81
+			case 435:
+				goto tr81
+			case 436:
+				goto tr81
+			case 437:
+				goto tr81
+			case 438:
+				goto tr81
+			case 439:
+				goto tr81
+			case 440:
+				goto tr81
+			case 441:
+				goto tr81
+			case 442:
+				goto tr81
+			case 443:
+				goto tr81
+			case 444:
+				goto tr81
+			case 445:
+				goto tr81
+			case 446:
+				goto tr81
+			case 447:
+				goto tr81
+			case 448:
+				goto tr81
+			case 449:
+				goto tr81
+			case 450:
+				goto tr81
+			case 451:
+				goto tr81
+			case 452:
+				goto tr81
+			case 453:
+				goto tr81
+			case 454:
+				goto tr81
+			case 455:
+				goto tr81
+			case 456:
+				goto tr81
+			case 457:
+				goto tr81
+			case 458:
+				goto tr81
+			case 459:
+				goto tr81
+			case 460:
+				goto tr81
+			case 461:
+				goto tr81
+			case 462:
+				goto tr81
+			case 463:
+				goto tr81
+			case 464:
+				goto tr81
+			case 465:
+				goto tr81
+			case 466:
+				goto tr81
+			case 467:
+				goto tr81
+			case 468:
+				goto tr81
+			case 469:
+				goto tr81
+			case 470:
+				goto tr81
+			case 471:
+				goto tr81
+			case 472:
+				goto tr81
+			case 473:
+				goto tr81
+			case 474:
+				goto tr81
+			case 475:
+				goto tr81
+			case 476:
+				goto tr81
+			case 477:
+				goto tr81
+			case 478:
+				goto tr81
+			case 479:
+				goto tr81
+			case 480:
+				goto tr81
+			case 481:
+				goto tr81
+			case 482:
+				goto tr81
+			case 483:
+				goto tr81
+			case 484:
+				goto tr81
+			case 485:
+				goto tr663
+			case 486:
+				goto tr81
+			case 487:
+				goto tr81
+			case 488:
+				goto tr81
+			case 489:
+				goto tr81
+			case 490:
+				goto tr81
+			case 491:
+				goto tr81
+			case 492:
+				goto tr81
+			case 493:
+				goto tr81
+			case 494:
+				goto tr81
+			case 495:
+				goto tr81
+			case 496:
+				goto tr81
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+//line lyx/lexer.rl:341
+
+	return int(tok)
+}
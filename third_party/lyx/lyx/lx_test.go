@@ -0,0 +1,233 @@
+package lyx_test
+
+import (
+	"testing"
+
+	"github.com/pg-sharding/lyx/lyx"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimple(t *testing.T) {
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   []int
+		err   error
+	}
+
+	for _, tt := range []tcase{
+		{
+			query: `
+			select 1
+			`,
+			exp: []int{lyx.SELECT, lyx.ICONST},
+			err: nil,
+		},
+		{
+			query: `
+			14
+			`,
+			exp: []int{lyx.ICONST},
+			err: nil,
+		},
+		{
+			query: `
+			$14
+			`,
+			exp: []int{lyx.PARAM},
+			err: nil,
+		},
+		{
+			query: `
+			'$14'
+			`,
+			exp: []int{lyx.SCONST},
+			err: nil,
+		},
+		{
+			query: `
+			select /*jjiewjow****/1
+			`,
+			exp: []int{lyx.SELECT, lyx.ICONST},
+			err: nil,
+		},
+		{
+			query: `
+			join x Using (id) "using" usingx
+			`,
+			exp: []int{lyx.JOIN, lyx.IDENT, lyx.USING, lyx.TOPENBR, lyx.IDENT, lyx.TCLOSEBR, lyx.IDENT, lyx.IDENT},
+			err: nil,
+		},
+		{
+			query: `
+			update table x set i = 19;
+			`,
+			exp: []int{lyx.UPDATE, lyx.TABLE, lyx.IDENT, lyx.SET, lyx.IDENT, lyx.TEQ, lyx.ICONST, lyx.TSEMICOLON},
+			err: nil,
+		},
+		{
+			query: `
+			select * from xx where i = 1 order by i;
+			`,
+			exp: []int{lyx.SELECT, lyx.TMUL, lyx.FROM, lyx.IDENT, lyx.WHERE, lyx.IDENT, lyx.TEQ, lyx.ICONST, lyx.ORDER, lyx.BY, lyx.IDENT, lyx.TSEMICOLON},
+			err: nil,
+		},
+		{
+			query: `
+			select * from xx where i + j != 1;
+			`,
+			exp: []int{lyx.SELECT, lyx.TMUL, lyx.FROM, lyx.IDENT, lyx.WHERE, lyx.IDENT, lyx.TPLUS, lyx.IDENT, lyx.TNOT_EQUALS, lyx.ICONST, lyx.TSEMICOLON},
+			err: nil,
+		},
+		{
+			query: `
+			SELECT kind, sum(len) AS
+			 total FROM films GROUP BY kind;
+			`,
+			exp: []int{
+				lyx.SELECT, lyx.IDENT, lyx.TCOMMA,
+				lyx.IDENT, lyx.TOPENBR, lyx.IDENT, lyx.TCLOSEBR, lyx.AS, lyx.IDENT,
+				lyx.FROM, lyx.IDENT, lyx.GROUP, lyx.BY, lyx.IDENT, lyx.TSEMICOLON},
+			err: nil,
+		},
+		{
+			query: `
+			PREPARE P(TEXT) AS SELECT $1
+			`,
+			exp: []int{
+				lyx.PREPARE, lyx.IDENT,
+				lyx.TOPENBR, lyx.IDENT,
+				lyx.TCLOSEBR, lyx.AS, lyx.SELECT, lyx.PARAM,
+			},
+			err: nil,
+		},
+		{
+			query: `
+			INSERT INTO xx VALUES
+				(1,2,'gyuguy');
+			`,
+			exp: []int{
+				lyx.INSERT, lyx.INTO, lyx.IDENT,
+				lyx.VALUES,
+				lyx.TOPENBR,
+				lyx.ICONST, lyx.TCOMMA, lyx.ICONST, lyx.TCOMMA, lyx.SCONST,
+				lyx.TCLOSEBR, lyx.TSEMICOLON},
+			err: nil,
+		},
+		{
+			query: `INSERT INTO films (code, title, did, date_prod, kind)
+			VALUES ('T_601', 'Yojimbo', 106, '1961-06-16', 'Drama');`,
+			exp: []int{
+				lyx.INSERT, lyx.INTO, lyx.IDENT,
+
+				lyx.TOPENBR,
+
+				lyx.IDENT,
+
+				lyx.TCOMMA,
+				lyx.IDENT,
+
+				lyx.TCOMMA,
+				lyx.IDENT,
+
+				lyx.TCOMMA,
+				lyx.IDENT,
+
+				lyx.TCOMMA,
+				lyx.IDENT,
+
+				lyx.TCLOSEBR,
+				lyx.VALUES,
+				lyx.TOPENBR,
+				lyx.SCONST,
+
+				lyx.TCOMMA,
+				lyx.SCONST,
+
+				lyx.TCOMMA,
+				lyx.ICONST,
+
+				lyx.TCOMMA,
+				lyx.SCONST,
+
+				lyx.TCOMMA,
+				lyx.SCONST,
+
+				lyx.TCLOSEBR, lyx.TSEMICOLON},
+			err: nil,
+		},
+		{
+			query: `
+			UPDATE customer1
+        	SET c_balance= -4755.000000
+			`,
+			exp: []int{
+				lyx.UPDATE, lyx.IDENT, lyx.SET,
+				lyx.IDENT, lyx.TEQ, lyx.SCONST,
+			},
+			err: nil,
+		},
+		{
+			query: `
+			SELECT 1 UNION SELECT 2
+			`,
+			exp: []int{
+				lyx.SELECT, lyx.ICONST, lyx.UNION, lyx.SELECT, lyx.ICONST,
+			},
+			err: nil,
+		},
+		{
+			query: `
+			SELECT * FROM t UNION ALL SELECT * FROM a
+			`,
+			exp: []int{
+				lyx.SELECT, lyx.TMUL, lyx.FROM, lyx.IDENT, lyx.UNION, lyx.ALL, lyx.SELECT, lyx.TMUL, lyx.FROM, lyx.IDENT,
+			},
+			err: nil,
+		},
+	} {
+		t := lyx.NewStringTokenizer(tt.query)
+		var res []int
+		for {
+			v := t.LexT()
+			if v == 0 {
+				break
+			}
+			res = append(res, v)
+		}
+
+		assert.Equal(tt.exp, res, tt.query)
+	}
+}
+
+func TestNextToken(t *testing.T) {
+	assert := assert.New(t)
+
+	query := "insert into xx /* (0) */ values ('a)', 1);"
+	tokenizer := lyx.NewStringTokenizer(query)
+
+	var res []lyx.Token
+	for {
+		tok := tokenizer.NextToken()
+		res = append(res, tok)
+		if tok.Type == 0 {
+			break
+		}
+	}
+
+	assert.Equal([]lyx.Token{
+		{Type: lyx.INSERT, Start: 0, End: 6},
+		{Type: lyx.INTO, Start: 7, End: 11},
+		{Type: lyx.IDENT, Start: 12, End: 14},
+		{Type: lyx.VALUES, Start: 25, End: 31},
+		{Type: lyx.TOPENBR, Start: 32, End: 33},
+		{Type: lyx.SCONST, Start: 33, End: 37},
+		{Type: lyx.TCOMMA, Start: 37, End: 38},
+		{Type: lyx.ICONST, Start: 39, End: 40},
+		{Type: lyx.TCLOSEBR, Start: 40, End: 41},
+		{Type: lyx.TSEMICOLON, Start: 41, End: 42},
+		{Start: 42, End: 42},
+	}, res)
+}
@@ -0,0 +1,103 @@
+package lyx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Tokenizer is the struct used to generate SQL
+// tokens for the parser.
+type Tokenizer struct {
+	s   string
+	pos int
+
+	ParseTree Node
+	LastError string
+	l         *Lexer
+}
+
+func (t *Tokenizer) Error(s string) {
+	t.LastError = s
+}
+
+func NewStringTokenizer(sql string) *Tokenizer {
+	return &Tokenizer{
+		s: sql,
+		l: NewLexer([]byte(sql)),
+	}
+}
+
+func (t *Tokenizer) Lex(lval *yySymType) int {
+	tok := t.l.Lex(lval)
+	/* offset of token in SQL text, kept by parser for every token */
+	lval.loc = t.l.ts
+	return tok
+}
+
+// identKeywords are keywords, which have no rule of their own in lexer.rl
+// and are recognized among unquoted identifiers
+var identKeywords = map[string]int{
+	"using": USING,
+}
+
+// identToken returns token of unquoted identifier
+func identToken(ident string) int {
+	if tok, ok := identKeywords[strings.ToLower(ident)]; ok {
+		return tok
+	}
+	return IDENT
+}
+
+func (t *Tokenizer) LexT() int {
+	return t.l.Lex(new(yySymType))
+}
+
+// Token is lexeme of SQL text, Start and End are its byte offsets
+type Token struct {
+	Type  int
+	Start int
+	End   int
+}
+
+// NextToken returns next lexeme of SQL text, whitespaces and comments
+// are skipped. Type of the lexeme is 0 at the end of text or at
+// the text, which is not a valid lexeme.
+func (t *Tokenizer) NextToken() Token {
+	tok := t.l.Lex(new(yySymType))
+	if tok == 0 {
+		return Token{Start: t.l.p, End: t.l.p}
+	}
+	return Token{
+		Type:  tok,
+		Start: t.l.ts,
+		End:   t.l.te,
+	}
+}
+
+func (t *Tokenizer) Reset(sql string) {
+	t.s = sql
+	ResetLexer(t.l, []byte(sql))
+}
+
+func setParseTree(yylex interface{}, stmt Node) {
+	yylex.(*Tokenizer).ParseTree = stmt
+}
+
+func Parse(sql string) (Node, error) {
+	tokenizer := NewStringTokenizer(sql)
+	if yyParse(tokenizer) != 0 {
+		return nil, errors.New(tokenizer.LastError + fmt.Sprintf(" on pos %d", tokenizer.l.ts))
+	}
+	ast := tokenizer.ParseTree
+	return ast, nil
+}
+
+func ParseWithLexerParser(l LyxParser, t *Tokenizer, sql string) (Node, error) {
+	t.Reset(sql)
+	if l.Parse(t) != 0 {
+		return nil, errors.New(t.LastError + fmt.Sprintf(" on pos %d", t.l.ts))
+	}
+	ast := t.ParseTree
+	return ast, nil
+}
@@ -33,6 +33,12 @@ type Show struct {
 	Where WhereClauseNode
 }
 
+// ShowKeyRange finds key ranges of the distribution, containing keys
+type ShowKeyRange struct {
+	Distribution string
+	Keys         []string
+}
+
 type Set struct {
 	Element Statement
 }
@@ -97,27 +103,63 @@ type KeyRangeDefinition struct {
 type ShardDefinition struct {
 	Id    string
 	Hosts []string
+	// shard, which definitions of distributed and reference relations are copied from
+	SchemaFrom string
 }
 
-func (*KeyRangeDefinition) iCreate()     {}
-func (*ShardDefinition) iCreate()        {}
-func (*DistributionDefinition) iCreate() {}
-func (*ShardingRuleDefinition) iCreate() {}
+type ReferenceRelationDefinition struct {
+	TableName string
+}
+
+type SequenceDefinition struct {
+	Name         string
+	RelationName string
+	ColumnName   string
+}
+
+func (*KeyRangeDefinition) iCreate()          {}
+func (*ShardDefinition) iCreate()             {}
+func (*DistributionDefinition) iCreate()      {}
+func (*ShardingRuleDefinition) iCreate()      {}
+func (*ReferenceRelationDefinition) iCreate() {}
+func (*SequenceDefinition) iCreate()          {}
 
 type SplitKeyRange struct {
 	Border         []byte
 	KeyRangeFromID string
 	KeyRangeID     string
+	NoWait         bool
 }
 
 type UniteKeyRange struct {
 	KeyRangeIDL string
 	KeyRangeIDR string
+	NoWait      bool
 }
 
 type MoveKeyRange struct {
 	DestShardID string
 	KeyRangeID  string
+	NoWait      bool
+}
+
+type CancelOperation struct {
+	ID string
+}
+
+type DrainShard struct {
+	ID     string
+	NoWait bool
+}
+
+type CheckReferenceRelation struct {
+	RelationName string
+}
+
+// CheckSchema compares definition of relation, or of all distributed
+// and reference relations, if RelationName is empty, between shards
+type CheckSchema struct {
+	RelationName string
 }
 
 type KeyRangeSelector struct {
@@ -138,11 +180,16 @@ type ShardSelector struct {
 
 type TaskGroupSelector struct{}
 
+type SequenceSelector struct {
+	Name string
+}
+
 func (*KeyRangeSelector) iDrop()     {}
 func (*ShardingRuleSelector) iDrop() {}
 func (*DistributionSelector) iDrop() {}
 func (*ShardSelector) iDrop()        {}
 func (*TaskGroupSelector) iDrop()    {}
+func (*SequenceSelector) iDrop()     {}
 
 type Lock struct {
 	KeyRangeID string
@@ -199,6 +246,7 @@ func (*AlterDistribution) iAlterDistribution() {}
 type DistributionKeyEntry struct {
 	Column       string
 	HashFunction string
+	Expression   string
 }
 
 type DistributedRelation struct {
@@ -239,6 +287,9 @@ const (
 	VersionStr            = "version"
 	RelationsStr          = "relations"
 	TaskGroupStr          = "task_group"
+	OperationsStr         = "operations"
+	SequencesStr          = "sequences"
+	SchemaChangesStr      = "schema_changes"
 	UnsupportedStr        = "unsupported"
 )
 
@@ -251,28 +302,36 @@ type Statement interface {
 	iStatement()
 }
 
-func (*Show) iStatement()                   {}
-func (*Set) iStatement()                    {}
-func (*KeyRangeSelector) iStatement()       {}
-func (*ShardingRuleSelector) iStatement()   {}
-func (*DistributionSelector) iStatement()   {}
-func (*ShardSelector) iStatement()          {}
-func (*TaskGroupSelector) iStatement()      {}
-func (*Lock) iStatement()                   {}
-func (*Unlock) iStatement()                 {}
-func (*Shutdown) iStatement()               {}
-func (*Listen) iStatement()                 {}
-func (*MoveKeyRange) iStatement()           {}
-func (*SplitKeyRange) iStatement()          {}
-func (*UniteKeyRange) iStatement()          {}
-func (*DistributionDefinition) iStatement() {}
-func (*ShardingRuleDefinition) iStatement() {}
-func (*KeyRangeDefinition) iStatement()     {}
-func (*ShardDefinition) iStatement()        {}
-func (*Kill) iStatement()                   {}
-func (*WhereClauseLeaf) iStatement()        {}
-func (*WhereClauseEmpty) iStatement()       {}
-func (*WhereClauseOp) iStatement()          {}
+func (*Show) iStatement()                        {}
+func (*Set) iStatement()                         {}
+func (*ShowKeyRange) iStatement()                {}
+func (*KeyRangeSelector) iStatement()            {}
+func (*ShardingRuleSelector) iStatement()        {}
+func (*DistributionSelector) iStatement()        {}
+func (*ShardSelector) iStatement()               {}
+func (*TaskGroupSelector) iStatement()           {}
+func (*SequenceSelector) iStatement()            {}
+func (*Lock) iStatement()                        {}
+func (*Unlock) iStatement()                      {}
+func (*Shutdown) iStatement()                    {}
+func (*Listen) iStatement()                      {}
+func (*MoveKeyRange) iStatement()                {}
+func (*SplitKeyRange) iStatement()               {}
+func (*UniteKeyRange) iStatement()               {}
+func (*CancelOperation) iStatement()             {}
+func (*DrainShard) iStatement()                  {}
+func (*CheckReferenceRelation) iStatement()      {}
+func (*CheckSchema) iStatement()                 {}
+func (*DistributionDefinition) iStatement()      {}
+func (*ShardingRuleDefinition) iStatement()      {}
+func (*KeyRangeDefinition) iStatement()          {}
+func (*ShardDefinition) iStatement()             {}
+func (*ReferenceRelationDefinition) iStatement() {}
+func (*SequenceDefinition) iStatement()          {}
+func (*Kill) iStatement()                        {}
+func (*WhereClauseLeaf) iStatement()             {}
+func (*WhereClauseEmpty) iStatement()            {}
+func (*WhereClauseOp) iStatement()               {}
 
 func (*RegisterRouter) iStatement()   {}
 func (*UnregisterRouter) iStatement() {}
@@ -34,9 +34,10 @@ type yySymType struct {
 	bool     bool
 	empty    struct{}
 
-	set       *Set
-	statement Statement
-	show      *Show
+	set            *Set
+	statement      Statement
+	show           *Show
+	show_key_range *ShowKeyRange
 
 	drop   *Drop
 	create *Create
@@ -45,17 +46,23 @@ type yySymType struct {
 	lock   *Lock
 	unlock *Unlock
 
-	ds            *DistributionDefinition
-	kr            *KeyRangeDefinition
-	shard         *ShardDefinition
-	sharding_rule *ShardingRuleDefinition
+	ds                 *DistributionDefinition
+	kr                 *KeyRangeDefinition
+	shard              *ShardDefinition
+	reference_relation *ReferenceRelationDefinition
+	sequence           *SequenceDefinition
+	sharding_rule      *ShardingRuleDefinition
 
 	register_router   *RegisterRouter
 	unregister_router *UnregisterRouter
 
-	split *SplitKeyRange
-	move  *MoveKeyRange
-	unite *UniteKeyRange
+	split            *SplitKeyRange
+	move             *MoveKeyRange
+	unite            *UniteKeyRange
+	cancel_operation *CancelOperation
+	drain_shard      *DrainShard
+	check_reference  *CheckReferenceRelation
+	check_schema     *CheckSchema
 
 	shutdown *Shutdown
 	listen   *Listen
@@ -155,11 +162,20 @@ const TRACE = 57412
 const MESSAGES = 57413
 const TASK = 57414
 const GROUP = 57415
-const VARCHAR = 57416
-const INTEGER = 57417
-const INT = 57418
-const TYPES = 57419
-const OP = 57420
+const CANCEL = 57416
+const OPERATION = 57417
+const NOWAIT = 57418
+const DRAIN = 57419
+const REFERENCE = 57420
+const CHECK = 57421
+const SEQUENCE = 57422
+const SCHEMA = 57423
+const KEY_RANGE = 57424
+const VARCHAR = 57425
+const INTEGER = 57426
+const INT = 57427
+const TYPES = 57428
+const OP = 57429
 
 var yyToknames = [...]string{
 	"$end",
@@ -235,6 +251,15 @@ var yyToknames = [...]string{
 	"MESSAGES",
 	"TASK",
 	"GROUP",
+	"CANCEL",
+	"OPERATION",
+	"NOWAIT",
+	"DRAIN",
+	"REFERENCE",
+	"CHECK",
+	"SEQUENCE",
+	"SCHEMA",
+	"KEY_RANGE",
 	"VARCHAR",
 	"INTEGER",
 	"INT",
@@ -248,7 +273,7 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line gram.y:830
+//line gram.y:968
 
 //line yacctab:1
 var yyExca = [...]int8{
@@ -259,151 +284,177 @@ var yyExca = [...]int8{
 
 const yyPrivate = 57344
 
-const yyLast = 245
-
-var yyAct = [...]uint8{
-	132, 175, 214, 170, 178, 155, 144, 154, 129, 143,
-	139, 116, 101, 92, 171, 172, 173, 56, 141, 97,
-	122, 89, 54, 52, 58, 51, 206, 207, 208, 59,
-	146, 87, 177, 68, 88, 69, 136, 82, 82, 82,
-	82, 120, 106, 82, 81, 147, 210, 85, 209, 200,
-	27, 28, 60, 199, 105, 177, 82, 149, 104, 95,
-	96, 164, 30, 29, 34, 35, 153, 121, 21, 20,
-	24, 25, 26, 31, 32, 146, 91, 107, 108, 36,
-	103, 95, 83, 140, 115, 118, 137, 195, 202, 192,
-	147, 125, 127, 67, 126, 124, 109, 94, 86, 125,
-	80, 123, 98, 90, 33, 133, 134, 135, 128, 119,
-	44, 84, 22, 23, 62, 45, 196, 43, 148, 179,
-	117, 82, 46, 114, 112, 150, 111, 142, 93, 57,
-	198, 197, 151, 185, 184, 77, 76, 42, 166, 160,
-	38, 168, 165, 102, 220, 53, 41, 180, 181, 167,
-	176, 40, 174, 39, 71, 182, 117, 82, 187, 50,
-	193, 186, 183, 70, 72, 188, 79, 190, 49, 130,
-	191, 61, 63, 48, 71, 47, 194, 73, 74, 75,
-	157, 176, 157, 70, 152, 159, 158, 159, 158, 100,
-	82, 162, 65, 201, 37, 189, 204, 203, 163, 1,
-	211, 212, 18, 215, 17, 16, 15, 14, 12, 13,
-	216, 217, 8, 218, 219, 9, 113, 169, 222, 223,
-	221, 215, 138, 224, 110, 78, 19, 205, 145, 213,
-	6, 5, 4, 3, 7, 11, 10, 66, 64, 55,
-	2, 131, 161, 156, 99,
+const yyLast = 304
+
+var yyAct = [...]int16{
+	160, 214, 211, 260, 206, 176, 157, 165, 186, 123,
+	175, 171, 142, 32, 33, 112, 207, 208, 209, 256,
+	173, 92, 79, 187, 93, 35, 34, 42, 43, 166,
+	90, 26, 25, 29, 30, 31, 36, 37, 117, 52,
+	150, 109, 44, 64, 53, 63, 51, 249, 250, 251,
+	82, 54, 99, 178, 68, 103, 213, 107, 83, 66,
+	108, 70, 149, 106, 122, 168, 71, 38, 179, 100,
+	223, 115, 116, 146, 118, 27, 28, 100, 213, 100,
+	61, 39, 62, 100, 40, 128, 41, 100, 253, 72,
+	252, 125, 130, 238, 132, 133, 134, 73, 237, 115,
+	78, 127, 141, 144, 100, 266, 148, 181, 126, 198,
+	81, 153, 155, 91, 129, 185, 147, 239, 151, 111,
+	153, 101, 178, 172, 119, 188, 154, 162, 163, 164,
+	156, 169, 167, 131, 152, 105, 135, 179, 52, 69,
+	114, 233, 104, 53, 180, 51, 230, 161, 98, 110,
+	54, 102, 245, 228, 174, 183, 75, 145, 234, 270,
+	215, 143, 197, 100, 241, 193, 140, 113, 50, 182,
+	202, 199, 200, 204, 138, 49, 137, 48, 236, 216,
+	217, 212, 203, 215, 97, 210, 235, 47, 65, 224,
+	221, 219, 201, 220, 222, 58, 225, 95, 143, 94,
+	276, 85, 57, 100, 56, 218, 46, 124, 268, 121,
+	84, 86, 258, 232, 55, 158, 212, 231, 227, 74,
+	76, 85, 229, 190, 223, 87, 88, 89, 192, 191,
+	84, 184, 100, 45, 247, 190, 246, 244, 254, 255,
+	192, 191, 257, 195, 1, 23, 261, 240, 226, 22,
+	196, 21, 243, 262, 263, 20, 19, 264, 265, 18,
+	17, 16, 15, 269, 13, 271, 272, 273, 14, 261,
+	8, 275, 274, 9, 242, 139, 205, 170, 277, 136,
+	96, 24, 267, 248, 177, 259, 60, 59, 6, 5,
+	4, 3, 7, 12, 11, 10, 80, 77, 67, 2,
+	159, 194, 189, 120,
 }
 
 var yyPact = [...]int16{
-	44, -1000, 125, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	7, -1000, 191, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	73, 73, -45, -47, -20, 72, 72, 188, 29, 150,
-	-1000, 72, 72, 72, 114, 113, 56, -1000, -1000, -1000,
-	-1000, -1000, -1000, 186, 30, 68, 39, -1000, -1000, -1000,
-	-1000, -30, -50, -1000, 60, -1000, 24, 95, 36, 186,
-	-54, -1000, 59, -1000, 181, -1000, 129, 129, -1000, -1000,
-	-1000, -1000, -1000, 1, -4, -17, 186, 35, -1000, 90,
-	186, 85, -1000, 117, 52, -18, 12, -51, 129, -1000,
-	34, 33, -1000, -1000, 95, -1000, -1000, -1000, 186, -1000,
-	153, -1000, -1000, -1000, 186, 186, 186, -26, -1000, -1000,
-	-1000, 41, 38, -1000, -59, 81, 37, 186, 0, 170,
-	11, 150, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 176,
-	153, 187, -1000, 5, -1000, -1000, 150, 186, 38, -1000,
-	186, -60, 37, -8, -1000, 79, 186, 186, -1000, 170,
-	111, 110, -1000, 150, 146, -1000, 153, -1000, -1000, -1000,
-	178, 150, -1000, -1000, 150, -1000, -1000, -1000, 45, 148,
-	-1000, -1000, -1000, -1000, -8, -1000, -1000, 43, -1000, 75,
-	-1000, -1000, 108, 107, -5, -9, 146, 150, 176, -1000,
-	-1000, -1000, 46, -60, -1000, 186, -39, -10, -12, 186,
-	186, -1000, 186, -1000, -1000, -1000, -1000, -1000, -1000, 186,
-	186, -31, -31, 132, -1000, 79, -31, -31, -1000, -1000,
-	186, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 101, 2, -25, -27, 17,
+	114, 114, 18, 46, 197, -1000, 114, 114, 114, -45,
+	64, -57, 177, 175, 104, -1000, -1000, -1000, -1000, -1000,
+	-1000, 228, 69, 108, 83, -1000, -1000, -1000, -1000, -1000,
+	-1000, 90, 228, -4, -30, -1000, 106, -1000, 67, 134,
+	79, 228, -35, 228, -1000, 81, -1000, 201, 1, -1000,
+	193, 193, -1000, -1000, -1000, -1000, -1000, 51, 43, 26,
+	197, 228, 88, 228, 228, 75, -1000, 140, 228, 128,
+	-1000, 159, 100, 14, 61, 228, -1, -31, 193, -1000,
+	73, 65, -1000, -1000, 134, -1000, -1000, -1000, -1000, 228,
+	-1000, 199, 103, -1000, -1000, -1000, 228, 228, 228, -1000,
+	-47, 228, -1000, 3, -1000, -1000, -1000, 86, 78, -1000,
+	-66, 122, 84, 228, 50, 217, 60, 197, -1000, 80,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 219, 199, 239,
+	-1000, 228, 53, -47, -47, -1000, -1000, -1000, 197, 228,
+	78, -1000, 228, -67, 84, 15, -1000, 120, 228, 228,
+	-1000, 217, 170, 167, -1000, 197, 212, -1000, 228, 199,
+	-1000, -1000, -1000, 231, 197, -1000, -1000, 111, 197, -1000,
+	-1000, -1000, -1000, -1000, 102, 205, -1000, -1000, -1000, -1000,
+	15, -1000, -1000, 97, -1000, 117, -1000, -1000, 163, 155,
+	40, 35, 58, 197, 126, 219, -1000, -1000, 197, -47,
+	110, -67, -1000, 228, -18, 32, 30, 228, 228, -62,
+	-1000, 228, 200, -1000, -1000, 228, -1000, -1000, -1000, -1000,
+	-1000, -1000, 228, 228, -7, -7, 48, -1000, 197, 196,
+	-1000, 143, -7, -7, -1000, -1000, 228, -1000, 228, -1000,
+	228, -1000, -1000, -1000, -1000, 183, 120, -1000,
 }
 
-var yyPgo = [...]uint8{
-	0, 244, 8, 243, 242, 241, 5, 0, 12, 240,
-	239, 145, 129, 238, 237, 236, 235, 234, 233, 232,
-	231, 230, 153, 151, 146, 137, 9, 229, 6, 2,
-	11, 228, 4, 227, 1, 226, 225, 224, 222, 10,
-	217, 216, 7, 3, 13, 215, 212, 209, 208, 207,
-	206, 205, 204, 202, 199, 194,
+var yyPgo = [...]int16{
+	0, 303, 6, 302, 301, 300, 23, 0, 9, 299,
+	298, 188, 139, 297, 296, 295, 294, 293, 292, 291,
+	290, 289, 288, 187, 177, 175, 168, 287, 286, 10,
+	285, 5, 3, 12, 284, 1, 283, 2, 281, 280,
+	279, 277, 11, 276, 275, 8, 274, 4, 15, 7,
+	273, 270, 268, 264, 262, 261, 260, 259, 256, 255,
+	251, 249, 245, 244, 233,
 }
 
 var yyR1 = [...]int8{
-	0, 54, 55, 55, 9, 9, 9, 9, 9, 9,
+	0, 63, 64, 64, 9, 9, 9, 9, 9, 9,
 	9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
-	9, 8, 6, 6, 6, 7, 3, 3, 3, 4,
-	4, 5, 2, 2, 2, 1, 1, 13, 14, 44,
-	44, 17, 17, 17, 17, 17, 17, 17, 17, 18,
-	18, 18, 18, 20, 20, 21, 35, 36, 36, 27,
-	27, 29, 39, 38, 38, 37, 19, 19, 19, 19,
-	15, 46, 22, 41, 41, 40, 40, 43, 43, 43,
-	23, 23, 26, 26, 28, 30, 30, 31, 31, 33,
-	33, 33, 32, 32, 34, 24, 24, 24, 24, 25,
-	25, 42, 42, 45, 10, 11, 12, 49, 16, 16,
-	50, 51, 48, 47, 52, 53, 53,
+	9, 9, 9, 9, 9, 9, 8, 6, 6, 6,
+	7, 3, 3, 3, 4, 4, 5, 2, 2, 2,
+	1, 1, 13, 14, 48, 48, 49, 49, 18, 18,
+	18, 18, 18, 18, 18, 18, 18, 19, 19, 19,
+	19, 21, 21, 22, 38, 39, 39, 30, 30, 32,
+	32, 42, 41, 41, 40, 20, 20, 20, 20, 20,
+	20, 15, 16, 46, 46, 51, 27, 28, 28, 23,
+	44, 44, 43, 43, 47, 47, 47, 24, 24, 29,
+	29, 31, 33, 33, 34, 34, 36, 36, 36, 35,
+	35, 37, 25, 25, 25, 25, 26, 26, 26, 45,
+	45, 50, 10, 11, 12, 54, 17, 17, 55, 56,
+	57, 58, 59, 60, 60, 53, 52, 61, 62, 62,
 }
 
 var yyR2 = [...]int8{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 3, 3, 0, 2, 1, 1, 1,
-	0, 2, 4, 2, 4, 3, 4, 3, 3, 2,
-	2, 2, 2, 4, 4, 3, 2, 2, 4, 3,
-	1, 2, 5, 1, 2, 2, 2, 2, 2, 2,
-	3, 2, 3, 3, 0, 3, 1, 1, 1, 1,
-	6, 5, 1, 2, 2, 2, 0, 2, 2, 1,
-	1, 1, 3, 0, 3, 9, 9, 8, 8, 5,
-	4, 1, 3, 2, 3, 3, 2, 6, 3, 3,
-	4, 4, 2, 1, 5, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 3, 3, 3,
+	0, 2, 1, 1, 1, 0, 1, 0, 2, 4,
+	2, 4, 3, 4, 3, 3, 3, 2, 2, 2,
+	2, 4, 4, 3, 2, 2, 4, 3, 1, 2,
+	5, 5, 1, 2, 2, 2, 2, 2, 2, 2,
+	2, 3, 7, 1, 3, 2, 3, 2, 7, 3,
+	3, 0, 3, 1, 1, 1, 1, 6, 5, 1,
+	2, 2, 2, 0, 2, 2, 1, 1, 1, 3,
+	0, 3, 9, 9, 8, 8, 5, 9, 4, 1,
+	3, 2, 3, 3, 2, 7, 3, 3, 5, 5,
+	3, 4, 4, 2, 3, 2, 1, 5, 3, 3,
 }
 
 var yyChk = [...]int16{
-	-1000, -54, -9, -18, -19, -20, -21, -17, -46, -45,
-	-15, -16, -48, -47, -49, -50, -51, -52, -53, -35,
-	25, 24, 68, 69, 26, 27, 28, 6, 7, 19,
-	18, 29, 30, 60, 20, 21, 35, -55, 15, -22,
-	-23, -24, -25, 44, 37, 42, 49, -22, -23, -24,
-	-25, 70, 70, -11, 42, -10, 37, -12, 44, 49,
-	72, -11, 42, -11, -13, 4, -14, 64, 4, -6,
-	13, 4, 14, -11, -11, -11, 22, 22, -36, -12,
-	44, -7, 4, 52, 43, -7, 59, 61, 64, 71,
-	43, 52, -44, 33, 61, -7, -7, 73, 43, -1,
-	8, -8, 14, -8, 57, 58, 59, -7, -7, 61,
-	-37, 36, 34, -41, 38, -7, -30, 39, -7, 57,
-	59, 55, 71, -8, 61, -7, 61, -7, -44, -2,
-	16, -5, -7, -7, -7, -7, 62, 45, -38, -39,
-	45, 77, -30, -26, -28, -31, 38, 53, -7, 57,
-	-6, -8, 14, 55, -42, -6, -3, 4, 10, 9,
-	-2, -4, 4, 11, 56, -6, -7, -39, -7, -40,
-	-43, 74, 75, 76, -26, -34, -28, 63, -32, 40,
-	-7, -7, -6, -8, 23, 23, -42, 12, -2, 17,
-	-6, -6, 44, 12, -34, 44, 41, 23, 23, 58,
-	58, -6, 42, -43, -7, -33, 65, 66, 67, 58,
-	58, -7, -7, -27, -29, -7, -7, -7, -34, -34,
-	12, -32, -34, -34, -29,
+	-1000, -63, -9, -19, -20, -21, -22, -18, -51, -50,
+	-15, -16, -17, -53, -52, -54, -55, -56, -57, -58,
+	-59, -60, -61, -62, -38, 25, 24, 68, 69, 26,
+	27, 28, 6, 7, 19, 18, 29, 30, 60, 74,
+	77, 79, 20, 21, 35, -64, 15, -23, -24, -25,
+	-26, 44, 37, 42, 49, -23, -24, -25, -26, -27,
+	-28, 78, 80, 70, 70, -11, 42, -10, 37, -12,
+	44, 49, 72, 80, -11, 42, -11, -13, 82, 4,
+	-14, 64, 4, -6, 13, 4, 14, -11, -11, -11,
+	75, 49, 78, 81, 22, 22, -39, -12, 44, -7,
+	4, 52, 43, -7, 59, 45, -7, 61, 64, 71,
+	43, 52, -48, 33, 61, -7, -7, 73, -7, 43,
+	-1, 8, 63, -8, 14, -8, 57, 58, 59, -6,
+	-7, 45, -7, -7, -7, 61, -40, 36, 34, -44,
+	38, -7, -33, 39, -7, 57, 59, 55, -7, 63,
+	71, -8, 61, -7, 61, -7, -48, -2, 16, -5,
+	-7, 44, -7, -7, -7, -49, 76, -7, 62, 45,
+	-41, -42, 45, 86, -33, -29, -31, -34, 38, 53,
+	-7, 57, -6, -8, 14, 55, -45, -6, 45, -3,
+	4, 10, 9, -2, -4, 4, 11, -7, 56, -49,
+	-49, -6, -7, -42, -7, -43, -47, 83, 84, 85,
+	-29, -37, -31, 63, -35, 40, -7, -7, -6, -8,
+	23, 23, -45, 12, -7, -2, 17, -6, 42, -6,
+	44, 12, -37, 44, 41, 23, 23, 58, 58, 59,
+	-6, 38, -46, -6, -49, 42, -47, -7, -36, 65,
+	66, 67, 58, 58, -7, -7, 81, -7, 12, -30,
+	-32, -7, -7, -7, -37, -37, 57, -6, 12, -35,
+	16, -37, -37, -7, -32, -7, 17, -35,
 }
 
-var yyDef = [...]int8{
+var yyDef = [...]int16{
 	0, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	113, 0, 0, 0, 0, 0, 0, 1, 3, 49,
-	50, 51, 52, 0, 0, 0, 0, 66, 67, 68,
-	69, 0, 0, 41, 0, 43, 0, 40, 0, 0,
-	0, 71, 0, 103, 35, 37, 0, 0, 38, 112,
-	22, 23, 24, 0, 0, 0, 0, 0, 56, 0,
-	0, 74, 25, 86, 0, 0, 0, 0, 0, 55,
-	0, 0, 45, 39, 40, 106, 47, 48, 0, 70,
-	0, 108, 21, 109, 0, 0, 0, 0, 115, 116,
-	57, 0, 0, 72, 0, 86, 0, 0, 0, 0,
-	0, 0, 53, 54, 42, 105, 44, 104, 46, 36,
-	0, 0, 31, 0, 110, 111, 0, 0, 65, 63,
-	0, 0, 0, 0, 82, 93, 0, 0, 85, 0,
-	0, 0, 21, 0, 100, 101, 0, 26, 27, 28,
-	0, 0, 29, 30, 0, 114, 58, 64, 0, 73,
-	76, 77, 78, 79, 0, 81, 83, 0, 84, 0,
-	87, 88, 0, 0, 0, 0, 99, 0, 34, 32,
-	33, 107, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 102, 0, 75, 94, 92, 89, 90, 91, 0,
-	0, 0, 0, 62, 60, 93, 0, 0, 97, 98,
-	0, 61, 95, 96, 59,
+	21, 22, 23, 24, 25, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 136, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1, 3, 57, 58, 59,
+	60, 0, 0, 0, 0, 75, 76, 77, 78, 79,
+	80, 0, 0, 0, 0, 48, 0, 50, 0, 45,
+	0, 0, 0, 0, 85, 0, 121, 40, 0, 42,
+	0, 0, 43, 135, 27, 28, 29, 0, 0, 0,
+	0, 0, 0, 133, 0, 0, 64, 0, 0, 91,
+	30, 103, 0, 0, 0, 0, 87, 0, 0, 63,
+	0, 0, 52, 44, 45, 124, 54, 55, 56, 0,
+	81, 0, 0, 126, 26, 127, 0, 0, 0, 130,
+	47, 0, 134, 0, 138, 139, 65, 0, 0, 89,
+	0, 103, 0, 0, 0, 0, 0, 0, 86, 0,
+	61, 62, 49, 123, 51, 122, 53, 41, 0, 0,
+	36, 0, 0, 47, 47, 131, 46, 132, 0, 0,
+	74, 72, 0, 0, 0, 0, 99, 110, 0, 0,
+	102, 0, 0, 0, 26, 0, 118, 119, 0, 0,
+	31, 32, 33, 0, 0, 34, 35, 0, 0, 128,
+	129, 137, 66, 73, 0, 90, 93, 94, 95, 96,
+	0, 98, 100, 0, 101, 0, 104, 105, 0, 0,
+	0, 0, 116, 0, 0, 39, 37, 38, 0, 47,
+	0, 0, 97, 0, 0, 0, 0, 0, 0, 0,
+	120, 0, 82, 83, 125, 0, 92, 111, 109, 106,
+	107, 108, 0, 0, 0, 0, 0, 88, 0, 71,
+	68, 110, 0, 0, 114, 115, 0, 84, 0, 69,
+	0, 112, 113, 117, 67, 0, 110, 70,
 }
 
 var yyTok1 = [...]int8{
@@ -418,7 +469,8 @@ var yyTok2 = [...]int8{
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
-	72, 73, 74, 75, 76, 77, 78,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83, 84, 85, 86, 87,
 }
 
 var yyTok3 = [...]int8{
@@ -764,193 +816,223 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line gram.y:212
+//line gram.y:234
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:213
+//line gram.y:235
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:218
+//line gram.y:240
 		{
 			setParseTree(yylex, yyDollar[1].create)
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:222
+//line gram.y:244
 		{
 			setParseTree(yylex, yyDollar[1].create)
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:226
+//line gram.y:248
 		{
 			setParseTree(yylex, yyDollar[1].trace)
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:230
+//line gram.y:252
 		{
 			setParseTree(yylex, yyDollar[1].stoptrace)
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:234
+//line gram.y:256
 		{
 			setParseTree(yylex, yyDollar[1].drop)
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:238
+//line gram.y:260
 		{
 			setParseTree(yylex, yyDollar[1].lock)
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:242
+//line gram.y:264
 		{
 			setParseTree(yylex, yyDollar[1].unlock)
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:246
+//line gram.y:268
 		{
 			setParseTree(yylex, yyDollar[1].show)
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:250
+//line gram.y:272
 		{
-			setParseTree(yylex, yyDollar[1].kill)
+			setParseTree(yylex, yyDollar[1].show_key_range)
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:254
+//line gram.y:276
 		{
-			setParseTree(yylex, yyDollar[1].listen)
+			setParseTree(yylex, yyDollar[1].kill)
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:258
+//line gram.y:280
 		{
-			setParseTree(yylex, yyDollar[1].shutdown)
+			setParseTree(yylex, yyDollar[1].listen)
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:262
+//line gram.y:284
 		{
-			setParseTree(yylex, yyDollar[1].split)
+			setParseTree(yylex, yyDollar[1].shutdown)
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:266
+//line gram.y:288
 		{
-			setParseTree(yylex, yyDollar[1].move)
+			setParseTree(yylex, yyDollar[1].split)
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:270
+//line gram.y:292
 		{
-			setParseTree(yylex, yyDollar[1].unite)
+			setParseTree(yylex, yyDollar[1].move)
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:274
+//line gram.y:296
 		{
-			setParseTree(yylex, yyDollar[1].register_router)
+			setParseTree(yylex, yyDollar[1].unite)
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:278
+//line gram.y:300
 		{
-			setParseTree(yylex, yyDollar[1].unregister_router)
+			setParseTree(yylex, yyDollar[1].cancel_operation)
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:282
+//line gram.y:304
 		{
-			setParseTree(yylex, yyDollar[1].alter)
+			setParseTree(yylex, yyDollar[1].drain_shard)
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:287
+//line gram.y:308
 		{
-			yyVAL.uinteger = uint(yyDollar[1].uinteger)
+			setParseTree(yylex, yyDollar[1].check_reference)
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:292
+//line gram.y:312
 		{
-			yyVAL.str = string(yyDollar[1].str)
+			setParseTree(yylex, yyDollar[1].check_schema)
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:296
+//line gram.y:316
 		{
-			yyVAL.str = string(yyDollar[1].str)
+			setParseTree(yylex, yyDollar[1].register_router)
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:298
+//line gram.y:320
 		{
-			yyVAL.str = strconv.Itoa(int(yyDollar[1].uinteger))
+			setParseTree(yylex, yyDollar[1].unregister_router)
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:303
+//line gram.y:324
 		{
-			yyVAL.str = string(yyDollar[1].str)
+			setParseTree(yylex, yyDollar[1].alter)
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:309
+//line gram.y:329
 		{
-			yyVAL.str = yyDollar[1].str
+			yyVAL.uinteger = uint(yyDollar[1].uinteger)
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:311
+//line gram.y:334
 		{
-			yyVAL.str = "AND"
+			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:313
+//line gram.y:338
 		{
-			yyVAL.str = "OR"
+			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:318
+//line gram.y:340
 		{
-			yyVAL.str = yyDollar[1].str
+			yyVAL.str = strconv.Itoa(int(yyDollar[1].uinteger))
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:320
+//line gram.y:345
 		{
-			yyVAL.str = "="
+			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:326
+//line gram.y:351
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line gram.y:353
+		{
+			yyVAL.str = "AND"
+		}
+	case 33:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line gram.y:355
+		{
+			yyVAL.str = "OR"
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line gram.y:360
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 35:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line gram.y:362
+		{
+			yyVAL.str = "="
+		}
+	case 36:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line gram.y:368
 		{
 			yyVAL.colref = ColumnRef{
 				ColName: yyDollar[1].str,
 			}
 		}
-	case 32:
+	case 37:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:334
+//line gram.y:376
 		{
 			yyVAL.where = yyDollar[2].where
 		}
-	case 33:
+	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:337
+//line gram.y:379
 		{
 			yyVAL.where = WhereClauseLeaf{
 				ColRef: yyDollar[1].colref,
@@ -958,9 +1040,9 @@ yydefault:
 				Value:  yyDollar[3].str,
 			}
 		}
-	case 34:
+	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:345
+//line gram.y:387
 		{
 			yyVAL.where = WhereClauseOp{
 				Op:    yyDollar[2].str,
@@ -968,32 +1050,32 @@ yydefault:
 				Right: yyDollar[3].where,
 			}
 		}
-	case 35:
+	case 40:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line gram.y:355
+//line gram.y:397
 		{
 			yyVAL.where = WhereClauseEmpty{}
 		}
-	case 36:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:359
+//line gram.y:401
 		{
 			yyVAL.where = yyDollar[2].where
 		}
-	case 37:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:366
+//line gram.y:408
 		{
 			switch v := strings.ToLower(string(yyDollar[1].str)); v {
-			case DatabasesStr, RoutersStr, PoolsStr, ShardsStr, BackendConnectionsStr, KeyRangesStr, ShardingRules, ClientsStr, StatusStr, DistributionsStr, VersionStr, RelationsStr, TaskGroupStr:
+			case DatabasesStr, RoutersStr, PoolsStr, ShardsStr, BackendConnectionsStr, KeyRangesStr, ShardingRules, ClientsStr, StatusStr, DistributionsStr, VersionStr, RelationsStr, TaskGroupStr, OperationsStr, SequencesStr, SchemaChangesStr:
 				yyVAL.str = v
 			default:
 				yyVAL.str = UnsupportedStr
 			}
 		}
-	case 38:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:377
+//line gram.y:419
 		{
 			switch v := string(yyDollar[1].str); v {
 			case ClientStr:
@@ -1002,119 +1084,137 @@ yydefault:
 				yyVAL.str = "unsupp"
 			}
 		}
-	case 39:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:387
+//line gram.y:429
 		{
 			yyVAL.bool = true
 		}
-	case 40:
+	case 45:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line gram.y:387
+//line gram.y:429
 		{
 			yyVAL.bool = false
 		}
-	case 41:
+	case 46:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line gram.y:432
+		{
+			yyVAL.bool = true
+		}
+	case 47:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line gram.y:432
+		{
+			yyVAL.bool = false
+		}
+	case 48:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:391
+//line gram.y:436
 		{
 			yyVAL.drop = &Drop{Element: yyDollar[2].key_range_selector}
 		}
-	case 42:
+	case 49:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line gram.y:395
+//line gram.y:440
 		{
 			yyVAL.drop = &Drop{Element: &KeyRangeSelector{KeyRangeID: `*`}}
 		}
-	case 43:
+	case 50:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:399
+//line gram.y:444
 		{
 			yyVAL.drop = &Drop{Element: yyDollar[2].sharding_rule_selector}
 		}
-	case 44:
+	case 51:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line gram.y:403
+//line gram.y:448
 		{
 			yyVAL.drop = &Drop{Element: &ShardingRuleSelector{ID: `*`}}
 		}
-	case 45:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:407
+//line gram.y:452
 		{
 			yyVAL.drop = &Drop{Element: yyDollar[2].distribution_selector, CascadeDelete: yyDollar[3].bool}
 		}
-	case 46:
+	case 53:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line gram.y:411
+//line gram.y:456
 		{
 			yyVAL.drop = &Drop{Element: &DistributionSelector{ID: `*`}, CascadeDelete: yyDollar[4].bool}
 		}
-	case 47:
+	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:415
+//line gram.y:460
 		{
 			yyVAL.drop = &Drop{Element: &ShardSelector{ID: yyDollar[3].str}}
 		}
-	case 48:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:419
+//line gram.y:464
 		{
 			yyVAL.drop = &Drop{Element: &TaskGroupSelector{}}
 		}
-	case 49:
+	case 56:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line gram.y:468
+		{
+			yyVAL.drop = &Drop{Element: &SequenceSelector{Name: yyDollar[3].str}}
+		}
+	case 57:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:426
+//line gram.y:475
 		{
 			yyVAL.create = &Create{Element: yyDollar[2].ds}
 		}
-	case 50:
+	case 58:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:431
+//line gram.y:480
 		{
 			yyVAL.create = &Create{Element: yyDollar[2].sharding_rule}
 		}
-	case 51:
+	case 59:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:436
+//line gram.y:485
 		{
 			yyVAL.create = &Create{Element: yyDollar[2].kr}
 		}
-	case 52:
+	case 60:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:440
+//line gram.y:489
 		{
 			yyVAL.create = &Create{Element: yyDollar[2].shard}
 		}
-	case 53:
+	case 61:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line gram.y:446
+//line gram.y:495
 		{
 			yyVAL.trace = &TraceStmt{All: true}
 		}
-	case 54:
+	case 62:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line gram.y:449
+//line gram.y:498
 		{
 			yyVAL.trace = &TraceStmt{
 				Client: yyDollar[4].uinteger,
 			}
 		}
-	case 55:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:457
+//line gram.y:506
 		{
 			yyVAL.stoptrace = &StopTraceStmt{}
 		}
-	case 56:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:463
+//line gram.y:512
 		{
 			yyVAL.alter = &Alter{Element: yyDollar[2].alter_distribution}
 		}
-	case 57:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:469
+//line gram.y:518
 		{
 			yyVAL.alter_distribution = &AlterDistribution{
 				Element: &AttachRelation{
@@ -1123,9 +1223,9 @@ yydefault:
 				},
 			}
 		}
-	case 58:
+	case 66:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line gram.y:478
+//line gram.y:527
 		{
 			yyVAL.alter_distribution = &AlterDistribution{
 				Element: &DetachRelation{
@@ -1134,155 +1234,221 @@ yydefault:
 				},
 			}
 		}
-	case 59:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:490
+//line gram.y:539
 		{
 			yyVAL.dEntrieslist = append(yyDollar[1].dEntrieslist, yyDollar[3].distrKeyEntry)
 		}
-	case 60:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:492
+//line gram.y:541
 		{
 			yyVAL.dEntrieslist = []DistributionKeyEntry{
 				yyDollar[1].distrKeyEntry,
 			}
 		}
-	case 61:
+	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:502
+//line gram.y:551
 		{
 			yyVAL.distrKeyEntry = DistributionKeyEntry{
 				Column:       yyDollar[1].str,
 				HashFunction: yyDollar[2].str,
 			}
 		}
-	case 62:
+	case 70:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line gram.y:558
+		{
+			yyVAL.distrKeyEntry = DistributionKeyEntry{
+				Column:       yyDollar[3].str,
+				HashFunction: yyDollar[5].str,
+				Expression:   yyDollar[1].str,
+			}
+		}
+	case 71:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line gram.y:511
+//line gram.y:568
 		{
 			yyVAL.distributed_relation = &DistributedRelation{
 				Name:            yyDollar[2].str,
 				DistributionKey: yyDollar[5].dEntrieslist,
 			}
 		}
-	case 63:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:520
+//line gram.y:577
 		{
 			yyVAL.relations = []*DistributedRelation{yyDollar[1].distributed_relation}
 		}
-	case 64:
+	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:522
+//line gram.y:579
 		{
 			yyVAL.relations = append(yyDollar[1].relations, yyDollar[2].distributed_relation)
 		}
-	case 65:
+	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:527
+//line gram.y:584
 		{
 			yyVAL.relations = yyDollar[2].relations
 		}
-	case 66:
+	case 75:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:533
+//line gram.y:590
 		{
 			yyVAL.create = &Create{Element: yyDollar[2].ds}
 		}
-	case 67:
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:538
+//line gram.y:595
 		{
 			yyVAL.create = &Create{Element: yyDollar[2].sharding_rule}
 		}
-	case 68:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:543
+//line gram.y:600
 		{
 			yyVAL.create = &Create{Element: yyDollar[2].kr}
 		}
-	case 69:
+	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:547
+//line gram.y:604
 		{
 			yyVAL.create = &Create{Element: yyDollar[2].shard}
 		}
-	case 70:
+	case 79:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line gram.y:608
+		{
+			yyVAL.create = &Create{Element: yyDollar[2].reference_relation}
+		}
+	case 80:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line gram.y:612
+		{
+			yyVAL.create = &Create{Element: yyDollar[2].sequence}
+		}
+	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:554
+//line gram.y:619
 		{
 			yyVAL.show = &Show{Cmd: yyDollar[2].str, Where: yyDollar[3].where}
 		}
-	case 71:
+	case 82:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line gram.y:625
+		{
+			yyVAL.show_key_range = &ShowKeyRange{Distribution: yyDollar[5].str, Keys: yyDollar[7].strlist}
+		}
+	case 83:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line gram.y:631
+		{
+			yyVAL.strlist = []string{yyDollar[1].str}
+		}
+	case 84:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line gram.y:635
+		{
+			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
+		}
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:560
+//line gram.y:641
 		{
 			yyVAL.lock = &Lock{KeyRangeID: yyDollar[2].key_range_selector.KeyRangeID}
 		}
-	case 72:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:568
+//line gram.y:649
+		{
+			yyVAL.reference_relation = &ReferenceRelationDefinition{
+				TableName: yyDollar[3].str,
+			}
+		}
+	case 87:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line gram.y:657
+		{
+			yyVAL.sequence = &SequenceDefinition{
+				Name: yyDollar[2].str,
+			}
+		}
+	case 88:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line gram.y:663
+		{
+			yyVAL.sequence = &SequenceDefinition{
+				Name:         yyDollar[2].str,
+				RelationName: yyDollar[5].str,
+				ColumnName:   yyDollar[7].str,
+			}
+		}
+	case 89:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line gram.y:673
 		{
 			yyVAL.ds = &DistributionDefinition{
 				ID:       yyDollar[2].str,
 				ColTypes: yyDollar[3].strlist,
 			}
 		}
-	case 73:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:576
+//line gram.y:681
 		{
 			yyVAL.strlist = yyDollar[3].strlist
 		}
-	case 74:
+	case 91:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line gram.y:578
+//line gram.y:683
 		{
 			/* empty column types should be prohibited */
 			yyVAL.strlist = nil
 		}
-	case 75:
+	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:584
+//line gram.y:689
 		{
 			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
 		}
-	case 76:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:586
+//line gram.y:691
 		{
 			yyVAL.strlist = []string{
 				yyDollar[1].str,
 			}
 		}
-	case 77:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:593
+//line gram.y:698
 		{
 			yyVAL.str = "varchar"
 		}
-	case 78:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:595
+//line gram.y:700
 		{
 			yyVAL.str = "integer"
 		}
-	case 79:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:597
+//line gram.y:702
 		{
 			yyVAL.str = "integer"
 		}
-	case 80:
+	case 97:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line gram.y:603
+//line gram.y:708
 		{
 			yyVAL.sharding_rule = &ShardingRuleDefinition{ID: yyDollar[3].str, TableName: yyDollar[4].str, Entries: yyDollar[5].entrieslist, Distribution: yyDollar[6].str}
 		}
-	case 81:
+	case 98:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line gram.y:608
+//line gram.y:713
 		{
 			str, err := randomHex(6)
 			if err != nil {
@@ -1290,91 +1456,91 @@ yydefault:
 			}
 			yyVAL.sharding_rule = &ShardingRuleDefinition{ID: "shrule" + str, TableName: yyDollar[3].str, Entries: yyDollar[4].entrieslist, Distribution: yyDollar[5].str}
 		}
-	case 82:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:617
+//line gram.y:722
 		{
 			yyVAL.entrieslist = make([]ShardingRuleEntry, 0)
 			yyVAL.entrieslist = append(yyVAL.entrieslist, yyDollar[1].shruleEntry)
 		}
-	case 83:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:623
+//line gram.y:728
 		{
 			yyVAL.entrieslist = append(yyDollar[1].entrieslist, yyDollar[2].shruleEntry)
 		}
-	case 84:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:629
+//line gram.y:734
 		{
 			yyVAL.shruleEntry = ShardingRuleEntry{
 				Column:       yyDollar[1].str,
 				HashFunction: yyDollar[2].str,
 			}
 		}
-	case 85:
+	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:638
+//line gram.y:743
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 86:
+	case 103:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line gram.y:641
+//line gram.y:746
 		{
 			yyVAL.str = ""
 		}
-	case 87:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:645
+//line gram.y:750
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 88:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:650
+//line gram.y:755
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 89:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:656
+//line gram.y:761
 		{
 			yyVAL.str = "identity"
 		}
-	case 90:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:658
+//line gram.y:763
 		{
 			yyVAL.str = "murmur"
 		}
-	case 91:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:660
+//line gram.y:765
 		{
 			yyVAL.str = "city"
 		}
-	case 92:
+	case 109:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:666
+//line gram.y:771
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 93:
+	case 110:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line gram.y:668
+//line gram.y:773
 		{
 			yyVAL.str = ""
 		}
-	case 94:
+	case 111:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:673
+//line gram.y:778
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 95:
+	case 112:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line gram.y:679
+//line gram.y:784
 		{
 			yyVAL.kr = &KeyRangeDefinition{
 				KeyRangeID:   yyDollar[3].str,
@@ -1383,9 +1549,9 @@ yydefault:
 				Distribution: yyDollar[9].str,
 			}
 		}
-	case 96:
+	case 113:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line gram.y:688
+//line gram.y:793
 		{
 			yyVAL.kr = &KeyRangeDefinition{
 				KeyRangeID:   yyDollar[3].str,
@@ -1394,9 +1560,9 @@ yydefault:
 				Distribution: yyDollar[9].str,
 			}
 		}
-	case 97:
+	case 114:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line gram.y:697
+//line gram.y:802
 		{
 			str, err := randomHex(6)
 			if err != nil {
@@ -1409,9 +1575,9 @@ yydefault:
 				KeyRangeID:   "kr" + str,
 			}
 		}
-	case 98:
+	case 115:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line gram.y:710
+//line gram.y:815
 		{
 			str, err := randomHex(6)
 			if err != nil {
@@ -1424,15 +1590,21 @@ yydefault:
 				Distribution: yyDollar[8].str,
 			}
 		}
-	case 99:
+	case 116:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line gram.y:725
+//line gram.y:830
 		{
 			yyVAL.shard = &ShardDefinition{Id: yyDollar[2].str, Hosts: yyDollar[5].strlist}
 		}
-	case 100:
+	case 117:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line gram.y:835
+		{
+			yyVAL.shard = &ShardDefinition{Id: yyDollar[2].str, Hosts: yyDollar[5].strlist, SchemaFrom: yyDollar[9].str}
+		}
+	case 118:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line gram.y:730
+//line gram.y:840
 		{
 			str, err := randomHex(6)
 			if err != nil {
@@ -1440,99 +1612,129 @@ yydefault:
 			}
 			yyVAL.shard = &ShardDefinition{Id: "shard" + str, Hosts: yyDollar[4].strlist}
 		}
-	case 101:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:740
+//line gram.y:850
 		{
 			yyVAL.strlist = []string{yyDollar[1].str}
 		}
-	case 102:
+	case 120:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:745
+//line gram.y:855
 		{
 			yyVAL.strlist = append(yyDollar[1].strlist, yyDollar[3].str)
 		}
-	case 103:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:751
+//line gram.y:861
 		{
 			yyVAL.unlock = &Unlock{KeyRangeID: yyDollar[2].key_range_selector.KeyRangeID}
 		}
-	case 104:
+	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:757
+//line gram.y:867
 		{
 			yyVAL.sharding_rule_selector = &ShardingRuleSelector{ID: yyDollar[3].str}
 		}
-	case 105:
+	case 123:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:763
+//line gram.y:873
 		{
 			yyVAL.key_range_selector = &KeyRangeSelector{KeyRangeID: yyDollar[3].str}
 		}
-	case 106:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:769
+//line gram.y:879
 		{
 			yyVAL.distribution_selector = &DistributionSelector{ID: yyDollar[2].str}
 		}
-	case 107:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line gram.y:775
+	case 125:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line gram.y:885
 		{
-			yyVAL.split = &SplitKeyRange{KeyRangeID: yyDollar[2].key_range_selector.KeyRangeID, KeyRangeFromID: yyDollar[4].str, Border: []byte(yyDollar[6].str)}
+			yyVAL.split = &SplitKeyRange{KeyRangeID: yyDollar[2].key_range_selector.KeyRangeID, KeyRangeFromID: yyDollar[4].str, Border: []byte(yyDollar[6].str), NoWait: yyDollar[7].bool}
 		}
-	case 108:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:781
+//line gram.y:891
 		{
 			yyVAL.kill = &Kill{Cmd: yyDollar[2].str, Target: yyDollar[3].uinteger}
 		}
-	case 109:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:784
+//line gram.y:894
 		{
 			yyVAL.kill = &Kill{Cmd: "client", Target: yyDollar[3].uinteger}
 		}
-	case 110:
+	case 128:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line gram.y:900
+		{
+			yyVAL.move = &MoveKeyRange{KeyRangeID: yyDollar[2].key_range_selector.KeyRangeID, DestShardID: yyDollar[4].str, NoWait: yyDollar[5].bool}
+		}
+	case 129:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line gram.y:906
+		{
+			yyVAL.unite = &UniteKeyRange{KeyRangeIDL: yyDollar[2].key_range_selector.KeyRangeID, KeyRangeIDR: yyDollar[4].str, NoWait: yyDollar[5].bool}
+		}
+	case 130:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line gram.y:912
+		{
+			yyVAL.cancel_operation = &CancelOperation{ID: yyDollar[3].str}
+		}
+	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line gram.y:790
+//line gram.y:918
 		{
-			yyVAL.move = &MoveKeyRange{KeyRangeID: yyDollar[2].key_range_selector.KeyRangeID, DestShardID: yyDollar[4].str}
+			yyVAL.drain_shard = &DrainShard{ID: yyDollar[3].str, NoWait: yyDollar[4].bool}
 		}
-	case 111:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line gram.y:796
+//line gram.y:924
 		{
-			yyVAL.unite = &UniteKeyRange{KeyRangeIDL: yyDollar[2].key_range_selector.KeyRangeID, KeyRangeIDR: yyDollar[4].str}
+			yyVAL.check_reference = &CheckReferenceRelation{RelationName: yyDollar[4].str}
 		}
-	case 112:
+	case 133:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line gram.y:802
+//line gram.y:930
+		{
+			yyVAL.check_schema = &CheckSchema{}
+		}
+	case 134:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line gram.y:934
+		{
+			yyVAL.check_schema = &CheckSchema{RelationName: yyDollar[3].str}
+		}
+	case 135:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line gram.y:940
 		{
 			yyVAL.listen = &Listen{addr: yyDollar[2].str}
 		}
-	case 113:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line gram.y:808
+//line gram.y:946
 		{
 			yyVAL.shutdown = &Shutdown{}
 		}
-	case 114:
+	case 137:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line gram.y:816
+//line gram.y:954
 		{
 			yyVAL.register_router = &RegisterRouter{ID: yyDollar[3].str, Addr: yyDollar[5].str}
 		}
-	case 115:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:822
+//line gram.y:960
 		{
 			yyVAL.unregister_router = &UnregisterRouter{ID: yyDollar[3].str}
 		}
-	case 116:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line gram.y:827
+//line gram.y:965
 		{
 			yyVAL.unregister_router = &UnregisterRouter{ID: `*`}
 		}
@@ -34,6 +34,7 @@ func randomHex(n int) (string, error) {
     set                    *Set
 	statement              Statement
 	show                   *Show
+	show_key_range         *ShowKeyRange
 
 	drop                   *Drop
 	create                 *Create
@@ -45,6 +46,8 @@ func randomHex(n int) (string, error) {
 	ds                     *DistributionDefinition
 	kr                     *KeyRangeDefinition
 	shard                  *ShardDefinition
+	reference_relation     *ReferenceRelationDefinition
+	sequence               *SequenceDefinition
 	sharding_rule          *ShardingRuleDefinition
 
 	register_router        *RegisterRouter
@@ -53,6 +56,10 @@ func randomHex(n int) (string, error) {
 	split                  *SplitKeyRange
 	move                   *MoveKeyRange
 	unite                  *UniteKeyRange
+	cancel_operation       *CancelOperation
+	drain_shard            *DrainShard
+	check_reference        *CheckReferenceRelation
+	check_schema           *CheckSchema
 
 	shutdown               *Shutdown
 	listen                 *Listen
@@ -139,6 +146,14 @@ func randomHex(n int) (string, error) {
 
 %token<str> TASK GROUP
 
+%token<str> CANCEL OPERATION NOWAIT DRAIN
+
+%token<str> REFERENCE CHECK
+
+%token<str> SEQUENCE SCHEMA
+
+%token<str> KEY_RANGE
+
 %token<str> VARCHAR INTEGER INT TYPES
 
 /* any operator */
@@ -153,6 +168,7 @@ func randomHex(n int) (string, error) {
 %type <str> kill_statement_type
 
 %type <show> show_stmt
+%type <show_key_range> show_key_range_stmt
 %type <kill> kill_stmt
 
 %type <drop> drop_stmt
@@ -165,6 +181,8 @@ func randomHex(n int) (string, error) {
 %type <sharding_rule> sharding_rule_define_stmt
 %type <kr> key_range_define_stmt
 %type <shard> shard_define_stmt
+%type <reference_relation> reference_relation_define_stmt
+%type <sequence> sequence_define_stmt
 
 %type<entrieslist> sharding_rule_argument_list
 %type<dEntrieslist> distribution_key_argument_list
@@ -186,9 +204,9 @@ func randomHex(n int) (string, error) {
 
 %type<distributed_relation> distributed_relation_def
 
-%type<strlist> col_types_list opt_col_types hosts_list
+%type<strlist> col_types_list opt_col_types hosts_list any_val_list
 %type<str> col_types_elem
-%type<bool> opt_cascade
+%type<bool> opt_cascade opt_nowait
 
 
 %type <unlock> unlock_stmt
@@ -198,6 +216,10 @@ func randomHex(n int) (string, error) {
 %type <split> split_key_range_stmt
 %type <move> move_key_range_stmt
 %type <unite> unite_key_range_stmt
+%type <cancel_operation> cancel_operation_stmt
+%type <drain_shard> drain_shard_stmt
+%type <check_reference> check_reference_relation_stmt
+%type <check_schema> check_schema_stmt
 %type <register_router> register_router_stmt
 %type <unregister_router> unregister_router_stmt
 %start any_command
@@ -246,6 +268,10 @@ command:
 	{
 		setParseTree(yylex, $1)
 	}
+	| show_key_range_stmt
+	{
+		setParseTree(yylex, $1)
+	}
 	| kill_stmt
 	{
 		setParseTree(yylex, $1)
@@ -270,6 +296,22 @@ command:
 	{
 	   setParseTree(yylex, $1)
 	}
+	| cancel_operation_stmt
+	{
+		setParseTree(yylex, $1)
+	}
+	| drain_shard_stmt
+	{
+		setParseTree(yylex, $1)
+	}
+	| check_reference_relation_stmt
+	{
+		setParseTree(yylex, $1)
+	}
+	| check_schema_stmt
+	{
+		setParseTree(yylex, $1)
+	}
 	| register_router_stmt
 	{
 		setParseTree(yylex, $1)
@@ -365,7 +407,7 @@ show_statement_type:
 	IDENT
 	{
 		switch v := strings.ToLower(string($1)); v {
-		case DatabasesStr, RoutersStr, PoolsStr, ShardsStr, BackendConnectionsStr, KeyRangesStr, ShardingRules, ClientsStr, StatusStr, DistributionsStr, VersionStr, RelationsStr, TaskGroupStr:
+		case DatabasesStr, RoutersStr, PoolsStr, ShardsStr, BackendConnectionsStr, KeyRangesStr, ShardingRules, ClientsStr, StatusStr, DistributionsStr, VersionStr, RelationsStr, TaskGroupStr, OperationsStr, SequencesStr, SchemaChangesStr:
 			$$ = v
 		default:
 			$$ = UnsupportedStr
@@ -386,6 +428,9 @@ kill_statement_type:
 opt_cascade:
 	CASCADE { $$ = true } | {$$ = false}
 
+opt_nowait:
+	NOWAIT { $$ = true } | {$$ = false}
+
 drop_stmt:
 	DROP key_range_stmt
 	{
@@ -419,6 +464,10 @@ drop_stmt:
 	{
 		$$ = &Drop{Element: &TaskGroupSelector{}}
 	}
+	| DROP SEQUENCE any_id
+	{
+		$$ = &Drop{Element: &SequenceSelector{Name: $3}}
+	}
 
 add_stmt:
 	// TODO: drop
@@ -505,6 +554,14 @@ distribution_key_entry:
 			HashFunction: $2,
 		}
 	}
+	| any_id TOPENBR any_id TCLOSEBR opt_hash_function_clause
+	{
+		$$ = DistributionKeyEntry {
+			Column: $3,
+			HashFunction: $5,
+			Expression: $1,
+		}
+	}
 
 distributed_relation_def:
 	RELATION any_id DISTRIBUTION KEY distribution_key_argument_list
@@ -544,6 +601,14 @@ create_stmt:
 		$$ = &Create{Element: $2}
 	}|
 	CREATE shard_define_stmt
+	{
+		$$ = &Create{Element: $2}
+	}|
+	CREATE reference_relation_define_stmt
+	{
+		$$ = &Create{Element: $2}
+	}|
+	CREATE sequence_define_stmt
 	{
 		$$ = &Create{Element: $2}
 	}
@@ -555,6 +620,22 @@ show_stmt:
 		$$ = &Show{Cmd: $2, Where: $3}
 	}
 
+show_key_range_stmt:
+	SHOW KEY_RANGE FOR DISTRIBUTION any_id KEY any_val_list
+	{
+		$$ = &ShowKeyRange{Distribution: $5, Keys: $7}
+	}
+
+any_val_list:
+	any_val
+	{
+		$$ = []string{$1}
+	} |
+	any_val_list TCOMMA any_val
+	{
+		$$ = append($1, $3)
+	}
+
 lock_stmt:
 	LOCK key_range_stmt
 	{
@@ -563,6 +644,30 @@ lock_stmt:
 	// or lock someting else
 
 
+reference_relation_define_stmt:
+	REFERENCE RELATION any_id
+	{
+		$$ = &ReferenceRelationDefinition{
+			TableName: $3,
+		}
+	}
+
+sequence_define_stmt:
+	SEQUENCE any_id
+	{
+		$$ = &SequenceDefinition{
+			Name: $2,
+		}
+	}
+	| SEQUENCE any_id FOR RELATION any_id COLUMN any_id
+	{
+		$$ = &SequenceDefinition{
+			Name:         $2,
+			RelationName: $5,
+			ColumnName:   $7,
+		}
+	}
+
 distribution_define_stmt:
 	DISTRIBUTION any_id opt_col_types
 	{
@@ -726,6 +831,11 @@ shard_define_stmt:
 		$$ = &ShardDefinition{Id: $2, Hosts: $5}
 	}
 	|
+	SHARD any_id WITH HOSTS hosts_list WITH SCHEMA FROM any_id
+	{
+		$$ = &ShardDefinition{Id: $2, Hosts: $5, SchemaFrom: $9}
+	}
+	|
 	SHARD WITH HOSTS hosts_list
 	{
 		str, err := randomHex(6)
@@ -771,9 +881,9 @@ distribution_select_stmt:
 	}
 
 split_key_range_stmt:
-	SPLIT key_range_stmt FROM any_id BY any_val
+	SPLIT key_range_stmt FROM any_id BY any_val opt_nowait
 	{
-		$$ = &SplitKeyRange{KeyRangeID: $2.KeyRangeID, KeyRangeFromID: $4, Border: []byte($6)}
+		$$ = &SplitKeyRange{KeyRangeID: $2.KeyRangeID, KeyRangeFromID: $4, Border: []byte($6), NoWait: $7}
 	}
 
 kill_stmt:
@@ -786,15 +896,43 @@ kill_stmt:
 	}
 
 move_key_range_stmt:
-	MOVE key_range_stmt TO any_id
+	MOVE key_range_stmt TO any_id opt_nowait
 	{
-		$$ = &MoveKeyRange{KeyRangeID: $2.KeyRangeID, DestShardID: $4}
+		$$ = &MoveKeyRange{KeyRangeID: $2.KeyRangeID, DestShardID: $4, NoWait: $5}
 	}
 
 unite_key_range_stmt:
-	UNITE key_range_stmt WITH any_id
+	UNITE key_range_stmt WITH any_id opt_nowait
+	{
+		$$ = &UniteKeyRange{KeyRangeIDL: $2.KeyRangeID, KeyRangeIDR: $4, NoWait: $5}
+	}
+
+cancel_operation_stmt:
+	CANCEL OPERATION any_val
+	{
+		$$ = &CancelOperation{ID: $3}
+	}
+
+drain_shard_stmt:
+	DRAIN SHARD any_id opt_nowait
+	{
+		$$ = &DrainShard{ID: $3, NoWait: $4}
+	}
+
+check_reference_relation_stmt:
+	CHECK REFERENCE RELATION any_id
+	{
+		$$ = &CheckReferenceRelation{RelationName: $4}
+	}
+
+check_schema_stmt:
+	CHECK SCHEMA
+	{
+		$$ = &CheckSchema{}
+	}
+	| CHECK SCHEMA any_id
 	{
-		$$ = &UniteKeyRange{KeyRangeIDL: $2.KeyRangeID, KeyRangeIDR: $4}
+		$$ = &CheckSchema{RelationName: $3}
 	}
 
 listen_stmt:
@@ -61,4 +61,13 @@ var reservedWords = map[string]int{
 	"hosts":        HOSTS,
 	"task":         TASK,
 	"group":        GROUP,
+	"cancel":       CANCEL,
+	"operation":    OPERATION,
+	"nowait":       NOWAIT,
+	"drain":        DRAIN,
+	"key_range":    KEY_RANGE,
+	"reference":    REFERENCE,
+	"check":        CHECK,
+	"sequence":     SEQUENCE,
+	"schema":       SCHEMA,
 }
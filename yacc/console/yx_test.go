@@ -111,6 +111,22 @@ func TestSimpleShow(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			query: "SHOW key_range FOR DISTRIBUTION ds1 KEY 42",
+			exp: &spqrparser.ShowKeyRange{
+				Distribution: "ds1",
+				Keys:         []string{"42"},
+			},
+			err: nil,
+		},
+		{
+			query: "SHOW key_range FOR DISTRIBUTION ds1 KEY 42, 'abc'",
+			exp: &spqrparser.ShowKeyRange{
+				Distribution: "ds1",
+				Keys:         []string{"42", "abc"},
+			},
+			err: nil,
+		},
 		{
 			query: "kill client 824636929312;",
 			exp: &spqrparser.Kill{
@@ -315,6 +331,85 @@ func TestSplitKeyRange(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			query: "SPLIT KEY RANGE krid3 FROM krid1 BY 5 NOWAIT;",
+			exp: &spqrparser.SplitKeyRange{
+				Border:         []byte("5"),
+				KeyRangeFromID: "krid1",
+				KeyRangeID:     "krid3",
+				NoWait:         true,
+			},
+			err: nil,
+		},
+	} {
+
+		tmp, err := spqrparser.Parse(tt.query)
+
+		assert.NoError(err, "query %s", tt.query)
+
+		assert.Equal(tt.exp, tmp, "query %s", tt.query)
+	}
+}
+
+func TestOperations(t *testing.T) {
+
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   spqrparser.Statement
+		err   error
+	}
+
+	for _, tt := range []tcase{
+		{
+			query: "MOVE KEY RANGE krid1 TO sh2 NOWAIT",
+			exp: &spqrparser.MoveKeyRange{
+				KeyRangeID:  "krid1",
+				DestShardID: "sh2",
+				NoWait:      true,
+			},
+			err: nil,
+		},
+		{
+			query: "UNITE KEY RANGE krid1 WITH krid2 NOWAIT",
+			exp: &spqrparser.UniteKeyRange{
+				KeyRangeIDL: "krid1",
+				KeyRangeIDR: "krid2",
+				NoWait:      true,
+			},
+			err: nil,
+		},
+		{
+			query: "CANCEL OPERATION '2d5f8f1c-3a4e-4f6b-9b1d-0c6a0e1d2f3a'",
+			exp: &spqrparser.CancelOperation{
+				ID: "2d5f8f1c-3a4e-4f6b-9b1d-0c6a0e1d2f3a",
+			},
+			err: nil,
+		},
+		{
+			query: "DRAIN SHARD sh1",
+			exp: &spqrparser.DrainShard{
+				ID: "sh1",
+			},
+			err: nil,
+		},
+		{
+			query: "DRAIN SHARD sh1 NOWAIT",
+			exp: &spqrparser.DrainShard{
+				ID:     "sh1",
+				NoWait: true,
+			},
+			err: nil,
+		},
+		{
+			query: "SHOW operations",
+			exp: &spqrparser.Show{
+				Cmd:   spqrparser.OperationsStr,
+				Where: spqrparser.WhereClauseEmpty{},
+			},
+			err: nil,
+		},
 	} {
 
 		tmp, err := spqrparser.Parse(tt.query)
@@ -460,6 +555,30 @@ func TestAlter(t *testing.T) {
 			err: nil,
 		},
 
+		{
+			query: "ALTER DISTRIBUTION ds1 ATTACH RELATION users DISTRIBUTION KEY lower(email) HASH FUNCTION murmur;",
+			exp: &spqrparser.Alter{
+				Element: &spqrparser.AlterDistribution{
+					Element: &spqrparser.AttachRelation{
+						Relations: []*spqrparser.DistributedRelation{
+							{
+								Name: "users",
+								DistributionKey: []spqrparser.DistributionKeyEntry{
+									{
+										Column:       "email",
+										HashFunction: "murmur",
+										Expression:   "lower",
+									},
+								},
+							},
+						},
+						Distribution: &spqrparser.DistributionSelector{ID: "ds1"},
+					},
+				},
+			},
+			err: nil,
+		},
+
 		{
 			query: "ALTER DISTRIBUTION ds1 DETACH RELATION t;",
 			exp: &spqrparser.Alter{
@@ -562,6 +681,17 @@ func TestShard(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			query: "ADD SHARD sh3 WITH HOSTS localhost:6432 WITH SCHEMA FROM sh1",
+			exp: &spqrparser.Create{
+				Element: &spqrparser.ShardDefinition{
+					Id:         "sh3",
+					Hosts:      []string{"localhost:6432"},
+					SchemaFrom: "sh1",
+				},
+			},
+			err: nil,
+		},
 		{
 			query: "DROP SHARD sh1;",
 			exp: &spqrparser.Drop{
@@ -580,3 +710,118 @@ func TestShard(t *testing.T) {
 		assert.Equal(tt.exp, tmp, "query %s", tt.query)
 	}
 }
+
+func TestReferenceRelation(t *testing.T) {
+
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   spqrparser.Statement
+		err   error
+	}
+
+	for _, tt := range []tcase{
+		{
+			query: "CREATE REFERENCE RELATION currencies;",
+			exp: &spqrparser.Create{
+				Element: &spqrparser.ReferenceRelationDefinition{
+					TableName: "currencies",
+				},
+			},
+			err: nil,
+		},
+		{
+			query: "CHECK REFERENCE RELATION currencies",
+			exp: &spqrparser.CheckReferenceRelation{
+				RelationName: "currencies",
+			},
+			err: nil,
+		},
+		{
+			query: "CHECK SCHEMA",
+			exp:   &spqrparser.CheckSchema{},
+			err:   nil,
+		},
+		{
+			query: "CHECK SCHEMA orders",
+			exp: &spqrparser.CheckSchema{
+				RelationName: "orders",
+			},
+			err: nil,
+		},
+		{
+			query: "SHOW schema_changes",
+			exp: &spqrparser.Show{
+				Cmd:   spqrparser.SchemaChangesStr,
+				Where: spqrparser.WhereClauseEmpty{},
+			},
+			err: nil,
+		},
+	} {
+
+		tmp, err := spqrparser.Parse(tt.query)
+
+		assert.NoError(err, "query %s", tt.query)
+
+		assert.Equal(tt.exp, tmp, "query %s", tt.query)
+	}
+}
+
+func TestSequence(t *testing.T) {
+
+	assert := assert.New(t)
+
+	type tcase struct {
+		query string
+		exp   spqrparser.Statement
+		err   error
+	}
+
+	for _, tt := range []tcase{
+		{
+			query: "CREATE SEQUENCE seq1;",
+			exp: &spqrparser.Create{
+				Element: &spqrparser.SequenceDefinition{
+					Name: "seq1",
+				},
+			},
+			err: nil,
+		},
+		{
+			query: "CREATE SEQUENCE orders_id FOR RELATION orders COLUMN id",
+			exp: &spqrparser.Create{
+				Element: &spqrparser.SequenceDefinition{
+					Name:         "orders_id",
+					RelationName: "orders",
+					ColumnName:   "id",
+				},
+			},
+			err: nil,
+		},
+		{
+			query: "DROP SEQUENCE orders_id",
+			exp: &spqrparser.Drop{
+				Element: &spqrparser.SequenceSelector{
+					Name: "orders_id",
+				},
+			},
+			err: nil,
+		},
+		{
+			query: "SHOW sequences",
+			exp: &spqrparser.Show{
+				Cmd:   spqrparser.SequencesStr,
+				Where: spqrparser.WhereClauseEmpty{},
+			},
+			err: nil,
+		},
+	} {
+
+		tmp, err := spqrparser.Parse(tt.query)
+
+		assert.NoError(err, "query %s", tt.query)
+
+		assert.Equal(tt.exp, tmp, "query %s", tt.query)
+	}
+}